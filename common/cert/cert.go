@@ -78,11 +78,24 @@ type SerialGenerator interface {
 
 type DefaultSerialGenerator struct{}
 
+// Generate returns a random, positive serial number of up to 128 bits. Revocation is tracked by serial number, so
+// serials must not repeat for the lifetime of the signer. RFC 5280 allows up to 20 octets.
 func (DefaultSerialGenerator) Generate() *big.Int {
-	//@todo this need to be better, this does not include negative numbers for 20bit values, nor is this managed
-	r, _ := rand.Int(rand.Reader, big.NewInt(524287))
+	limit := new(big.Int).Lsh(big.NewInt(1), 127)
+	r, _ := rand.Int(rand.Reader, limit)
 
-	return r
+	return r.Add(r, big.NewInt(1))
+}
+
+// RevocationInfo holds the locations relying parties can check the revocation status of issued certificates at
+type RevocationInfo struct {
+	CRLDistributionPoints []string
+	OCSPServer            []string
+}
+
+func (ri *RevocationInfo) Apply(c *x509.Certificate) {
+	c.CRLDistributionPoints = ri.CRLDistributionPoints
+	c.OCSPServer = ri.OCSPServer
 }
 
 var _ Signer = &ServerSigner{}
@@ -91,6 +104,7 @@ type ServerSigner struct {
 	caCert          *x509.Certificate
 	caKey           crypto.PrivateKey
 	SerialGenerator SerialGenerator
+	RevocationInfo  RevocationInfo
 }
 
 func (s *ServerSigner) Cert() *x509.Certificate {
@@ -134,6 +148,8 @@ func (s *ServerSigner) SignCsr(csr *x509.CertificateRequest, opts *SigningOpts)
 		IsCA:         false,
 	}
 
+	s.RevocationInfo.Apply(&certTemplate)
+
 	if opts != nil {
 		opts.Apply(&certTemplate)
 	}
@@ -153,6 +169,7 @@ type ClientSigner struct {
 	caCert          *x509.Certificate
 	caKey           crypto.PrivateKey
 	SerialGenerator SerialGenerator
+	RevocationInfo  RevocationInfo
 }
 
 func (s *ClientSigner) Cert() *x509.Certificate {
@@ -197,6 +214,8 @@ func (s *ClientSigner) SignCsr(csr *x509.CertificateRequest, opts *SigningOpts)
 		IsCA:         false,
 	}
 
+	s.RevocationInfo.Apply(&certTemplate)
+
 	if opts != nil {
 		opts.Apply(&certTemplate)
 	}
@@ -33,16 +33,18 @@ func (self *CircuitInspectDetail) IncludeGoroutines() bool {
 }
 
 type XgressDetail struct {
-	Address               string                  `json:"address"`
-	Originator            string                  `json:"originator"`
-	TimeSinceLastLinkRx   string                  `json:"timeSinceLastLinkRx"`
-	SendBufferDetail      *XgressSendBufferDetail `json:"sendBufferDetail"`
-	RecvBufferDetail      *XgressRecvBufferDetail `json:"recvBufferDetail"`
-	XgressPointer         string                  `json:"xgressPointer"`
-	LinkSendBufferPointer string                  `json:"linkSendBufferPointer"`
-	Goroutines            []string                `json:"goroutines"`
-	Sequence              int32                   `json:"sequence"`
-	Flags                 string                  `json:"flags"`
+	Address               string                        `json:"address"`
+	Originator            string                        `json:"originator"`
+	TimeSinceLastLinkRx   string                        `json:"timeSinceLastLinkRx"`
+	SendBufferDetail      *XgressSendBufferDetail       `json:"sendBufferDetail"`
+	RecvBufferDetail      *XgressRecvBufferDetail       `json:"recvBufferDetail"`
+	FecDetail             *XgressFecDetail              `json:"fecDetail,omitempty"`
+	BandwidthLimits       []*XgressBandwidthLimitDetail `json:"bandwidthLimits,omitempty"`
+	XgressPointer         string                        `json:"xgressPointer"`
+	LinkSendBufferPointer string                        `json:"linkSendBufferPointer"`
+	Goroutines            []string                      `json:"goroutines"`
+	Sequence              int32                         `json:"sequence"`
+	Flags                 string                        `json:"flags"`
 }
 
 type XgressSendBufferDetail struct {
@@ -61,6 +63,20 @@ type XgressSendBufferDetail struct {
 	TimeSinceLastRetx     string  `json:"timeSinceLastRetx"`
 	CloseWhenEmpty        bool    `json:"closeWhenEmpty"`
 	AcquiredSafely        bool    `json:"acquiredSafely"`
+
+	CongestionControl *XgressCongestionControlDetail `json:"congestionControl,omitempty"`
+}
+
+type XgressFecDetail struct {
+	GroupSize          uint32 `json:"groupSize"`
+	PeerSupported      bool   `json:"peerSupported"`
+	ParityPayloadsSent uint64 `json:"parityPayloadsSent"`
+	RecoveredPayloads  uint64 `json:"recoveredPayloads"`
+}
+
+type XgressCongestionControlDetail struct {
+	Algorithm string                 `json:"algorithm"`
+	State     map[string]interface{} `json:"state"`
 }
 
 type XgressRecvBufferDetail struct {
@@ -72,3 +88,17 @@ type XgressRecvBufferDetail struct {
 	NextPayload    string `json:"nextPayload"`
 	AcquiredSafely bool   `json:"acquiredSafely"`
 }
+
+type XgressBandwidthLimitDetail struct {
+	Id                 string `json:"id"`
+	Name               string `json:"name"`
+	Scope              string `json:"scope"`
+	RateBytesPerSecond uint64 `json:"rateBytesPerSecond"`
+	BurstBytes         uint64 `json:"burstBytes"`
+	RxShapedPayloads   uint64 `json:"rxShapedPayloads"`
+	TxShapedPayloads   uint64 `json:"txShapedPayloads"`
+	RxShapedBytes      uint64 `json:"rxShapedBytes"`
+	TxShapedBytes      uint64 `json:"txShapedBytes"`
+	RxDelay            string `json:"rxDelay"`
+	TxDelay            string `json:"txDelay"`
+}
@@ -36,6 +36,38 @@ type LinkInspectDetail struct {
 	Dest        string `json:"dest"`
 	DestVersion string `json:"destVersion"`
 	Dialed      bool   `json:"dialed"`
+
+	Qos  []*LinkQosDetail `json:"qos,omitempty"`
+	Bond *LinkBondDetail  `json:"bond,omitempty"`
+}
+
+// LinkQosDetail shows the state of the priority class scheduler for one of a link's channels
+type LinkQosDetail struct {
+	Channel string                         `json:"channel"`
+	Mode    string                         `json:"mode"`
+	Classes map[string]*LinkQosClassDetail `json:"classes"`
+}
+
+type LinkQosClassDetail struct {
+	QueueDepth int    `json:"queueDepth"`
+	Weight     uint32 `json:"weight,omitempty"`
+	Sent       uint64 `json:"sent"`
+	Dropped    uint64 `json:"dropped"`
+}
+
+// LinkBondDetail shows the members of a bonded link and whether payloads are currently striped over them
+type LinkBondDetail struct {
+	Members        []*LinkBondMemberDetail `json:"members"`
+	ReorderBuffers int                     `json:"reorderBuffers"`
+}
+
+type LinkBondMemberDetail struct {
+	LinkId   string  `json:"linkId"`
+	Healthy  bool    `json:"healthy"`
+	Reason   string  `json:"reason,omitempty"`
+	Latency  string  `json:"latency"`
+	LossRate float64 `json:"lossRate"`
+	Sent     uint64  `json:"sent"`
 }
 
 type LinkDest struct {
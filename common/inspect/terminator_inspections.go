@@ -37,3 +37,24 @@ type SdkTerminatorInspectDetail struct {
 	CreateTime      string `json:"createTime"`
 	LastAttempt     string `json:"lastAttempt"`
 }
+
+type TerminatorLatencyInspectResult struct {
+	Terminators []*TerminatorLatencyInspectDetail `json:"terminators"`
+}
+
+type TerminatorLatencyInspectDetail struct {
+	TerminatorId           string `json:"terminatorId"`
+	ServiceId              string `json:"serviceId"`
+	DialSamples            int    `json:"dialSamples"`
+	DialLatencyP50         string `json:"dialLatencyP50"`
+	DialLatencyP95         string `json:"dialLatencyP95"`
+	DialSuccesses          uint64 `json:"dialSuccesses"`
+	DialFailures           uint64 `json:"dialFailures"`
+	ConsecutiveFailures    uint32 `json:"consecutiveFailures"`
+	CircuitDurationSamples int    `json:"circuitDurationSamples"`
+	CircuitDurationP50     string `json:"circuitDurationP50"`
+	CircuitDurationP95     string `json:"circuitDurationP95"`
+	Ejected                bool   `json:"ejected"`
+	EjectedUntil           string `json:"ejectedUntil,omitempty"`
+	EjectionCount          uint32 `json:"ejectionCount"`
+}
@@ -206,6 +206,17 @@ func (c *AccessClaims) TotpComplete() bool {
 	return false
 }
 
+// MfaComplete returns true if a second factor, TOTP or WebAuthn, was passed
+func (c *AccessClaims) MfaComplete() bool {
+	for _, amr := range c.AuthenticationMethodsReferences {
+		if amr == "totp" || amr == "webauthn" {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (c *AccessClaims) HasAudience(targetAud string) bool {
 	for _, aud := range c.Audience {
 		if aud == targetAud {
@@ -254,3 +265,14 @@ func (c *IdTokenClaims) TotpComplete() bool {
 
 	return false
 }
+
+// MfaComplete returns true if a second factor, TOTP or WebAuthn, was passed
+func (c *IdTokenClaims) MfaComplete() bool {
+	for _, amr := range c.AuthenticationMethodsReferences {
+		if amr == "totp" || amr == "webauthn" {
+			return true
+		}
+	}
+
+	return false
+}
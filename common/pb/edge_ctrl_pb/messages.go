@@ -3,7 +3,9 @@ package edge_ctrl_pb
 import (
 	"github.com/openziti/edge-api/rest_model"
 	"github.com/openziti/sdk-golang/ziti"
+	"github.com/openziti/ziti/common/schedule"
 	"github.com/openziti/ziti/controller/xt"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (m *ClientHello) GetContentType() int32 {
@@ -157,3 +159,50 @@ func (self TerminatorPrecedence) GetZitiLabel() rest_model.TerminatorPrecedence
 
 	return rest_model.TerminatorPrecedenceDefault
 }
+
+// NewPolicySchedule converts a policy schedule for distribution to routers. Returns nil if the schedule is unset.
+func NewPolicySchedule(policySchedule *schedule.Schedule) *DataState_PolicySchedule {
+	if !policySchedule.IsSet() {
+		return nil
+	}
+
+	result := &DataState_PolicySchedule{
+		Windows:  policySchedule.Windows,
+		Timezone: policySchedule.Timezone,
+	}
+
+	if policySchedule.ValidFrom != nil {
+		result.ValidFrom = timestamppb.New(*policySchedule.ValidFrom)
+	}
+
+	if policySchedule.ValidUntil != nil {
+		result.ValidUntil = timestamppb.New(*policySchedule.ValidUntil)
+	}
+
+	return result
+}
+
+// ToSchedule converts the distributed schedule back into a schedule which can be evaluated. A nil schedule converts
+// to nil, which is always in force.
+func (self *DataState_PolicySchedule) ToSchedule() *schedule.Schedule {
+	if self == nil {
+		return nil
+	}
+
+	result := &schedule.Schedule{
+		Windows:  self.Windows,
+		Timezone: self.Timezone,
+	}
+
+	if self.ValidFrom != nil {
+		validFrom := self.ValidFrom.AsTime()
+		result.ValidFrom = &validFrom
+	}
+
+	if self.ValidUntil != nil {
+		validUntil := self.ValidUntil.AsTime()
+		result.ValidUntil = &validUntil
+	}
+
+	return result
+}
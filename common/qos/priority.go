@@ -0,0 +1,89 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+// Package qos defines the priority classes which services assign to their circuits.
+//
+// The class is carried to routers in the route message and used by links to schedule payloads, so that interactive
+// traffic isn't stuck behind bulk transfers sharing the same link. The zero value is Standard, so circuits routed
+// by controllers which don't know about priority classes are scheduled as they always were.
+package qos
+
+import (
+	"fmt"
+	"strings"
+)
+
+type PriorityClass uint32
+
+const (
+	Standard PriorityClass = iota
+	Bulk
+	Interactive
+	Realtime
+
+	// ClassCount is the number of priority classes, and so the number of queues a link scheduler maintains
+	ClassCount = 4
+)
+
+var classNames = [ClassCount]string{"standard", "bulk", "interactive", "realtime"}
+
+// schedulingRanks maps classes to queues, with rank 0 being served first
+var schedulingRanks = [ClassCount]int{2, 3, 1, 0}
+
+func (self PriorityClass) String() string {
+	if self < ClassCount {
+		return classNames[self]
+	}
+	return fmt.Sprintf("unknown(%d)", uint32(self))
+}
+
+// Rank returns the scheduling rank of the class. Rank 0 is served first. Unknown classes, which may be sent by newer
+// controllers, are treated as Standard.
+func (self PriorityClass) Rank() int {
+	if self < ClassCount {
+		return schedulingRanks[self]
+	}
+	return schedulingRanks[Standard]
+}
+
+// ClassForRank returns the class which is scheduled at the given rank
+func ClassForRank(rank int) PriorityClass {
+	for class, classRank := range schedulingRanks {
+		if classRank == rank {
+			return PriorityClass(class)
+		}
+	}
+	return Standard
+}
+
+// ParsePriorityClass converts a class name to a PriorityClass. The empty string is Standard.
+func ParsePriorityClass(name string) (PriorityClass, error) {
+	if name == "" {
+		return Standard, nil
+	}
+	for class, className := range classNames {
+		if strings.EqualFold(name, className) {
+			return PriorityClass(class), nil
+		}
+	}
+	return Standard, fmt.Errorf("invalid priority class '%s', must be one of %s", name, strings.Join(classNames[:], ", "))
+}
+
+// IsValidPriorityClass returns true if the name is empty or names a known class
+func IsValidPriorityClass(name string) bool {
+	_, err := ParsePriorityClass(name)
+	return err == nil
+}
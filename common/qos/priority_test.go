@@ -0,0 +1,50 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package qos
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParsePriorityClass(t *testing.T) {
+	req := require.New(t)
+
+	class, err := ParsePriorityClass("")
+	req.NoError(err)
+	req.Equal(Standard, class)
+
+	class, err = ParsePriorityClass("Interactive")
+	req.NoError(err)
+	req.Equal(Interactive, class)
+
+	_, err = ParsePriorityClass("urgent")
+	req.Error(err)
+}
+
+func Test_Ranks(t *testing.T) {
+	req := require.New(t)
+
+	req.Equal(0, Realtime.Rank())
+	req.Equal(3, Bulk.Rank())
+	req.Equal(Standard.Rank(), PriorityClass(42).Rank())
+
+	for rank := 0; rank < ClassCount; rank++ {
+		req.Equal(rank, ClassForRank(rank).Rank())
+	}
+}
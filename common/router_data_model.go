@@ -9,16 +9,20 @@ import (
 	cmap "github.com/orcaman/concurrent-map/v2"
 	"io"
 	"os"
+	"sort"
+	"strings"
+	"time"
 )
 
 // AccessPolicies represents the Identity's access to a Service through many Policies. The PostureChecks provided
 // are referenced by the granting Policies. The PostureChecks for each of the Policies may be evaluated to determine
-// a valid policy and posture access path.
+// a valid policy and posture access path. If DeniedBy is set, a deny policy blocks access regardless of Policies.
 type AccessPolicies struct {
 	Identity      *Identity
 	Service       *edge_ctrl_pb.DataState_Service
 	Policies      []*ServicePolicy
 	PostureChecks map[string]*edge_ctrl_pb.DataState_PostureCheck
+	DeniedBy      *ServicePolicy
 }
 
 type DataStateIdentity = edge_ctrl_pb.DataState_Identity
@@ -45,12 +49,13 @@ type RouterDataModel struct {
 	EventCache
 	listeners map[chan *edge_ctrl_pb.DataState_ChangeSet]struct{}
 
-	Identities      cmap.ConcurrentMap[string, *Identity]                            `json:"identities"`
-	Services        cmap.ConcurrentMap[string, *edge_ctrl_pb.DataState_Service]      `json:"services"`
-	ServicePolicies cmap.ConcurrentMap[string, *ServicePolicy]                       `json:"servicePolicies"`
-	PostureChecks   cmap.ConcurrentMap[string, *edge_ctrl_pb.DataState_PostureCheck] `json:"postureChecks"`
-	PublicKeys      cmap.ConcurrentMap[string, *edge_ctrl_pb.DataState_PublicKey]    `json:"publicKeys"`
-	Revocations     cmap.ConcurrentMap[string, *edge_ctrl_pb.DataState_Revocation]   `json:"revocations"`
+	Identities      cmap.ConcurrentMap[string, *Identity]                              `json:"identities"`
+	Services        cmap.ConcurrentMap[string, *edge_ctrl_pb.DataState_Service]        `json:"services"`
+	ServicePolicies cmap.ConcurrentMap[string, *ServicePolicy]                         `json:"servicePolicies"`
+	PostureChecks   cmap.ConcurrentMap[string, *edge_ctrl_pb.DataState_PostureCheck]   `json:"postureChecks"`
+	PublicKeys      cmap.ConcurrentMap[string, *edge_ctrl_pb.DataState_PublicKey]      `json:"publicKeys"`
+	Revocations     cmap.ConcurrentMap[string, *edge_ctrl_pb.DataState_Revocation]     `json:"revocations"`
+	BandwidthLimits cmap.ConcurrentMap[string, *edge_ctrl_pb.DataState_BandwidthLimit] `json:"bandwidthLimits"`
 
 	listenerBufferSize uint
 	lastSaveIndex      *uint64
@@ -67,6 +72,7 @@ func NewSenderRouterDataModel(logSize uint64, listenerBufferSize uint) *RouterDa
 		PostureChecks:      cmap.New[*edge_ctrl_pb.DataState_PostureCheck](),
 		PublicKeys:         cmap.New[*edge_ctrl_pb.DataState_PublicKey](),
 		Revocations:        cmap.New[*edge_ctrl_pb.DataState_Revocation](),
+		BandwidthLimits:    cmap.New[*edge_ctrl_pb.DataState_BandwidthLimit](),
 		listenerBufferSize: listenerBufferSize,
 	}
 }
@@ -82,6 +88,7 @@ func NewReceiverRouterDataModel(listenerBufferSize uint) *RouterDataModel {
 		PostureChecks:      cmap.New[*edge_ctrl_pb.DataState_PostureCheck](),
 		PublicKeys:         cmap.New[*edge_ctrl_pb.DataState_PublicKey](),
 		Revocations:        cmap.New[*edge_ctrl_pb.DataState_Revocation](),
+		BandwidthLimits:    cmap.New[*edge_ctrl_pb.DataState_BandwidthLimit](),
 		listenerBufferSize: listenerBufferSize,
 	}
 }
@@ -173,6 +180,8 @@ func (rdm *RouterDataModel) Handle(event *edge_ctrl_pb.DataState_Event) {
 		rdm.HandlePublicKeyEvent(event, typedModel)
 	case *edge_ctrl_pb.DataState_Event_Revocation:
 		rdm.HandleRevocationEvent(event, typedModel)
+	case *edge_ctrl_pb.DataState_Event_BandwidthLimit:
+		rdm.HandleBandwidthLimitEvent(event, typedModel)
 	case *edge_ctrl_pb.DataState_Event_ServicePolicyChange:
 		rdm.HandleServicePolicyChange(typedModel.ServicePolicyChange)
 	}
@@ -275,6 +284,16 @@ func (rdm *RouterDataModel) HandleRevocationEvent(event *edge_ctrl_pb.DataState_
 	}
 }
 
+// HandleBandwidthLimitEvent will apply the delta event to the router data model. It is not restricted by index
+// calculations. This method is generally meant for bulk loading of data during startup.
+func (rdm *RouterDataModel) HandleBandwidthLimitEvent(event *edge_ctrl_pb.DataState_Event, model *edge_ctrl_pb.DataState_Event_BandwidthLimit) {
+	if event.Action == edge_ctrl_pb.DataState_Delete {
+		rdm.BandwidthLimits.Remove(model.BandwidthLimit.Id)
+	} else {
+		rdm.BandwidthLimits.Set(model.BandwidthLimit.Id, model.BandwidthLimit)
+	}
+}
+
 func (rdm *RouterDataModel) HandleServicePolicyChange(model *edge_ctrl_pb.DataState_ServicePolicyChange) {
 	if model.RelatedEntityType == edge_ctrl_pb.ServicePolicyRelatedEntityType_RelatedIdentity {
 		for _, identityId := range model.RelatedEntityIds {
@@ -433,6 +452,16 @@ func (rdm *RouterDataModel) GetDataState() *edge_ctrl_pb.DataState {
 			}
 			events = append(events, newEvent)
 		})
+
+		rdm.BandwidthLimits.IterCb(func(key string, v *edge_ctrl_pb.DataState_BandwidthLimit) {
+			newEvent := &edge_ctrl_pb.DataState_Event{
+				Action: edge_ctrl_pb.DataState_Create,
+				Model: &edge_ctrl_pb.DataState_Event_BandwidthLimit{
+					BandwidthLimit: v,
+				},
+			}
+			events = append(events, newEvent)
+		})
 	})
 
 	return &edge_ctrl_pb.DataState{
@@ -440,6 +469,52 @@ func (rdm *RouterDataModel) GetDataState() *edge_ctrl_pb.DataState {
 	}
 }
 
+// GetBandwidthLimits returns the bandwidth limits which apply to circuits between the given identity and service. A
+// limit applies if both its identity roles and its service roles match. Roles match on #all, @<id> or any of the
+// entity's role attributes.
+func (rdm *RouterDataModel) GetBandwidthLimits(identityId, serviceId string) []*edge_ctrl_pb.DataState_BandwidthLimit {
+	var identityAttrs, serviceAttrs []string
+
+	if identity, ok := rdm.Identities.Get(identityId); ok && identity != nil {
+		identityAttrs = identity.RoleAttributes
+	}
+
+	if service, ok := rdm.Services.Get(serviceId); ok && service != nil {
+		serviceAttrs = service.RoleAttributes
+	}
+
+	var result []*edge_ctrl_pb.DataState_BandwidthLimit
+	rdm.BandwidthLimits.IterCb(func(key string, v *edge_ctrl_pb.DataState_BandwidthLimit) {
+		if rolesMatch(v.IdentityRoles, identityId, identityAttrs) && rolesMatch(v.ServiceRoles, serviceId, serviceAttrs) {
+			result = append(result, v)
+		}
+	})
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Id < result[j].Id
+	})
+
+	return result
+}
+
+func rolesMatch(roles []string, id string, attrs []string) bool {
+	for _, role := range roles {
+		if role == "#all" || (id != "" && role == "@"+id) {
+			return true
+		}
+
+		if strings.HasPrefix(role, "#") {
+			attr := role[1:]
+			for _, candidate := range attrs {
+				if candidate == attr {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
 // rdmDb is a helper structure of serializing router data models to JSON gzipped files.
 type rdmDb struct {
 	RouterDataModel *RouterDataModel `json:"model"`
@@ -513,6 +588,7 @@ func (rdm *RouterDataModel) GetServiceAccessPolicies(identityId string, serviceI
 
 	postureChecks := map[string]*edge_ctrl_pb.DataState_PostureCheck{}
 
+	now := time.Now()
 	for servicePolicyId := range identity.ServicePolicies {
 		servicePolicy, ok := rdm.ServicePolicies.Get(servicePolicyId)
 
@@ -524,6 +600,23 @@ func (rdm *RouterDataModel) GetServiceAccessPolicies(identityId string, serviceI
 			continue
 		}
 
+		// deny policies take precedence over any policies granting access
+		if servicePolicy.Deny {
+			if _, found := servicePolicy.Services[serviceId]; found {
+				return &AccessPolicies{
+					Identity: identity,
+					Service:  service,
+					DeniedBy: servicePolicy,
+				}, nil
+			}
+			continue
+		}
+
+		// policies outside their schedule don't grant access
+		if !servicePolicy.Schedule.ToSchedule().IsActive(now) {
+			continue
+		}
+
 		policies = append(policies, servicePolicy)
 
 		for postureCheckId := range servicePolicy.PostureChecks {
@@ -0,0 +1,294 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+// Package schedule implements the time windows which restrict when a policy is in force.
+//
+// A window has the form `<days> <start>-<end>`, for example `mon-fri 09:00-17:00`. Days may be `*` for every day, a
+// single day, a range such as `mon-fri` or a comma separated list such as `mon,wed,sat-sun`. Times are 24-hour
+// `HH:MM` and are evaluated in the schedule's timezone. A window whose end is before its start runs past midnight,
+// so `fri 22:00-02:00` covers late Friday and early Saturday. An end of `24:00` runs to the end of the day.
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const maxWindowDays = 8
+
+var dayNames = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// Schedule restricts a policy to a validity period and, optionally, to recurring windows within it. The zero value
+// places no restrictions.
+type Schedule struct {
+	ValidFrom  *time.Time `json:"validFrom,omitempty"`
+	ValidUntil *time.Time `json:"validUntil,omitempty"`
+	Windows    []string   `json:"windows,omitempty"`
+	Timezone   string     `json:"timezone,omitempty"`
+}
+
+// IsSet returns true if the schedule places any restrictions on when the policy is in force
+func (self *Schedule) IsSet() bool {
+	return self != nil && (self.ValidFrom != nil || self.ValidUntil != nil || len(self.Windows) > 0)
+}
+
+// Validate checks that the timezone and windows can be parsed and that the validity period isn't empty
+func (self *Schedule) Validate() error {
+	if self == nil {
+		return nil
+	}
+
+	if self.ValidFrom != nil && self.ValidUntil != nil && !self.ValidUntil.After(*self.ValidFrom) {
+		return errors.Errorf("validUntil [%v] must be after validFrom [%v]", self.ValidUntil.Format(time.RFC3339), self.ValidFrom.Format(time.RFC3339))
+	}
+
+	if _, err := self.location(); err != nil {
+		return err
+	}
+
+	_, err := self.parseWindows()
+	return err
+}
+
+// IsActive returns true if the policy is in force at the given time. A schedule which can't be parsed is never active.
+func (self *Schedule) IsActive(t time.Time) bool {
+	if !self.IsSet() {
+		return true
+	}
+
+	if self.ValidFrom != nil && t.Before(*self.ValidFrom) {
+		return false
+	}
+
+	if self.ValidUntil != nil && !t.Before(*self.ValidUntil) {
+		return false
+	}
+
+	if len(self.Windows) == 0 {
+		return true
+	}
+
+	loc, err := self.location()
+	if err != nil {
+		return false
+	}
+
+	windows, err := self.parseWindows()
+	if err != nil {
+		return false
+	}
+
+	local := t.In(loc)
+	for _, w := range windows {
+		if w.contains(local) {
+			return true
+		}
+	}
+	return false
+}
+
+// NextChange returns the first time after t at which the schedule may become active or inactive, or nil if the
+// schedule won't change again
+func (self *Schedule) NextChange(t time.Time) *time.Time {
+	if !self.IsSet() {
+		return nil
+	}
+
+	var result *time.Time
+	consider := func(candidate time.Time) {
+		if candidate.After(t) && (result == nil || candidate.Before(*result)) {
+			result = &candidate
+		}
+	}
+
+	if self.ValidFrom != nil {
+		consider(*self.ValidFrom)
+	}
+
+	if self.ValidUntil != nil {
+		if !t.Before(*self.ValidUntil) {
+			return nil
+		}
+		consider(*self.ValidUntil)
+	}
+
+	loc, err := self.location()
+	if err != nil {
+		return result
+	}
+
+	windows, err := self.parseWindows()
+	if err != nil {
+		return result
+	}
+
+	local := t.In(loc)
+	midnight := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+	for offset := -1; offset < maxWindowDays; offset++ {
+		day := midnight.AddDate(0, 0, offset)
+		for _, w := range windows {
+			if w.days[day.Weekday()] {
+				start, end := w.bounds(day)
+				consider(start)
+				consider(end)
+			}
+		}
+	}
+
+	return result
+}
+
+func (self *Schedule) location() (*time.Location, error) {
+	if self.Timezone == "" {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(self.Timezone)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid timezone [%v]", self.Timezone)
+	}
+	return loc, nil
+}
+
+func (self *Schedule) parseWindows() ([]*window, error) {
+	var result []*window
+	for _, spec := range self.Windows {
+		w, err := parseWindow(spec)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, w)
+	}
+	return result, nil
+}
+
+type window struct {
+	days  [7]bool
+	start int // minutes after midnight
+	end   int // minutes after midnight, greater than start
+}
+
+// contains checks both the window starting on t's day and one starting the day before, which may run past midnight
+func (self *window) contains(t time.Time) bool {
+	minute := t.Hour()*60 + t.Minute()
+	if self.days[t.Weekday()] && minute >= self.start && minute < self.end {
+		return true
+	}
+	prev := (t.Weekday() + 6) % 7
+	return self.days[prev] && self.end > 24*60 && minute < self.end-24*60
+}
+
+func (self *window) bounds(day time.Time) (time.Time, time.Time) {
+	start := time.Date(day.Year(), day.Month(), day.Day(), self.start/60, self.start%60, 0, 0, day.Location())
+	end := time.Date(day.Year(), day.Month(), day.Day(), self.end/60, self.end%60, 0, 0, day.Location())
+	return start, end
+}
+
+func parseWindow(spec string) (*window, error) {
+	parts := strings.Fields(spec)
+	if len(parts) != 2 {
+		return nil, errors.Errorf("invalid schedule window [%v], expected '<days> <HH:MM>-<HH:MM>'", spec)
+	}
+
+	result := &window{}
+	if err := parseDays(parts[0], &result.days); err != nil {
+		return nil, errors.Wrapf(err, "invalid schedule window [%v]", spec)
+	}
+
+	startStr, endStr, found := strings.Cut(parts[1], "-")
+	if !found {
+		return nil, errors.Errorf("invalid schedule window [%v], expected time range in the form HH:MM-HH:MM", spec)
+	}
+
+	var err error
+	if result.start, err = parseMinutes(startStr); err != nil || result.start == 24*60 {
+		return nil, errors.Errorf("invalid schedule window [%v], invalid start time [%v]", spec, startStr)
+	}
+
+	if result.end, err = parseMinutes(endStr); err != nil {
+		return nil, errors.Errorf("invalid schedule window [%v], invalid end time [%v]", spec, endStr)
+	}
+
+	if result.end == result.start {
+		return nil, errors.Errorf("invalid schedule window [%v], start and end times must differ", spec)
+	}
+
+	if result.end < result.start {
+		result.end += 24 * 60
+	}
+
+	return result, nil
+}
+
+func parseDays(spec string, days *[7]bool) error {
+	if spec == "*" {
+		for i := range days {
+			days[i] = true
+		}
+		return nil
+	}
+
+	for _, item := range strings.Split(strings.ToLower(spec), ",") {
+		fromStr, toStr, isRange := strings.Cut(item, "-")
+		from, ok := dayNames[fromStr]
+		if !ok {
+			return fmt.Errorf("unknown day [%v]", fromStr)
+		}
+		to := from
+		if isRange {
+			if to, ok = dayNames[toStr]; !ok {
+				return fmt.Errorf("unknown day [%v]", toStr)
+			}
+		}
+		for d := from; ; d = (d + 1) % 7 {
+			days[d] = true
+			if d == to {
+				break
+			}
+		}
+	}
+	return nil
+}
+
+func parseMinutes(s string) (int, error) {
+	hoursStr, minutesStr, found := strings.Cut(s, ":")
+	if !found || len(minutesStr) != 2 {
+		return 0, errors.Errorf("invalid time [%v]", s)
+	}
+	hours, err := strconv.Atoi(hoursStr)
+	if err != nil {
+		return 0, err
+	}
+	minutes, err := strconv.Atoi(minutesStr)
+	if err != nil {
+		return 0, err
+	}
+	if hours < 0 || minutes < 0 || minutes > 59 || hours > 24 || (hours == 24 && minutes != 0) {
+		return 0, errors.Errorf("invalid time [%v]", s)
+	}
+	return hours*60 + minutes, nil
+}
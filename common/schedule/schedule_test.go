@@ -0,0 +1,86 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduleIsActive(t *testing.T) {
+	req := require.New(t)
+
+	ny, err := time.LoadLocation("America/New_York")
+	req.NoError(err)
+
+	s := &Schedule{
+		Windows:  []string{"mon-fri 09:00-17:00", "sat 22:00-02:00"},
+		Timezone: "America/New_York",
+	}
+	req.NoError(s.Validate())
+
+	// 2024-06-03 is a Monday
+	req.True(s.IsActive(time.Date(2024, 6, 3, 9, 0, 0, 0, ny)))
+	req.True(s.IsActive(time.Date(2024, 6, 7, 16, 59, 0, 0, ny)))
+	req.False(s.IsActive(time.Date(2024, 6, 7, 17, 0, 0, 0, ny)))
+	req.False(s.IsActive(time.Date(2024, 6, 3, 8, 59, 0, 0, ny)))
+
+	// same instant, expressed in UTC
+	req.True(s.IsActive(time.Date(2024, 6, 3, 13, 30, 0, 0, time.UTC)))
+	req.False(s.IsActive(time.Date(2024, 6, 3, 12, 30, 0, 0, time.UTC)))
+
+	// window running past midnight into Sunday
+	req.True(s.IsActive(time.Date(2024, 6, 8, 23, 0, 0, 0, ny)))
+	req.True(s.IsActive(time.Date(2024, 6, 9, 1, 59, 0, 0, ny)))
+	req.False(s.IsActive(time.Date(2024, 6, 9, 2, 0, 0, 0, ny)))
+	req.False(s.IsActive(time.Date(2024, 6, 8, 12, 0, 0, 0, ny)))
+
+	now := time.Date(2024, 6, 3, 12, 0, 0, 0, ny)
+	next := s.NextChange(now)
+	req.NotNil(next)
+	req.True(next.Equal(time.Date(2024, 6, 3, 17, 0, 0, 0, ny)))
+
+	next = s.NextChange(time.Date(2024, 6, 7, 18, 0, 0, 0, ny))
+	req.NotNil(next)
+	req.True(next.Equal(time.Date(2024, 6, 8, 22, 0, 0, 0, ny)))
+}
+
+func TestScheduleValidity(t *testing.T) {
+	req := require.New(t)
+
+	from := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	until := from.Add(48 * time.Hour)
+
+	s := &Schedule{ValidFrom: &from, ValidUntil: &until}
+	req.NoError(s.Validate())
+
+	req.False(s.IsActive(from.Add(-time.Second)))
+	req.True(s.IsActive(from))
+	req.True(s.IsActive(until.Add(-time.Second)))
+	req.False(s.IsActive(until))
+
+	req.True(s.NextChange(from.Add(-time.Hour)).Equal(from))
+	req.True(s.NextChange(from).Equal(until))
+	req.Nil(s.NextChange(until))
+
+	s = &Schedule{ValidFrom: &until, ValidUntil: &from}
+	req.Error(s.Validate())
+
+	var unset *Schedule
+	req.False(unset.IsSet())
+	req.True(unset.IsActive(from))
+}
+
+func TestScheduleValidate(t *testing.T) {
+	req := require.New(t)
+
+	for _, valid := range []string{"* 00:00-24:00", "mon,wed,fri 08:30-12:00", "fri-mon 20:00-06:00", "SUN 00:00-01:00"} {
+		req.NoError((&Schedule{Windows: []string{valid}}).Validate(), valid)
+	}
+
+	for _, invalid := range []string{"mon", "mon 9-17", "mon 09:00-09:00", "xyz 09:00-17:00", "mon 24:00-02:00", "mon 09:60-10:00", "mon-fri 09:00"} {
+		req.Error((&Schedule{Windows: []string{invalid}}).Validate(), invalid)
+	}
+
+	req.Error((&Schedule{Timezone: "Not/AZone"}).Validate())
+}
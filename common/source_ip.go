@@ -0,0 +1,77 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package common
+
+import (
+	"fmt"
+	"net"
+	"strings"
+)
+
+// ParseSourceIp returns the IP of an observed client address, which may be a bare IP or a host:port pair as found in
+// http.Request.RemoteAddr and net.Addr.String()
+func ParseSourceIp(addr string) net.IP {
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		addr = host
+	}
+	return net.ParseIP(strings.Trim(addr, "[]"))
+}
+
+// ValidateCidrs returns an error for the first entry which isn't a valid CIDR
+func ValidateCidrs(cidrs []string) error {
+	for _, cidr := range cidrs {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CheckSourceIp returns an error if the given IP is in any of the denied CIDRs or, when allowed CIDRs are given, isn't
+// in any of them. Denied CIDRs take precedence over allowed CIDRs.
+func CheckSourceIp(ip net.IP, allowedCidrs, deniedCidrs []string) error {
+	if ip == nil {
+		return fmt.Errorf("no source ip was observed")
+	}
+
+	if cidr, found := findCidrContaining(ip, deniedCidrs); found {
+		return fmt.Errorf("source ip %s is in denied range %s", ip, cidr)
+	}
+
+	if len(allowedCidrs) == 0 {
+		return nil
+	}
+
+	if _, found := findCidrContaining(ip, allowedCidrs); !found {
+		return fmt.Errorf("source ip %s is not in any allowed range", ip)
+	}
+
+	return nil
+}
+
+func findCidrContaining(ip net.IP, cidrs []string) (string, bool) {
+	for _, cidr := range cidrs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			continue
+		}
+		if ipNet.Contains(ip) {
+			return cidr, true
+		}
+	}
+	return "", false
+}
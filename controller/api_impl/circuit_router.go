@@ -17,9 +17,14 @@
 package api_impl
 
 import (
+	"errors"
+	"net/http"
+
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/openziti/foundation/v2/errorz"
 	"github.com/openziti/storage/boltz"
 	"github.com/openziti/ziti/controller/api"
+	"github.com/openziti/ziti/controller/apierror"
 	"github.com/openziti/ziti/controller/change"
 	"github.com/openziti/ziti/controller/network"
 	"github.com/openziti/ziti/controller/rest_model"
@@ -54,6 +59,10 @@ func (r *CircuitRouter) Register(fabricApi *operations.ZitiFabricAPI, wrapper Re
 	fabricApi.CircuitDeleteCircuitHandler = circuit.DeleteCircuitHandlerFunc(func(params circuit.DeleteCircuitParams) middleware.Responder {
 		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Delete(n, rc, params) }, params.HTTPRequest, params.ID, "")
 	})
+
+	fabricApi.CircuitRerouteCircuitsHandler = circuit.RerouteCircuitsHandlerFunc(func(params circuit.RerouteCircuitsParams) middleware.Responder {
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Reroute(n, rc, params) }, params.HTTPRequest, "", "")
+	})
 }
 
 func (r *CircuitRouter) ListCircuits(n *network.Network, rc api.RequestContext) {
@@ -110,3 +119,64 @@ func (r *CircuitRouter) Delete(network *network.Network, rc api.RequestContext,
 		return network.RemoveCircuit(id, p.Options.Immediate)
 	}))
 }
+
+func (r *CircuitRouter) Reroute(n *network.Network, rc api.RequestContext, params circuit.RerouteCircuitsParams) {
+	reroute := params.Reroute
+
+	selectors := 0
+	for _, v := range []string{reroute.CircuitID, reroute.LinkID, reroute.RouterID} {
+		if v != "" {
+			selectors++
+		}
+	}
+
+	if selectors != 1 {
+		rc.RespondWithApiError(apierror.NewBadRequestFieldError(*errorz.NewFieldError("exactly one of circuitId, linkId or routerId must be specified", "circuitId", reroute.CircuitID)))
+		return
+	}
+
+	if len(reroute.Path) > 0 && reroute.CircuitID == "" {
+		rc.RespondWithApiError(apierror.NewBadRequestFieldError(*errorz.NewFieldError("path may only be specified when rerouting a single circuit", "path", reroute.Path)))
+		return
+	}
+
+	var result *network.RerouteResult
+	var err error
+
+	if reroute.CircuitID != "" {
+		if err = n.RerouteCircuit(reroute.CircuitID, reroute.Path); err == nil {
+			result = &network.RerouteResult{Rerouted: []string{reroute.CircuitID}}
+		}
+	} else if reroute.LinkID != "" {
+		result, err = n.RerouteCircuitsUsingLink(reroute.LinkID)
+	} else {
+		result, err = n.RerouteCircuitsThroughRouter(reroute.RouterID)
+	}
+
+	if err != nil {
+		var fieldErr *errorz.FieldError
+		if boltz.IsErrNotFoundErr(err) {
+			rc.RespondWithNotFoundWithCause(err)
+		} else if errors.As(err, &fieldErr) {
+			rc.RespondWithApiError(apierror.NewBadRequestFieldError(*fieldErr))
+		} else {
+			rc.RespondWithError(err)
+		}
+		return
+	}
+
+	apiResult := rest_model.CircuitRerouteResult{
+		Rerouted: result.Rerouted,
+	}
+	if apiResult.Rerouted == nil {
+		apiResult.Rerouted = []string{}
+	}
+	if len(result.Failed) > 0 {
+		apiResult.Failed = map[string]string{}
+		for circuitId, err := range result.Failed {
+			apiResult.Failed[circuitId] = err.Error()
+		}
+	}
+
+	rc.Respond(apiResult, http.StatusOK)
+}
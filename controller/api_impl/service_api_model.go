@@ -54,6 +54,13 @@ func MapCreateServiceToModel(service *rest_model.ServiceCreate) *network.Service
 		},
 		Name:               stringz.OrEmpty(service.Name),
 		TerminatorStrategy: service.TerminatorStrategy,
+		PriorityClass:      service.PriorityClass,
+		RoutingConstraints: network.RoutingConstraints{
+			RouterRoles:         service.RouterRoles,
+			RouterRolesSemantic: service.RouterRolesSemantic,
+			ExcludedLinks:       service.ExcludedLinks,
+			MaxHops:             uint32(service.MaxHops),
+		},
 	}
 
 	if ret.Id == "" {
@@ -75,6 +82,13 @@ func MapUpdateServiceToModel(id string, service *rest_model.ServiceUpdate) *netw
 		},
 		Name:               stringz.OrEmpty(service.Name),
 		TerminatorStrategy: service.TerminatorStrategy,
+		PriorityClass:      service.PriorityClass,
+		RoutingConstraints: network.RoutingConstraints{
+			RouterRoles:         service.RouterRoles,
+			RouterRolesSemantic: service.RouterRolesSemantic,
+			ExcludedLinks:       service.ExcludedLinks,
+			MaxHops:             uint32(service.MaxHops),
+		},
 	}
 
 	return ret
@@ -88,6 +102,13 @@ func MapPatchServiceToModel(id string, service *rest_model.ServicePatch) *networ
 		},
 		Name:               service.Name,
 		TerminatorStrategy: service.TerminatorStrategy,
+		PriorityClass:      service.PriorityClass,
+		RoutingConstraints: network.RoutingConstraints{
+			RouterRoles:         service.RouterRoles,
+			RouterRolesSemantic: service.RouterRolesSemantic,
+			ExcludedLinks:       service.ExcludedLinks,
+			MaxHops:             uint32(service.MaxHops),
+		},
 	}
 
 	return ret
@@ -97,8 +118,13 @@ type ServiceModelMapper struct{}
 
 func (ServiceModelMapper) ToApi(_ *network.Network, _ api.RequestContext, service *network.Service) (interface{}, error) {
 	return &rest_model.ServiceDetail{
-		BaseEntity:         BaseEntityToRestModel(service, ServiceLinkFactory),
-		Name:               &service.Name,
-		TerminatorStrategy: &service.TerminatorStrategy,
+		BaseEntity:          BaseEntityToRestModel(service, ServiceLinkFactory),
+		Name:                &service.Name,
+		TerminatorStrategy:  &service.TerminatorStrategy,
+		RouterRoles:         service.RouterRoles,
+		RouterRolesSemantic: service.RouterRolesSemantic,
+		ExcludedLinks:       service.ExcludedLinks,
+		MaxHops:             int64(service.MaxHops),
+		PriorityClass:       service.PriorityClass,
 	}, nil
 }
@@ -279,6 +279,15 @@ func NewNoEdgeRoutersAvailable() *errorz.ApiError {
 	}
 }
 
+func NewPolicyNotInSchedule(cause error) *errorz.ApiError {
+	return &errorz.ApiError{
+		Cause:   cause,
+		Code:    PolicyNotInScheduleCode,
+		Message: PolicyNotInScheduleMessage,
+		Status:  PolicyNotInScheduleStatus,
+	}
+}
+
 func NewMissingCertClaim() *errorz.ApiError {
 	return &errorz.ApiError{
 		Code:    MissingCertClaimCode,
@@ -328,6 +337,15 @@ func NewInvalidMfaTokenError() *errorz.ApiError {
 	}
 }
 
+func NewInvalidWebAuthnResponseError(cause error) *errorz.ApiError {
+	return &errorz.ApiError{
+		Cause:   cause,
+		Code:    MfaInvalidWebAuthnResponseCode,
+		Message: MfaInvalidWebAuthnResponseMessage,
+		Status:  MfaInvalidWebAuthnResponseStatus,
+	}
+}
+
 func NewEdgeRouterFailedReEnrollment(cause error) *errorz.ApiError {
 	return &errorz.ApiError{
 		Code:        EdgeRouterFailedReEnrollmentCode,
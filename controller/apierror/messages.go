@@ -82,6 +82,10 @@ const (
 	NoEdgeRoutersAvailableMessage string = "No edge routers are assigned and online to handle the requested connection"
 	NoEdgeRoutersAvailableStatus  int    = http.StatusBadRequest
 
+	PolicyNotInScheduleCode    string = "POLICY_NOT_IN_SCHEDULE"
+	PolicyNotInScheduleMessage string = "The policies granting access to the service are outside of their scheduled windows"
+	PolicyNotInScheduleStatus  int    = http.StatusForbidden
+
 	CouldNotParseX509FromDerCode    string = "COULD_NOT_PARSE_x509_FROM_DER"
 	CouldNotParseX509FromDerMessage string = "could not parse x509 from DER"
 	CouldNotParseX509FromDerStatus  int    = http.StatusBadRequest
@@ -167,6 +171,10 @@ const (
 	MfaNotEnrolledMessage string = "The current identity is not enrolled in MFA"
 	MfaNotEnrolledStatus  int    = http.StatusConflict
 
+	MfaInvalidWebAuthnResponseCode    string = "MFA_INVALID_WEBAUTHN_RESPONSE"
+	MfaInvalidWebAuthnResponseMessage string = "An invalid WebAuthn response was provided"
+	MfaInvalidWebAuthnResponseStatus  int    = http.StatusBadRequest
+
 	CouldNotDecodeProxiedCertCode    string = "COULD_NOT_PARSE_PROXY_CERT"
 	CouldNotDecodeProxiedCertMessage string = "could not decode proxy client cert"
 	CouldNotDecodeProxiedCertStatus  int    = http.StatusInternalServerError
@@ -57,6 +57,12 @@ const (
 
 	DefaultTotpDomain = "openziti.io"
 
+	DefaultWebAuthnRpName = "OpenZiti"
+
+	DefaultCrlValidity    = time.Hour
+	DefaultOcspValidity   = time.Hour
+	MinRevocationValidity = time.Minute
+
 	DefaultAuthRateLimiterEnabled = true
 	DefaultAuthRateLimiterMaxSize = 250
 	DefaultAuthRateLimiterMinSize = 5
@@ -81,6 +87,25 @@ type Totp struct {
 	Hostname string
 }
 
+// WebAuthn configures the relying party used for WebAuthn (FIDO2) multifactor authentication. RpId defaults to the
+// host of [edge.api.address] and Origins to that address over https.
+type WebAuthn struct {
+	RpId                    string
+	RpName                  string
+	Origins                 []string
+	RequireUserVerification bool
+}
+
+// CertificateRevocation configures the CRL and OCSP responses published for certificates issued by the edge signer.
+// BaseUrl defaults to https://[edge.api.address]. When EmbedUrls is set, newly issued certificates carry the CRL and
+// OCSP locations under BaseUrl.
+type CertificateRevocation struct {
+	CrlValidity  time.Duration
+	OcspValidity time.Duration
+	BaseUrl      string
+	EmbedUrls    bool
+}
+
 type Api struct {
 	SessionTimeout          time.Duration
 	ActivityUpdateBatchSize int
@@ -100,6 +125,8 @@ type Config struct {
 	caPems          *bytes.Buffer
 	caPemsOnce      sync.Once
 	Totp            Totp
+	WebAuthn        WebAuthn
+	Revocation      CertificateRevocation
 	AuthRateLimiter command.AdaptiveRateLimiterConfig
 }
 
@@ -192,6 +219,142 @@ func (c *Config) loadTotpSection(edgeConfigMap map[any]any) error {
 	return nil
 }
 
+func (c *Config) loadWebAuthnSection(edgeConfigMap map[any]any) error {
+	c.WebAuthn = WebAuthn{
+		RpName: DefaultWebAuthnRpName,
+	}
+
+	if host, port, err := net.SplitHostPort(c.Api.Address); err == nil {
+		c.WebAuthn.RpId = host
+		if port == "443" {
+			c.WebAuthn.Origins = []string{"https://" + host}
+		} else {
+			c.WebAuthn.Origins = []string{"https://" + c.Api.Address}
+		}
+	}
+
+	value, found := edgeConfigMap["webAuthn"]
+	if !found || value == nil {
+		return nil
+	}
+
+	webAuthnMap, ok := value.(map[any]any)
+	if !ok {
+		return errors.New("[edge.webAuthn] must be a map")
+	}
+
+	if val, found := webAuthnMap["rpId"]; found {
+		rpId, ok := val.(string)
+		if !ok || rpId == "" {
+			return errors.Errorf("[edge.webAuthn.rpId] must be a non-empty string")
+		}
+		if parsedUrl, err := url.Parse("https://" + rpId); err != nil || parsedUrl.Hostname() != rpId {
+			return errors.Errorf("invalid hostname in [edge.webAuthn.rpId]: %s", rpId)
+		}
+		c.WebAuthn.RpId = rpId
+	}
+
+	if val, found := webAuthnMap["rpName"]; found {
+		rpName, ok := val.(string)
+		if !ok || rpName == "" {
+			return errors.Errorf("[edge.webAuthn.rpName] must be a non-empty string")
+		}
+		c.WebAuthn.RpName = rpName
+	}
+
+	if val, found := webAuthnMap["origins"]; found {
+		originList, ok := val.([]any)
+		if !ok {
+			return errors.Errorf("[edge.webAuthn.origins] must be a list of strings")
+		}
+		c.WebAuthn.Origins = nil
+		for i, originVal := range originList {
+			origin, ok := originVal.(string)
+			if !ok {
+				return errors.Errorf("[edge.webAuthn.origins[%d]] must be a string", i)
+			}
+			if parsedUrl, err := url.Parse(origin); err != nil || parsedUrl.Scheme == "" || parsedUrl.Host == "" || parsedUrl.Path != "" {
+				return errors.Errorf("invalid origin in [edge.webAuthn.origins[%d]]: %s, expected scheme://host[:port]", i, origin)
+			}
+			c.WebAuthn.Origins = append(c.WebAuthn.Origins, origin)
+		}
+	}
+
+	if val, found := webAuthnMap["requireUserVerification"]; found {
+		if c.WebAuthn.RequireUserVerification, ok = val.(bool); !ok {
+			return errors.Errorf("[edge.webAuthn.requireUserVerification] must be a bool")
+		}
+	}
+
+	return nil
+}
+
+func (c *Config) loadCertificateRevocationSection(edgeConfigMap map[any]any) error {
+	c.Revocation = CertificateRevocation{
+		CrlValidity:  DefaultCrlValidity,
+		OcspValidity: DefaultOcspValidity,
+		BaseUrl:      "https://" + c.Api.Address,
+		EmbedUrls:    true,
+	}
+
+	value, found := edgeConfigMap["certificateRevocation"]
+	if !found || value == nil {
+		return nil
+	}
+
+	revocationMap, ok := value.(map[any]any)
+	if !ok {
+		return errors.New("[edge.certificateRevocation] must be a map")
+	}
+
+	parseValidity := func(key string, target *time.Duration) error {
+		val, found := revocationMap[key]
+		if !found {
+			return nil
+		}
+		strVal, ok := val.(string)
+		if !ok {
+			return errors.Errorf("[edge.certificateRevocation.%s] must be a string duration", key)
+		}
+		duration, err := time.ParseDuration(strVal)
+		if err != nil {
+			return errors.Wrapf(err, "invalid value %v for [edge.certificateRevocation.%s], must be string duration", strVal, key)
+		}
+		if duration < MinRevocationValidity {
+			return errors.Errorf("invalid value %v for [edge.certificateRevocation.%s], must be at least %v", strVal, key, MinRevocationValidity)
+		}
+		*target = duration
+		return nil
+	}
+
+	if err := parseValidity("crlValidity", &c.Revocation.CrlValidity); err != nil {
+		return err
+	}
+
+	if err := parseValidity("ocspValidity", &c.Revocation.OcspValidity); err != nil {
+		return err
+	}
+
+	if val, found := revocationMap["baseUrl"]; found {
+		baseUrl, ok := val.(string)
+		if !ok {
+			return errors.New("[edge.certificateRevocation.baseUrl] must be a string")
+		}
+		if parsedUrl, err := url.Parse(baseUrl); err != nil || parsedUrl.Host == "" || (parsedUrl.Scheme != "http" && parsedUrl.Scheme != "https") {
+			return errors.Errorf("invalid value %s for [edge.certificateRevocation.baseUrl], expected http(s)://host[:port]", baseUrl)
+		}
+		c.Revocation.BaseUrl = strings.TrimSuffix(baseUrl, "/")
+	}
+
+	if val, found := revocationMap["embedUrls"]; found {
+		if c.Revocation.EmbedUrls, ok = val.(bool); !ok {
+			return errors.New("[edge.certificateRevocation.embedUrls] must be a bool")
+		}
+	}
+
+	return nil
+}
+
 func (c *Config) loadApiSection(edgeConfigMap map[interface{}]interface{}) error {
 	c.Api = Api{}
 	c.Api.HttpTimeouts = *DefaultHttpTimeouts()
@@ -467,6 +630,14 @@ func LoadFromMap(configMap map[interface{}]interface{}) (*Config, error) {
 		return nil, err
 	}
 
+	if err = edgeConfig.loadWebAuthnSection(edgeConfigMap); err != nil {
+		return nil, err
+	}
+
+	if err = edgeConfig.loadCertificateRevocationSection(edgeConfigMap); err != nil {
+		return nil, err
+	}
+
 	if err = edgeConfig.loadEnrollmentSection(edgeConfigMap); err != nil {
 		return nil, err
 	}
@@ -60,8 +60,10 @@ import (
 	"github.com/openziti/ziti/controller/xctrl"
 	"github.com/openziti/ziti/controller/xmgmt"
 	"github.com/openziti/ziti/controller/xt"
+	"github.com/openziti/ziti/controller/xt_latency"
 	"github.com/openziti/ziti/controller/xt_random"
 	"github.com/openziti/ziti/controller/xt_smartrouting"
+	"github.com/openziti/ziti/controller/xt_sticky"
 	"github.com/openziti/ziti/controller/xt_weighted"
 	"github.com/sirupsen/logrus"
 )
@@ -287,6 +289,10 @@ func (c *Controller) initWeb() {
 		logrus.WithError(err).Fatalf("failed to create metrics api factory")
 	}
 
+	if err := c.xweb.GetRegistry().Add(events.NewPrometheusApiFactory(c.eventDispatcher)); err != nil {
+		logrus.WithError(err).Fatalf("failed to create events prometheus api factory")
+	}
+
 	if err := c.xweb.GetRegistry().Add(zac.NewZitiAdminConsoleFactory()); err != nil {
 		logrus.WithError(err).Fatalf("failed to create single page application factory")
 	}
@@ -446,6 +452,8 @@ func (c *Controller) registerXts() {
 	xt.GlobalRegistry().RegisterFactory(xt_smartrouting.NewFactory())
 	xt.GlobalRegistry().RegisterFactory(xt_random.NewFactory())
 	xt.GlobalRegistry().RegisterFactory(xt_weighted.NewFactory())
+	xt.GlobalRegistry().RegisterFactory(xt_latency.NewFactory())
+	xt.GlobalRegistry().RegisterFactory(xt_sticky.NewFactory())
 }
 
 func (c *Controller) registerComponents() error {
@@ -611,6 +619,8 @@ func getApiPath(binding string) string {
 		return "health-checks"
 	case "edge-oidc":
 		return "/oidc"
+	case "edge-scim":
+		return "/scim/v2"
 	}
 
 	return ""
@@ -0,0 +1,167 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package db
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/foundation/v2/stringz"
+	"github.com/openziti/storage/ast"
+	"github.com/openziti/storage/boltz"
+)
+
+const (
+	FieldBandwidthLimitRateBytesPerSecond = "rateBytesPerSecond"
+	FieldBandwidthLimitBurstBytes         = "burstBytes"
+	FieldBandwidthLimitScope              = "scope"
+
+	// BandwidthLimitScopeCircuit gives each circuit its own allowance
+	BandwidthLimitScopeCircuit = "circuit"
+
+	// BandwidthLimitScopeIdentity shares the allowance between all circuits of an identity on a router
+	BandwidthLimitScopeIdentity = "identity"
+
+	// BandwidthLimitScopeService shares the allowance between all circuits for a service on a router
+	BandwidthLimitScopeService = "service"
+)
+
+var BandwidthLimitScopes = []string{BandwidthLimitScopeCircuit, BandwidthLimitScopeIdentity, BandwidthLimitScopeService}
+
+// BandwidthLimit caps the throughput of circuits for the identities and services matching its roles. Limits are
+// enforced by edge routers, at the xgress where the circuit enters or leaves the network.
+type BandwidthLimit struct {
+	boltz.BaseExtEntity
+	Name               string   `json:"name"`
+	IdentityRoles      []string `json:"identityRoles"`
+	ServiceRoles       []string `json:"serviceRoles"`
+	RateBytesPerSecond int64    `json:"rateBytesPerSecond"`
+	BurstBytes         int64    `json:"burstBytes"`
+	Scope              string   `json:"scope"`
+}
+
+func (entity *BandwidthLimit) GetName() string {
+	return entity.Name
+}
+
+func (entity *BandwidthLimit) GetEntityType() string {
+	return EntityTypeBandwidthLimits
+}
+
+var _ BandwidthLimitStore = (*bandwidthLimitStoreImpl)(nil)
+
+type BandwidthLimitStore interface {
+	NameIndexed
+	Store[*BandwidthLimit]
+}
+
+func newBandwidthLimitStore(stores *stores) *bandwidthLimitStoreImpl {
+	store := &bandwidthLimitStoreImpl{}
+	store.baseStore = newBaseStore[*BandwidthLimit](stores, store)
+	store.InitImpl(store)
+	return store
+}
+
+type bandwidthLimitStoreImpl struct {
+	*baseStore[*BandwidthLimit]
+	indexName boltz.ReadIndex
+}
+
+func (store *bandwidthLimitStoreImpl) initializeLocal() {
+	store.AddExtEntitySymbols()
+	store.indexName = store.addUniqueNameField()
+
+	store.AddPublicSetSymbol(FieldIdentityRoles, ast.NodeTypeString)
+	store.AddPublicSetSymbol(FieldServiceRoles, ast.NodeTypeString)
+	store.AddSymbol(FieldBandwidthLimitRateBytesPerSecond, ast.NodeTypeInt64)
+	store.AddSymbol(FieldBandwidthLimitBurstBytes, ast.NodeTypeInt64)
+	store.AddSymbol(FieldBandwidthLimitScope, ast.NodeTypeString)
+}
+
+func (store *bandwidthLimitStoreImpl) initializeLinked() {}
+
+func (store *bandwidthLimitStoreImpl) GetNameIndex() boltz.ReadIndex {
+	return store.indexName
+}
+
+func (store *bandwidthLimitStoreImpl) NewEntity() *BandwidthLimit {
+	return &BandwidthLimit{}
+}
+
+func (store *bandwidthLimitStoreImpl) FillEntity(entity *BandwidthLimit, bucket *boltz.TypedBucket) {
+	entity.LoadBaseValues(bucket)
+	entity.Name = bucket.GetStringOrError(FieldName)
+	entity.IdentityRoles = bucket.GetStringList(FieldIdentityRoles)
+	entity.ServiceRoles = bucket.GetStringList(FieldServiceRoles)
+	entity.RateBytesPerSecond = bucket.GetInt64WithDefault(FieldBandwidthLimitRateBytesPerSecond, 0)
+	entity.BurstBytes = bucket.GetInt64WithDefault(FieldBandwidthLimitBurstBytes, 0)
+	entity.Scope = bucket.GetStringWithDefault(FieldBandwidthLimitScope, BandwidthLimitScopeCircuit)
+}
+
+func (store *bandwidthLimitStoreImpl) PersistEntity(entity *BandwidthLimit, ctx *boltz.PersistContext) {
+	store.validateRoles(ctx, FieldIdentityRoles, entity.IdentityRoles, store.stores.identity)
+	store.validateRoles(ctx, FieldServiceRoles, entity.ServiceRoles, store.stores.edgeService)
+
+	if ctx.ProceedWithSet(FieldBandwidthLimitRateBytesPerSecond) && entity.RateBytesPerSecond <= 0 {
+		ctx.Bucket.SetError(errorz.NewFieldError("rate must be greater than zero", FieldBandwidthLimitRateBytesPerSecond, entity.RateBytesPerSecond))
+	}
+
+	if ctx.ProceedWithSet(FieldBandwidthLimitBurstBytes) && entity.BurstBytes < 0 {
+		ctx.Bucket.SetError(errorz.NewFieldError("burst may not be negative", FieldBandwidthLimitBurstBytes, entity.BurstBytes))
+	}
+
+	if ctx.ProceedWithSet(FieldBandwidthLimitScope) {
+		if entity.Scope == "" {
+			entity.Scope = BandwidthLimitScopeCircuit
+		}
+
+		if !stringz.Contains(BandwidthLimitScopes, entity.Scope) {
+			msg := fmt.Sprintf("invalid scope, must be one of %v", BandwidthLimitScopes)
+			ctx.Bucket.SetError(errorz.NewFieldError(msg, FieldBandwidthLimitScope, entity.Scope))
+		}
+	}
+
+	entity.SetBaseValues(ctx)
+	ctx.SetRequiredString(FieldName, entity.Name)
+
+	sort.Strings(entity.IdentityRoles)
+	sort.Strings(entity.ServiceRoles)
+
+	ctx.SetStringList(FieldIdentityRoles, entity.IdentityRoles)
+	ctx.SetStringList(FieldServiceRoles, entity.ServiceRoles)
+	ctx.SetInt64(FieldBandwidthLimitRateBytesPerSecond, entity.RateBytesPerSecond)
+	ctx.SetInt64(FieldBandwidthLimitBurstBytes, entity.BurstBytes)
+	ctx.SetString(FieldBandwidthLimitScope, entity.Scope)
+}
+
+func (store *bandwidthLimitStoreImpl) validateRoles(ctx *boltz.PersistContext, field string, roles []string, entityStore boltz.Store) {
+	if err := validateRolesAndIds(field, roles); err != nil {
+		ctx.Bucket.SetError(err)
+		return
+	}
+
+	_, ids, err := splitRolesAndIds(roles)
+	if err != nil {
+		ctx.Bucket.SetError(err)
+		return
+	}
+
+	if err = validateEntityIds(ctx.Bucket.Tx(), entityStore, field, ids); err != nil {
+		ctx.Bucket.SetError(err)
+	}
+}
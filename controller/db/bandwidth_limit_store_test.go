@@ -0,0 +1,93 @@
+package db
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/storage/boltztest"
+	"github.com/openziti/ziti/common/eid"
+)
+
+func Test_BandwidthLimitStore(t *testing.T) {
+	ctx := NewTestContext(t)
+	defer ctx.Cleanup()
+	ctx.Init()
+
+	t.Run("test create bandwidth limits", ctx.testCreateBandwidthLimit)
+	t.Run("test create/update bandwidth limits with invalid values", ctx.testBandwidthLimitInvalidValues)
+}
+
+func newBandwidthLimit(name string) *BandwidthLimit {
+	return &BandwidthLimit{
+		BaseExtEntity:      boltz.BaseExtEntity{Id: eid.New()},
+		Name:               name,
+		RateBytesPerSecond: 1024 * 1024,
+	}
+}
+
+func (ctx *TestContext) testCreateBandwidthLimit(_ *testing.T) {
+	ctx.CleanupAll()
+
+	identity := ctx.RequireNewIdentity(eid.New(), false)
+	service := ctx.RequireNewService(eid.New())
+
+	limit := newBandwidthLimit(eid.New())
+	limit.IdentityRoles = []string{roleRef("noisy"), entityRef(identity.Id)}
+	limit.ServiceRoles = []string{entityRef(service.Id)}
+	limit.BurstBytes = 64 * 1024
+	limit.Scope = BandwidthLimitScopeIdentity
+	boltztest.RequireCreate(ctx, limit)
+	boltztest.ValidateBaseline(ctx, limit)
+
+	// scope defaults to circuit
+	defaultScope := newBandwidthLimit(eid.New())
+	defaultScope.ServiceRoles = []string{AllRole}
+	boltztest.RequireCreate(ctx, defaultScope)
+	defaultScope.Scope = BandwidthLimitScopeCircuit
+	boltztest.ValidateBaseline(ctx, defaultScope)
+
+	limit.RateBytesPerSecond = 2048
+	limit.Scope = BandwidthLimitScopeService
+	boltztest.RequireUpdate(ctx, limit)
+	boltztest.ValidateUpdated(ctx, limit)
+
+	boltztest.RequireDelete(ctx, limit)
+	boltztest.RequireDelete(ctx, defaultScope)
+}
+
+func (ctx *TestContext) testBandwidthLimitInvalidValues(_ *testing.T) {
+	ctx.CleanupAll()
+
+	limit := newBandwidthLimit(eid.New())
+	invalidId := eid.New()
+	limit.IdentityRoles = []string{entityRef(invalidId)}
+	err := boltztest.Create(ctx, limit)
+	ctx.EqualError(err, fmt.Sprintf("the value '[%v]' for 'identityRoles' is invalid: no identities found with the given ids", invalidId))
+
+	limit.IdentityRoles = nil
+	limit.ServiceRoles = []string{entityRef(invalidId)}
+	err = boltztest.Create(ctx, limit)
+	ctx.EqualError(err, fmt.Sprintf("the value '[%v]' for 'serviceRoles' is invalid: no services found with the given ids", invalidId))
+
+	limit.ServiceRoles = nil
+	limit.RateBytesPerSecond = 0
+	err = boltztest.Create(ctx, limit)
+	ctx.EqualError(err, "the value '0' for 'rateBytesPerSecond' is invalid: rate must be greater than zero")
+
+	limit.RateBytesPerSecond = 1024
+	limit.BurstBytes = -1
+	err = boltztest.Create(ctx, limit)
+	ctx.EqualError(err, "the value '-1' for 'burstBytes' is invalid: burst may not be negative")
+
+	limit.BurstBytes = 0
+	limit.Scope = "router"
+	err = boltztest.Create(ctx, limit)
+	ctx.EqualError(err, fmt.Sprintf("the value 'router' for 'scope' is invalid: invalid scope, must be one of %v", BandwidthLimitScopes))
+
+	limit.Scope = ""
+	boltztest.RequireCreate(ctx, limit)
+
+	limit.Scope = "router"
+	ctx.Error(boltztest.Update(ctx, limit))
+}
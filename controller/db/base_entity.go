@@ -25,8 +25,10 @@ const (
 	EntityTypeApiSessions               = "apiSessions"
 	EntityTypeApiSessionCertificates    = "apiSessionCertificates"
 	EntityTypeAuthPolicies              = "authPolicies"
+	EntityTypeBandwidthLimits           = "bandwidthLimits"
 	EntityTypeEventualEvents            = "eventualEvents"
 	EntityTypeCas                       = "cas"
+	EntityTypeCertificateRevocations    = "certificateRevocations"
 	EntityTypeConfigs                   = "configs"
 	EntityTypeConfigTypes               = "configTypes"
 	EntityTypeControllers               = "controllers"
@@ -61,6 +63,11 @@ const (
 
 var validSemantics = []string{SemanticAllOf, SemanticAnyOf}
 
+// IsSemanticValid reports whether the given policy semantic is AllOf or AnyOf
+func IsSemanticValid(semantic string) bool {
+	return isSemanticValid(semantic)
+}
+
 func isSemanticValid(semantic string) bool {
 	for _, validSemantic := range validSemantics {
 		if strings.EqualFold(validSemantic, semantic) {
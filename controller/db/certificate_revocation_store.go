@@ -0,0 +1,286 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package db
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"github.com/michaelquigley/pfxlog"
+	nfpem "github.com/openziti/foundation/v2/pem"
+	"github.com/openziti/foundation/v2/stringz"
+	"github.com/openziti/storage/ast"
+	"github.com/openziti/storage/boltz"
+	"time"
+)
+
+const (
+	FieldCertificateRevocationSerialNumber = "serialNumber"
+	FieldCertificateRevocationIssuer       = "issuer"
+	FieldCertificateRevocationFingerprint  = "fingerprint"
+	FieldCertificateRevocationReason       = "reason"
+	FieldCertificateRevocationNotAfter     = "notAfter"
+	FieldCertificateRevocationOwnerType    = "ownerType"
+	FieldCertificateRevocationOwnerId      = "ownerId"
+
+	CertificateRevocationOwnerIdentity = "identity"
+	CertificateRevocationOwnerRouter   = "router"
+
+	// Revocation reason codes as defined by RFC 5280 section 5.3.1
+	CertificateRevocationReasonSuperseded           = 4
+	CertificateRevocationReasonCessationOfOperation = 5
+)
+
+// CertificateRevocation records a certificate that has been removed from an identity or router before it expired.
+// Revocations are kept until the certificate expires and are published via CRL and OCSP for the issuer they belong to.
+type CertificateRevocation struct {
+	boltz.BaseExtEntity
+	SerialNumber string    `json:"serialNumber"`
+	Issuer       string    `json:"issuer"`
+	Fingerprint  string    `json:"fingerprint"`
+	Reason       int64     `json:"reason"`
+	NotAfter     time.Time `json:"notAfter"`
+	OwnerType    string    `json:"ownerType"`
+	OwnerId      string    `json:"ownerId"`
+}
+
+func (entity *CertificateRevocation) GetEntityType() string {
+	return EntityTypeCertificateRevocations
+}
+
+// CertificateIssuerHash returns the hex encoded SHA-256 of the DER encoded issuer name. Revocations are grouped by it.
+func CertificateIssuerHash(rawIssuer []byte) string {
+	hash := sha256.Sum256(rawIssuer)
+	return hex.EncodeToString(hash[:])
+}
+
+// CertificateRevocationId returns the id a revocation of a certificate with the given issuer and serial number is
+// stored under. Serial numbers are only unique per issuer, so both are part of the id.
+func CertificateRevocationId(issuerHash string, serialNumber string) string {
+	return issuerHash + ":" + serialNumber
+}
+
+var _ CertificateRevocationStore = (*certificateRevocationStoreImpl)(nil)
+
+type CertificateRevocationStore interface {
+	Store[*CertificateRevocation]
+}
+
+func newCertificateRevocationStore(stores *stores) *certificateRevocationStoreImpl {
+	store := &certificateRevocationStoreImpl{}
+	store.baseStore = newBaseStore[*CertificateRevocation](stores, store)
+	store.InitImpl(store)
+	return store
+}
+
+type certificateRevocationStoreImpl struct {
+	*baseStore[*CertificateRevocation]
+}
+
+func (store *certificateRevocationStoreImpl) initializeLocal() {
+	store.AddExtEntitySymbols()
+	store.AddSymbol(FieldCertificateRevocationSerialNumber, ast.NodeTypeString)
+	store.AddSymbol(FieldCertificateRevocationIssuer, ast.NodeTypeString)
+	store.AddSymbol(FieldCertificateRevocationFingerprint, ast.NodeTypeString)
+	store.AddSymbol(FieldCertificateRevocationReason, ast.NodeTypeInt64)
+	store.AddSymbol(FieldCertificateRevocationNotAfter, ast.NodeTypeDatetime)
+	store.AddSymbol(FieldCertificateRevocationOwnerType, ast.NodeTypeString)
+	store.AddSymbol(FieldCertificateRevocationOwnerId, ast.NodeTypeString)
+}
+
+func (store *certificateRevocationStoreImpl) initializeLinked() {
+	store.stores.authenticator.AddEntityConstraint(&authenticatorRevocationConstraint{store: store})
+	store.stores.edgeRouter.AddEntityConstraint(&edgeRouterRevocationConstraint{store: store})
+}
+
+func (store *certificateRevocationStoreImpl) NewEntity() *CertificateRevocation {
+	return &CertificateRevocation{}
+}
+
+func (store *certificateRevocationStoreImpl) FillEntity(entity *CertificateRevocation, bucket *boltz.TypedBucket) {
+	entity.LoadBaseValues(bucket)
+	entity.SerialNumber = bucket.GetStringOrError(FieldCertificateRevocationSerialNumber)
+	entity.Issuer = bucket.GetStringOrError(FieldCertificateRevocationIssuer)
+	entity.Fingerprint = bucket.GetStringWithDefault(FieldCertificateRevocationFingerprint, "")
+	entity.Reason = bucket.GetInt64WithDefault(FieldCertificateRevocationReason, 0)
+	entity.NotAfter = bucket.GetTimeOrError(FieldCertificateRevocationNotAfter)
+	entity.OwnerType = bucket.GetStringWithDefault(FieldCertificateRevocationOwnerType, "")
+	entity.OwnerId = bucket.GetStringWithDefault(FieldCertificateRevocationOwnerId, "")
+}
+
+func (store *certificateRevocationStoreImpl) PersistEntity(entity *CertificateRevocation, ctx *boltz.PersistContext) {
+	entity.SetBaseValues(ctx)
+	ctx.SetString(FieldCertificateRevocationSerialNumber, entity.SerialNumber)
+	ctx.SetString(FieldCertificateRevocationIssuer, entity.Issuer)
+	ctx.SetString(FieldCertificateRevocationFingerprint, entity.Fingerprint)
+	ctx.SetInt64(FieldCertificateRevocationReason, entity.Reason)
+	ctx.SetTimeP(FieldCertificateRevocationNotAfter, &entity.NotAfter)
+	ctx.SetString(FieldCertificateRevocationOwnerType, entity.OwnerType)
+	ctx.SetString(FieldCertificateRevocationOwnerId, entity.OwnerId)
+}
+
+// revokePem records a revocation for the leaf certificate of the given PEM. Certificates which have already expired
+// or are already revoked are ignored.
+func (store *certificateRevocationStoreImpl) revokePem(ctx boltz.MutateContext, certPem string, ownerType, ownerId string, reason int64) error {
+	if certPem == "" {
+		return nil
+	}
+
+	certs := nfpem.PemStringToCertificates(certPem)
+	if len(certs) == 0 {
+		pfxlog.Logger().WithField("ownerType", ownerType).WithField("ownerId", ownerId).
+			Warn("unable to parse certificate being removed, no revocation recorded")
+		return nil
+	}
+
+	return store.revoke(ctx, certs[0], ownerType, ownerId, reason)
+}
+
+func (store *certificateRevocationStoreImpl) revoke(ctx boltz.MutateContext, cert *x509.Certificate, ownerType, ownerId string, reason int64) error {
+	now := time.Now()
+	if cert.NotAfter.Before(now) {
+		return nil
+	}
+
+	issuer := CertificateIssuerHash(cert.RawIssuer)
+	serialNumber := fmt.Sprintf("%X", cert.SerialNumber)
+	id := CertificateRevocationId(issuer, serialNumber)
+
+	if store.IsEntityPresent(ctx.Tx(), id) {
+		return nil
+	}
+
+	if err := store.deleteExpired(ctx, now); err != nil {
+		return err
+	}
+
+	pfxlog.Logger().WithField("ownerType", ownerType).WithField("ownerId", ownerId).
+		WithField("serialNumber", serialNumber).Info("revoking certificate")
+
+	return store.Create(ctx, &CertificateRevocation{
+		BaseExtEntity: boltz.BaseExtEntity{Id: id},
+		SerialNumber:  serialNumber,
+		Issuer:        issuer,
+		Fingerprint:   nfpem.FingerprintFromCertificate(cert),
+		Reason:        reason,
+		NotAfter:      cert.NotAfter,
+		OwnerType:     ownerType,
+		OwnerId:       ownerId,
+	})
+}
+
+// deleteExpired removes revocations for certificates which have expired, as they no longer need to be published
+func (store *certificateRevocationStoreImpl) deleteExpired(ctx boltz.MutateContext, now time.Time) error {
+	query := fmt.Sprintf(`%s < datetime(%s)`, FieldCertificateRevocationNotAfter, now.UTC().Format(time.RFC3339))
+	return store.DeleteWhere(ctx, query)
+}
+
+// revokeReplaced handles a certificate field changing during an update. The previous certificate is revoked unless it
+// was moved to the other certificate field, as happens when an extended certificate is verified.
+func (store *certificateRevocationStoreImpl) revokeReplaced(ctx boltz.MutateContext, ownerType, ownerId, initial, final string, retained ...string) error {
+	if initial == "" || initial == final || stringz.Contains(retained, initial) {
+		return nil
+	}
+	return store.revokePem(ctx, initial, ownerType, ownerId, CertificateRevocationReasonSuperseded)
+}
+
+// authenticatorRevocationConstraint revokes identity certificates when their authenticator is deleted, for example
+// when the identity is deleted or re-enrolled, or when the certificate is replaced by an extended one
+type authenticatorRevocationConstraint struct {
+	store *certificateRevocationStoreImpl
+}
+
+func (self *authenticatorRevocationConstraint) ProcessPreCommit(state *boltz.EntityChangeState[*Authenticator]) error {
+	if state.ChangeType != boltz.EntityUpdated && state.ChangeType != boltz.EntityDeleted {
+		return nil
+	}
+
+	initial := authenticatorCertOf(state.InitialState)
+	if initial == nil {
+		return nil
+	}
+
+	identityId := state.InitialState.IdentityId
+
+	if state.ChangeType == boltz.EntityDeleted {
+		if err := self.store.revokePem(state.Ctx, initial.Pem, CertificateRevocationOwnerIdentity, identityId, CertificateRevocationReasonCessationOfOperation); err != nil {
+			return err
+		}
+		return self.store.revokePem(state.Ctx, initial.UnverifiedPem, CertificateRevocationOwnerIdentity, identityId, CertificateRevocationReasonCessationOfOperation)
+	}
+
+	final := authenticatorCertOf(state.FinalState)
+	if final == nil {
+		final = &AuthenticatorCert{}
+	}
+
+	if err := self.store.revokeReplaced(state.Ctx, CertificateRevocationOwnerIdentity, identityId, initial.Pem, final.Pem); err != nil {
+		return err
+	}
+	return self.store.revokeReplaced(state.Ctx, CertificateRevocationOwnerIdentity, identityId, initial.UnverifiedPem, final.UnverifiedPem, final.Pem)
+}
+
+func (self *authenticatorRevocationConstraint) ProcessPostCommit(*boltz.EntityChangeState[*Authenticator]) {
+}
+
+func authenticatorCertOf(authenticator *Authenticator) *AuthenticatorCert {
+	if authenticator == nil {
+		return nil
+	}
+	if authCert, ok := authenticator.SubType.(*AuthenticatorCert); ok {
+		return authCert
+	}
+	return nil
+}
+
+// edgeRouterRevocationConstraint revokes edge router client certificates when the router is deleted or re-enrolled,
+// or when the certificate is replaced by an extended one
+type edgeRouterRevocationConstraint struct {
+	store *certificateRevocationStoreImpl
+}
+
+func (self *edgeRouterRevocationConstraint) ProcessPreCommit(state *boltz.EntityChangeState[*EdgeRouter]) error {
+	if state.ChangeType != boltz.EntityUpdated && state.ChangeType != boltz.EntityDeleted {
+		return nil
+	}
+
+	initial := state.InitialState
+	if initial == nil {
+		return nil
+	}
+
+	if state.ChangeType == boltz.EntityDeleted {
+		if err := self.store.revokePem(state.Ctx, stringz.OrEmpty(initial.CertPem), CertificateRevocationOwnerRouter, initial.Id, CertificateRevocationReasonCessationOfOperation); err != nil {
+			return err
+		}
+		return self.store.revokePem(state.Ctx, stringz.OrEmpty(initial.UnverifiedCertPem), CertificateRevocationOwnerRouter, initial.Id, CertificateRevocationReasonCessationOfOperation)
+	}
+
+	final := state.FinalState
+	if final == nil {
+		return nil
+	}
+
+	if err := self.store.revokeReplaced(state.Ctx, CertificateRevocationOwnerRouter, initial.Id, stringz.OrEmpty(initial.CertPem), stringz.OrEmpty(final.CertPem)); err != nil {
+		return err
+	}
+	return self.store.revokeReplaced(state.Ctx, CertificateRevocationOwnerRouter, initial.Id, stringz.OrEmpty(initial.UnverifiedCertPem), stringz.OrEmpty(final.UnverifiedCertPem), stringz.OrEmpty(final.CertPem))
+}
+
+func (self *edgeRouterRevocationConstraint) ProcessPostCommit(*boltz.EntityChangeState[*EdgeRouter]) {
+}
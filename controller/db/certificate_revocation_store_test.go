@@ -0,0 +1,196 @@
+package db
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/storage/boltztest"
+	"github.com/openziti/ziti/common/eid"
+	"go.etcd.io/bbolt"
+	"math/big"
+	"testing"
+	"time"
+)
+
+// revocations reference the deleted identity or router they were recorded for
+const revocationsPath = "/" + RootBucket + "/" + EntityTypeCertificateRevocations
+
+func Test_CertificateRevocationStore(t *testing.T) {
+	ctx := NewTestContext(t)
+	defer ctx.Cleanup()
+	ctx.Init()
+
+	issuer := newTestIssuer(ctx)
+
+	t.Run("identity certificates are revoked when replaced or deleted", func(t *testing.T) {
+		ctx.NextTest(t)
+		identity := ctx.RequireNewIdentity(eid.New(), false)
+
+		initialPem, initialCert := issuer.issue(time.Hour)
+		authenticator := &Authenticator{
+			BaseExtEntity: boltz.BaseExtEntity{Id: eid.New()},
+			Type:          MethodAuthenticatorCert,
+			IdentityId:    identity.Id,
+			SubType:       &AuthenticatorCert{Pem: initialPem},
+		}
+		boltztest.RequireCreate(ctx, authenticator)
+		ctx.requireNotRevoked(initialCert)
+
+		// extending a certificate sets the unverified certificate and verifying it replaces the current one
+		extendedPem, extendedCert := issuer.issue(time.Hour)
+		authenticator.SubType = &AuthenticatorCert{Pem: initialPem, UnverifiedPem: extendedPem}
+		boltztest.RequireUpdate(ctx, authenticator)
+		ctx.requireNotRevoked(initialCert)
+
+		authenticator.SubType = &AuthenticatorCert{Pem: extendedPem}
+		boltztest.RequireUpdate(ctx, authenticator)
+		revocation := ctx.requireRevoked(initialCert)
+		ctx.Equal(int64(CertificateRevocationReasonSuperseded), revocation.Reason)
+		ctx.Equal(CertificateRevocationOwnerIdentity, revocation.OwnerType)
+		ctx.Equal(identity.Id, revocation.OwnerId)
+		ctx.requireNotRevoked(extendedCert)
+
+		// abandoned extensions are revoked as well
+		abandonedPem, abandonedCert := issuer.issue(time.Hour)
+		authenticator.SubType = &AuthenticatorCert{Pem: extendedPem, UnverifiedPem: abandonedPem}
+		boltztest.RequireUpdate(ctx, authenticator)
+		authenticator.SubType = &AuthenticatorCert{Pem: extendedPem}
+		boltztest.RequireUpdate(ctx, authenticator)
+		ctx.requireRevoked(abandonedCert)
+
+		boltztest.RequireDelete(ctx, identity, revocationsPath)
+		revocation = ctx.requireRevoked(extendedCert)
+		ctx.Equal(int64(CertificateRevocationReasonCessationOfOperation), revocation.Reason)
+	})
+
+	t.Run("expired certificates are not revoked", func(t *testing.T) {
+		ctx.NextTest(t)
+		identity := ctx.RequireNewIdentity(eid.New(), false)
+
+		expiredPem, expiredCert := issuer.issue(-time.Minute)
+		boltztest.RequireCreate(ctx, &Authenticator{
+			BaseExtEntity: boltz.BaseExtEntity{Id: eid.New()},
+			Type:          MethodAuthenticatorCert,
+			IdentityId:    identity.Id,
+			SubType:       &AuthenticatorCert{Pem: expiredPem},
+		})
+
+		boltztest.RequireDelete(ctx, identity, revocationsPath)
+		ctx.requireNotRevoked(expiredCert)
+	})
+
+	t.Run("edge router certificates are revoked when re-enrolled or deleted", func(t *testing.T) {
+		ctx.NextTest(t)
+
+		initialPem, initialCert := issuer.issue(time.Hour)
+		edgeRouter := newEdgeRouter(eid.New())
+		edgeRouter.CertPem = &initialPem
+		boltztest.RequireCreate(ctx, edgeRouter)
+
+		// re-enrolling clears the certificate
+		edgeRouter.CertPem = nil
+		boltztest.RequireUpdate(ctx, edgeRouter)
+		revocation := ctx.requireRevoked(initialCert)
+		ctx.Equal(CertificateRevocationOwnerRouter, revocation.OwnerType)
+		ctx.Equal(edgeRouter.Id, revocation.OwnerId)
+
+		enrolledPem, enrolledCert := issuer.issue(time.Hour)
+		edgeRouter.CertPem = &enrolledPem
+		boltztest.RequireUpdate(ctx, edgeRouter)
+		ctx.requireNotRevoked(enrolledCert)
+
+		boltztest.RequireDelete(ctx, edgeRouter, revocationsPath)
+		ctx.requireRevoked(enrolledCert)
+	})
+}
+
+func (ctx *TestContext) loadCertificateRevocation(cert *x509.Certificate) *CertificateRevocation {
+	id := CertificateRevocationId(CertificateIssuerHash(cert.RawIssuer), fmt.Sprintf("%X", cert.SerialNumber))
+
+	var result *CertificateRevocation
+	err := ctx.GetDb().View(func(tx *bbolt.Tx) error {
+		revocation, found, err := ctx.stores.CertificateRevocation.FindById(tx, id)
+		if found {
+			result = revocation
+		}
+		return err
+	})
+	ctx.NoError(err)
+	return result
+}
+
+func (ctx *TestContext) requireRevoked(cert *x509.Certificate) *CertificateRevocation {
+	revocation := ctx.loadCertificateRevocation(cert)
+	ctx.NotNil(revocation)
+	ctx.Equal(fmt.Sprintf("%X", cert.SerialNumber), revocation.SerialNumber)
+	ctx.Equal(cert.NotAfter.UTC(), revocation.NotAfter.UTC())
+	return revocation
+}
+
+func (ctx *TestContext) requireNotRevoked(cert *x509.Certificate) {
+	ctx.Nil(ctx.loadCertificateRevocation(cert))
+}
+
+type testIssuer struct {
+	ctx    *TestContext
+	cert   *x509.Certificate
+	key    *ecdsa.PrivateKey
+	serial int64
+}
+
+func newTestIssuer(ctx *TestContext) *testIssuer {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	ctx.NoError(err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	ctx.NoError(err)
+
+	cert, err := x509.ParseCertificate(der)
+	ctx.NoError(err)
+
+	return &testIssuer{
+		ctx:    ctx,
+		cert:   cert,
+		key:    key,
+		serial: 100,
+	}
+}
+
+// issue returns a new certificate, as PEM and parsed, which expires after the given duration
+func (self *testIssuer) issue(validFor time.Duration) (string, *x509.Certificate) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	self.ctx.NoError(err)
+
+	self.serial++
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(self.serial),
+		Subject:      pkix.Name{CommonName: eid.New()},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(validFor).Truncate(time.Second),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, self.cert, &key.PublicKey, self.key)
+	self.ctx.NoError(err)
+
+	cert, err := x509.ParseCertificate(der)
+	self.ctx.NoError(err)
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})), cert
+}
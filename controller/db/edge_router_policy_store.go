@@ -7,6 +7,8 @@ import (
 	"github.com/openziti/storage/ast"
 	"github.com/openziti/storage/boltz"
 	"github.com/openziti/ziti/common/eid"
+	"github.com/openziti/ziti/common/schedule"
+	"go.etcd.io/bbolt"
 	"sort"
 )
 
@@ -20,10 +22,11 @@ func newEdgeRouterPolicy(name string) *EdgeRouterPolicy {
 
 type EdgeRouterPolicy struct {
 	boltz.BaseExtEntity
-	Name            string   `json:"name"`
-	Semantic        string   `json:"semantic"`
-	IdentityRoles   []string `json:"identityRoles"`
-	EdgeRouterRoles []string `json:"edgeRouterRoles"`
+	Name            string             `json:"name"`
+	Semantic        string             `json:"semantic"`
+	IdentityRoles   []string           `json:"identityRoles"`
+	EdgeRouterRoles []string           `json:"edgeRouterRoles"`
+	Schedule        *schedule.Schedule `json:"schedule,omitempty"`
 }
 
 func (entity *EdgeRouterPolicy) GetName() string {
@@ -43,6 +46,7 @@ var _ EdgeRouterPolicyStore = (*edgeRouterPolicyStoreImpl)(nil)
 type EdgeRouterPolicyStore interface {
 	NameIndexed
 	Store[*EdgeRouterPolicy]
+	ScheduledPolicyStore
 }
 
 func newEdgeRouterPolicyStore(stores *stores) *edgeRouterPolicyStoreImpl {
@@ -77,6 +81,7 @@ func (store *edgeRouterPolicyStoreImpl) initializeLocal() {
 	store.symbolSemantic = store.AddSymbol(FieldSemantic, ast.NodeTypeString)
 	store.symbolIdentityRoles = store.AddPublicSetSymbol(FieldIdentityRoles, ast.NodeTypeString)
 	store.symbolEdgeRouterRoles = store.AddPublicSetSymbol(FieldEdgeRouterRoles, ast.NodeTypeString)
+	addPolicyScheduleSymbols(store)
 	store.symbolIdentities = store.AddFkSetSymbol(EntityTypeIdentities, store.stores.identity)
 	store.symbolEdgeRouters = store.AddFkSetSymbol(EntityTypeRouters, store.stores.edgeRouter)
 
@@ -98,6 +103,11 @@ func (store *edgeRouterPolicyStoreImpl) FillEntity(entity *EdgeRouterPolicy, buc
 	entity.Semantic = bucket.GetStringWithDefault(FieldSemantic, SemanticAllOf)
 	entity.IdentityRoles = bucket.GetStringList(FieldIdentityRoles)
 	entity.EdgeRouterRoles = bucket.GetStringList(FieldEdgeRouterRoles)
+	entity.Schedule = loadPolicySchedule(bucket)
+}
+
+func (store *edgeRouterPolicyStoreImpl) GetSchedule(tx *bbolt.Tx, id []byte) *schedule.Schedule {
+	return loadPolicySchedule(store.GetEntityBucket(tx, id))
 }
 
 func (store *edgeRouterPolicyStoreImpl) PersistEntity(entity *EdgeRouterPolicy, ctx *boltz.PersistContext) {
@@ -118,6 +128,7 @@ func (store *edgeRouterPolicyStoreImpl) PersistEntity(entity *EdgeRouterPolicy,
 		}
 		ctx.SetRequiredString(FieldSemantic, entity.Semantic)
 	}
+	persistPolicySchedule(entity.Schedule, ctx)
 
 	sort.Strings(entity.EdgeRouterRoles)
 	sort.Strings(entity.IdentityRoles)
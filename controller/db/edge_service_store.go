@@ -27,7 +27,10 @@ import (
 const (
 	FieldEdgeServiceDialIdentities = "dialIdentities"
 	FieldEdgeServiceBindIdentities = "bindIdentities"
-	FieldServiceEncryptionRequired = "encryptionRequired"
+
+	FieldEdgeServiceDenyDialIdentities = "denyDialIdentities"
+	FieldEdgeServiceDenyBindIdentities = "denyBindIdentities"
+	FieldServiceEncryptionRequired     = "encryptionRequired"
 )
 
 type EdgeService struct {
@@ -92,9 +95,14 @@ type edgeServiceStoreImpl struct {
 	symbolBindIdentities boltz.EntitySetSymbol
 	symbolEdgeRouters    boltz.EntitySetSymbol
 
-	bindIdentitiesCollection boltz.RefCountedLinkCollection
-	dialIdentitiesCollection boltz.RefCountedLinkCollection
-	edgeRoutersCollection    boltz.RefCountedLinkCollection
+	symbolDenyDialIdentities boltz.EntitySetSymbol
+	symbolDenyBindIdentities boltz.EntitySetSymbol
+
+	bindIdentitiesCollection     boltz.RefCountedLinkCollection
+	dialIdentitiesCollection     boltz.RefCountedLinkCollection
+	denyBindIdentitiesCollection boltz.RefCountedLinkCollection
+	denyDialIdentitiesCollection boltz.RefCountedLinkCollection
+	edgeRoutersCollection        boltz.RefCountedLinkCollection
 }
 
 func (store *edgeServiceStoreImpl) HandleUpdate(ctx boltz.MutateContext, entity *Service, checker boltz.FieldChecker) (bool, error) {
@@ -137,6 +145,8 @@ func (store *edgeServiceStoreImpl) initializeLocal() {
 
 	store.symbolBindIdentities = store.AddFkSetSymbol(FieldEdgeServiceBindIdentities, store.stores.identity)
 	store.symbolDialIdentities = store.AddFkSetSymbol(FieldEdgeServiceDialIdentities, store.stores.identity)
+	store.symbolDenyBindIdentities = store.AddFkSetSymbol(FieldEdgeServiceDenyBindIdentities, store.stores.identity)
+	store.symbolDenyDialIdentities = store.AddFkSetSymbol(FieldEdgeServiceDenyDialIdentities, store.stores.identity)
 
 	// TODO: migrate this field name to routers, to match identity store
 	store.symbolEdgeRouters = store.AddFkSetSymbol(FieldEdgeRouters, store.stores.edgeRouter)
@@ -151,6 +161,8 @@ func (store *edgeServiceStoreImpl) initializeLinked() {
 
 	store.bindIdentitiesCollection = store.AddRefCountedLinkCollection(store.symbolBindIdentities, store.stores.identity.symbolBindServices)
 	store.dialIdentitiesCollection = store.AddRefCountedLinkCollection(store.symbolDialIdentities, store.stores.identity.symbolDialServices)
+	store.denyBindIdentitiesCollection = store.AddRefCountedLinkCollection(store.symbolDenyBindIdentities, store.stores.identity.symbolDenyBindServices)
+	store.denyDialIdentitiesCollection = store.AddRefCountedLinkCollection(store.symbolDenyDialIdentities, store.stores.identity.symbolDenyDialServices)
 	store.edgeRoutersCollection = store.AddRefCountedLinkCollection(store.symbolEdgeRouters, store.stores.edgeRouter.symbolServices)
 }
 
@@ -298,12 +310,25 @@ func (store *edgeServiceStoreImpl) GetRoleAttributesCursorProvider(values []stri
 	return store.getRoleAttributesCursorProvider(store.indexRoleAttributes, values, semantic)
 }
 
+// getIdentitiesCollection returns the service to identity links maintained for service policies of the given type and
+// effect
+func (store *edgeServiceStoreImpl) getIdentitiesCollection(policyType PolicyType, effect PolicyEffect) boltz.RefCountedLinkCollection {
+	if effect.IsDeny() {
+		if policyType == PolicyTypeBind {
+			return store.denyBindIdentitiesCollection
+		}
+		return store.denyDialIdentitiesCollection
+	}
+	if policyType == PolicyTypeBind {
+		return store.bindIdentitiesCollection
+	}
+	return store.dialIdentitiesCollection
+}
+
 func (store *edgeServiceStoreImpl) IsBindableByIdentity(tx *bbolt.Tx, id string, identityId string) bool {
-	linkCount := store.bindIdentitiesCollection.GetLinkCount(tx, []byte(id), []byte(identityId))
-	return linkCount != nil && *linkCount > 0
+	return store.stores.identity.isServiceAccessible(tx, []byte(identityId), []byte(id), PolicyTypeBind)
 }
 
 func (store *edgeServiceStoreImpl) IsDialableByIdentity(tx *bbolt.Tx, id string, identityId string) bool {
-	linkCount := store.dialIdentitiesCollection.GetLinkCount(tx, []byte(id), []byte(identityId))
-	return linkCount != nil && *linkCount > 0
+	return store.stores.identity.isServiceAccessible(tx, []byte(identityId), []byte(id), PolicyTypeDial)
 }
@@ -39,6 +39,8 @@ const (
 	MethodEnrollOttCa = "ottca"
 	MethodEnrollCa    = "ca"
 	MethodEnrollUpdb  = "updb"
+
+	MethodEnrollDeviceKey = "devicekey"
 )
 
 type Enrollment struct {
@@ -52,6 +52,8 @@ const (
 
 	FieldIdentityBindServices              = "bindServices"
 	FieldIdentityDialServices              = "dialServices"
+	FieldIdentityDenyBindServices          = "denyBindServices"
+	FieldIdentityDenyDialServices          = "denyDialServices"
 	FieldIdentityDefaultHostingPrecedence  = "defaultHostingPrecedence"
 	FieldIdentityDefaultHostingCost        = "defaultHostingCost"
 	FieldIdentityServiceHostingPrecedences = "serviceHostingPrecedences"
@@ -61,6 +63,10 @@ const (
 	FieldIdentityExternalId                = "externalId"
 	FieldIdentityDisabledAt                = "disabledAt"
 	FieldIdentityDisabledUntil             = "disabledUntil"
+
+	FieldIdentityDeviceKeyPublicKey   = "deviceKeyPublicKey"
+	FieldIdentityDeviceKeyFingerprint = "deviceKeyFingerprint"
+	FieldIdentityDeviceKeyEnrolledAt  = "deviceKeyEnrolledAt"
 )
 
 func newIdentity(name string, identityTypeId string, roleAttributes ...string) *Identity {
@@ -90,6 +96,14 @@ type SdkInfo struct {
 	AppVersion string `json:"appVersion"`
 }
 
+// DeviceKey is a key bound to the device an identity runs on. It is enrolled separately from the identity's
+// certificate and is used to prove that the identity is in use on the device it was enrolled on.
+type DeviceKey struct {
+	PublicKey   string    `json:"publicKey"`
+	Fingerprint string    `json:"fingerprint"`
+	EnrolledAt  time.Time `json:"enrolledAt"`
+}
+
 type Identity struct {
 	boltz.BaseExtEntity
 	Name                      string                     `json:"name"`
@@ -111,6 +125,7 @@ type Identity struct {
 	DisabledAt                *time.Time                 `json:"disabledAt"`
 	DisabledUntil             *time.Time                 `json:"disabledUntil"`
 	Disabled                  bool                       `json:"disabled"`
+	DeviceKey                 *DeviceKey                 `json:"deviceKey"`
 }
 
 func (entity *Identity) GetEntityType() string {
@@ -168,12 +183,16 @@ type identityStoreImpl struct {
 	symbolEdgeRouters        boltz.EntitySetSymbol
 	symbolBindServices       boltz.EntitySetSymbol
 	symbolDialServices       boltz.EntitySetSymbol
-
-	edgeRoutersCollection  boltz.RefCountedLinkCollection
-	bindServicesCollection boltz.RefCountedLinkCollection
-	dialServicesCollection boltz.RefCountedLinkCollection
-	symbolExternalId       boltz.EntitySymbol
-	externalIdIndex        boltz.ReadIndex
+	symbolDenyBindServices   boltz.EntitySetSymbol
+	symbolDenyDialServices   boltz.EntitySetSymbol
+
+	edgeRoutersCollection      boltz.RefCountedLinkCollection
+	bindServicesCollection     boltz.RefCountedLinkCollection
+	dialServicesCollection     boltz.RefCountedLinkCollection
+	denyBindServicesCollection boltz.RefCountedLinkCollection
+	denyDialServicesCollection boltz.RefCountedLinkCollection
+	symbolExternalId           boltz.EntitySymbol
+	externalIdIndex            boltz.ReadIndex
 }
 
 func (store *identityStoreImpl) GetRoleAttributesIndex() boltz.SetReadIndex {
@@ -190,6 +209,8 @@ func (store *identityStoreImpl) initializeLocal() {
 	store.symbolEdgeRouters = store.AddFkSetSymbol(EntityTypeRouters, store.stores.edgeRouter)
 	store.symbolBindServices = store.AddFkSetSymbol(FieldIdentityBindServices, store.stores.edgeService)
 	store.symbolDialServices = store.AddFkSetSymbol(FieldIdentityDialServices, store.stores.edgeService)
+	store.symbolDenyBindServices = store.AddFkSetSymbol(FieldIdentityDenyBindServices, store.stores.edgeService)
+	store.symbolDenyDialServices = store.AddFkSetSymbol(FieldIdentityDenyDialServices, store.stores.edgeService)
 	store.symbolEdgeRouterPolicies = store.AddFkSetSymbol(EntityTypeEdgeRouterPolicies, store.stores.edgeRouterPolicy)
 	store.symbolServicePolicies = store.AddFkSetSymbol(EntityTypeServicePolicies, store.stores.servicePolicy)
 	store.symbolEnrollments = store.AddFkSetSymbol(FieldIdentityEnrollments, store.stores.enrollment)
@@ -204,6 +225,7 @@ func (store *identityStoreImpl) initializeLocal() {
 
 	store.AddSymbol(FieldIdentityIsAdmin, ast.NodeTypeBool)
 	store.AddSymbol(FieldIdentityIsDefaultAdmin, ast.NodeTypeBool)
+	store.AddSymbol(FieldIdentityDeviceKeyFingerprint, ast.NodeTypeString)
 
 	store.indexRoleAttributes.AddListener(store.rolesChanged)
 }
@@ -215,6 +237,35 @@ func (store *identityStoreImpl) initializeLinked() {
 	store.edgeRoutersCollection = store.AddRefCountedLinkCollection(store.symbolEdgeRouters, store.stores.edgeRouter.symbolIdentities)
 	store.bindServicesCollection = store.AddRefCountedLinkCollection(store.symbolBindServices, store.stores.edgeService.symbolBindIdentities)
 	store.dialServicesCollection = store.AddRefCountedLinkCollection(store.symbolDialServices, store.stores.edgeService.symbolDialIdentities)
+	store.denyBindServicesCollection = store.AddRefCountedLinkCollection(store.symbolDenyBindServices, store.stores.edgeService.symbolDenyBindIdentities)
+	store.denyDialServicesCollection = store.AddRefCountedLinkCollection(store.symbolDenyDialServices, store.stores.edgeService.symbolDenyDialIdentities)
+}
+
+// getServicesCollection returns the identity to service links maintained for service policies of the given type and
+// effect
+func (store *identityStoreImpl) getServicesCollection(policyType PolicyType, effect PolicyEffect) boltz.RefCountedLinkCollection {
+	if effect.IsDeny() {
+		if policyType == PolicyTypeBind {
+			return store.denyBindServicesCollection
+		}
+		return store.denyDialServicesCollection
+	}
+	if policyType == PolicyTypeBind {
+		return store.bindServicesCollection
+	}
+	return store.dialServicesCollection
+}
+
+func (store *identityStoreImpl) hasServiceLink(tx *bbolt.Tx, identityId, serviceId []byte, policyType PolicyType, effect PolicyEffect) bool {
+	linkCount := store.getServicesCollection(policyType, effect).GetLinkCount(tx, identityId, serviceId)
+	return linkCount != nil && *linkCount > 0
+}
+
+// isServiceAccessible returns true if an allow policy of the given type links the identity and service, and no deny
+// policy of that type does
+func (store *identityStoreImpl) isServiceAccessible(tx *bbolt.Tx, identityId, serviceId []byte, policyType PolicyType) bool {
+	return store.hasServiceLink(tx, identityId, serviceId, policyType, PolicyEffectAllow) &&
+		!store.hasServiceLink(tx, identityId, serviceId, policyType, PolicyEffectDeny)
 }
 
 func (store *identityStoreImpl) NewEntity() *Identity {
@@ -264,6 +315,16 @@ func (store *identityStoreImpl) FillEntity(entity *Identity, bucket *boltz.Typed
 		Hostname:  bucket.GetStringWithDefault(FieldIdentityEnvInfoHostname, ""),
 	}
 
+	if publicKey := bucket.GetString(FieldIdentityDeviceKeyPublicKey); publicKey != nil {
+		entity.DeviceKey = &DeviceKey{
+			PublicKey:   *publicKey,
+			Fingerprint: bucket.GetStringWithDefault(FieldIdentityDeviceKeyFingerprint, ""),
+		}
+		if enrolledAt := bucket.GetTime(FieldIdentityDeviceKeyEnrolledAt); enrolledAt != nil {
+			entity.DeviceKey.EnrolledAt = *enrolledAt
+		}
+	}
+
 	entity.ServiceHostingPrecedences = map[string]ziti.Precedence{}
 	for k, v := range bucket.GetMap(FieldIdentityServiceHostingPrecedences) {
 		entity.ServiceHostingPrecedences[k] = ziti.Precedence(v.(int32))
@@ -307,6 +368,16 @@ func (store *identityStoreImpl) PersistEntity(entity *Identity, ctx *boltz.Persi
 	}
 	ctx.SetStringP(FieldIdentityExternalId, entity.ExternalId)
 
+	if entity.DeviceKey != nil {
+		ctx.SetString(FieldIdentityDeviceKeyPublicKey, entity.DeviceKey.PublicKey)
+		ctx.SetString(FieldIdentityDeviceKeyFingerprint, entity.DeviceKey.Fingerprint)
+		ctx.SetTimeP(FieldIdentityDeviceKeyEnrolledAt, &entity.DeviceKey.EnrolledAt)
+	} else {
+		ctx.SetStringP(FieldIdentityDeviceKeyPublicKey, nil)
+		ctx.SetStringP(FieldIdentityDeviceKeyFingerprint, nil)
+		ctx.SetTimeP(FieldIdentityDeviceKeyEnrolledAt, nil)
+	}
+
 	if entity.EnvInfo != nil {
 		ctx.SetString(FieldIdentityEnvInfoArch, entity.EnvInfo.Arch)
 		ctx.SetString(FieldIdentityEnvInfoOs, entity.EnvInfo.Os)
@@ -661,8 +732,13 @@ type IdentityServicesCursorProvider struct {
 }
 
 func (self *IdentityServicesCursorProvider) Cursor(tx *bbolt.Tx, forward bool) ast.SetCursor {
-	fst := self.store.dialServicesCollection.IterateLinks(tx, []byte(self.identityId), forward)
-	snd := self.store.bindServicesCollection.IterateLinks(tx, []byte(self.identityId), forward)
+	identityId := []byte(self.identityId)
+	fst := ast.NewFilteredCursor(self.store.dialServicesCollection.IterateLinks(tx, identityId, forward), func(serviceId []byte) bool {
+		return !self.store.hasServiceLink(tx, identityId, serviceId, PolicyTypeDial, PolicyEffectDeny)
+	})
+	snd := ast.NewFilteredCursor(self.store.bindServicesCollection.IterateLinks(tx, identityId, forward), func(serviceId []byte) bool {
+		return !self.store.hasServiceLink(tx, identityId, serviceId, PolicyTypeBind, PolicyEffectDeny)
+	})
 	if !fst.IsValid() {
 		return snd
 	}
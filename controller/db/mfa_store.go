@@ -18,8 +18,10 @@ package db
 
 import (
 	"github.com/google/uuid"
+	"github.com/michaelquigley/pfxlog"
 	"github.com/openziti/storage/boltz"
 	"github.com/openziti/ziti/common/eid"
+	"time"
 )
 
 const (
@@ -28,8 +30,29 @@ const (
 	FieldMfaRecoveryCodes = "recoveryCodes"
 	FieldMfaSecret        = "secret"
 	FieldMfaSalt          = "salt"
+
+	FieldMfaWebAuthnCredentials = "webAuthnCredentials"
+
+	FieldWebAuthnCredentialName      = "name"
+	FieldWebAuthnCredentialPublicKey = "publicKey"
+	FieldWebAuthnCredentialAlgorithm = "algorithm"
+	FieldWebAuthnCredentialSignCount = "signCount"
+	FieldWebAuthnCredentialAaguid    = "aaguid"
+	FieldWebAuthnCredentialCreatedAt = "createdAt"
 )
 
+// WebAuthnCredential is a FIDO2/WebAuthn credential, such as a hardware security key, registered as a second factor.
+// Ids, public keys and AAGUIDs are stored base64url encoded, public keys in their COSE_Key form.
+type WebAuthnCredential struct {
+	Id        string    `json:"id"`
+	Name      string    `json:"name"`
+	PublicKey string    `json:"publicKey"`
+	Algorithm int64     `json:"algorithm"`
+	SignCount int64     `json:"signCount"`
+	Aaguid    string    `json:"aaguid"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
 type Mfa struct {
 	boltz.BaseExtEntity
 	IdentityId    string   `json:"identityId"`
@@ -37,6 +60,8 @@ type Mfa struct {
 	Secret        string   `json:"secret"`
 	Salt          string   `json:"salt"`
 	RecoveryCodes []string `json:"recoveryCodes"`
+
+	WebAuthnCredentials []*WebAuthnCredential `json:"webAuthnCredentials"`
 }
 
 func NewMfa(identityId string) *Mfa {
@@ -94,6 +119,29 @@ func (store *MfaStoreImpl) FillEntity(entity *Mfa, bucket *boltz.TypedBucket) {
 	entity.RecoveryCodes = bucket.GetStringList(FieldMfaRecoveryCodes)
 	entity.Salt = bucket.GetStringOrError(FieldMfaSalt)
 	entity.Secret = bucket.GetStringWithDefault(FieldMfaSecret, "")
+
+	entity.WebAuthnCredentials = nil
+	if credentialsBucket := bucket.GetBucket(FieldMfaWebAuthnCredentials); credentialsBucket != nil {
+		cursor := credentialsBucket.Cursor()
+		for key, _ := cursor.First(); key != nil; key, _ = cursor.Next() {
+			credentialBucket := credentialsBucket.GetBucket(string(key))
+			if credentialBucket == nil {
+				continue
+			}
+			credential := &WebAuthnCredential{
+				Id:        string(key),
+				Name:      credentialBucket.GetStringWithDefault(FieldWebAuthnCredentialName, ""),
+				PublicKey: credentialBucket.GetStringOrError(FieldWebAuthnCredentialPublicKey),
+				Algorithm: credentialBucket.GetInt64WithDefault(FieldWebAuthnCredentialAlgorithm, 0),
+				SignCount: credentialBucket.GetInt64WithDefault(FieldWebAuthnCredentialSignCount, 0),
+				Aaguid:    credentialBucket.GetStringWithDefault(FieldWebAuthnCredentialAaguid, ""),
+			}
+			if createdAt := credentialBucket.GetTime(FieldWebAuthnCredentialCreatedAt); createdAt != nil {
+				credential.CreatedAt = *createdAt
+			}
+			entity.WebAuthnCredentials = append(entity.WebAuthnCredentials, credential)
+		}
+	}
 }
 
 func (store *MfaStoreImpl) PersistEntity(entity *Mfa, ctx *boltz.PersistContext) {
@@ -103,4 +151,39 @@ func (store *MfaStoreImpl) PersistEntity(entity *Mfa, ctx *boltz.PersistContext)
 	ctx.SetStringList(FieldMfaRecoveryCodes, entity.RecoveryCodes)
 	ctx.SetString(FieldMfaSalt, entity.Salt)
 	ctx.SetString(FieldMfaSecret, entity.Secret)
+
+	if ctx.ProceedWithSet(FieldMfaWebAuthnCredentials) {
+		store.persistWebAuthnCredentials(entity, ctx)
+	}
+}
+
+func (store *MfaStoreImpl) persistWebAuthnCredentials(entity *Mfa, ctx *boltz.PersistContext) {
+	credentialsBucket := ctx.Bucket.GetOrCreateBucket(FieldMfaWebAuthnCredentials)
+
+	seenKeys := map[string]struct{}{}
+	for _, credential := range entity.WebAuthnCredentials {
+		seenKeys[credential.Id] = struct{}{}
+
+		credentialBucket := credentialsBucket.GetOrCreateBucket(credential.Id)
+		credentialBucket.SetString(FieldWebAuthnCredentialName, credential.Name, nil)
+		credentialBucket.SetString(FieldWebAuthnCredentialPublicKey, credential.PublicKey, nil)
+		credentialBucket.SetInt64(FieldWebAuthnCredentialAlgorithm, credential.Algorithm, nil)
+		credentialBucket.SetInt64(FieldWebAuthnCredentialSignCount, credential.SignCount, nil)
+		credentialBucket.SetString(FieldWebAuthnCredentialAaguid, credential.Aaguid, nil)
+		credentialBucket.SetTime(FieldWebAuthnCredentialCreatedAt, credential.CreatedAt, nil)
+	}
+
+	var removeKeys [][]byte
+	cursor := credentialsBucket.Cursor()
+	for key, _ := cursor.First(); key != nil; key, _ = cursor.Next() {
+		if _, ok := seenKeys[string(key)]; !ok {
+			removeKeys = append(removeKeys, key)
+		}
+	}
+
+	for _, key := range removeKeys {
+		if err := credentialsBucket.DeleteBucket(key); err != nil {
+			pfxlog.Logger().Debugf("error deleting webauthn credential %s: %v", string(key), err)
+		}
+	}
 }
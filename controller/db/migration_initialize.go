@@ -39,7 +39,12 @@ func (m *Migrations) initialize(step *boltz.MigrationStep) int {
 	m.createInterceptV1ConfigType(step)
 	m.createHostV1ConfigType(step)
 	m.addProcessMultiPostureCheck(step)
+	m.addDeviceKeyPostureCheckType(step)
+	m.addSdkVersionPostureCheckType(step)
+	m.addSourceIpPostureCheckType(step)
 	step.SetError(m.stores.ConfigType.Create(step.Ctx, hostV2ConfigType))
+	m.createXgressV1ConfigType(step)
+	m.updateXgressV1ConfigTypeV41(step)
 	m.addSystemAuthPolicies(step)
 
 	return CurrentDbVersion
@@ -0,0 +1,51 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package db
+
+import (
+	"github.com/openziti/storage/boltz"
+	log "github.com/sirupsen/logrus"
+)
+
+// XgressV1ConfigTypeId is the id of the config type used to override router xgress options for a service's circuits
+const XgressV1ConfigTypeId = "xgress.v1"
+
+var xgressV1ConfigType = &ConfigType{
+	BaseExtEntity: boltz.BaseExtEntity{Id: XgressV1ConfigTypeId},
+	Name:          "xgress.v1",
+	Schema: map[string]interface{}{
+		"$id":                  "http://ziti-edge.netfoundry.io/schemas/xgress.v1.schema.json",
+		"type":                 "object",
+		"additionalProperties": false,
+		"properties": map[string]interface{}{
+			"congestionControl": map[string]interface{}{
+				"type":        "string",
+				"enum":        []interface{}{"txportal", "cubic", "bbr"},
+				"description": "Congestion control algorithm used for the service's circuits, overriding the router's congestionControl xgress option",
+			},
+		},
+	},
+}
+
+func (m *Migrations) createXgressV1ConfigType(step *boltz.MigrationStep) {
+	cfg, _ := m.stores.ConfigType.LoadOneByName(step.Ctx.Tx(), xgressV1ConfigType.Name)
+	if cfg == nil {
+		step.SetError(m.stores.ConfigType.Create(step.Ctx, xgressV1ConfigType))
+	} else {
+		log.Debugf("'%s' config type already exists. not creating.", xgressV1ConfigType.Name)
+	}
+}
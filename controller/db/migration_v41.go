@@ -0,0 +1,58 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package db
+
+import (
+	"github.com/openziti/storage/boltz"
+)
+
+// xgressV1ConfigTypeV41 adds the fec option to the xgress.v1 config type
+var xgressV1ConfigTypeV41 = &ConfigType{
+	BaseExtEntity: boltz.BaseExtEntity{Id: XgressV1ConfigTypeId},
+	Name:          "xgress.v1",
+	Schema: map[string]interface{}{
+		"$id":                  "http://ziti-edge.netfoundry.io/schemas/xgress.v1.schema.json",
+		"type":                 "object",
+		"additionalProperties": false,
+		"properties": map[string]interface{}{
+			"congestionControl": map[string]interface{}{
+				"type":        "string",
+				"enum":        []interface{}{"txportal", "cubic", "bbr"},
+				"description": "Congestion control algorithm used for the service's circuits, overriding the router's congestionControl xgress option",
+			},
+			"fec": map[string]interface{}{
+				"type":        "boolean",
+				"description": "Enables or disables forward error correction for the service's circuits, overriding the router's fec xgress option",
+			},
+		},
+	},
+}
+
+// updateXgressV1ConfigTypeV41 updates the schema of the xgress.v1 config type. The config type is looked up by name,
+// as v40 doesn't create it if a config type with that name already exists, which may have a different id
+func (m *Migrations) updateXgressV1ConfigTypeV41(step *boltz.MigrationStep) {
+	cfg, _ := m.stores.ConfigType.LoadOneByName(step.Ctx.Tx(), xgressV1ConfigTypeV41.Name)
+	if cfg == nil {
+		step.SetError(m.stores.ConfigType.Create(step.Ctx, xgressV1ConfigTypeV41))
+		return
+	}
+
+	cfg.Schema = xgressV1ConfigTypeV41.Schema
+	step.SetError(m.stores.ConfigType.Update(step.Ctx, cfg, boltz.MapFieldChecker{
+		FieldConfigTypeSchema: struct{}{},
+	}))
+}
@@ -23,7 +23,7 @@ import (
 )
 
 const (
-	CurrentDbVersion = 36
+	CurrentDbVersion = 41
 	FieldVersion     = "version"
 )
 
@@ -171,6 +171,26 @@ func (m *Migrations) migrate(step *boltz.MigrationStep) int {
 		m.dropEntity(step, EntityTypeApiSessionCertificates)
 	}
 
+	if step.CurrentVersion < 37 {
+		m.addDeviceKeyPostureCheckType(step)
+	}
+
+	if step.CurrentVersion < 38 {
+		m.addSdkVersionPostureCheckType(step)
+	}
+
+	if step.CurrentVersion < 39 {
+		m.addSourceIpPostureCheckType(step)
+	}
+
+	if step.CurrentVersion < 40 {
+		m.createXgressV1ConfigType(step)
+	}
+
+	if step.CurrentVersion < 41 {
+		m.updateXgressV1ConfigTypeV41(step)
+	}
+
 	// current version
 	if step.CurrentVersion <= CurrentDbVersion {
 		return CurrentDbVersion
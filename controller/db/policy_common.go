@@ -92,6 +92,21 @@ func (self *roleAttributeChangeContext) addServicePolicyEvent(identityId, servic
 	self.addServiceEvent(self.tx(), identityId, serviceId, eventType)
 }
 
+// addServiceAccessEvent reports a change to the identity to service links of the given policy type and effect as a
+// change in access. Deny policies take precedence, so allow links only affect access when no deny links are present,
+// and deny links only affect access when allow links are present.
+func (self *roleAttributeChangeContext) addServiceAccessEvent(stores *stores, identityId, serviceId []byte, policyType PolicyType, effect PolicyEffect, add bool) {
+	if effect.IsDeny() {
+		if !stores.identity.hasServiceLink(self.tx(), identityId, serviceId, policyType, PolicyEffectAllow) {
+			return
+		}
+		add = !add
+	} else if stores.identity.hasServiceLink(self.tx(), identityId, serviceId, policyType, PolicyEffectDeny) {
+		return
+	}
+	self.addServicePolicyEvent(identityId, serviceId, policyType, add)
+}
+
 func (self *roleAttributeChangeContext) notifyOfPolicyChangeEvent(
 	policyId []byte,
 	relatedId []byte,
@@ -159,36 +174,23 @@ func (store *baseStore[E]) updateServicePolicyRelatedRoles(ctx *roleAttributeCha
 		if fieldType, policyTypeValue := policyTypeSymbol.Eval(ctx.tx(), policyId); fieldType == boltz.TypeInt32 {
 			policyType = GetPolicyTypeForId(*boltz.BytesToInt32(policyTypeValue))
 		}
-		if policyType == PolicyTypeDial {
-			if isServices {
-				ctx.denormLinkCollection = store.stores.edgeService.dialIdentitiesCollection
-				ctx.denormChangeHandler = func(fromId, toId []byte, add bool) {
-					ctx.addServicePolicyEvent(toId, fromId, PolicyTypeDial, add)
-				}
-			} else if isIdentity {
-				ctx.denormLinkCollection = store.stores.identity.dialServicesCollection
-				ctx.denormChangeHandler = func(fromId, toId []byte, add bool) {
-					ctx.addServicePolicyEvent(fromId, toId, PolicyTypeDial, add)
-				}
-			} else {
-				ctx.denormLinkCollection = store.stores.postureCheck.dialServicesCollection
-				ctx.denormChangeHandler = func(fromId, toId []byte, add bool) {
-					pfxlog.Logger().Warnf("posture check %v -> service %v - included? %v", string(fromId), string(toId), add)
-					ctx.addServiceUpdatedEvent(store.stores, ctx.tx(), toId)
-				}
-			}
-		} else if isServices {
-			ctx.denormLinkCollection = store.stores.edgeService.bindIdentitiesCollection
+		effect := servicePolicyStore.GetEffect(ctx.tx(), policyId)
+		if isServices {
+			ctx.denormLinkCollection = store.stores.edgeService.getIdentitiesCollection(policyType, effect)
 			ctx.denormChangeHandler = func(fromId, toId []byte, add bool) {
-				ctx.addServicePolicyEvent(toId, fromId, PolicyTypeBind, add)
+				ctx.addServiceAccessEvent(store.stores, toId, fromId, policyType, effect, add)
 			}
 		} else if isIdentity {
-			ctx.denormLinkCollection = store.stores.identity.bindServicesCollection
+			ctx.denormLinkCollection = store.stores.identity.getServicesCollection(policyType, effect)
 			ctx.denormChangeHandler = func(fromId, toId []byte, add bool) {
-				ctx.addServicePolicyEvent(fromId, toId, PolicyTypeBind, add)
+				ctx.addServiceAccessEvent(store.stores, fromId, toId, policyType, effect, add)
 			}
 		} else {
-			ctx.denormLinkCollection = store.stores.postureCheck.bindServicesCollection
+			if policyType == PolicyTypeDial {
+				ctx.denormLinkCollection = store.stores.postureCheck.dialServicesCollection
+			} else {
+				ctx.denormLinkCollection = store.stores.postureCheck.bindServicesCollection
+			}
 			ctx.denormChangeHandler = func(fromId, toId []byte, add bool) {
 				pfxlog.Logger().Warnf("posture check %v -> service %v - included? %v", string(fromId), string(toId), add)
 				ctx.addServiceUpdatedEvent(store.stores, ctx.tx(), toId)
@@ -286,15 +288,29 @@ func UpdateRelatedRoles(ctx *roleAttributeChangeContext, entityId []byte, newRol
 }
 
 func evaluatePolicyAgainstEntity(ctx *roleAttributeChangeContext, semantic string, entityId, policyId []byte, ids, roles, roleAttributes []string) (bool, bool) {
-	if stringz.Contains(ids, string(entityId)) || stringz.Contains(roles, "all") ||
-		(strings.EqualFold(semantic, SemanticAllOf) && len(roles) > 0 && stringz.ContainsAll(roleAttributes, roles...)) ||
-		(strings.EqualFold(semantic, SemanticAnyOf) && len(roles) > 0 && stringz.ContainsAny(roleAttributes, roles...)) {
+	if IsEntitySelected(semantic, string(entityId), ids, roles, roleAttributes) {
 		return true, ProcessEntityPolicyMatched(ctx, entityId, policyId)
 	} else {
 		return false, ProcessEntityPolicyUnmatched(ctx, entityId, policyId)
 	}
 }
 
+// IsEntitySelected reports whether a policy with the given semantic, role attributes and ids selects the entity with
+// the given id and role attributes
+func IsEntitySelected(semantic string, entityId string, ids, roles, roleAttributes []string) bool {
+	return stringz.Contains(ids, entityId) || stringz.Contains(roles, "all") ||
+		(strings.EqualFold(semantic, SemanticAllOf) && len(roles) > 0 && stringz.ContainsAll(roleAttributes, roles...)) ||
+		(strings.EqualFold(semantic, SemanticAnyOf) && len(roles) > 0 && stringz.ContainsAny(roleAttributes, roles...))
+}
+
+// SplitRolesAndIds validates the given policy roles and splits them into role attributes and entity ids
+func SplitRolesAndIds(field string, values []string) ([]string, []string, error) {
+	if err := validateRolesAndIds(field, values); err != nil {
+		return nil, nil, err
+	}
+	return splitRolesAndIds(values)
+}
+
 func ProcessEntityPolicyMatched(ctx *roleAttributeChangeContext, entityId, policyId []byte) bool {
 	// first add it to the denormalize link table from the policy to the entity (ex: service policy -> identity)
 	// If it's already there (in other words, this policy didn't change in relation to the entity,
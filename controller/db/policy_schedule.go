@@ -0,0 +1,88 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package db
+
+import (
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/storage/ast"
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/common/schedule"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	FieldPolicyValidFrom        = "validFrom"
+	FieldPolicyValidUntil       = "validUntil"
+	FieldPolicyScheduleWindows  = "scheduleWindows"
+	FieldPolicyScheduleTimezone = "scheduleTimezone"
+)
+
+// PolicyScheduleFields are the fields which make up a policy's schedule, for use when updating only the schedule
+var PolicyScheduleFields = []string{
+	FieldPolicyValidFrom,
+	FieldPolicyValidUntil,
+	FieldPolicyScheduleWindows,
+	FieldPolicyScheduleTimezone,
+}
+
+// ScheduledPolicyStore is implemented by stores for policies which may be restricted to a schedule
+type ScheduledPolicyStore interface {
+	boltz.Store
+	// GetSchedule returns the schedule for the given policy, or nil if the policy is always in force
+	GetSchedule(tx *bbolt.Tx, id []byte) *schedule.Schedule
+}
+
+func addPolicyScheduleSymbols(store boltz.ConfigurableStore) {
+	store.AddSymbol(FieldPolicyValidFrom, ast.NodeTypeDatetime)
+	store.AddSymbol(FieldPolicyValidUntil, ast.NodeTypeDatetime)
+	store.AddSetSymbol(FieldPolicyScheduleWindows, ast.NodeTypeString)
+	store.AddSymbol(FieldPolicyScheduleTimezone, ast.NodeTypeString)
+}
+
+func loadPolicySchedule(bucket *boltz.TypedBucket) *schedule.Schedule {
+	if bucket == nil {
+		return nil
+	}
+
+	result := &schedule.Schedule{
+		ValidFrom:  bucket.GetTime(FieldPolicyValidFrom),
+		ValidUntil: bucket.GetTime(FieldPolicyValidUntil),
+		Windows:    bucket.GetStringList(FieldPolicyScheduleWindows),
+		Timezone:   bucket.GetStringWithDefault(FieldPolicyScheduleTimezone, ""),
+	}
+
+	if !result.IsSet() {
+		return nil
+	}
+	return result
+}
+
+func persistPolicySchedule(policySchedule *schedule.Schedule, ctx *boltz.PersistContext) {
+	if policySchedule == nil {
+		policySchedule = &schedule.Schedule{}
+	}
+
+	if err := policySchedule.Validate(); err != nil {
+		ctx.Bucket.SetError(errorz.NewFieldError(err.Error(), FieldPolicyScheduleWindows, policySchedule.Windows))
+		return
+	}
+
+	ctx.SetTimeP(FieldPolicyValidFrom, policySchedule.ValidFrom)
+	ctx.SetTimeP(FieldPolicyValidUntil, policySchedule.ValidUntil)
+	ctx.SetStringList(FieldPolicyScheduleWindows, policySchedule.Windows)
+	ctx.SetString(FieldPolicyScheduleTimezone, policySchedule.Timezone)
+}
@@ -0,0 +1,53 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package db
+
+import (
+	"github.com/openziti/storage/boltz"
+)
+
+const (
+	FieldPostureCheckDeviceKeyTimeoutSeconds = "timeoutSeconds"
+)
+
+// PostureCheckDeviceKey requires the endpoint to prove possession of the device key enrolled for its identity. A
+// TimeoutSeconds of -1 means a proof is valid for the lifetime of the API session.
+type PostureCheckDeviceKey struct {
+	TimeoutSeconds int64 `json:"timeoutSeconds"`
+}
+
+func newPostureCheckDeviceKey() PostureCheckSubType {
+	return &PostureCheckDeviceKey{
+		TimeoutSeconds: -1,
+	}
+}
+
+func (entity *PostureCheckDeviceKey) LoadValues(bucket *boltz.TypedBucket) {
+	entity.TimeoutSeconds = bucket.GetInt64WithDefault(FieldPostureCheckDeviceKeyTimeoutSeconds, -1)
+
+	if entity.TimeoutSeconds <= 0 {
+		entity.TimeoutSeconds = -1
+	}
+}
+
+func (entity *PostureCheckDeviceKey) SetValues(ctx *boltz.PersistContext, bucket *boltz.TypedBucket) {
+	if entity.TimeoutSeconds <= 0 {
+		entity.TimeoutSeconds = -1
+	}
+
+	bucket.SetInt64(FieldPostureCheckDeviceKeyTimeoutSeconds, entity.TimeoutSeconds, ctx.FieldChecker)
+}
@@ -0,0 +1,105 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package db
+
+import (
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/storage/boltz"
+)
+
+const (
+	FieldPostureCheckSdkVersionConstraints = "constraints"
+	FieldPostureCheckSdkVersionAppIds      = "appIds"
+	FieldPostureCheckSdkVersionSdkType     = "sdkType"
+	FieldPostureCheckSdkVersionOsType      = "osType"
+	FieldPostureCheckSdkVersionVersions    = "versions"
+)
+
+// PostureCheckSdkVersion requires the SDK or tunneler reported on the API session to match one of the constraints.
+// If AppIds is not empty, the reported application id must also be one of them.
+type PostureCheckSdkVersion struct {
+	Constraints []*SdkVersionConstraint `json:"constraints"`
+	AppIds      []string                `json:"appIds"`
+}
+
+// SdkVersionConstraint applies a semver range to SDKs of the given type on the given OS. An empty SdkType or OsType
+// matches any value.
+type SdkVersionConstraint struct {
+	SdkType  string `json:"sdkType"`
+	OsType   string `json:"osType"`
+	Versions string `json:"versions"`
+}
+
+func newPostureCheckSdkVersion() PostureCheckSubType {
+	return &PostureCheckSdkVersion{}
+}
+
+func (entity *PostureCheckSdkVersion) LoadValues(bucket *boltz.TypedBucket) {
+	entity.AppIds = bucket.GetStringList(FieldPostureCheckSdkVersionAppIds)
+
+	constraintsBucket := bucket.GetBucket(FieldPostureCheckSdkVersionConstraints)
+	if constraintsBucket == nil {
+		return
+	}
+
+	cursor := constraintsBucket.Cursor()
+	for key, _ := cursor.First(); key != nil; key, _ = cursor.Next() {
+		constraintBucket := constraintsBucket.GetBucket(string(key))
+		if constraintBucket == nil {
+			continue
+		}
+
+		entity.Constraints = append(entity.Constraints, &SdkVersionConstraint{
+			SdkType:  constraintBucket.GetStringWithDefault(FieldPostureCheckSdkVersionSdkType, ""),
+			OsType:   constraintBucket.GetStringWithDefault(FieldPostureCheckSdkVersionOsType, ""),
+			Versions: constraintBucket.GetStringWithDefault(FieldPostureCheckSdkVersionVersions, ""),
+		})
+	}
+}
+
+func (entity *PostureCheckSdkVersion) SetValues(ctx *boltz.PersistContext, bucket *boltz.TypedBucket) {
+	bucket.SetStringList(FieldPostureCheckSdkVersionAppIds, entity.AppIds, ctx.FieldChecker)
+
+	constraintsBucket := bucket.GetOrCreateBucket(FieldPostureCheckSdkVersionConstraints)
+
+	seenKeys := map[string]struct{}{}
+	for _, constraint := range entity.Constraints {
+		key := constraint.SdkType + "-" + constraint.OsType
+		seenKeys[key] = struct{}{}
+
+		constraintBucket := constraintsBucket.GetOrCreateBucket(key)
+
+		constraintBucket.SetString(FieldPostureCheckSdkVersionSdkType, constraint.SdkType, ctx.FieldChecker)
+		constraintBucket.SetString(FieldPostureCheckSdkVersionOsType, constraint.OsType, ctx.FieldChecker)
+		constraintBucket.SetString(FieldPostureCheckSdkVersionVersions, constraint.Versions, ctx.FieldChecker)
+	}
+
+	cursor := constraintsBucket.Cursor()
+
+	var removeKeys [][]byte
+	for key, _ := cursor.First(); key != nil; key, _ = cursor.Next() {
+		if _, ok := seenKeys[string(key)]; !ok {
+			removeKeys = append(removeKeys, key)
+		}
+	}
+
+	for _, key := range removeKeys {
+		if err := constraintsBucket.DeleteBucket(key); err != nil {
+			pfxlog.Logger().Debugf("error deleting sdk version constraint key %s: %v", string(key), err)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package db
+
+import (
+	"github.com/openziti/storage/boltz"
+)
+
+const (
+	FieldPostureCheckSourceIpAllowedCidrs = "allowedCidrs"
+	FieldPostureCheckSourceIpDeniedCidrs  = "deniedCidrs"
+)
+
+// PostureCheckSourceIp restricts access by the observed source IP of the client. Denied CIDRs take precedence. If
+// AllowedCidrs is not empty, the source IP must be in one of them.
+type PostureCheckSourceIp struct {
+	AllowedCidrs []string `json:"allowedCidrs"`
+	DeniedCidrs  []string `json:"deniedCidrs"`
+}
+
+func newPostureCheckSourceIp() PostureCheckSubType {
+	return &PostureCheckSourceIp{}
+}
+
+func (entity *PostureCheckSourceIp) LoadValues(bucket *boltz.TypedBucket) {
+	entity.AllowedCidrs = bucket.GetStringList(FieldPostureCheckSourceIpAllowedCidrs)
+	entity.DeniedCidrs = bucket.GetStringList(FieldPostureCheckSourceIpDeniedCidrs)
+}
+
+func (entity *PostureCheckSourceIp) SetValues(ctx *boltz.PersistContext, bucket *boltz.TypedBucket) {
+	bucket.SetStringList(FieldPostureCheckSourceIpAllowedCidrs, entity.AllowedCidrs, ctx.FieldChecker)
+	bucket.SetStringList(FieldPostureCheckSourceIpDeniedCidrs, entity.DeniedCidrs, ctx.FieldChecker)
+}
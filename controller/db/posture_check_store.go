@@ -26,10 +26,11 @@ import (
 
 const (
 	//Fields
-	FieldPostureCheckTypeId       = "typeId"
-	FieldPostureCheckVersion      = "version"
-	FieldPostureCheckBindServices = "bindServices"
-	FieldPostureCheckDialServices = "dialServices"
+	FieldPostureCheckTypeId             = "typeId"
+	FieldPostureCheckVersion            = "version"
+	FieldPostureCheckGracePeriodSeconds = "gracePeriodSeconds"
+	FieldPostureCheckBindServices       = "bindServices"
+	FieldPostureCheckDialServices       = "dialServices"
 )
 
 const (
@@ -39,6 +40,9 @@ const (
 	PostureCheckTypeProcessMulti = "PROCESS_MULTI"
 	PostureCheckTypeMAC          = "MAC"
 	PostureCheckTypeMFA          = "MFA"
+	PostureCheckTypeDeviceKey    = "DEVICE_KEY"
+	PostureCheckTypeSdkVersion   = "SDK_VERSION"
+	PostureCheckTypeSourceIp     = "SOURCE_IP"
 )
 
 var postureCheckSubTypeMap = map[string]newPostureCheckSubType{
@@ -48,6 +52,9 @@ var postureCheckSubTypeMap = map[string]newPostureCheckSubType{
 	PostureCheckTypeProcessMulti: newPostureCheckProcessMulti,
 	PostureCheckTypeMAC:          newPostureCheckMacAddresses,
 	PostureCheckTypeMFA:          newPostureCheckMfa,
+	PostureCheckTypeDeviceKey:    newPostureCheckDeviceKey,
+	PostureCheckTypeSdkVersion:   newPostureCheckSdkVersion,
+	PostureCheckTypeSourceIp:     newPostureCheckSourceIp,
 }
 
 type newPostureCheckSubType func() PostureCheckSubType
@@ -66,11 +73,12 @@ func newPostureCheck(typeId string) PostureCheckSubType {
 
 type PostureCheck struct {
 	boltz.BaseExtEntity
-	Name           string              `json:"name"`
-	TypeId         string              `json:"typeId"`
-	Version        int64               `json:"version"`
-	RoleAttributes []string            `json:"roleAttributes"`
-	SubType        PostureCheckSubType `json:"subType"`
+	Name               string              `json:"name"`
+	TypeId             string              `json:"typeId"`
+	Version            int64               `json:"version"`
+	RoleAttributes     []string            `json:"roleAttributes"`
+	GracePeriodSeconds int64               `json:"gracePeriodSeconds"`
+	SubType            PostureCheckSubType `json:"subType"`
 }
 
 func (entity *PostureCheck) GetName() string {
@@ -118,6 +126,7 @@ func (store *postureCheckStoreImpl) FillEntity(entity *PostureCheck, bucket *bol
 	entity.TypeId = bucket.GetStringOrError(FieldPostureCheckTypeId)
 	entity.Version = bucket.GetInt64WithDefault(FieldPostureCheckVersion, 0)
 	entity.RoleAttributes = bucket.GetStringList(FieldRoleAttributes)
+	entity.GracePeriodSeconds = bucket.GetInt64WithDefault(FieldPostureCheckGracePeriodSeconds, 0)
 
 	entity.SubType = newPostureCheck(entity.TypeId)
 	if entity.SubType == nil {
@@ -135,6 +144,7 @@ func (store *postureCheckStoreImpl) PersistEntity(entity *PostureCheck, ctx *bol
 	ctx.SetString(FieldPostureCheckTypeId, entity.TypeId)
 	ctx.SetInt64(FieldPostureCheckVersion, entity.Version)
 	ctx.SetStringList(FieldRoleAttributes, entity.RoleAttributes)
+	ctx.SetInt64(FieldPostureCheckGracePeriodSeconds, entity.GracePeriodSeconds)
 
 	childBucket := ctx.Bucket.GetOrCreateBucket(entity.TypeId)
 
@@ -153,6 +163,7 @@ func (store *postureCheckStoreImpl) GetRoleAttributesIndex() boltz.SetReadIndex
 func (store *postureCheckStoreImpl) initializeLocal() {
 	store.AddExtEntitySymbols()
 	store.indexName = store.addUniqueNameField()
+	store.AddSymbol(FieldPostureCheckGracePeriodSeconds, ast.NodeTypeInt64)
 	store.AddSymbol(FieldPostureCheckMfaPromptOnUnlock, ast.NodeTypeBool, PostureCheckTypeMFA)
 	store.AddSymbol(FieldPostureCheckMfaPromptOnWake, ast.NodeTypeBool, PostureCheckTypeMFA)
 
@@ -7,7 +7,10 @@ import (
 	"github.com/openziti/storage/ast"
 	"github.com/openziti/storage/boltz"
 	"github.com/openziti/ziti/common/pb/edge_ctrl_pb"
+	"github.com/openziti/ziti/common/schedule"
+	"go.etcd.io/bbolt"
 	"sort"
+	"strings"
 )
 
 type PolicyType string
@@ -44,8 +47,28 @@ func GetPolicyTypeForId(policyTypeId int32) PolicyType {
 	return policyType
 }
 
+// PolicyEffect determines whether a service policy grants or denies access. Deny policies take precedence over allow
+// policies, so an identity matched by both for a service may not use it.
+type PolicyEffect string
+
+func (self PolicyEffect) String() string {
+	return string(self)
+}
+
+func (self PolicyEffect) IsDeny() bool {
+	return self == PolicyEffectDeny
+}
+
+func (self PolicyEffect) IsValid() bool {
+	return self == PolicyEffectAllow || self == PolicyEffectDeny
+}
+
 const (
-	FieldServicePolicyType = "type"
+	FieldServicePolicyType   = "type"
+	FieldServicePolicyEffect = "effect"
+
+	PolicyEffectAllow PolicyEffect = "Allow"
+	PolicyEffectDeny  PolicyEffect = "Deny"
 
 	PolicyTypeInvalidName = "Invalid"
 	PolicyTypeDialName    = "Dial"
@@ -58,12 +81,14 @@ const (
 
 type ServicePolicy struct {
 	boltz.BaseExtEntity
-	PolicyType        PolicyType `json:"policyType"`
-	Name              string     `json:"name"`
-	Semantic          string     `json:"semantic"`
-	IdentityRoles     []string   `json:"identityRoles"`
-	ServiceRoles      []string   `json:"serviceRoles"`
-	PostureCheckRoles []string   `json:"postureCheckRoles"`
+	PolicyType        PolicyType         `json:"policyType"`
+	Name              string             `json:"name"`
+	Semantic          string             `json:"semantic"`
+	IdentityRoles     []string           `json:"identityRoles"`
+	ServiceRoles      []string           `json:"serviceRoles"`
+	PostureCheckRoles []string           `json:"postureCheckRoles"`
+	Schedule          *schedule.Schedule `json:"schedule,omitempty"`
+	Effect            PolicyEffect       `json:"effect,omitempty"`
 }
 
 func (entity *ServicePolicy) GetName() string {
@@ -85,6 +110,9 @@ var _ ServicePolicyStore = (*servicePolicyStoreImpl)(nil)
 type ServicePolicyStore interface {
 	NameIndexed
 	Store[*ServicePolicy]
+	ScheduledPolicyStore
+	// GetEffect returns whether the given policy allows or denies access
+	GetEffect(tx *bbolt.Tx, id []byte) PolicyEffect
 }
 
 func newServicePolicyStore(stores *stores) *servicePolicyStoreImpl {
@@ -100,6 +128,7 @@ type servicePolicyStoreImpl struct {
 	indexName        boltz.ReadIndex
 	symbolPolicyType boltz.EntitySymbol
 	symbolSemantic   boltz.EntitySymbol
+	symbolEffect     boltz.EntitySymbol
 
 	symbolIdentityRoles     boltz.EntitySetSymbol
 	symbolServiceRoles      boltz.EntitySetSymbol
@@ -128,10 +157,12 @@ func (store *servicePolicyStoreImpl) initializeLocal() {
 	store.indexName = store.addUniqueNameField()
 	store.symbolPolicyType = store.AddSymbol(FieldServicePolicyType, ast.NodeTypeInt64)
 	store.symbolSemantic = store.AddSymbol(FieldSemantic, ast.NodeTypeString)
+	store.symbolEffect = store.AddSymbol(FieldServicePolicyEffect, ast.NodeTypeString)
 
 	store.symbolIdentityRoles = store.AddPublicSetSymbol(FieldIdentityRoles, ast.NodeTypeString)
 	store.symbolServiceRoles = store.AddPublicSetSymbol(FieldServiceRoles, ast.NodeTypeString)
 	store.symbolPostureCheckRoles = store.AddPublicSetSymbol(FieldPostureCheckRoles, ast.NodeTypeString)
+	addPolicyScheduleSymbols(store)
 
 	store.symbolIdentities = store.AddFkSetSymbol(EntityTypeIdentities, store.stores.identity)
 	store.symbolServices = store.AddFkSetSymbol(EntityTypeServices, store.stores.edgeService)
@@ -152,6 +183,19 @@ func (store *servicePolicyStoreImpl) FillEntity(entity *ServicePolicy, bucket *b
 	entity.IdentityRoles = bucket.GetStringList(FieldIdentityRoles)
 	entity.ServiceRoles = bucket.GetStringList(FieldServiceRoles)
 	entity.PostureCheckRoles = bucket.GetStringList(FieldPostureCheckRoles)
+	entity.Schedule = loadPolicySchedule(bucket)
+	entity.Effect = PolicyEffect(bucket.GetStringWithDefault(FieldServicePolicyEffect, PolicyEffectAllow.String()))
+}
+
+func (store *servicePolicyStoreImpl) GetSchedule(tx *bbolt.Tx, id []byte) *schedule.Schedule {
+	return loadPolicySchedule(store.GetEntityBucket(tx, id))
+}
+
+func (store *servicePolicyStoreImpl) GetEffect(tx *bbolt.Tx, id []byte) PolicyEffect {
+	if effect := boltz.FieldToString(store.symbolEffect.Eval(tx, id)); effect != nil {
+		return PolicyEffect(*effect)
+	}
+	return PolicyEffectAllow
 }
 
 func (store *servicePolicyStoreImpl) PersistEntity(entity *ServicePolicy, ctx *boltz.PersistContext) {
@@ -166,6 +210,39 @@ func (store *servicePolicyStoreImpl) PersistEntity(entity *ServicePolicy, ctx *b
 		entity.PolicyType = GetPolicyTypeForId(ctx.Bucket.GetInt32WithDefault(FieldServicePolicyType, PolicyTypeDial.Id()))
 	}
 
+	if ctx.ProceedWithSet(FieldServicePolicyEffect) {
+		if entity.Effect == "" {
+			entity.Effect = PolicyEffectAllow
+		}
+		if !entity.Effect.IsValid() {
+			ctx.Bucket.SetError(errorz.NewFieldError("invalid policy effect", FieldServicePolicyEffect, entity.Effect))
+			return
+		}
+	} else {
+		entity.Effect = PolicyEffect(ctx.Bucket.GetStringWithDefault(FieldServicePolicyEffect, PolicyEffectAllow.String()))
+	}
+
+	if entity.Effect.IsDeny() {
+		// deny policies always apply, so they can't be made conditional on posture or on a schedule
+		postureCheckRoles := entity.PostureCheckRoles
+		if !ctx.ProceedWithSet(FieldPostureCheckRoles) {
+			postureCheckRoles = ctx.Bucket.GetStringList(FieldPostureCheckRoles)
+		}
+		if len(postureCheckRoles) > 0 {
+			ctx.Bucket.SetError(errorz.NewFieldError("deny policies may not have posture check roles", FieldPostureCheckRoles, postureCheckRoles))
+			return
+		}
+
+		policySchedule := entity.Schedule
+		if !ctx.ProceedWithSet(FieldPolicyScheduleWindows) {
+			policySchedule = loadPolicySchedule(ctx.Bucket)
+		}
+		if policySchedule.IsSet() {
+			ctx.Bucket.SetError(errorz.NewFieldError("deny policies may not have a schedule", FieldPolicyScheduleWindows, policySchedule.Windows))
+			return
+		}
+	}
+
 	if err := validateRolesAndIds(FieldIdentityRoles, entity.IdentityRoles); err != nil {
 		ctx.Bucket.SetError(err)
 	}
@@ -183,12 +260,25 @@ func (store *servicePolicyStoreImpl) PersistEntity(entity *ServicePolicy, ctx *b
 		return
 	}
 
+	oldPolicyType := GetPolicyTypeForId(ctx.Bucket.GetInt32WithDefault(FieldServicePolicyType, PolicyTypeDial.Id()))
+	oldEffect := PolicyEffect(ctx.Bucket.GetStringWithDefault(FieldServicePolicyEffect, PolicyEffectAllow.String()))
+
 	entity.SetBaseValues(ctx)
 	ctx.SetRequiredString(FieldName, entity.Name)
 	ctx.SetInt32(FieldServicePolicyType, entity.PolicyType.Id())
 	ctx.SetRequiredString(FieldSemantic, entity.Semantic)
+	ctx.SetString(FieldServicePolicyEffect, entity.Effect.String())
+	persistPolicySchedule(entity.Schedule, ctx)
 	servicePolicyStore := ctx.Store.(*servicePolicyStoreImpl)
 
+	if !ctx.IsCreate && (oldPolicyType != entity.PolicyType || oldEffect != entity.Effect) {
+		servicePolicyStore.relinkForTypeOrEffectChange(ctx, &ServicePolicy{
+			BaseExtEntity: boltz.BaseExtEntity{Id: entity.Id},
+			PolicyType:    oldPolicyType,
+			Effect:        oldEffect,
+		}, entity)
+	}
+
 	sort.Strings(entity.ServiceRoles)
 	sort.Strings(entity.IdentityRoles)
 	sort.Strings(entity.PostureCheckRoles)
@@ -209,6 +299,26 @@ func (store *servicePolicyStoreImpl) PersistEntity(entity *ServicePolicy, ctx *b
 	}
 }
 
+// relinkForTypeOrEffectChange moves the denormalized links of a policy whose type or effect changed to the collections
+// for its new type and effect. Roles which are being updated are linked for the new type and effect as they're persisted.
+func (store *servicePolicyStoreImpl) relinkForTypeOrEffectChange(ctx *boltz.PersistContext, previous *ServicePolicy, policy *ServicePolicy) {
+	identityRoles := ctx.Bucket.GetStringList(FieldIdentityRoles)
+	ctx.Bucket.SetStringList(FieldIdentityRoles, nil, nil)
+	store.identityRolesUpdated(ctx, previous)
+	if !ctx.ProceedWithSet(FieldIdentityRoles) {
+		ctx.Bucket.SetStringList(FieldIdentityRoles, identityRoles, nil)
+		store.identityRolesUpdated(ctx, policy)
+	}
+
+	postureCheckRoles := ctx.Bucket.GetStringList(FieldPostureCheckRoles)
+	ctx.Bucket.SetStringList(FieldPostureCheckRoles, nil, nil)
+	store.postureCheckRolesUpdated(ctx, previous)
+	if !ctx.ProceedWithSet(FieldPostureCheckRoles) {
+		ctx.Bucket.SetStringList(FieldPostureCheckRoles, postureCheckRoles, nil)
+		store.postureCheckRolesUpdated(ctx, policy)
+	}
+}
+
 /*
 Optimizations
  1. When changing policies if only ids have changed, only add/remove ids from groups as needed
@@ -224,16 +334,9 @@ func (store *servicePolicyStoreImpl) serviceRolesUpdated(persistCtx *boltz.Persi
 		relatedLinkCollection: store.identityCollection,
 		ErrorHolder:           persistCtx.Bucket,
 	}
-	if policy.PolicyType == PolicyTypeDial {
-		ctx.denormLinkCollection = store.stores.edgeService.dialIdentitiesCollection
-		ctx.denormChangeHandler = func(fromId, toId []byte, add bool) {
-			ctx.addServicePolicyEvent(toId, fromId, PolicyTypeDial, add)
-		}
-	} else {
-		ctx.denormLinkCollection = store.stores.edgeService.bindIdentitiesCollection
-		ctx.denormChangeHandler = func(fromId, toId []byte, add bool) {
-			ctx.addServicePolicyEvent(toId, fromId, PolicyTypeBind, add)
-		}
+	ctx.denormLinkCollection = store.stores.edgeService.getIdentitiesCollection(policy.PolicyType, policy.Effect)
+	ctx.denormChangeHandler = func(fromId, toId []byte, add bool) {
+		ctx.addServiceAccessEvent(store.stores, toId, fromId, policy.PolicyType, policy.Effect, add)
 	}
 
 	ctx.changeHandler = func(policyId []byte, relatedId []byte, add bool) {
@@ -252,16 +355,9 @@ func (store *servicePolicyStoreImpl) identityRolesUpdated(persistCtx *boltz.Pers
 		ErrorHolder:           persistCtx.Bucket,
 	}
 
-	if policy.PolicyType == PolicyTypeDial {
-		ctx.denormLinkCollection = store.stores.identity.dialServicesCollection
-		ctx.denormChangeHandler = func(fromId, toId []byte, add bool) {
-			ctx.addServicePolicyEvent(fromId, toId, PolicyTypeDial, add)
-		}
-	} else {
-		ctx.denormLinkCollection = store.stores.identity.bindServicesCollection
-		ctx.denormChangeHandler = func(fromId, toId []byte, add bool) {
-			ctx.addServicePolicyEvent(fromId, toId, PolicyTypeBind, add)
-		}
+	ctx.denormLinkCollection = store.stores.identity.getServicesCollection(policy.PolicyType, policy.Effect)
+	ctx.denormChangeHandler = func(fromId, toId []byte, add bool) {
+		ctx.addServiceAccessEvent(store.stores, fromId, toId, policy.PolicyType, policy.Effect, add)
 	}
 
 	ctx.changeHandler = func(policyId []byte, relatedId []byte, add bool) {
@@ -314,51 +410,37 @@ func (store *servicePolicyStoreImpl) DeleteById(ctx boltz.MutateContext, id stri
 }
 
 func (store *servicePolicyStoreImpl) CheckIntegrity(mutateCtx boltz.MutateContext, fix bool, errorSink func(err error, fixed bool)) error {
-	ctx := &denormCheckCtx{
-		name:                   "service-policies/bind",
-		mutateCtx:              mutateCtx,
-		sourceStore:            store.stores.identity,
-		targetStore:            store.stores.edgeService,
-		policyStore:            store,
-		sourceCollection:       store.identityCollection,
-		targetCollection:       store.serviceCollection,
-		targetDenormCollection: store.stores.identity.bindServicesCollection,
-		errorSink:              errorSink,
-		repair:                 fix,
-		policyFilter: func(policyId []byte) bool {
-			policyType := PolicyTypeInvalid
-			if result := boltz.FieldToInt32(store.symbolPolicyType.Eval(mutateCtx.Tx(), policyId)); result != nil {
-				policyType = GetPolicyTypeForId(*result)
+	for _, effect := range []PolicyEffect{PolicyEffectAllow, PolicyEffectDeny} {
+		for _, policyType := range []PolicyType{PolicyTypeBind, PolicyTypeDial} {
+			name := "service-policies/" + strings.ToLower(policyType.String())
+			if effect.IsDeny() {
+				name = "service-policies/deny-" + strings.ToLower(policyType.String())
 			}
-			return policyType == PolicyTypeBind
-		},
-	}
-	if err := validatePolicyDenormalization(ctx); err != nil {
-		return err
-	}
 
-	ctx = &denormCheckCtx{
-		name:                   "service-policies/dial",
-		mutateCtx:              mutateCtx,
-		sourceStore:            store.stores.identity,
-		targetStore:            store.stores.edgeService,
-		policyStore:            store,
-		sourceCollection:       store.identityCollection,
-		targetCollection:       store.serviceCollection,
-		targetDenormCollection: store.stores.identity.dialServicesCollection,
-		errorSink:              errorSink,
-		repair:                 fix,
-		policyFilter: func(policyId []byte) bool {
-			policyType := PolicyTypeInvalid
-			if result := boltz.FieldToInt32(store.symbolPolicyType.Eval(mutateCtx.Tx(), policyId)); result != nil {
-				policyType = GetPolicyTypeForId(*result)
+			ctx := &denormCheckCtx{
+				name:                   name,
+				mutateCtx:              mutateCtx,
+				sourceStore:            store.stores.identity,
+				targetStore:            store.stores.edgeService,
+				policyStore:            store,
+				sourceCollection:       store.identityCollection,
+				targetCollection:       store.serviceCollection,
+				targetDenormCollection: store.stores.identity.getServicesCollection(policyType, effect),
+				errorSink:              errorSink,
+				repair:                 fix,
+				policyFilter: func(policyId []byte) bool {
+					currentPolicyType := PolicyTypeInvalid
+					if result := boltz.FieldToInt32(store.symbolPolicyType.Eval(mutateCtx.Tx(), policyId)); result != nil {
+						currentPolicyType = GetPolicyTypeForId(*result)
+					}
+					return currentPolicyType == policyType && store.GetEffect(mutateCtx.Tx(), policyId) == effect
+				},
 			}
-			return policyType == PolicyTypeDial
-		},
-	}
 
-	if err := validatePolicyDenormalization(ctx); err != nil {
-		return err
+			if err := validatePolicyDenormalization(ctx); err != nil {
+				return err
+			}
+		}
 	}
 
 	return store.BaseStore.CheckIntegrity(mutateCtx, fix, errorSink)
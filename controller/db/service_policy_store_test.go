@@ -7,10 +7,12 @@ import (
 	"github.com/openziti/storage/boltz"
 	"github.com/openziti/storage/boltztest"
 	"github.com/openziti/ziti/common/eid"
+	"github.com/openziti/ziti/common/schedule"
 	"go.etcd.io/bbolt"
 	"math/rand"
 	"sort"
 	"testing"
+	"time"
 )
 
 func Test_ServicePolicyStore(t *testing.T) {
@@ -22,6 +24,8 @@ func Test_ServicePolicyStore(t *testing.T) {
 	t.Run("test create/update service policies with invalid entity refs", ctx.testServicePolicyInvalidValues)
 	t.Run("test service policy evaluation", ctx.testServicePolicyRoleEvaluation)
 	t.Run("test update/delete referenced entities", ctx.testServicePolicyUpdateDeleteRefs)
+	t.Run("test service policy schedules", ctx.testServicePolicySchedule)
+	t.Run("test deny service policies", ctx.testServicePolicyDeny)
 }
 
 func newServicePolicy(name string) *ServicePolicy {
@@ -59,6 +63,126 @@ func (ctx *TestContext) testCreateServicePolicy(_ *testing.T) {
 	ctx.NoError(err)
 }
 
+func (ctx *TestContext) testServicePolicySchedule(_ *testing.T) {
+	ctx.CleanupAll()
+
+	validFrom := time.Now().Add(-time.Hour).UTC().Truncate(time.Second)
+	validUntil := validFrom.Add(24 * time.Hour)
+
+	policy := newServicePolicy(eid.New())
+	policy.Schedule = &schedule.Schedule{
+		ValidFrom:  &validFrom,
+		ValidUntil: &validUntil,
+		Windows:    []string{"mon-fri 09:00-17:00"},
+		Timezone:   "Europe/Berlin",
+	}
+	boltztest.RequireCreate(ctx, policy)
+
+	err := ctx.GetDb().View(func(tx *bbolt.Tx) error {
+		loaded := ctx.stores.ServicePolicy.GetSchedule(tx, []byte(policy.Id))
+		ctx.NotNil(loaded)
+		ctx.True(validFrom.Equal(*loaded.ValidFrom))
+		ctx.True(validUntil.Equal(*loaded.ValidUntil))
+		ctx.Equal(policy.Schedule.Windows, loaded.Windows)
+		ctx.Equal("Europe/Berlin", loaded.Timezone)
+		return nil
+	})
+	ctx.NoError(err)
+
+	policy.Schedule = nil
+	boltztest.RequireUpdate(ctx, policy)
+
+	err = ctx.GetDb().View(func(tx *bbolt.Tx) error {
+		ctx.Nil(ctx.stores.ServicePolicy.GetSchedule(tx, []byte(policy.Id)))
+		return nil
+	})
+	ctx.NoError(err)
+
+	policy.Schedule = &schedule.Schedule{Windows: []string{"someday 09:00-17:00"}}
+	err = boltztest.Update(ctx, policy)
+	ctx.Error(err)
+}
+
+func (ctx *TestContext) testServicePolicyDeny(_ *testing.T) {
+	ctx.CleanupAll()
+
+	role := eid.New()
+	quarantined := eid.New()
+
+	employee := ctx.RequireNewIdentity(eid.New(), false)
+	employee.RoleAttributes = []string{role}
+	boltztest.RequireUpdate(ctx, employee)
+
+	quarantinedEmployee := ctx.RequireNewIdentity(eid.New(), false)
+	quarantinedEmployee.RoleAttributes = []string{role, quarantined}
+	boltztest.RequireUpdate(ctx, quarantinedEmployee)
+
+	service := ctx.RequireNewService(eid.New())
+
+	ctx.requireNewServicePolicy(PolicyTypeDial, ss(roleRef(role)), ss(entityRef(service.Id)))
+
+	isDialable := func(identityId string) bool {
+		result := false
+		ctx.NoError(ctx.GetDb().View(func(tx *bbolt.Tx) error {
+			result = ctx.stores.EdgeService.IsDialableByIdentity(tx, service.Id, identityId)
+			return nil
+		}))
+		return result
+	}
+
+	ctx.True(isDialable(employee.Id))
+	ctx.True(isDialable(quarantinedEmployee.Id))
+
+	denyPolicy := newServicePolicy(eid.New())
+	denyPolicy.PolicyType = PolicyTypeDial
+	denyPolicy.Effect = PolicyEffectDeny
+	denyPolicy.IdentityRoles = ss(roleRef(quarantined))
+	denyPolicy.ServiceRoles = ss(entityRef(service.Id))
+	boltztest.RequireCreate(ctx, denyPolicy)
+
+	ctx.True(isDialable(employee.Id))
+	ctx.False(isDialable(quarantinedEmployee.Id))
+	ctx.validateServicePolicyDenormalization()
+
+	// a deny policy of the other type doesn't affect dial access
+	denyPolicy.PolicyType = PolicyTypeBind
+	boltztest.RequireUpdate(ctx, denyPolicy)
+	ctx.True(isDialable(quarantinedEmployee.Id))
+	ctx.validateServicePolicyDenormalization()
+
+	denyPolicy.PolicyType = PolicyTypeDial
+	boltztest.RequireUpdate(ctx, denyPolicy)
+	ctx.False(isDialable(quarantinedEmployee.Id))
+
+	// switching the policy back to allow restores access
+	denyPolicy.Effect = PolicyEffectAllow
+	boltztest.RequireUpdate(ctx, denyPolicy)
+	ctx.True(isDialable(quarantinedEmployee.Id))
+	ctx.validateServicePolicyDenormalization()
+
+	denyPolicy.Effect = PolicyEffectDeny
+	boltztest.RequireUpdate(ctx, denyPolicy)
+	ctx.False(isDialable(quarantinedEmployee.Id))
+
+	// removing the identity from the deny policy restores access
+	quarantinedEmployee.RoleAttributes = []string{role}
+	boltztest.RequireUpdate(ctx, quarantinedEmployee)
+	ctx.True(isDialable(quarantinedEmployee.Id))
+	ctx.validateServicePolicyDenormalization()
+
+	// deny policies may not have posture checks or schedules
+	denyPolicy.PostureCheckRoles = ss(AllRole)
+	ctx.Error(boltztest.Update(ctx, denyPolicy))
+	denyPolicy.PostureCheckRoles = nil
+
+	denyPolicy.Schedule = &schedule.Schedule{Windows: []string{"mon-fri 09:00-17:00"}}
+	ctx.Error(boltztest.Update(ctx, denyPolicy))
+	denyPolicy.Schedule = nil
+
+	denyPolicy.Effect = "Maybe"
+	ctx.Error(boltztest.Update(ctx, denyPolicy))
+}
+
 func (ctx *TestContext) testServicePolicyInvalidValues(_ *testing.T) {
 	ctx.CleanupAll()
 
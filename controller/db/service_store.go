@@ -17,8 +17,10 @@
 package db
 
 import (
+	"github.com/openziti/foundation/v2/errorz"
 	"github.com/openziti/storage/ast"
 	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/common/qos"
 	"github.com/openziti/ziti/controller/xt"
 	"github.com/openziti/ziti/controller/xt_smartrouting"
 	"go.etcd.io/bbolt"
@@ -26,9 +28,14 @@ import (
 )
 
 const (
-	EntityTypeServices             = "services"
-	FieldServiceTerminatorStrategy = "terminatorStrategy"
-	FieldServiceMaxIdleTime        = "maxIdleTime"
+	EntityTypeServices              = "services"
+	FieldServiceTerminatorStrategy  = "terminatorStrategy"
+	FieldServiceMaxIdleTime         = "maxIdleTime"
+	FieldServiceRouterRoles         = "routerRoles"
+	FieldServiceRouterRolesSemantic = "routerRolesSemantic"
+	FieldServiceExcludedLinks       = "excludedLinks"
+	FieldServiceMaxHops             = "maxHops"
+	FieldServicePriorityClass       = "priorityClass"
 )
 
 type Service struct {
@@ -36,6 +43,19 @@ type Service struct {
 	Name               string        `json:"name"`
 	MaxIdleTime        time.Duration `json:"maxIdleTime"`
 	TerminatorStrategy string        `json:"terminatorStrategy"`
+
+	// RouterRoles, if set, restricts circuits for the service to routers matching the roles, using the edge router
+	// role attributes
+	RouterRoles         []string `json:"routerRoles"`
+	RouterRolesSemantic string   `json:"routerRolesSemantic"`
+	// ExcludedLinks contains link ids, or router id pairs in the form <routerId>:<routerId>, which circuits for the
+	// service may not use
+	ExcludedLinks []string `json:"excludedLinks"`
+	// MaxHops, if non-zero, is the maximum number of links a circuit for the service may use
+	MaxHops uint32 `json:"maxHops"`
+	// PriorityClass is the qos class used to schedule payloads for circuits of the service on links. Empty means
+	// standard
+	PriorityClass string `json:"priorityClass"`
 }
 
 func (entity *Service) GetEntityType() string {
@@ -76,6 +96,10 @@ func (store *serviceStoreImpl) initializeLocal() {
 	store.indexName = store.AddUniqueIndex(symbolName)
 
 	store.AddSymbol(FieldServiceTerminatorStrategy, ast.NodeTypeString)
+	store.AddSetSymbol(FieldServiceRouterRoles, ast.NodeTypeString)
+	store.AddSetSymbol(FieldServiceExcludedLinks, ast.NodeTypeString)
+	store.AddSymbol(FieldServiceMaxHops, ast.NodeTypeInt64)
+	store.AddSymbol(FieldServicePriorityClass, ast.NodeTypeString)
 	store.terminatorsSymbol = store.AddFkSetSymbol(EntityTypeTerminators, store.stores.terminator)
 }
 
@@ -95,6 +119,11 @@ func (store *serviceStoreImpl) FillEntity(entity *Service, bucket *boltz.TypedBu
 	entity.Name = bucket.GetStringOrError(FieldName)
 	entity.TerminatorStrategy = bucket.GetStringWithDefault(FieldServiceTerminatorStrategy, "")
 	entity.MaxIdleTime = time.Duration(bucket.GetInt64WithDefault(FieldServiceMaxIdleTime, 0))
+	entity.RouterRoles = bucket.GetStringList(FieldServiceRouterRoles)
+	entity.RouterRolesSemantic = bucket.GetStringWithDefault(FieldServiceRouterRolesSemantic, "")
+	entity.ExcludedLinks = bucket.GetStringList(FieldServiceExcludedLinks)
+	entity.MaxHops = uint32(bucket.GetInt64WithDefault(FieldServiceMaxHops, 0))
+	entity.PriorityClass = bucket.GetStringWithDefault(FieldServicePriorityClass, "")
 }
 
 func (store *serviceStoreImpl) PersistEntity(entity *Service, ctx *boltz.PersistContext) {
@@ -102,6 +131,24 @@ func (store *serviceStoreImpl) PersistEntity(entity *Service, ctx *boltz.Persist
 	ctx.SetString(FieldName, entity.Name)
 	ctx.SetInt64(FieldServiceMaxIdleTime, int64(entity.MaxIdleTime))
 
+	if len(entity.RouterRoles) > 0 && entity.RouterRolesSemantic == "" {
+		entity.RouterRolesSemantic = SemanticAllOf
+	}
+	if entity.RouterRolesSemantic != "" && !isSemanticValid(entity.RouterRolesSemantic) {
+		ctx.Bucket.SetError(errorz.NewFieldError("invalid semantic", FieldServiceRouterRolesSemantic, entity.RouterRolesSemantic))
+		return
+	}
+	ctx.SetStringList(FieldServiceRouterRoles, entity.RouterRoles)
+	ctx.SetString(FieldServiceRouterRolesSemantic, entity.RouterRolesSemantic)
+	ctx.SetStringList(FieldServiceExcludedLinks, entity.ExcludedLinks)
+	ctx.SetInt64(FieldServiceMaxHops, int64(entity.MaxHops))
+
+	if !qos.IsValidPriorityClass(entity.PriorityClass) {
+		ctx.Bucket.SetError(errorz.NewFieldError("invalid priority class", FieldServicePriorityClass, entity.PriorityClass))
+		return
+	}
+	ctx.SetString(FieldServicePriorityClass, entity.PriorityClass)
+
 	if entity.TerminatorStrategy == "" {
 		entity.TerminatorStrategy = xt_smartrouting.Name
 	}
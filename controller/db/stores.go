@@ -98,9 +98,11 @@ type Stores struct {
 	ApiSession              ApiSessionStore
 	ApiSessionCertificate   ApiSessionCertificateStore
 	AuthPolicy              AuthPolicyStore
+	BandwidthLimit          BandwidthLimitStore
 	EventualEvent           EventualEventStore
 	ExternalJwtSigner       ExternalJwtSignerStore
 	Ca                      CaStore
+	CertificateRevocation   CertificateRevocationStore
 	Config                  ConfigStore
 	ConfigType              ConfigTypeStore
 	Controller              ControllerStore
@@ -200,8 +202,10 @@ type stores struct {
 	service                 *serviceStoreImpl
 	apiSession              *apiSessionStoreImpl
 	authPolicy              *AuthPolicyStoreImpl
+	bandwidthLimit          *bandwidthLimitStoreImpl
 	eventualEvent           *eventualEventStoreImpl
 	ca                      *caStoreImpl
+	certificateRevocation   *certificateRevocationStoreImpl
 	config                  *configStoreImpl
 	configType              *configTypeStoreImpl
 	controller              *controllerStoreImpl
@@ -257,7 +261,9 @@ func InitStores(db boltz.Db, rateLimiter rate.RateLimiter) (*Stores, error) {
 	internalStores.apiSessionCertificate = newApiSessionCertificateStore(internalStores)
 	internalStores.authenticator = newAuthenticatorStore(internalStores)
 	internalStores.authPolicy = newAuthPolicyStore(internalStores)
+	internalStores.bandwidthLimit = newBandwidthLimitStore(internalStores)
 	internalStores.ca = newCaStore(internalStores)
+	internalStores.certificateRevocation = newCertificateRevocationStore(internalStores)
 	internalStores.config = newConfigsStore(internalStores)
 	internalStores.configType = newConfigTypesStore(internalStores)
 	internalStores.controller = newControllerStore(internalStores)
@@ -287,8 +293,10 @@ func InitStores(db boltz.Db, rateLimiter rate.RateLimiter) (*Stores, error) {
 		ApiSession:              internalStores.apiSession,
 		ApiSessionCertificate:   internalStores.apiSessionCertificate,
 		AuthPolicy:              internalStores.authPolicy,
+		BandwidthLimit:          internalStores.bandwidthLimit,
 		EventualEvent:           internalStores.eventualEvent,
 		Ca:                      internalStores.ca,
+		CertificateRevocation:   internalStores.certificateRevocation,
 		Config:                  internalStores.config,
 		ConfigType:              internalStores.configType,
 		Controller:              internalStores.controller,
@@ -0,0 +1,118 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package env
+
+import (
+	"github.com/go-openapi/runtime"
+	"github.com/openziti/ziti/controller/apierror"
+	"github.com/openziti/ziti/controller/internal/permissions"
+	"github.com/openziti/ziti/controller/response"
+	"strings"
+)
+
+const apiRouteIdSegment = "{id}"
+
+// ApiRoute is an operation served alongside the operations of a generated edge API server, for operations which the
+// edge-api module doesn't define yet
+type ApiRoute struct {
+	Method string
+
+	// Path is relative to the API's base path. A {id} segment matches any single segment, which is set as the
+	// request's entity id.
+	Path string
+
+	// Accepts, if set, limits the route to the requests it returns true for. Other requests are left to the
+	// generated server.
+	Accepts func(rc *response.RequestContext) bool
+
+	Handler     func(ae *AppEnv, rc *response.RequestContext)
+	Permissions []permissions.Resolver
+
+	segments []string
+}
+
+// ApiRoutes holds the ApiRoute instances registered for an edge API by the routers
+type ApiRoutes struct {
+	basePath string
+	routes   []*ApiRoute
+}
+
+func NewApiRoutes(basePath string) *ApiRoutes {
+	return &ApiRoutes{
+		basePath: basePath,
+	}
+}
+
+func (self *ApiRoutes) Add(route *ApiRoute) {
+	route.segments = strings.Split(strings.Trim(route.Path, "/"), "/")
+	self.routes = append(self.routes, route)
+}
+
+// Handle serves the request if it's for one of the routes and returns false otherwise. Requests for the path of a
+// route without an Accepts filter are answered with method not allowed if no route matches their method.
+func (self *ApiRoutes) Handle(ae *AppEnv, rc *response.RequestContext) bool {
+	path := rc.Request.URL.Path
+	if !strings.HasPrefix(path, self.basePath+"/") {
+		return false
+	}
+	segments := strings.Split(strings.TrimPrefix(path, self.basePath+"/"), "/")
+
+	pathMatched := false
+	for _, route := range self.routes {
+		id, ok := route.match(segments)
+		if !ok {
+			continue
+		}
+
+		if route.Method != rc.Request.Method {
+			pathMatched = pathMatched || route.Accepts == nil
+			continue
+		}
+
+		if route.Accepts != nil && !route.Accepts(rc) {
+			continue
+		}
+
+		ae.IsAllowed(route.Handler, rc.Request, id, "", route.Permissions...).WriteResponse(rc.ResponseWriter, runtime.JSONProducer())
+		return true
+	}
+
+	if pathMatched {
+		rc.RespondWithApiError(apierror.NewMethodNotAllowed())
+	}
+
+	return pathMatched
+}
+
+func (self *ApiRoute) match(segments []string) (string, bool) {
+	if len(segments) != len(self.segments) {
+		return "", false
+	}
+
+	id := ""
+	for i, segment := range self.segments {
+		if segment == apiRouteIdSegment {
+			if segments[i] == "" {
+				return "", false
+			}
+			id = segments[i]
+		} else if segment != segments[i] {
+			return "", false
+		}
+	}
+	return id, true
+}
@@ -0,0 +1,87 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package env
+
+import (
+	"github.com/openziti/ziti/controller/response"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestApiRouteMatch(t *testing.T) {
+	req := require.New(t)
+
+	routes := NewApiRoutes("/edge/management/v1")
+	route := &ApiRoute{Method: http.MethodPut, Path: "/service-policies/{id}/schedule"}
+	routes.Add(route)
+
+	match := func(path string) (string, bool) {
+		return route.match(strings.Split(strings.TrimPrefix(path, "/"), "/"))
+	}
+
+	id, ok := match("/service-policies/abc/schedule")
+	req.True(ok)
+	req.Equal("abc", id)
+
+	_, ok = match("/service-policies//schedule")
+	req.False(ok)
+
+	_, ok = match("/service-policies/abc/effect")
+	req.False(ok)
+
+	_, ok = match("/service-policies/abc/schedule/extra")
+	req.False(ok)
+}
+
+func TestApiRoutesRejectOtherMethods(t *testing.T) {
+	req := require.New(t)
+
+	routes := NewApiRoutes("/edge/management/v1")
+	routes.Add(&ApiRoute{Method: http.MethodPut, Path: "/service-policies/{id}/effect"})
+	routes.Add(&ApiRoute{
+		Method:  http.MethodPost,
+		Path:    "/posture-checks",
+		Accepts: func(*response.RequestContext) bool { return false },
+	})
+
+	handle := func(method, path string) (bool, int) {
+		recorder := httptest.NewRecorder()
+		rc := &response.RequestContext{
+			Request:        httptest.NewRequest(method, path, nil),
+			ResponseWriter: recorder,
+		}
+		rc.Responder = response.NewResponder(rc)
+		return routes.Handle(nil, rc), recorder.Code
+	}
+
+	handled, code := handle(http.MethodGet, "/edge/management/v1/service-policies/abc/effect")
+	req.True(handled)
+	req.Equal(http.StatusMethodNotAllowed, code)
+
+	// routes with an Accepts filter share their path with generated operations
+	handled, _ = handle(http.MethodGet, "/edge/management/v1/posture-checks")
+	req.False(handled)
+
+	handled, _ = handle(http.MethodPost, "/edge/management/v1/posture-checks")
+	req.False(handled)
+
+	handled, _ = handle(http.MethodGet, "/edge/management/v1/services")
+	req.False(handled)
+}
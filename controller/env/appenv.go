@@ -49,6 +49,7 @@ import (
 	"github.com/openziti/ziti/common"
 	"github.com/openziti/ziti/common/cert"
 	"github.com/openziti/ziti/common/eid"
+	"github.com/openziti/ziti/controller"
 	"github.com/openziti/ziti/controller/api"
 	"github.com/openziti/ziti/controller/command"
 	"github.com/openziti/ziti/controller/config"
@@ -99,6 +100,8 @@ type AppEnv struct {
 	HostController       HostController
 	ManagementApi        *managementOperations.ZitiEdgeManagementAPI
 	ClientApi            *clientOperations.ZitiEdgeClientAPI
+	ManagementApiRoutes  *ApiRoutes
+	ClientApiRoutes      *ApiRoutes
 	IdentityRefreshMap   cmap.ConcurrentMap[string, time.Time]
 	identityRefreshMeter metrics.Meter
 	StartupTime          time.Time
@@ -418,6 +421,7 @@ func (ae *AppEnv) ProcessZtSession(rc *response.RequestContext, ztSession string
 	if rc.ApiSession != nil {
 		//updates for api session timeouts
 		ae.GetManagers().ApiSession.MarkLastActivityById(rc.ApiSession.Id)
+		ae.recordSourceIp(rc)
 
 		var err error
 		rc.Identity, err = ae.GetManagers().Identity.Read(rc.ApiSession.IdentityId)
@@ -517,7 +521,7 @@ func (ae *AppEnv) ProcessJwt(rc *response.RequestContext, token *jwt.Token) erro
 		Identity:           rc.Identity,
 		IPAddress:          rc.Request.RemoteAddr,
 		ConfigTypes:        configTypes,
-		MfaComplete:        rc.Claims.TotpComplete(),
+		MfaComplete:        rc.Claims.MfaComplete(),
 		MfaRequired:        false,
 		ExpiresAt:          rc.Claims.Expiration.AsTime(),
 		ExpirationDuration: time.Until(rc.Claims.Expiration.AsTime()),
@@ -525,6 +529,8 @@ func (ae *AppEnv) ProcessJwt(rc *response.RequestContext, token *jwt.Token) erro
 		AuthenticatorId:    "oidc",
 	}
 
+	ae.recordSourceIp(rc)
+
 	rc.AuthPolicy, err = ae.GetManagers().AuthPolicy.Read(rc.Identity.AuthPolicyId)
 
 	if err != nil {
@@ -547,6 +553,17 @@ func (ae *AppEnv) ProcessJwt(rc *response.RequestContext, token *jwt.Token) erro
 	return nil
 }
 
+// recordSourceIp makes the source IP of the request available to SOURCE_IP posture checks of the request's API session
+func (ae *AppEnv) recordSourceIp(rc *response.RequestContext) {
+	if rc.ApiSession == nil || rc.Request == nil {
+		return
+	}
+
+	if sourceIp := common.ParseSourceIp(rc.Request.RemoteAddr); sourceIp != nil {
+		ae.GetManagers().PostureResponse.SetSourceIp(rc.ApiSession.IdentityId, rc.ApiSession.Id, sourceIp.String())
+	}
+}
+
 func (ae *AppEnv) FillRequestContext(rc *response.RequestContext) error {
 	ztSession := ae.getZtSessionFromRequest(rc.Request)
 
@@ -576,6 +593,16 @@ func NewAuthQueryZitiMfa() *rest_model.AuthQueryDetail {
 	}
 }
 
+func NewAuthQueryZitiWebAuthn() *rest_model.AuthQueryDetail {
+	provider := rest_model.MfaProvidersZiti
+	return &rest_model.AuthQueryDetail{
+		TypeID:     "WEBAUTHN",
+		HTTPMethod: http.MethodPost,
+		HTTPURL:    "./current-api-session/mfa/webauthn/assertion-options",
+		Provider:   &provider,
+	}
+}
+
 func NewAuthQueryExtJwt(url string) *rest_model.AuthQueryDetail {
 	return &rest_model.AuthQueryDetail{
 		HTTPURL: url,
@@ -594,6 +621,10 @@ func ProcessAuthQueries(ae *AppEnv, rc *response.RequestContext) {
 
 	if totpRequired && !rc.ApiSession.MfaComplete {
 		rc.AuthQueries = append(rc.AuthQueries, NewAuthQueryZitiMfa())
+
+		if mfa, err := ae.Managers.Mfa.ReadOneByIdentityId(rc.ApiSession.IdentityId); err == nil && mfa.HasWebAuthn() {
+			rc.AuthQueries = append(rc.AuthQueries, NewAuthQueryZitiWebAuthn())
+		}
 	}
 
 	if rc.AuthPolicy.Secondary.RequiredExtJwtSigner != nil {
@@ -639,14 +670,16 @@ func NewAppEnv(c *config.Config, host HostController) *AppEnv {
 			Api:           "1.0.0",
 			EnrollmentApi: "1.0.0",
 		},
-		HostController:     host,
-		InstanceId:         cuid.New(),
-		AuthRegistry:       &model.AuthProcessorRegistryImpl{},
-		EnrollRegistry:     &model.EnrollmentRegistryImpl{},
-		ManagementApi:      managementApi,
-		ClientApi:          clientApi,
-		IdentityRefreshMap: cmap.New[time.Time](),
-		StartupTime:        time.Now().UTC(),
+		HostController:      host,
+		InstanceId:          cuid.New(),
+		AuthRegistry:        &model.AuthProcessorRegistryImpl{},
+		EnrollRegistry:      &model.EnrollmentRegistryImpl{},
+		ManagementApi:       managementApi,
+		ClientApi:           clientApi,
+		ManagementApiRoutes: NewApiRoutes(controller.ManagementRestApiBaseUrlLatest),
+		ClientApiRoutes:     NewApiRoutes(controller.ClientRestApiBaseUrlLatest),
+		IdentityRefreshMap:  cmap.New[time.Time](),
+		StartupTime:         time.Now().UTC(),
 		AuthRateLimiter: command.NewAdaptiveRateLimiter(command.AdaptiveRateLimiterConfig{
 			Enabled:          c.AuthRateLimiter.Enabled,
 			MinSize:          c.AuthRateLimiter.MinSize,
@@ -706,9 +739,23 @@ func NewAppEnv(c *config.Config, host HostController) *AppEnv {
 	managementApi.ZtSessionAuth = clientApi.ZtSessionAuth
 	managementApi.Oauth2Auth = clientApi.Oauth2Auth
 
-	ae.ApiClientCsrSigner = cert.NewClientSigner(ae.Config.Enrollment.SigningCert.Cert().Leaf, ae.Config.Enrollment.SigningCert.Cert().PrivateKey)
-	ae.ApiServerCsrSigner = cert.NewServerSigner(ae.Config.Enrollment.SigningCert.Cert().Leaf, ae.Config.Enrollment.SigningCert.Cert().PrivateKey)
-	ae.ControlClientCsrSigner = cert.NewClientSigner(ae.Config.Enrollment.SigningCert.Cert().Leaf, ae.Config.Enrollment.SigningCert.Cert().PrivateKey)
+	apiClientCsrSigner := cert.NewClientSigner(ae.Config.Enrollment.SigningCert.Cert().Leaf, ae.Config.Enrollment.SigningCert.Cert().PrivateKey)
+	apiServerCsrSigner := cert.NewServerSigner(ae.Config.Enrollment.SigningCert.Cert().Leaf, ae.Config.Enrollment.SigningCert.Cert().PrivateKey)
+	controlClientCsrSigner := cert.NewClientSigner(ae.Config.Enrollment.SigningCert.Cert().Leaf, ae.Config.Enrollment.SigningCert.Cert().PrivateKey)
+
+	if ae.Config.Revocation.EmbedUrls {
+		revocationInfo := cert.RevocationInfo{
+			CRLDistributionPoints: []string{ae.Config.Revocation.BaseUrl + controller.WellKnownCrl},
+			OCSPServer:            []string{ae.Config.Revocation.BaseUrl + controller.WellKnownOcsp},
+		}
+		apiClientCsrSigner.RevocationInfo = revocationInfo
+		apiServerCsrSigner.RevocationInfo = revocationInfo
+		controlClientCsrSigner.RevocationInfo = revocationInfo
+	}
+
+	ae.ApiClientCsrSigner = apiClientCsrSigner
+	ae.ApiServerCsrSigner = apiServerCsrSigner
+	ae.ControlClientCsrSigner = controlClientCsrSigner
 
 	ae.FingerprintGenerator = cert.NewFingerprintGenerator()
 
@@ -121,6 +121,9 @@ type Dispatcher interface {
 	AddSessionEventHandler(handler SessionEventHandler)
 	RemoveSessionEventHandler(handler SessionEventHandler)
 
+	AddPostureWarningEventHandler(handler PostureWarningEventHandler)
+	RemovePostureWarningEventHandler(handler PostureWarningEventHandler)
+
 	AddEntityCountEventHandler(handler EntityCountEventHandler, interval time.Duration, onlyLeaderEvents bool)
 	RemoveEntityCountEventHandler(handler EntityCountEventHandler)
 
@@ -134,6 +137,7 @@ type Dispatcher interface {
 	TerminatorEventHandler
 	UsageEventHandler
 	ClusterEventHandler
+	PostureWarningEventHandler
 }
 
 // A Subscription has information to configure an event handler. It contains the EventType to
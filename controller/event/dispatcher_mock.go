@@ -35,6 +35,12 @@ func (d DispatcherMock) AddSessionEventHandler(handler SessionEventHandler) {}
 
 func (d DispatcherMock) RemoveSessionEventHandler(handler SessionEventHandler) {}
 
+func (d DispatcherMock) AddPostureWarningEventHandler(handler PostureWarningEventHandler) {}
+
+func (d DispatcherMock) RemovePostureWarningEventHandler(handler PostureWarningEventHandler) {}
+
+func (d DispatcherMock) AcceptPostureWarningEvent(event *PostureWarningEvent) {}
+
 func (d DispatcherMock) AddEntityCountEventHandler(handler EntityCountEventHandler, interval time.Duration, onlyLeaderEvents bool) {
 }
 
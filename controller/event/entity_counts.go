@@ -38,3 +38,8 @@ func (event *EntityCountEvent) String() string {
 type EntityCountEventHandler interface {
 	AcceptEntityCountEvent(event *EntityCountEvent)
 }
+
+type EntityCountEventHandlerWrapper interface {
+	EntityCountEventHandler
+	IsWrapping(value EntityCountEventHandler) bool
+}
@@ -62,3 +62,8 @@ func (event *LinkEvent) String() string {
 type LinkEventHandler interface {
 	AcceptLinkEvent(event *LinkEvent)
 }
+
+type LinkEventHandlerWrapper interface {
+	LinkEventHandler
+	IsWrapping(value LinkEventHandler) bool
+}
@@ -0,0 +1,62 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package event
+
+import (
+	"fmt"
+	"time"
+)
+
+// PostureWarningEventTypeWarning is emitted when a posture check starts failing for an API session and the check's
+// grace period begins. Access continues until the grace period expires.
+const PostureWarningEventTypeWarning = "warning"
+
+// PostureWarningEventTypeExpired is emitted when a posture check grace period runs out and access is cut
+const PostureWarningEventTypeExpired = "expired"
+
+// PostureWarningEventTypeCleared is emitted when a posture check passes again during or after its grace period
+const PostureWarningEventTypeCleared = "cleared"
+
+const PostureWarningEventNS = "edge.postureWarnings"
+
+type PostureWarningEvent struct {
+	Namespace          string     `json:"namespace"`
+	EventType          string     `json:"event_type"`
+	Timestamp          time.Time  `json:"timestamp"`
+	IdentityId         string     `json:"identity_id"`
+	ApiSessionId       string     `json:"api_session_id"`
+	PostureCheckId     string     `json:"posture_check_id"`
+	PostureCheckName   string     `json:"posture_check_name"`
+	PostureCheckType   string     `json:"posture_check_type"`
+	GracePeriodSeconds int64      `json:"grace_period_seconds"`
+	ExpiresAt          *time.Time `json:"expires_at,omitempty"`
+}
+
+func (event *PostureWarningEvent) String() string {
+	return fmt.Sprintf("%v.%v timestamp=%v identityId=%v apiSessionId=%v postureCheckId=%v postureCheckType=%v expiresAt=%v",
+		event.Namespace, event.EventType, event.Timestamp, event.IdentityId, event.ApiSessionId, event.PostureCheckId,
+		event.PostureCheckType, event.ExpiresAt)
+}
+
+type PostureWarningEventHandler interface {
+	AcceptPostureWarningEvent(event *PostureWarningEvent)
+}
+
+type PostureWarningEventHandlerWrapper interface {
+	PostureWarningEventHandler
+	IsWrapping(value PostureWarningEventHandler) bool
+}
@@ -46,3 +46,8 @@ func (event *RouterEvent) String() string {
 type RouterEventHandler interface {
 	AcceptRouterEvent(event *RouterEvent)
 }
+
+type RouterEventHandlerWrapper interface {
+	RouterEventHandler
+	IsWrapping(value RouterEventHandler) bool
+}
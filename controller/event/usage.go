@@ -28,6 +28,11 @@ type UsageEventHandler interface {
 	AcceptUsageEvent(event *UsageEvent)
 }
 
+type UsageEventHandlerWrapper interface {
+	UsageEventHandler
+	IsWrapping(value UsageEventHandler) bool
+}
+
 type UsageEventV3 struct {
 	Namespace        string            `json:"namespace"`
 	Version          uint32            `json:"version"`
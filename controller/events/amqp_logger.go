@@ -27,10 +27,12 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-type AMQPEventLoggerFactory struct{}
+type AMQPEventLoggerFactory struct {
+	dispatcher *Dispatcher
+}
 
-func (AMQPEventLoggerFactory) NewEventHandler(config map[interface{}]interface{}) (interface{}, error) {
-	return NewAMQPEventLogger(fabricFormatterFactory{}, config)
+func (self AMQPEventLoggerFactory) NewEventHandler(config map[interface{}]interface{}) (interface{}, error) {
+	return self.dispatcher.NewAMQPEventLogger(fabricFormatterFactory{}, config)
 }
 
 type amqpWriteCloser struct {
@@ -230,7 +232,7 @@ func parseAMQPConfig(config map[interface{}]interface{}) (*amqpConfig, error) {
 	return ret, nil
 }
 
-func NewAMQPEventLogger(formatterFactory LoggingHandlerFactory, config map[interface{}]interface{}) (interface{}, error) {
+func (self *Dispatcher) NewAMQPEventLogger(formatterFactory LoggingHandlerFactory, config map[interface{}]interface{}) (interface{}, error) {
 	bufferSize := 10
 	if value, found := config["bufferSize"]; found {
 		if size, ok := value.(int); ok {
@@ -245,7 +247,11 @@ func NewAMQPEventLogger(formatterFactory LoggingHandlerFactory, config map[inter
 
 	if value, found := config["format"]; found {
 		if format, ok := value.(string); ok {
-			return formatterFactory.NewLoggingHandler(format, bufferSize, newAMQPWriteCloser(conf))
+			sink, err := self.newOutputEventSink("amqp", config, newAMQPWriteCloser(conf))
+			if err != nil {
+				return nil, err
+			}
+			return formatterFactory.NewLoggingHandler(format, bufferSize, sink)
 		}
 		return nil, errors.New("invalid 'format' for event amqp log")
 
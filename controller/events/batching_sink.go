@@ -0,0 +1,499 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"context"
+	"encoding/binary"
+	"github.com/cenkalti/backoff/v4"
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/metrics"
+	"github.com/pkg/errors"
+	"math"
+	"time"
+)
+
+// sinkEvent is a formatted event, along with its type, as queued for delivery by a batchingEventSink
+type sinkEvent struct {
+	eventType string
+	data      []byte
+}
+
+// maxSinkEventTypeLen is the longest event type which can be spooled, as the type length is encoded in two bytes
+const maxSinkEventTypeLen = math.MaxUint16
+
+func (self *sinkEvent) encode() ([]byte, error) {
+	if len(self.eventType) > maxSinkEventTypeLen {
+		return nil, errors.Errorf("event type length %d exceeds max of %d", len(self.eventType), maxSinkEventTypeLen)
+	}
+	result := make([]byte, 2, len(self.eventType)+len(self.data)+2)
+	binary.BigEndian.PutUint16(result, uint16(len(self.eventType)))
+	result = append(result, self.eventType...)
+	return append(result, self.data...), nil
+}
+
+func decodeSinkEvent(record []byte) (*sinkEvent, error) {
+	if len(record) < 2 {
+		return nil, errors.New("invalid spooled event record")
+	}
+	typeEnd := int(binary.BigEndian.Uint16(record)) + 2
+	if len(record) < typeEnd {
+		return nil, errors.New("invalid spooled event record")
+	}
+	return &sinkEvent{
+		eventType: string(record[2:typeEnd]),
+		data:      record[typeEnd:],
+	}, nil
+}
+
+// batchSender delivers batches of formatted events to some remote system
+type batchSender interface {
+	SendBatch(ctx context.Context, batch []*sinkEvent) error
+	Close() error
+}
+
+type batchingSinkConfig struct {
+	bufferSize           int
+	batchSize            int
+	batchInterval        time.Duration
+	sendTimeout          time.Duration
+	maxAttempts          int
+	retryInitialInterval time.Duration
+	retryMaxInterval     time.Duration
+	replayInterval       time.Duration
+	spoolDir             string
+	spoolMaxBytes        int64
+}
+
+func parseBatchingSinkConfig(config map[interface{}]interface{}) (*batchingSinkConfig, error) {
+	result := &batchingSinkConfig{
+		bufferSize:           1000,
+		batchSize:            100,
+		batchInterval:        time.Second,
+		sendTimeout:          10 * time.Second,
+		maxAttempts:          3,
+		retryInitialInterval: 500 * time.Millisecond,
+		retryMaxInterval:     10 * time.Second,
+		replayInterval:       10 * time.Second,
+	}
+
+	var err error
+
+	if value, found := config["bufferSize"]; found {
+		if result.bufferSize, err = parsePositiveInt("bufferSize", value); err != nil {
+			return nil, err
+		}
+	}
+
+	if value, found := config["batchSize"]; found {
+		if result.batchSize, err = parsePositiveInt("batchSize", value); err != nil {
+			return nil, err
+		}
+	}
+
+	if value, found := config["maxAttempts"]; found {
+		if result.maxAttempts, err = parsePositiveInt("maxAttempts", value); err != nil {
+			return nil, err
+		}
+	}
+
+	durations := map[string]*time.Duration{
+		"batchInterval":        &result.batchInterval,
+		"sendTimeout":          &result.sendTimeout,
+		"retryInitialInterval": &result.retryInitialInterval,
+		"retryMaxInterval":     &result.retryMaxInterval,
+		"replayInterval":       &result.replayInterval,
+	}
+
+	for key, target := range durations {
+		if value, found := config[key]; found {
+			if *target, err = parsePositiveDuration(key, value); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if value, found := config["spoolDir"]; found {
+		if dir, ok := value.(string); ok {
+			result.spoolDir = dir
+		} else {
+			return nil, errors.Errorf("invalid value for 'spoolDir': %v", value)
+		}
+	}
+
+	if value, found := config["spoolMaxSizeMb"]; found {
+		mb, err := parsePositiveInt("spoolMaxSizeMb", value)
+		if err != nil {
+			return nil, err
+		}
+		result.spoolMaxBytes = int64(mb) * 1024 * 1024
+	}
+
+	return result, nil
+}
+
+func parsePositiveInt(name string, value interface{}) (int, error) {
+	if v, ok := value.(int); ok && v > 0 {
+		return v, nil
+	}
+	return 0, errors.Errorf("invalid value for '%s', must be a positive integer: %v", name, value)
+}
+
+func parsePositiveDuration(name string, value interface{}) (time.Duration, error) {
+	if s, ok := value.(string); ok {
+		if d, err := time.ParseDuration(s); err == nil && d > 0 {
+			return d, nil
+		}
+	}
+	return 0, errors.Errorf("invalid value for '%s', must be a positive duration: %v", name, value)
+}
+
+// batchingEventSink collects formatted events into batches and hands them to a batchSender, retrying with backoff.
+// If a spool directory is configured, batches which can't be delivered, and events which arrive while the in-memory
+// queue is full, are written to disk and replayed in order once the remote system is reachable again, including
+// after a controller restart. Delivery is at-least-once: a partially replayed spool segment is retried in full.
+type batchingEventSink struct {
+	name        string
+	config      *batchingSinkConfig
+	sender      batchSender
+	events      chan *sinkEvent
+	spool       *diskSpool
+	closeNotify <-chan struct{}
+
+	sentMeter    metrics.Meter
+	droppedMeter metrics.Meter
+	spooledMeter metrics.Meter
+	errorsMeter  metrics.Meter
+	sendTimer    metrics.Timer
+	queueGauge   metrics.Gauge
+	spoolGauge   metrics.Gauge
+}
+
+func newBatchingEventSink(name string, config *batchingSinkConfig, sender batchSender, registry metrics.Registry, closeNotify <-chan struct{}) (*batchingEventSink, error) {
+	result := &batchingEventSink{
+		name:        name,
+		config:      config,
+		sender:      sender,
+		events:      make(chan *sinkEvent, config.bufferSize),
+		closeNotify: closeNotify,
+	}
+
+	if config.spoolDir != "" {
+		spool, err := newDiskSpool(config.spoolDir, config.spoolMaxBytes, 0, 0, 0)
+		if err != nil {
+			return nil, err
+		}
+		result.spool = spool
+	}
+
+	prefix := "events.sink." + name + "."
+	result.sentMeter = registry.Meter(prefix + "sent")
+	result.droppedMeter = registry.Meter(prefix + "dropped")
+	result.spooledMeter = registry.Meter(prefix + "spooled")
+	result.errorsMeter = registry.Meter(prefix + "send_errors")
+	result.sendTimer = registry.Timer(prefix + "send_time")
+	result.queueGauge = registry.FuncGauge(prefix+"queue_size", func() int64 {
+		return int64(len(result.events))
+	})
+	result.spoolGauge = registry.FuncGauge(prefix+"spool_bytes", func() int64 {
+		if result.spool == nil {
+			return 0
+		}
+		return result.spool.Size()
+	})
+
+	go result.run()
+
+	return result, nil
+}
+
+func (self *batchingEventSink) AcceptFormattedEvent(eventType string, formattedEvent []byte) {
+	evt := &sinkEvent{
+		eventType: eventType,
+		data:      formattedEvent,
+	}
+
+	select {
+	case self.events <- evt:
+	case <-self.closeNotify:
+		self.overflow([]*sinkEvent{evt})
+	default:
+		self.overflow([]*sinkEvent{evt})
+	}
+}
+
+func (self *batchingEventSink) run() {
+	log := pfxlog.Logger().WithField("sink", self.name)
+	log.Info("event sink started")
+
+	flushTicker := time.NewTicker(self.config.batchInterval)
+	defer flushTicker.Stop()
+
+	replayTicker := time.NewTicker(self.config.replayInterval)
+	defer replayTicker.Stop()
+
+	var batch []*sinkEvent
+
+	// replay anything left over from a previous run before handling new events
+	self.replaySpool()
+
+	for {
+		select {
+		case evt := <-self.events:
+			batch = append(batch, evt)
+			if len(batch) >= self.config.batchSize {
+				self.flush(batch)
+				batch = nil
+			}
+		case <-flushTicker.C:
+			if len(batch) > 0 {
+				self.flush(batch)
+				batch = nil
+			}
+		case <-replayTicker.C:
+			self.replaySpool()
+		case <-self.closeNotify:
+			self.shutdown(batch)
+			log.Info("event sink stopped")
+			return
+		}
+	}
+}
+
+func (self *batchingEventSink) shutdown(batch []*sinkEvent) {
+drain:
+	for {
+		select {
+		case evt := <-self.events:
+			batch = append(batch, evt)
+		default:
+			break drain
+		}
+	}
+
+	if len(batch) > 0 {
+		// don't hold up shutdown retrying if we can persist the events instead
+		if self.spool != nil {
+			self.overflow(batch)
+		} else {
+			self.flush(batch)
+		}
+	}
+
+	if err := self.sender.Close(); err != nil {
+		pfxlog.Logger().WithField("sink", self.name).WithError(err).Error("error closing event sink")
+	}
+
+	if self.spool != nil {
+		_ = self.spool.Close()
+	}
+
+	self.sentMeter.Dispose()
+	self.droppedMeter.Dispose()
+	self.spooledMeter.Dispose()
+	self.errorsMeter.Dispose()
+	self.sendTimer.Dispose()
+	self.queueGauge.Dispose()
+	self.spoolGauge.Dispose()
+}
+
+func (self *batchingEventSink) flush(batch []*sinkEvent) {
+	// if there's a backlog on disk, new events go behind it, so that ordering is maintained
+	if self.spool != nil && !self.spool.IsEmpty() {
+		self.overflow(batch)
+		return
+	}
+
+	if err := self.send(batch); err != nil {
+		pfxlog.Logger().WithField("sink", self.name).WithError(err).WithField("events", len(batch)).
+			Error("unable to deliver events")
+		self.overflow(batch)
+	}
+}
+
+func (self *batchingEventSink) send(batch []*sinkEvent) error {
+	expBackoff := backoff.NewExponentialBackOff()
+	expBackoff.InitialInterval = self.config.retryInitialInterval
+	expBackoff.MaxInterval = self.config.retryMaxInterval
+	expBackoff.MaxElapsedTime = 0
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		select {
+		case <-self.closeNotify:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	operation := func() error {
+		sendCtx, sendCancel := context.WithTimeout(ctx, self.config.sendTimeout)
+		defer sendCancel()
+
+		start := time.Now()
+		err := self.sender.SendBatch(sendCtx, batch)
+		self.sendTimer.Update(time.Since(start))
+
+		if err != nil {
+			self.errorsMeter.Mark(1)
+			pfxlog.Logger().WithField("sink", self.name).WithError(err).Warn("event batch delivery attempt failed")
+			return err
+		}
+
+		self.sentMeter.Mark(int64(len(batch)))
+		return nil
+	}
+
+	retries := backoff.WithMaxRetries(backoff.WithContext(expBackoff, ctx), uint64(self.config.maxAttempts-1))
+	return backoff.Retry(operation, retries)
+}
+
+func (self *batchingEventSink) overflow(batch []*sinkEvent) {
+	if self.spool == nil {
+		self.drop(len(batch))
+		return
+	}
+
+	records := make([][]byte, 0, len(batch))
+	for _, evt := range batch {
+		record, err := evt.encode()
+		if err != nil {
+			pfxlog.Logger().WithField("sink", self.name).WithError(err).Error("unable to spool event")
+			self.drop(1)
+			continue
+		}
+		records = append(records, record)
+	}
+
+	if err := self.spool.Append(records...); err != nil {
+		pfxlog.Logger().WithField("sink", self.name).WithError(err).Error("unable to spool events")
+		self.drop(len(records))
+		return
+	}
+
+	self.spooledMeter.Mark(int64(len(records)))
+}
+
+func (self *batchingEventSink) drop(count int) {
+	self.droppedMeter.Mark(int64(count))
+	pfxlog.Logger().WithField("sink", self.name).WithField("events", count).Error("dropping events")
+}
+
+func (self *batchingEventSink) replaySpool() {
+	if self.spool == nil {
+		return
+	}
+
+	log := pfxlog.Logger().WithField("sink", self.name)
+
+	for {
+		select {
+		case <-self.closeNotify:
+			return
+		default:
+		}
+
+		records, seq, found, err := self.spool.ReadOldest()
+		if !found {
+			return
+		}
+
+		if err != nil {
+			log.WithError(err).Error("unable to read event spool segment, discarding")
+			if err = self.spool.Remove(seq); err != nil {
+				log.WithError(err).Error("unable to remove event spool segment")
+				return
+			}
+			continue
+		}
+
+		var batch []*sinkEvent
+		for _, record := range records {
+			evt, err := decodeSinkEvent(record)
+			if err != nil {
+				log.WithError(err).Error("skipping invalid spooled event")
+				continue
+			}
+			batch = append(batch, evt)
+
+			if len(batch) >= self.config.batchSize {
+				if err = self.send(batch); err != nil {
+					return
+				}
+				batch = nil
+			}
+		}
+
+		if len(batch) > 0 {
+			if err = self.send(batch); err != nil {
+				return
+			}
+		}
+
+		if err = self.spool.Remove(seq); err != nil {
+			log.WithError(err).Error("unable to remove replayed event spool segment")
+			return
+		}
+
+		log.WithField("events", len(records)).Info("replayed spooled events")
+	}
+}
+
+// newBatchingHandler creates an event handler which formats events using the configured formatter and delivers them
+// via a batchingEventSink using the given sender
+func (self *Dispatcher) newBatchingHandler(handlerType string, config map[interface{}]interface{}, sender batchSender) (interface{}, error) {
+	sinkConfig, err := parseBatchingSinkConfig(config)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to parse %s config", handlerType)
+	}
+
+	var ok bool
+	format := "json"
+	if value, found := config["format"]; found {
+		if format, ok = value.(string); !ok {
+			return nil, errors.Errorf("invalid 'format' for %s event handler", handlerType)
+		}
+	}
+
+	formatterFactory := self.GetFormatterFactory(format)
+	if formatterFactory == nil {
+		return nil, errors.Errorf("invalid 'format' for %s event handler: %v", handlerType, format)
+	}
+
+	name := handlerType
+	if value, found := config["name"]; found {
+		if name, ok = value.(string); !ok || name == "" {
+			return nil, errors.Errorf("invalid 'name' for %s event handler", handlerType)
+		}
+	}
+
+	var registry metrics.Registry
+	if self.network != nil {
+		registry = self.network.GetMetricsRegistry()
+	} else {
+		registry = metrics.NewRegistry(name, nil)
+	}
+
+	sink, err := newBatchingEventSink(name, sinkConfig, sender, registry, self.closeNotify)
+	if err != nil {
+		return nil, err
+	}
+
+	return formatterFactory.NewFormatter(sink), nil
+}
@@ -0,0 +1,258 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"github.com/openziti/ziti/controller/event"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type webhookRecorder struct {
+	sync.Mutex
+	fail     atomic.Bool
+	secret   []byte
+	received []string
+	badSigs  int
+}
+
+func (self *webhookRecorder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	if self.fail.Load() {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+
+	self.Lock()
+	defer self.Unlock()
+
+	expected := "sha256=" + SignWebhookPayload(self.secret, r.Header.Get(WebhookTimestampHeader), body)
+	if r.Header.Get(WebhookSignatureHeader) != expected {
+		self.badSigs++
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(body))
+	for scanner.Scan() {
+		self.received = append(self.received, scanner.Text())
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (self *webhookRecorder) count() int {
+	self.Lock()
+	defer self.Unlock()
+	return len(self.received)
+}
+
+func newTestWebhookHandler(t *testing.T, url string, spoolDir string, closeNotify <-chan struct{}) event.CircuitEventHandler {
+	dispatcher := NewDispatcher(closeNotify)
+	config := map[interface{}]interface{}{
+		"type":                 "webhook",
+		"format":               "json",
+		"url":                  url,
+		"secret":               "s3cret",
+		"batchSize":            5,
+		"batchInterval":        "10ms",
+		"maxAttempts":          2,
+		"retryInitialInterval": "10ms",
+		"replayInterval":       "50ms",
+	}
+	if spoolDir != "" {
+		config["spoolDir"] = spoolDir
+	}
+	handler, err := dispatcher.eventHandlerFactories.Get("webhook").NewEventHandler(config)
+	require.NoError(t, err)
+	return handler.(event.CircuitEventHandler)
+}
+
+func sendCircuitEvents(handler event.CircuitEventHandler, start, count int) {
+	for i := start; i < start+count; i++ {
+		handler.AcceptCircuitEvent(&event.CircuitEvent{
+			Namespace: event.CircuitEventsNs,
+			EventType: event.CircuitCreated,
+			CircuitId: fmt.Sprintf("circuit-%d", i),
+		})
+	}
+}
+
+func TestWebhookDelivery(t *testing.T) {
+	req := require.New(t)
+
+	recorder := &webhookRecorder{secret: []byte("s3cret")}
+	server := httptest.NewServer(recorder)
+	defer server.Close()
+
+	closeNotify := make(chan struct{})
+	defer close(closeNotify)
+
+	handler := newTestWebhookHandler(t, server.URL, "", closeNotify)
+	sendCircuitEvents(handler, 0, 12)
+
+	req.Eventually(func() bool { return recorder.count() == 12 }, 5*time.Second, 10*time.Millisecond)
+	req.Equal(0, recorder.badSigs)
+	req.True(strings.Contains(recorder.received[0], `"circuit_id":"circuit-0"`))
+}
+
+func TestWebhookSpoolsAndReplays(t *testing.T) {
+	req := require.New(t)
+
+	recorder := &webhookRecorder{secret: []byte("s3cret")}
+	recorder.fail.Store(true)
+	server := httptest.NewServer(recorder)
+	defer server.Close()
+
+	spoolDir := t.TempDir()
+	closeNotify := make(chan struct{})
+	handler := newTestWebhookHandler(t, server.URL, spoolDir, closeNotify)
+	sendCircuitEvents(handler, 0, 10)
+
+	req.Eventually(func() bool { return spooledBytes(spoolDir) > 0 }, 5*time.Second, 10*time.Millisecond)
+
+	// simulate a controller restart while the remote is down, then bring the remote back
+	close(closeNotify)
+	time.Sleep(100 * time.Millisecond)
+	recorder.fail.Store(false)
+
+	closeNotify = make(chan struct{})
+	defer close(closeNotify)
+	handler = newTestWebhookHandler(t, server.URL, spoolDir, closeNotify)
+	sendCircuitEvents(handler, 10, 5)
+
+	req.Eventually(func() bool { return recorder.count() >= 15 }, 5*time.Second, 10*time.Millisecond)
+
+	recorder.Lock()
+	defer recorder.Unlock()
+	for i := 0; i < 15; i++ {
+		found := false
+		for _, body := range recorder.received {
+			if strings.Contains(body, fmt.Sprintf(`"circuit-%d"`, i)) {
+				found = true
+			}
+		}
+		req.True(found, "circuit-%d not delivered", i)
+	}
+}
+
+func spooledBytes(dir string) int64 {
+	var result int64
+	entries, _ := os.ReadDir(dir)
+	for _, entry := range entries {
+		if info, err := entry.Info(); err == nil {
+			result += info.Size()
+		}
+	}
+	return result
+}
+
+func TestDiskSpool(t *testing.T) {
+	req := require.New(t)
+
+	dir := t.TempDir()
+	spool, err := newDiskSpool(dir, 1024, 64, 0, 0)
+	req.NoError(err)
+
+	for i := 0; i < 10; i++ {
+		req.NoError(spool.Append([]byte(fmt.Sprintf("record-%d", i))))
+	}
+	req.NoError(spool.Close())
+
+	// reopen, as if after a restart
+	spool, err = newDiskSpool(dir, 1024, 64, 0, 0)
+	req.NoError(err)
+	req.Equal(int64(10), spool.Count())
+
+	var read []string
+	for !spool.IsEmpty() {
+		records, seq, found, err := spool.ReadOldest()
+		req.NoError(err)
+		req.True(found)
+		for _, record := range records {
+			read = append(read, string(record))
+		}
+		req.NoError(spool.Remove(seq))
+	}
+
+	req.Len(read, 10)
+	for i, record := range read {
+		req.Equal(fmt.Sprintf("record-%d", i), record)
+	}
+	req.Equal(int64(0), spool.Size())
+	req.Equal(int64(0), spool.Count())
+
+	req.ErrorIs(spool.Append(make([]byte, 2048)), errSpoolFull)
+}
+
+func TestSinkEventEncoding(t *testing.T) {
+	req := require.New(t)
+
+	longType := strings.Repeat("t", 300)
+	record, err := (&sinkEvent{eventType: longType, data: []byte("data")}).encode()
+	req.NoError(err)
+
+	evt, err := decodeSinkEvent(record)
+	req.NoError(err)
+	req.Equal(longType, evt.eventType)
+	req.Equal("data", string(evt.data))
+
+	_, err = (&sinkEvent{eventType: strings.Repeat("t", maxSinkEventTypeLen+1)}).encode()
+	req.Error(err)
+
+	_, err = decodeSinkEvent(record[:10])
+	req.Error(err)
+}
+
+func TestDiskSpoolSealsSegmentsBySizeOrAge(t *testing.T) {
+	req := require.New(t)
+
+	spool, err := newDiskSpool(t.TempDir(), 1024, 64, 50*time.Millisecond, 0)
+	req.NoError(err)
+	defer func() { _ = spool.Close() }()
+
+	req.NoError(spool.Append([]byte("record-0")))
+
+	// the segment being written to is neither full nor old enough to be read
+	_, _, found, err := spool.ReadOldest()
+	req.NoError(err)
+	req.False(found)
+
+	time.Sleep(60 * time.Millisecond)
+
+	records, seq, found, err := spool.ReadOldest()
+	req.NoError(err)
+	req.True(found)
+	req.Len(records, 1)
+	req.NoError(spool.Remove(seq))
+
+	// filling a segment seals it without waiting for it to age
+	req.NoError(spool.Append(make([]byte, 64)))
+	req.NoError(spool.Append([]byte("record-1")))
+	records, _, found, err = spool.ReadOldest()
+	req.NoError(err)
+	req.True(found)
+	req.Len(records, 1)
+}
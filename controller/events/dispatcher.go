@@ -67,14 +67,21 @@ func NewDispatcher(closeNotify <-chan struct{}) *Dispatcher {
 	result.RegisterEventTypeFunctions(event.ApiSessionEventNS, result.registerApiSessionEventHandler, result.unregisterApiSessionEventHandler)
 	result.RegisterEventTypeFunctions(event.EntityCountEventNS, result.registerEntityCountEventHandler, result.unregisterEntityCountEventHandler)
 	result.RegisterEventTypeFunctions(event.SessionEventNS, result.registerSessionEventHandler, result.unregisterSessionEventHandler)
+	result.RegisterEventTypeFunctions(event.PostureWarningEventNS, result.registerPostureWarningEventHandler, result.unregisterPostureWarningEventHandler)
 
 	result.RegisterFormatterFactory("json", event.FormatterFactoryF(func(sink event.FormattedEventSink) io.Closer {
 		return NewJsonFormatter(16, sink)
 	}))
+	result.RegisterFormatterFactory(ProtobufFormat, event.FormatterFactoryF(func(sink event.FormattedEventSink) io.Closer {
+		return NewProtobufFormatter(16, sink)
+	}))
 
-	result.RegisterEventHandlerFactory("file", FileEventLoggerFactory{})
-	result.RegisterEventHandlerFactory("stdout", StdOutLoggerFactory{})
-	result.RegisterEventHandlerFactory("amqp", AMQPEventLoggerFactory{})
+	result.RegisterEventHandlerFactory("file", FileEventLoggerFactory{dispatcher: result})
+	result.RegisterEventHandlerFactory("stdout", StdOutLoggerFactory{dispatcher: result})
+	result.RegisterEventHandlerFactory("amqp", AMQPEventLoggerFactory{dispatcher: result})
+	result.RegisterEventHandlerFactory("webhook", &WebhookEventLoggerFactory{dispatcher: result})
+	result.RegisterEventHandlerFactory("kafka", &KafkaEventLoggerFactory{dispatcher: result})
+	result.RegisterEventHandlerFactory("prometheus", &PrometheusEventHandlerFactory{dispatcher: result})
 
 	return result
 }
@@ -98,11 +105,14 @@ type Dispatcher struct {
 	entityCountEventHandlers concurrenz.CopyOnWriteSlice[*entityCountState]
 	sessionEventHandlers     concurrenz.CopyOnWriteSlice[event.SessionEventHandler]
 
+	postureWarningEventHandlers concurrenz.CopyOnWriteSlice[event.PostureWarningEventHandler]
+
 	metricsMappers concurrenz.CopyOnWriteSlice[event.MetricsMapper]
 
 	registrationHandlers  concurrenz.CopyOnWriteMap[string, event.TypeRegistrar]
 	eventHandlerFactories concurrenz.CopyOnWriteMap[string, event.HandlerFactory]
 	formatterFactories    concurrenz.CopyOnWriteMap[string, event.FormatterFactory]
+	prometheusHandlers    concurrenz.CopyOnWriteMap[string, *prometheusEventHandler]
 
 	network *network.Network
 	stores  *db.Stores
@@ -185,6 +195,7 @@ events:
       - type: fabric.circuits
         include:
           - created
+        filter: 'service.name = "billing" or tags.clientId != null'
       - type: edge.sessions
         include:
           - created
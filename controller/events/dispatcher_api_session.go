@@ -87,6 +87,14 @@ func (self *Dispatcher) registerApiSessionEventHandler(val interface{}, config m
 		return errors.Errorf("type %v doesn't implement github.com/openziti/ziti/controller/event/ApiSessionEventHandler interface.", reflect.TypeOf(val))
 	}
 
+	queryFilter, err := self.newEventFilter(&event.ApiSessionEvent{}, config)
+	if err != nil {
+		return err
+	}
+	if queryFilter != nil {
+		handler = &queryFilteredApiSessionEventHandler{filter: queryFilter, wrapped: handler}
+	}
+
 	var includeList []string
 	if includeVar, ok := config["include"]; ok {
 		if includeStr, ok := includeVar.(string); ok {
@@ -144,3 +152,24 @@ func (self *apiSessionEventAdapter) IsWrapping(value event.ApiSessionEventHandle
 	}
 	return false
 }
+
+type queryFilteredApiSessionEventHandler struct {
+	filter  *eventFilter
+	wrapped event.ApiSessionEventHandler
+}
+
+func (self *queryFilteredApiSessionEventHandler) IsWrapping(value event.ApiSessionEventHandler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.ApiSessionEventHandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
+
+func (self *queryFilteredApiSessionEventHandler) AcceptApiSessionEvent(evt *event.ApiSessionEvent) {
+	if self.filter.Matches(evt) {
+		self.wrapped.AcceptApiSessionEvent(evt)
+	}
+}
@@ -54,6 +54,14 @@ func (self *Dispatcher) registerCircuitEventHandler(val interface{}, config map[
 		return errors.Errorf("type %v doesn't implement github.com/openziti/ziti/controller/event/CircuitEventHandler interface.", reflect.TypeOf(val))
 	}
 
+	queryFilter, err := self.newEventFilter(&event.CircuitEvent{}, config)
+	if err != nil {
+		return err
+	}
+	if queryFilter != nil {
+		handler = &queryFilteredCircuitEventHandler{filter: queryFilter, wrapped: handler}
+	}
+
 	var includeList []string
 	if includeVar, ok := config["include"]; ok {
 		if includeStr, ok := includeVar.(string); ok {
@@ -120,3 +128,24 @@ func (self *filteredCircuitEventHandler) AcceptCircuitEvent(event *event.Circuit
 		self.wrapped.AcceptCircuitEvent(event)
 	}
 }
+
+type queryFilteredCircuitEventHandler struct {
+	filter  *eventFilter
+	wrapped event.CircuitEventHandler
+}
+
+func (self *queryFilteredCircuitEventHandler) IsWrapping(value event.CircuitEventHandler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.CircuitEventHandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
+
+func (self *queryFilteredCircuitEventHandler) AcceptCircuitEvent(evt *event.CircuitEvent) {
+	if self.filter.Matches(evt) {
+		self.wrapped.AcceptCircuitEvent(evt)
+	}
+}
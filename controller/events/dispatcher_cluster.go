@@ -27,7 +27,15 @@ func (self *Dispatcher) AddClusterEventHandler(handler event.ClusterEventHandler
 }
 
 func (self *Dispatcher) RemoveClusterEventHandler(handler event.ClusterEventHandler) {
-	self.clusterEventHandlers.Delete(handler)
+	self.clusterEventHandlers.DeleteIf(func(val event.ClusterEventHandler) bool {
+		if val == handler {
+			return true
+		}
+		if w, ok := val.(event.ClusterEventHandlerWrapper); ok {
+			return w.IsWrapping(handler)
+		}
+		return false
+	})
 }
 
 func (self *Dispatcher) AcceptClusterEvent(event *event.ClusterEvent) {
@@ -38,13 +46,21 @@ func (self *Dispatcher) AcceptClusterEvent(event *event.ClusterEvent) {
 	}()
 }
 
-func (self *Dispatcher) registerClusterEventHandler(val interface{}, _ map[string]interface{}) error {
+func (self *Dispatcher) registerClusterEventHandler(val interface{}, config map[string]interface{}) error {
 	handler, ok := val.(event.ClusterEventHandler)
 
 	if !ok {
 		return errors.Errorf("type %v doesn't implement github.com/openziti/ziti/controller/event/ClusterEventHandler interface.", reflect.TypeOf(val))
 	}
 
+	queryFilter, err := self.newEventFilter(&event.ClusterEvent{}, config)
+	if err != nil {
+		return err
+	}
+	if queryFilter != nil {
+		handler = &queryFilteredClusterEventHandler{filter: queryFilter, wrapped: handler}
+	}
+
 	self.clusterEventHandlers.Append(handler)
 
 	return nil
@@ -55,3 +71,24 @@ func (self *Dispatcher) unregisterClusterEventHandler(val interface{}) {
 		self.RemoveClusterEventHandler(handler)
 	}
 }
+
+type queryFilteredClusterEventHandler struct {
+	filter  *eventFilter
+	wrapped event.ClusterEventHandler
+}
+
+func (self *queryFilteredClusterEventHandler) IsWrapping(value event.ClusterEventHandler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.ClusterEventHandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
+
+func (self *queryFilteredClusterEventHandler) AcceptClusterEvent(evt *event.ClusterEvent) {
+	if self.filter.Matches(evt) {
+		self.wrapped.AcceptClusterEvent(evt)
+	}
+}
@@ -68,6 +68,14 @@ func (self *Dispatcher) registerEntityChangeEventHandler(val interface{}, option
 		return errors.Errorf("type %v doesn't implement github.com/openziti/ziti/controller/event/EntityChangeEventHandler interface.", reflect.TypeOf(val))
 	}
 
+	queryFilter, err := self.newEventFilter(&event.EntityChangeEvent{}, options)
+	if err != nil {
+		return err
+	}
+	if queryFilter != nil {
+		handler = &queryFilteredEntityChangeEventHandler{filter: queryFilter, wrapped: handler}
+	}
+
 	propagateAlways := false
 	if val, found := options["propagateAlways"]; found {
 		if b, ok := val.(bool); ok {
@@ -372,3 +380,24 @@ func (self *entityChangeEventFilter) AcceptEntityChangeEvent(evt *event.EntityCh
 
 	self.EntityChangeEventHandler.AcceptEntityChangeEvent(evt)
 }
+
+type queryFilteredEntityChangeEventHandler struct {
+	filter  *eventFilter
+	wrapped event.EntityChangeEventHandler
+}
+
+func (self *queryFilteredEntityChangeEventHandler) IsWrapping(value event.EntityChangeEventHandler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.EntityChangeEventHandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
+
+func (self *queryFilteredEntityChangeEventHandler) AcceptEntityChangeEvent(evt *event.EntityChangeEvent) {
+	if self.filter.Matches(evt) {
+		self.wrapped.AcceptEntityChangeEvent(evt)
+	}
+}
@@ -37,6 +37,8 @@ func (self *Dispatcher) RemoveEntityCountEventHandler(handler event.EntityCountE
 	for _, state := range self.entityCountEventHandlers.Value() {
 		if state.handler == handler {
 			self.entityCountEventHandlers.Delete(state)
+		} else if w, ok := state.handler.(event.EntityCountEventHandlerWrapper); ok && w.IsWrapping(handler) {
+			self.entityCountEventHandlers.Delete(state)
 		}
 	}
 }
@@ -94,6 +96,14 @@ func (self *Dispatcher) registerEntityCountEventHandler(val interface{}, config
 		return errors.Errorf("type %v doesn't implement github.com/openziti/ziti/controller/events/EntityCountEventHandler interface.", reflect.TypeOf(val))
 	}
 
+	queryFilter, err := self.newEventFilter(&event.EntityCountEvent{}, config)
+	if err != nil {
+		return err
+	}
+	if queryFilter != nil {
+		handler = &queryFilteredEntityCountEventHandler{filter: queryFilter, wrapped: handler}
+	}
+
 	interval := time.Minute * 5
 
 	if val, ok := config["interval"]; ok {
@@ -136,3 +146,24 @@ type entityCountState struct {
 	interval         time.Duration
 	nextRun          time.Time
 }
+
+type queryFilteredEntityCountEventHandler struct {
+	filter  *eventFilter
+	wrapped event.EntityCountEventHandler
+}
+
+func (self *queryFilteredEntityCountEventHandler) IsWrapping(value event.EntityCountEventHandler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.EntityCountEventHandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
+
+func (self *queryFilteredEntityCountEventHandler) AcceptEntityCountEvent(evt *event.EntityCountEvent) {
+	if self.filter.Matches(evt) {
+		self.wrapped.AcceptEntityCountEvent(evt)
+	}
+}
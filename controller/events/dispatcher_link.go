@@ -27,7 +27,15 @@ func (self *Dispatcher) AddLinkEventHandler(handler event.LinkEventHandler) {
 }
 
 func (self *Dispatcher) RemoveLinkEventHandler(handler event.LinkEventHandler) {
-	self.linkEventHandlers.Delete(handler)
+	self.linkEventHandlers.DeleteIf(func(val event.LinkEventHandler) bool {
+		if val == handler {
+			return true
+		}
+		if w, ok := val.(event.LinkEventHandlerWrapper); ok {
+			return w.IsWrapping(handler)
+		}
+		return false
+	})
 }
 
 func (self *Dispatcher) AcceptLinkEvent(event *event.LinkEvent) {
@@ -38,13 +46,21 @@ func (self *Dispatcher) AcceptLinkEvent(event *event.LinkEvent) {
 	}()
 }
 
-func (self *Dispatcher) registerLinkEventHandler(val interface{}, _ map[string]interface{}) error {
+func (self *Dispatcher) registerLinkEventHandler(val interface{}, config map[string]interface{}) error {
 	handler, ok := val.(event.LinkEventHandler)
 
 	if !ok {
 		return errors.Errorf("type %v doesn't implement github.com/openziti/ziti/controller/event/LinkEventHandler interface.", reflect.TypeOf(val))
 	}
 
+	queryFilter, err := self.newEventFilter(&event.LinkEvent{}, config)
+	if err != nil {
+		return err
+	}
+	if queryFilter != nil {
+		handler = &queryFilteredLinkEventHandler{filter: queryFilter, wrapped: handler}
+	}
+
 	self.linkEventHandlers.Append(handler)
 
 	return nil
@@ -55,3 +71,24 @@ func (self *Dispatcher) unregisterLinkEventHandler(val interface{}) {
 		self.RemoveLinkEventHandler(handler)
 	}
 }
+
+type queryFilteredLinkEventHandler struct {
+	filter  *eventFilter
+	wrapped event.LinkEventHandler
+}
+
+func (self *queryFilteredLinkEventHandler) IsWrapping(value event.LinkEventHandler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.LinkEventHandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
+
+func (self *queryFilteredLinkEventHandler) AcceptLinkEvent(evt *event.LinkEvent) {
+	if self.filter.Matches(evt) {
+		self.wrapped.AcceptLinkEvent(evt)
+	}
+}
@@ -90,6 +90,14 @@ func (self *Dispatcher) registerMetricsEventHandler(val interface{}, config map[
 		return errors.Errorf("type %v doesn't implement github.com/openziti/ziti/controller/event/MetricsEventHandler interface.", reflect.TypeOf(val))
 	}
 
+	queryFilter, err := self.newEventFilter(&event.MetricsEvent{}, config)
+	if err != nil {
+		return err
+	}
+	if queryFilter != nil {
+		handler = &queryFilteredMetricsEventHandler{filter: queryFilter, wrapped: handler}
+	}
+
 	var sourceFilterDef = ""
 	if sourceRegexVal, ok := config["sourceFilter"]; ok {
 		sourceFilterDef, ok = sourceRegexVal.(string)
@@ -99,7 +107,6 @@ func (self *Dispatcher) registerMetricsEventHandler(val interface{}, config map[
 	}
 
 	var sourceFilter *regexp.Regexp
-	var err error
 	if sourceFilterDef != "" {
 		if sourceFilter, err = regexp.Compile(sourceFilterDef); err != nil {
 			return err
@@ -284,3 +291,24 @@ func (self *filteringMetricsMessageAdapter) AcceptMetricsMsg(msg *metrics_pb.Met
 	}
 	self.dispatcher.convertMetricsMsgToEvents(msg, self.sourceFilter, self.metricFilter, self.handler)
 }
+
+type queryFilteredMetricsEventHandler struct {
+	filter  *eventFilter
+	wrapped event.MetricsEventHandler
+}
+
+func (self *queryFilteredMetricsEventHandler) IsWrapping(value event.MetricsEventHandler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.MetricsEventHandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
+
+func (self *queryFilteredMetricsEventHandler) AcceptMetricsEvent(evt *event.MetricsEvent) {
+	if self.filter.Matches(evt) {
+		self.wrapped.AcceptMetricsEvent(evt)
+	}
+}
@@ -0,0 +1,150 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"fmt"
+	"github.com/openziti/foundation/v2/stringz"
+	"github.com/openziti/ziti/controller/event"
+	"github.com/pkg/errors"
+	"reflect"
+)
+
+var postureWarningEventTypes = []string{
+	event.PostureWarningEventTypeWarning,
+	event.PostureWarningEventTypeExpired,
+	event.PostureWarningEventTypeCleared,
+}
+
+func (self *Dispatcher) AddPostureWarningEventHandler(handler event.PostureWarningEventHandler) {
+	self.postureWarningEventHandlers.Append(handler)
+}
+
+func (self *Dispatcher) RemovePostureWarningEventHandler(handler event.PostureWarningEventHandler) {
+	self.postureWarningEventHandlers.DeleteIf(func(val event.PostureWarningEventHandler) bool {
+		if val == handler {
+			return true
+		}
+		if w, ok := val.(event.PostureWarningEventHandlerWrapper); ok {
+			return w.IsWrapping(handler)
+		}
+		return false
+	})
+}
+
+func (self *Dispatcher) AcceptPostureWarningEvent(evt *event.PostureWarningEvent) {
+	go func() {
+		for _, handler := range self.postureWarningEventHandlers.Value() {
+			handler.AcceptPostureWarningEvent(evt)
+		}
+	}()
+}
+
+func (self *Dispatcher) registerPostureWarningEventHandler(val interface{}, config map[string]interface{}) error {
+	handler, ok := val.(event.PostureWarningEventHandler)
+
+	if !ok {
+		return errors.Errorf("type %v doesn't implement github.com/openziti/ziti/controller/event/PostureWarningEventHandler interface.", reflect.TypeOf(val))
+	}
+
+	queryFilter, err := self.newEventFilter(&event.PostureWarningEvent{}, config)
+	if err != nil {
+		return err
+	}
+	if queryFilter != nil {
+		handler = &queryFilteredPostureWarningEventHandler{filter: queryFilter, wrapped: handler}
+	}
+
+	var includeList []string
+	if includeVar, ok := config["include"]; ok {
+		if includeStr, ok := includeVar.(string); ok {
+			includeList = append(includeList, includeStr)
+		} else if includeIntfList, ok := includeVar.([]interface{}); ok {
+			for _, val := range includeIntfList {
+				includeList = append(includeList, fmt.Sprintf("%v", val))
+			}
+		} else {
+			return errors.Errorf("invalid type %v for %v include configuration", reflect.TypeOf(includeVar), event.PostureWarningEventNS)
+		}
+	}
+
+	if len(includeList) == 0 {
+		self.AddPostureWarningEventHandler(handler)
+		return nil
+	}
+
+	for _, include := range includeList {
+		if !stringz.Contains(postureWarningEventTypes, include) {
+			return errors.Errorf("invalid include %v for %v. valid values are %+v", include, event.PostureWarningEventNS, postureWarningEventTypes)
+		}
+	}
+
+	self.AddPostureWarningEventHandler(&postureWarningEventAdapter{
+		wrapped:     handler,
+		includeList: includeList,
+	})
+
+	return nil
+}
+
+func (self *Dispatcher) unregisterPostureWarningEventHandler(val interface{}) {
+	if handler, ok := val.(event.PostureWarningEventHandler); ok {
+		self.RemovePostureWarningEventHandler(handler)
+	}
+}
+
+type postureWarningEventAdapter struct {
+	wrapped     event.PostureWarningEventHandler
+	includeList []string
+}
+
+func (self *postureWarningEventAdapter) AcceptPostureWarningEvent(evt *event.PostureWarningEvent) {
+	if stringz.Contains(self.includeList, evt.EventType) {
+		self.wrapped.AcceptPostureWarningEvent(evt)
+	}
+}
+
+func (self *postureWarningEventAdapter) IsWrapping(value event.PostureWarningEventHandler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.PostureWarningEventHandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
+
+type queryFilteredPostureWarningEventHandler struct {
+	filter  *eventFilter
+	wrapped event.PostureWarningEventHandler
+}
+
+func (self *queryFilteredPostureWarningEventHandler) IsWrapping(value event.PostureWarningEventHandler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.PostureWarningEventHandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
+
+func (self *queryFilteredPostureWarningEventHandler) AcceptPostureWarningEvent(evt *event.PostureWarningEvent) {
+	if self.filter.Matches(evt) {
+		self.wrapped.AcceptPostureWarningEvent(evt)
+	}
+}
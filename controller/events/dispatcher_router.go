@@ -29,7 +29,15 @@ func (self *Dispatcher) AddRouterEventHandler(handler event.RouterEventHandler)
 }
 
 func (self *Dispatcher) RemoveRouterEventHandler(handler event.RouterEventHandler) {
-	self.routerEventHandlers.Delete(handler)
+	self.routerEventHandlers.DeleteIf(func(val event.RouterEventHandler) bool {
+		if val == handler {
+			return true
+		}
+		if w, ok := val.(event.RouterEventHandlerWrapper); ok {
+			return w.IsWrapping(handler)
+		}
+		return false
+	})
 }
 
 func (self *Dispatcher) AcceptRouterEvent(event *event.RouterEvent) {
@@ -47,13 +55,21 @@ func (self *Dispatcher) initRouterEvents(n *network.Network) {
 	n.AddRouterPresenceHandler(routerEvtAdapter)
 }
 
-func (self *Dispatcher) registerRouterEventHandler(val interface{}, _ map[string]interface{}) error {
+func (self *Dispatcher) registerRouterEventHandler(val interface{}, config map[string]interface{}) error {
 	handler, ok := val.(event.RouterEventHandler)
 
 	if !ok {
 		return errors.Errorf("type %v doesn't implement github.com/openziti/ziti/controller/event/RouterEventHandler interface.", reflect.TypeOf(val))
 	}
 
+	queryFilter, err := self.newEventFilter(&event.RouterEvent{}, config)
+	if err != nil {
+		return err
+	}
+	if queryFilter != nil {
+		handler = &queryFilteredRouterEventHandler{filter: queryFilter, wrapped: handler}
+	}
+
 	self.AddRouterEventHandler(handler)
 
 	return nil
@@ -89,3 +105,24 @@ func (self *routerEventAdapter) routerChange(eventType event.RouterEventType, r
 
 	self.Dispatcher.AcceptRouterEvent(evt)
 }
+
+type queryFilteredRouterEventHandler struct {
+	filter  *eventFilter
+	wrapped event.RouterEventHandler
+}
+
+func (self *queryFilteredRouterEventHandler) IsWrapping(value event.RouterEventHandler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.RouterEventHandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
+
+func (self *queryFilteredRouterEventHandler) AcceptRouterEvent(evt *event.RouterEvent) {
+	if self.filter.Matches(evt) {
+		self.wrapped.AcceptRouterEvent(evt)
+	}
+}
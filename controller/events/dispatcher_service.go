@@ -30,7 +30,15 @@ func (self *Dispatcher) AddServiceEventHandler(handler event.ServiceEventHandler
 }
 
 func (self *Dispatcher) RemoveServiceEventHandler(handler event.ServiceEventHandler) {
-	self.serviceEventHandlers.Delete(handler)
+	self.serviceEventHandlers.DeleteIf(func(val event.ServiceEventHandler) bool {
+		if val == handler {
+			return true
+		}
+		if w, ok := val.(event.ServiceEventHandlerWrapper); ok {
+			return w.IsWrapping(handler)
+		}
+		return false
+	})
 }
 
 func (self *Dispatcher) AcceptServiceEvent(event *event.ServiceEvent) {
@@ -41,12 +49,20 @@ func (self *Dispatcher) AcceptServiceEvent(event *event.ServiceEvent) {
 	}()
 }
 
-func (self *Dispatcher) registerServiceEventHandler(val interface{}, _ map[string]interface{}) error {
+func (self *Dispatcher) registerServiceEventHandler(val interface{}, config map[string]interface{}) error {
 	handler, ok := val.(event.ServiceEventHandler)
 	if !ok {
 		return errors.Errorf("type %v doesn't implement github.com/openziti/edge/event/ServiceEventHandler interface.", reflect.TypeOf(val))
 	}
 
+	queryFilter, err := self.newEventFilter(&event.ServiceEvent{}, config)
+	if err != nil {
+		return err
+	}
+	if queryFilter != nil {
+		handler = &queryFilteredServiceEventHandler{filter: queryFilter, wrapped: handler}
+	}
+
 	self.AddServiceEventHandler(handler)
 	return nil
 }
@@ -93,3 +109,24 @@ func (self *serviceEventAdapter) AcceptMetrics(message *metrics_pb.MetricsMessag
 		}
 	}
 }
+
+type queryFilteredServiceEventHandler struct {
+	filter  *eventFilter
+	wrapped event.ServiceEventHandler
+}
+
+func (self *queryFilteredServiceEventHandler) IsWrapping(value event.ServiceEventHandler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.ServiceEventHandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
+
+func (self *queryFilteredServiceEventHandler) AcceptServiceEvent(evt *event.ServiceEvent) {
+	if self.filter.Matches(evt) {
+		self.wrapped.AcceptServiceEvent(evt)
+	}
+}
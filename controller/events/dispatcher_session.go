@@ -90,6 +90,14 @@ func (self *Dispatcher) registerSessionEventHandler(val interface{}, config map[
 		return errors.Errorf("type %v doesn't implement github.com/openziti/edge/events/SessionEventHandler interface.", reflect.TypeOf(val))
 	}
 
+	queryFilter, err := self.newEventFilter(&event.SessionEvent{}, config)
+	if err != nil {
+		return err
+	}
+	if queryFilter != nil {
+		handler = &queryFilteredSessionEventHandler{filter: queryFilter, wrapped: handler}
+	}
+
 	var includeList []string
 	if includeVar, ok := config["include"]; ok {
 		if includeStr, ok := includeVar.(string); ok {
@@ -147,3 +155,24 @@ func (self *sessionEventAdapter) IsWrapping(value event.SessionEventHandler) boo
 	}
 	return false
 }
+
+type queryFilteredSessionEventHandler struct {
+	filter  *eventFilter
+	wrapped event.SessionEventHandler
+}
+
+func (self *queryFilteredSessionEventHandler) IsWrapping(value event.SessionEventHandler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.SessionEventHandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
+
+func (self *queryFilteredSessionEventHandler) AcceptSessionEvent(evt *event.SessionEvent) {
+	if self.filter.Matches(evt) {
+		self.wrapped.AcceptSessionEvent(evt)
+	}
+}
@@ -61,6 +61,14 @@ func (self *Dispatcher) registerTerminatorEventHandler(val interface{}, options
 		return errors.Errorf("type %v doesn't implement github.com/openziti/ziti/controller/event/TerminatorEventHandler interface.", reflect.TypeOf(val))
 	}
 
+	queryFilter, err := self.newEventFilter(&event.TerminatorEvent{}, options)
+	if err != nil {
+		return err
+	}
+	if queryFilter != nil {
+		handler = &queryFilteredTerminatorEventHandler{filter: queryFilter, wrapped: handler}
+	}
+
 	propagateAlways := false
 	if val, found := options["propagateAlways"]; found {
 		if b, ok := val.(bool); ok {
@@ -220,3 +228,24 @@ func (self *terminatorEventAdapter) createTerminatorEvent(eventType event.Termin
 
 	self.Dispatcher.AcceptTerminatorEvent(evt)
 }
+
+type queryFilteredTerminatorEventHandler struct {
+	filter  *eventFilter
+	wrapped event.TerminatorEventHandler
+}
+
+func (self *queryFilteredTerminatorEventHandler) IsWrapping(value event.TerminatorEventHandler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.TerminatorEventHandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
+
+func (self *queryFilteredTerminatorEventHandler) AcceptTerminatorEvent(evt *event.TerminatorEvent) {
+	if self.filter.Matches(evt) {
+		self.wrapped.AcceptTerminatorEvent(evt)
+	}
+}
@@ -29,7 +29,15 @@ func (self *Dispatcher) AddUsageEventHandler(handler event.UsageEventHandler) {
 }
 
 func (self *Dispatcher) RemoveUsageEventHandler(handler event.UsageEventHandler) {
-	self.usageEventHandlers.Delete(handler)
+	self.usageEventHandlers.DeleteIf(func(val event.UsageEventHandler) bool {
+		if val == handler {
+			return true
+		}
+		if w, ok := val.(event.UsageEventHandlerWrapper); ok {
+			return w.IsWrapping(handler)
+		}
+		return false
+	})
 }
 
 func (self *Dispatcher) AddUsageEventV3Handler(handler event.UsageEventV3Handler) {
@@ -83,6 +91,15 @@ func (self *Dispatcher) registerUsageEventHandler(val interface{}, config map[st
 		if !ok {
 			return errors.Errorf("type %v doesn't implement github.com/openziti/ziti/controller/event/UsageEventHandler interface.", reflect.TypeOf(val))
 		}
+
+		queryFilter, err := self.newEventFilter(&event.UsageEvent{}, config)
+		if err != nil {
+			return err
+		}
+		if queryFilter != nil {
+			handler = &queryFilteredUsageEventHandler{filter: queryFilter, wrapped: handler}
+		}
+
 		self.AddUsageEventHandler(handler)
 	} else if version == 3 {
 		handler, ok := val.(event.UsageEventV3Handler)
@@ -90,6 +107,14 @@ func (self *Dispatcher) registerUsageEventHandler(val interface{}, config map[st
 			return errors.Errorf("type %v doesn't implement github.com/openziti/ziti/controller/event/UsageEventV3Handler interface.", reflect.TypeOf(val))
 		}
 
+		queryFilter, err := self.newEventFilter(&event.UsageEventV3{}, config)
+		if err != nil {
+			return err
+		}
+		if queryFilter != nil {
+			handler = &queryFilteredUsageEventV3Handler{filter: queryFilter, wrapped: handler}
+		}
+
 		if includeListVal, found := config["include"]; found {
 			includes := map[string]struct{}{}
 			if list, ok := includeListVal.([]interface{}); ok {
@@ -259,3 +284,45 @@ func (self *filteredUsageV3EventHandler) AcceptUsageEventV3(event *event.UsageEv
 	newEvent.Usage = usage
 	self.wrapped.AcceptUsageEventV3(&newEvent)
 }
+
+type queryFilteredUsageEventHandler struct {
+	filter  *eventFilter
+	wrapped event.UsageEventHandler
+}
+
+func (self *queryFilteredUsageEventHandler) IsWrapping(value event.UsageEventHandler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.UsageEventHandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
+
+func (self *queryFilteredUsageEventHandler) AcceptUsageEvent(evt *event.UsageEvent) {
+	if self.filter.Matches(evt) {
+		self.wrapped.AcceptUsageEvent(evt)
+	}
+}
+
+type queryFilteredUsageEventV3Handler struct {
+	filter  *eventFilter
+	wrapped event.UsageEventV3Handler
+}
+
+func (self *queryFilteredUsageEventV3Handler) IsWrapping(value event.UsageEventV3Handler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.UsageEventV3HandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
+
+func (self *queryFilteredUsageEventV3Handler) AcceptUsageEventV3(evt *event.UsageEventV3) {
+	if self.filter.Matches(evt) {
+		self.wrapped.AcceptUsageEventV3(evt)
+	}
+}
@@ -0,0 +1,460 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"github.com/openziti/storage/ast"
+	"github.com/openziti/ziti/controller/network"
+	"github.com/pkg/errors"
+	"reflect"
+	"strings"
+	"time"
+	"unicode"
+)
+
+const EventFilterOption = "filter"
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// eventFilter evaluates a ziti query language predicate, the same syntax used for list filters, against individual
+// events. Symbols are derived from the event's struct fields using camel case names, so a circuit event exposes
+// eventType, serviceId, path.ingressId, tags.<name>, and so on. Fields are also available under their json names. String
+// slices are exposed as sets, and entity ids for services and routers can be resolved to names, for example
+// service.name or srcRouter.name.
+/**
+Example subscription:
+  - type: fabric.circuits
+    filter: 'service.name = "billing" and eventType = "failed"'
+*/
+type eventFilter struct {
+	query   ast.Query
+	symbols *eventSymbolTypes
+}
+
+// newEventFilter returns a filter for the expression in the subscription options, or nil if none was given.
+// prototype should be a pointer to the type of event being filtered.
+func (self *Dispatcher) newEventFilter(prototype any, options map[string]interface{}) (*eventFilter, error) {
+	val, found := options[EventFilterOption]
+	if !found {
+		return nil, nil
+	}
+
+	filterStr, ok := val.(string)
+	if !ok {
+		return nil, errors.Errorf("invalid event filter %v of type %v, must be string", val, reflect.TypeOf(val))
+	}
+
+	if strings.TrimSpace(filterStr) == "" {
+		return nil, nil
+	}
+
+	return parseEventFilter(prototype, filterStr, self.network)
+}
+
+// parseEventFilter parses the given filter expression against the fields of the given event type. If n is not nil,
+// service and router name symbols will be resolved using it.
+func parseEventFilter(prototype any, filter string, n *network.Network) (*eventFilter, error) {
+	symbols := newEventSymbolTypes(reflect.TypeOf(prototype), n)
+
+	query, err := ast.Parse(symbols, filter)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid event filter '%v'", filter)
+	}
+
+	if len(query.GetSortFields()) > 0 || query.GetSkip() != nil || query.GetLimit() != nil {
+		return nil, errors.Errorf("invalid event filter '%v', sort, skip and limit are not supported", filter)
+	}
+
+	return &eventFilter{
+		query:   query,
+		symbols: symbols,
+	}, nil
+}
+
+// Matches returns true if the given event satisfies the filter. evt must be the same type as the prototype used to
+// create the filter.
+func (self *eventFilter) Matches(evt any) bool {
+	if self == nil {
+		return true
+	}
+
+	return self.query.EvalBool(&eventSymbols{
+		eventSymbolTypes: self.symbols,
+		value:            reflect.ValueOf(evt),
+	})
+}
+
+type eventSymbol struct {
+	nodeType ast.NodeType
+	path     []int
+	isSet    bool
+	resolve  func(id string) *string
+	idSymbol string
+}
+
+type eventMapSymbol struct {
+	nodeType ast.NodeType
+	path     []int
+}
+
+type eventSymbolTypes struct {
+	symbols    map[string]*eventSymbol
+	mapSymbols map[string]*eventMapSymbol
+}
+
+func newEventSymbolTypes(t reflect.Type, n *network.Network) *eventSymbolTypes {
+	result := &eventSymbolTypes{
+		symbols:    map[string]*eventSymbol{},
+		mapSymbols: map[string]*eventMapSymbol{},
+	}
+
+	result.addFields(t, nil, "", "")
+
+	if n != nil {
+		for name, symbol := range result.symbols {
+			if symbol.nodeType != ast.NodeTypeString || symbol.isSet || symbol.resolve != nil {
+				continue
+			}
+			if strings.HasSuffix(name, "serviceId") || strings.HasSuffix(name, "ServiceId") {
+				result.addNameSymbol(name, func(id string) *string {
+					if svc, _ := n.Services.Read(id); svc != nil {
+						return &svc.Name
+					}
+					return nil
+				})
+			} else if strings.HasSuffix(name, "routerId") || strings.HasSuffix(name, "RouterId") {
+				result.addNameSymbol(name, func(id string) *string {
+					if router, _ := n.Routers.Read(id); router != nil {
+						return &router.Name
+					}
+					return nil
+				})
+			}
+		}
+	}
+
+	return result
+}
+
+func (self *eventSymbolTypes) addNameSymbol(idSymbol string, resolve func(id string) *string) {
+	name := strings.TrimSuffix(idSymbol, "Id") + ".name"
+	if _, found := self.symbols[name]; !found {
+		self.symbols[name] = &eventSymbol{
+			nodeType: ast.NodeTypeString,
+			resolve:  resolve,
+			idSymbol: idSymbol,
+		}
+	}
+}
+
+func (self *eventSymbolTypes) addFields(t reflect.Type, path []int, prefix, jsonPrefix string) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if t.Kind() != reflect.Struct {
+		return
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		jsonName := strings.Split(field.Tag.Get("json"), ",")[0]
+		if jsonName == "-" {
+			continue
+		}
+
+		fieldPath := append(append([]int{}, path...), i)
+		names := []string{prefix + lowerFirst(field.Name)}
+		if jsonName != "" && jsonPrefix+jsonName != names[0] {
+			names = append(names, jsonPrefix+jsonName)
+		}
+
+		fieldType := field.Type
+		for fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+
+		if fieldType.Kind() == reflect.Struct && fieldType != timeType {
+			jsonChildPrefix := jsonPrefix + jsonName + "."
+			if jsonName == "" {
+				jsonChildPrefix = names[0] + "."
+			}
+			self.addFields(fieldType, fieldPath, names[0]+".", jsonChildPrefix)
+			continue
+		}
+
+		if fieldType.Kind() == reflect.Map {
+			if fieldType.Key().Kind() != reflect.String {
+				continue
+			}
+			if nodeType, ok := eventNodeType(fieldType.Elem()); ok {
+				for _, name := range names {
+					self.mapSymbols[name+"."] = &eventMapSymbol{nodeType: nodeType, path: fieldPath}
+				}
+			}
+			continue
+		}
+
+		if fieldType.Kind() == reflect.Slice {
+			if fieldType.Elem().Kind() == reflect.String {
+				for _, name := range names {
+					self.symbols[name] = &eventSymbol{nodeType: ast.NodeTypeString, path: fieldPath, isSet: true}
+				}
+			}
+			continue
+		}
+
+		if nodeType, ok := eventNodeType(fieldType); ok {
+			for _, name := range names {
+				self.symbols[name] = &eventSymbol{nodeType: nodeType, path: fieldPath}
+			}
+		}
+	}
+}
+
+func (self *eventSymbolTypes) getMapSymbol(name string) (*eventMapSymbol, string) {
+	if idx := strings.LastIndex(name, "."); idx > 0 {
+		if symbol, found := self.mapSymbols[name[:idx+1]]; found {
+			return symbol, name[idx+1:]
+		}
+	}
+	return nil, ""
+}
+
+func (self *eventSymbolTypes) GetSymbolType(name string) (ast.NodeType, bool) {
+	if symbol, found := self.symbols[name]; found {
+		return symbol.nodeType, true
+	}
+	if symbol, _ := self.getMapSymbol(name); symbol != nil {
+		return symbol.nodeType, true
+	}
+	return 0, false
+}
+
+func (self *eventSymbolTypes) GetSetSymbolTypes(string) ast.SymbolTypes {
+	return nil
+}
+
+func (self *eventSymbolTypes) IsSet(name string) (bool, bool) {
+	if symbol, found := self.symbols[name]; found {
+		return symbol.isSet, true
+	}
+	if symbol, _ := self.getMapSymbol(name); symbol != nil {
+		return false, true
+	}
+	return false, false
+}
+
+// eventSymbols evaluates symbols against a single event. Set symbols return the element at the current position of
+// the most recently opened cursor for that set.
+type eventSymbols struct {
+	*eventSymbolTypes
+	value   reflect.Value
+	cursors map[string]*stringSliceCursor
+}
+
+func (self *eventSymbols) field(path []int) (reflect.Value, bool) {
+	v := self.value
+	for _, idx := range path {
+		for v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				return v, false
+			}
+			v = v.Elem()
+		}
+		v = v.Field(idx)
+	}
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return v, false
+		}
+		v = v.Elem()
+	}
+	return v, true
+}
+
+func (self *eventSymbols) eval(name string) any {
+	if symbol, found := self.symbols[name]; found {
+		if symbol.resolve != nil {
+			if id := self.EvalString(symbol.idSymbol); id != nil {
+				return symbol.resolve(*id)
+			}
+			return nil
+		}
+
+		if symbol.isSet {
+			if cursor := self.cursors[name]; cursor != nil && cursor.IsValid() {
+				result := cursor.values[cursor.idx]
+				return &result
+			}
+			return nil
+		}
+
+		if v, ok := self.field(symbol.path); ok {
+			return toSymbolValue(v, symbol.nodeType)
+		}
+		return nil
+	}
+
+	if symbol, key := self.getMapSymbol(name); symbol != nil {
+		if m, ok := self.field(symbol.path); ok && !m.IsNil() {
+			if v := m.MapIndex(reflect.ValueOf(key).Convert(m.Type().Key())); v.IsValid() {
+				return toSymbolValue(v, symbol.nodeType)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (self *eventSymbols) EvalBool(name string) *bool {
+	result, _ := self.eval(name).(*bool)
+	return result
+}
+
+func (self *eventSymbols) EvalString(name string) *string {
+	result, _ := self.eval(name).(*string)
+	return result
+}
+
+func (self *eventSymbols) EvalInt64(name string) *int64 {
+	result, _ := self.eval(name).(*int64)
+	return result
+}
+
+func (self *eventSymbols) EvalFloat64(name string) *float64 {
+	result, _ := self.eval(name).(*float64)
+	return result
+}
+
+func (self *eventSymbols) EvalDatetime(name string) *time.Time {
+	result, _ := self.eval(name).(*time.Time)
+	return result
+}
+
+func (self *eventSymbols) IsNil(name string) bool {
+	val := self.eval(name)
+	if val == nil {
+		return true
+	}
+	return reflect.ValueOf(val).IsNil()
+}
+
+func (self *eventSymbols) OpenSetCursor(name string) ast.SetCursor {
+	cursor := &stringSliceCursor{}
+	if symbol, found := self.symbols[name]; found && symbol.isSet {
+		if v, ok := self.field(symbol.path); ok {
+			cursor.values, _ = v.Interface().([]string)
+			if cursor.values == nil && v.Type().Elem().Kind() == reflect.String {
+				for i := 0; i < v.Len(); i++ {
+					cursor.values = append(cursor.values, v.Index(i).String())
+				}
+			}
+		}
+	}
+
+	if self.cursors == nil {
+		self.cursors = map[string]*stringSliceCursor{}
+	}
+	self.cursors[name] = cursor
+	return cursor
+}
+
+func (self *eventSymbols) OpenSetCursorForQuery(name string, _ ast.Query) ast.SetCursor {
+	return self.OpenSetCursor(name)
+}
+
+type stringSliceCursor struct {
+	values []string
+	idx    int
+}
+
+func (self *stringSliceCursor) Next() {
+	self.idx++
+}
+
+func (self *stringSliceCursor) IsValid() bool {
+	return self.idx < len(self.values)
+}
+
+func (self *stringSliceCursor) Current() []byte {
+	return []byte(self.values[self.idx])
+}
+
+func eventNodeType(t reflect.Type) (ast.NodeType, bool) {
+	if t == timeType {
+		return ast.NodeTypeDatetime, true
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return ast.NodeTypeString, true
+	case reflect.Bool:
+		return ast.NodeTypeBool, true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return ast.NodeTypeInt64, true
+	case reflect.Float32, reflect.Float64:
+		return ast.NodeTypeFloat64, true
+	default:
+		return 0, false
+	}
+}
+
+func toSymbolValue(v reflect.Value, nodeType ast.NodeType) any {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+
+	switch nodeType {
+	case ast.NodeTypeString:
+		result := v.String()
+		return &result
+	case ast.NodeTypeBool:
+		result := v.Bool()
+		return &result
+	case ast.NodeTypeInt64:
+		var result int64
+		if v.CanInt() {
+			result = v.Int()
+		} else {
+			result = int64(v.Uint())
+		}
+		return &result
+	case ast.NodeTypeFloat64:
+		result := v.Float()
+		return &result
+	case ast.NodeTypeDatetime:
+		result, _ := v.Interface().(time.Time)
+		return &result
+	}
+	return nil
+}
+
+func lowerFirst(s string) string {
+	for i, r := range s {
+		return string(unicode.ToLower(r)) + s[i+len(string(r)):]
+	}
+	return s
+}
@@ -0,0 +1,115 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"github.com/openziti/ziti/controller/event"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestEventFilter(t *testing.T) {
+	req := require.New(t)
+
+	failureCause := "NO_TERMINATORS"
+	evt := &event.CircuitEvent{
+		Namespace:    event.CircuitEventsNs,
+		EventType:    event.CircuitFailed,
+		CircuitId:    "circuit1",
+		Timestamp:    time.Now(),
+		ServiceId:    "billing",
+		LinkCount:    2,
+		FailureCause: &failureCause,
+		Path: event.CircuitPath{
+			Nodes:     []string{"r1", "r2", "r3"},
+			IngressId: "ingress1",
+		},
+		Tags: map[string]string{"clientId": "client1"},
+	}
+
+	matches := func(filter string) bool {
+		f, err := parseEventFilter(&event.CircuitEvent{}, filter, nil)
+		req.NoError(err, filter)
+		return f.Matches(evt)
+	}
+
+	req.True(matches(`serviceId = "billing" and eventType = "failed"`))
+	req.True(matches(`service_id = "billing" and event_type = "failed"`))
+	req.False(matches(`serviceId = "billing" and eventType = "created"`))
+	req.True(matches(`linkCount > 1`))
+	req.True(matches(`failureCause contains "TERMINATORS"`))
+	req.True(matches(`duration = null`))
+	req.True(matches(`path.ingressId = "ingress1"`))
+	req.True(matches(`anyOf(path.nodes) = "r2"`))
+	req.False(matches(`anyOf(path.nodes) = "r4"`))
+	req.True(matches(`tags.clientId = "client1"`))
+	req.True(matches(`tags.missing = null`))
+	req.True(matches(`eventType = "created" or tags.clientId != null`))
+	req.True(matches(`timestamp > datetime(2020-01-01T00:00:00Z)`))
+
+	_, err := parseEventFilter(&event.CircuitEvent{}, `noSuchField = 1`, nil)
+	req.Error(err)
+
+	_, err = parseEventFilter(&event.CircuitEvent{}, `serviceId = "billing" limit 5`, nil)
+	req.Error(err)
+}
+
+func TestEventFilterSubscription(t *testing.T) {
+	req := require.New(t)
+
+	closeNotify := make(chan struct{})
+	defer close(closeNotify)
+	dispatcher := NewDispatcher(closeNotify)
+
+	var received []*event.LinkEvent
+	handler := &testLinkEventHandler{f: func(evt *event.LinkEvent) {
+		received = append(received, evt)
+	}}
+
+	err := dispatcher.ProcessSubscriptions(handler, []*event.Subscription{{
+		Type:    event.LinkEventsNs,
+		Options: map[string]interface{}{"filter": `srcRouterId = "r1" and cost < 100`},
+	}})
+	req.NoError(err)
+
+	for _, h := range dispatcher.linkEventHandlers.Value() {
+		h.AcceptLinkEvent(&event.LinkEvent{LinkId: "l1", SrcRouterId: "r1", Cost: 10})
+		h.AcceptLinkEvent(&event.LinkEvent{LinkId: "l2", SrcRouterId: "r2", Cost: 10})
+		h.AcceptLinkEvent(&event.LinkEvent{LinkId: "l3", SrcRouterId: "r1", Cost: 200})
+	}
+
+	req.Len(received, 1)
+	req.Equal("l1", received[0].LinkId)
+
+	dispatcher.RemoveAllSubscriptions(handler)
+	req.Empty(dispatcher.linkEventHandlers.Value())
+
+	err = dispatcher.ProcessSubscriptions(handler, []*event.Subscription{{
+		Type:    event.LinkEventsNs,
+		Options: map[string]interface{}{"filter": `srcRouterId = `},
+	}})
+	req.Error(err)
+}
+
+type testLinkEventHandler struct {
+	f func(evt *event.LinkEvent)
+}
+
+func (self *testLinkEventHandler) AcceptLinkEvent(evt *event.LinkEvent) {
+	self.f(evt)
+}
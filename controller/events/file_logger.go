@@ -19,6 +19,7 @@ package events
 import (
 	"fmt"
 	"github.com/natefinch/lumberjack"
+	"github.com/openziti/ziti/controller/event"
 	"github.com/pkg/errors"
 	"io"
 	"os"
@@ -27,27 +28,35 @@ import (
 
 type fabricFormatterFactory struct{}
 
-func (f fabricFormatterFactory) NewLoggingHandler(format string, buffer int, out io.WriteCloser) (interface{}, error) {
+func (f fabricFormatterFactory) NewLoggingHandler(format string, buffer int, sink event.FormattedEventSink) (interface{}, error) {
 	if strings.EqualFold(format, "json") {
-		return NewJsonFormatter(buffer, NewWriterEventSink(out)), nil
+		return NewJsonFormatter(buffer, sink), nil
+	}
+
+	if strings.EqualFold(format, ProtobufFormat) {
+		return NewProtobufFormatter(buffer, sink), nil
 	}
 
 	return nil, errors.Errorf("invalid 'format' for event log output file: %v", format)
 }
 
-type StdOutLoggerFactory struct{}
+type StdOutLoggerFactory struct {
+	dispatcher *Dispatcher
+}
 
-func (StdOutLoggerFactory) NewEventHandler(config map[interface{}]interface{}) (interface{}, error) {
-	return NewFileEventLogger(fabricFormatterFactory{}, true, config)
+func (self StdOutLoggerFactory) NewEventHandler(config map[interface{}]interface{}) (interface{}, error) {
+	return self.dispatcher.NewFileEventLogger(fabricFormatterFactory{}, true, config)
 }
 
-type FileEventLoggerFactory struct{}
+type FileEventLoggerFactory struct {
+	dispatcher *Dispatcher
+}
 
-func (FileEventLoggerFactory) NewEventHandler(config map[interface{}]interface{}) (interface{}, error) {
-	return NewFileEventLogger(fabricFormatterFactory{}, false, config)
+func (self FileEventLoggerFactory) NewEventHandler(config map[interface{}]interface{}) (interface{}, error) {
+	return self.dispatcher.NewFileEventLogger(fabricFormatterFactory{}, false, config)
 }
 
-func NewFileEventLogger(formatterFactory LoggingHandlerFactory, stdout bool, config map[interface{}]interface{}) (interface{}, error) {
+func (self *Dispatcher) NewFileEventLogger(formatterFactory LoggingHandlerFactory, stdout bool, config map[interface{}]interface{}) (interface{}, error) {
 	// allow config to increase the buffer size
 	bufferSize := 10
 	if value, found := config["bufferSize"]; found {
@@ -56,6 +65,16 @@ func NewFileEventLogger(formatterFactory LoggingHandlerFactory, stdout bool, con
 		}
 	}
 
+	format := ""
+	if value, found := config["format"]; found {
+		var ok bool
+		if format, ok = value.(string); !ok {
+			return nil, errors.New("invalid 'format' for event log output file")
+		}
+	} else {
+		return nil, errors.New("'format' must be specified for event handler")
+	}
+
 	var output io.WriteCloser = os.Stdout
 
 	if !stdout {
@@ -93,23 +112,29 @@ func NewFileEventLogger(formatterFactory LoggingHandlerFactory, stdout bool, con
 			return nil, errors.New("missing required 'path' config for events FileLogger handler")
 		}
 
-		output = &newlineWriter{
-			out: &lumberjack.Logger{
-				Filename:   filepath,
-				MaxSize:    maxsize,
-				MaxBackups: maxBackupFiles,
-			},
+		output = &lumberjack.Logger{
+			Filename:   filepath,
+			MaxSize:    maxsize,
+			MaxBackups: maxBackupFiles,
 		}
-	}
 
-	if value, found := config["format"]; found {
-		if format, ok := value.(string); ok {
-			return formatterFactory.NewLoggingHandler(format, bufferSize, output)
+		// binary records are length-delimited and must not be separated by newlines
+		if !strings.EqualFold(format, ProtobufFormat) {
+			output = &newlineWriter{out: output}
 		}
-		return nil, errors.New("invalid 'format' for event log output file")
+	}
 
+	handlerType := "file"
+	if stdout {
+		handlerType = "stdout"
 	}
-	return nil, errors.New("'format' must be specified for event handler")
+
+	sink, err := self.newOutputEventSink(handlerType, config, output)
+	if err != nil {
+		return nil, err
+	}
+
+	return formatterFactory.NewLoggingHandler(format, bufferSize, sink)
 }
 
 type newlineWriter struct {
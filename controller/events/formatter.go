@@ -29,7 +29,7 @@ import (
 )
 
 type LoggingHandlerFactory interface {
-	NewLoggingHandler(format string, buffer int, out io.WriteCloser) (interface{}, error)
+	NewLoggingHandler(format string, buffer int, sink event.FormattedEventSink) (interface{}, error)
 }
 
 func NewWriterEventSink(out io.Writer) event.FormattedEventSink {
@@ -81,6 +81,15 @@ func (f *BaseFormatter) Close() error {
 }
 
 func (f *BaseFormatter) AcceptLoggingEvent(event FormatterEvent) {
+	if sink, ok := f.sink.(writeAheadSink); ok && sink.IsWriteAhead() {
+		if formattedEvent, err := event.Format(); err != nil {
+			pfxlog.Logger().WithError(err).Errorf("failed to output event of type %v", reflect.TypeOf(event))
+		} else {
+			sink.AcceptFormattedEvent(event.GetEventType(), formattedEvent)
+		}
+		return
+	}
+
 	select {
 	case f.events <- event:
 	case <-f.closeNotify:
@@ -205,6 +214,16 @@ func (event *JsonSessionEvent) Format() ([]byte, error) {
 	return MarshalJson(event)
 }
 
+type JsonPostureWarningEvent event.PostureWarningEvent
+
+func (event *JsonPostureWarningEvent) GetEventType() string {
+	return "postureWarning"
+}
+
+func (event *JsonPostureWarningEvent) Format() ([]byte, error) {
+	return MarshalJson(event)
+}
+
 type JsonApiSessionEvent event.ApiSessionEvent
 
 func (event *JsonApiSessionEvent) GetEventType() string {
@@ -289,6 +308,10 @@ func (formatter *JsonFormatter) AcceptSessionEvent(event *event.SessionEvent) {
 	formatter.AcceptLoggingEvent((*JsonSessionEvent)(event))
 }
 
+func (formatter *JsonFormatter) AcceptPostureWarningEvent(event *event.PostureWarningEvent) {
+	formatter.AcceptLoggingEvent((*JsonPostureWarningEvent)(event))
+}
+
 func (formatter *JsonFormatter) AcceptEntityCountEvent(event *event.EntityCountEvent) {
 	formatter.AcceptLoggingEvent((*JsonEntityCountEvent)(event))
 }
@@ -0,0 +1,168 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"github.com/pkg/errors"
+	"github.com/segmentio/kafka-go"
+	"github.com/segmentio/kafka-go/sasl"
+	"github.com/segmentio/kafka-go/sasl/plain"
+	"github.com/segmentio/kafka-go/sasl/scram"
+	"strings"
+	"time"
+)
+
+const KafkaEventTypeHeader = "eventType"
+
+// KafkaEventLoggerFactory creates handlers which produce events to a topic on any broker speaking the Kafka protocol.
+// Each event is a separate record, with the event type in the eventType record header.
+/**
+Example configuration:
+    handler:
+      type: kafka
+      format: json
+      brokers:
+        - kafka-1.example.com:9092
+        - kafka-2.example.com:9092
+      topic: ziti-events
+      tls: true                    # default: false
+      sasl:                        # optional
+        mechanism: scram-sha-512   # plain, scram-sha-256 or scram-sha-512
+        username: ziti
+        password: secret
+      batchSize: 100               # default: 100
+      batchInterval: 1s            # default: 1s
+      spoolDir: /var/lib/ziti/kafka-spool  # optional, enables the disk-backed retry buffer
+*/
+type KafkaEventLoggerFactory struct {
+	dispatcher *Dispatcher
+}
+
+func (self *KafkaEventLoggerFactory) NewEventHandler(config map[interface{}]interface{}) (interface{}, error) {
+	sender, err := newKafkaSender(config)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to parse kafka config")
+	}
+	return self.dispatcher.newBatchingHandler("kafka", config, sender)
+}
+
+type kafkaSender struct {
+	writer *kafka.Writer
+}
+
+func newKafkaSender(config map[interface{}]interface{}) (*kafkaSender, error) {
+	var brokers []string
+	if value, found := config["brokers"]; !found {
+		return nil, errors.New("missing kafka brokers")
+	} else if list, ok := value.([]interface{}); !ok || len(list) == 0 {
+		return nil, errors.Errorf("invalid kafka brokers, must be a non-empty list: %v", value)
+	} else {
+		for _, broker := range list {
+			brokers = append(brokers, fmt.Sprintf("%v", broker))
+		}
+	}
+
+	var topic string
+	if value, found := config["topic"]; !found {
+		return nil, errors.New("missing kafka topic")
+	} else if topic, _ = value.(string); topic == "" {
+		return nil, errors.Errorf("invalid kafka topic: %v", value)
+	}
+
+	batchSize := 100
+	if value, found := config["batchSize"]; found {
+		if size, ok := value.(int); ok && size > 0 {
+			batchSize = size
+		}
+	}
+
+	transport := &kafka.Transport{}
+
+	if value, found := config["tls"]; found {
+		if enabled, ok := value.(bool); !ok {
+			return nil, errors.Errorf("invalid kafka tls value, must be a boolean: %v", value)
+		} else if enabled {
+			transport.TLS = &tls.Config{MinVersion: tls.VersionTLS12}
+		}
+	}
+
+	if value, found := config["sasl"]; found {
+		saslConfig, ok := value.(map[interface{}]interface{})
+		if !ok {
+			return nil, errors.Errorf("invalid kafka sasl config, must be a map: %v", value)
+		}
+		mechanism, err := parseKafkaSaslConfig(saslConfig)
+		if err != nil {
+			return nil, err
+		}
+		transport.SASL = mechanism
+	}
+
+	return &kafkaSender{
+		writer: &kafka.Writer{
+			Addr:         kafka.TCP(brokers...),
+			Topic:        topic,
+			Balancer:     &kafka.LeastBytes{},
+			RequiredAcks: kafka.RequireAll,
+			// batching and retries are handled by the batchingEventSink
+			MaxAttempts:  1,
+			BatchSize:    batchSize,
+			BatchTimeout: 10 * time.Millisecond,
+			Transport:    transport,
+		},
+	}, nil
+}
+
+func parseKafkaSaslConfig(config map[interface{}]interface{}) (sasl.Mechanism, error) {
+	username, _ := config["username"].(string)
+	password, _ := config["password"].(string)
+	if username == "" {
+		return nil, errors.New("kafka sasl config requires a username")
+	}
+
+	mechanism, _ := config["mechanism"].(string)
+	switch strings.ToLower(mechanism) {
+	case "", "plain":
+		return plain.Mechanism{Username: username, Password: password}, nil
+	case "scram-sha-256":
+		return scram.Mechanism(scram.SHA256, username, password)
+	case "scram-sha-512":
+		return scram.Mechanism(scram.SHA512, username, password)
+	default:
+		return nil, errors.Errorf("unsupported kafka sasl mechanism %v", mechanism)
+	}
+}
+
+func (self *kafkaSender) SendBatch(ctx context.Context, batch []*sinkEvent) error {
+	messages := make([]kafka.Message, 0, len(batch))
+	for _, evt := range batch {
+		messages = append(messages, kafka.Message{
+			Value: evt.data,
+			Headers: []kafka.Header{
+				{Key: KafkaEventTypeHeader, Value: []byte(evt.eventType)},
+			},
+		})
+	}
+	return self.writer.WriteMessages(ctx, messages...)
+}
+
+func (self *kafkaSender) Close() error {
+	return self.writer.Close()
+}
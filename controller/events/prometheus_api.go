@@ -0,0 +1,106 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"github.com/openziti/xweb/v2"
+	"github.com/pkg/errors"
+	"net/http"
+	"strings"
+)
+
+const PrometheusApiBinding = "events-prometheus"
+
+var _ xweb.ApiHandlerFactory = &PrometheusApiFactory{}
+
+// PrometheusApiFactory serves the metrics collected by prometheus event handlers on a web listener. Each binding
+// serves the handler configured with the same path.
+/**
+Example configuration:
+  apis:
+    - binding: events-prometheus
+      options:
+        path: /prometheus           # default: /prometheus
+*/
+type PrometheusApiFactory struct {
+	dispatcher *Dispatcher
+}
+
+func NewPrometheusApiFactory(dispatcher *Dispatcher) *PrometheusApiFactory {
+	return &PrometheusApiFactory{
+		dispatcher: dispatcher,
+	}
+}
+
+func (factory *PrometheusApiFactory) Validate(*xweb.InstanceConfig) error {
+	return nil
+}
+
+func (factory *PrometheusApiFactory) Binding() string {
+	return PrometheusApiBinding
+}
+
+func (factory *PrometheusApiFactory) New(_ *xweb.ServerConfig, options map[interface{}]interface{}) (xweb.ApiHandler, error) {
+	handler := &PrometheusApiHandler{
+		dispatcher: factory.dispatcher,
+		options:    options,
+		path:       defaultPrometheusPath,
+	}
+
+	if value, found := options["path"]; found {
+		if path, ok := value.(string); ok && strings.HasPrefix(path, "/") {
+			handler.path = path
+		} else {
+			return nil, errors.Errorf("invalid %v path, must start with '/': %v", PrometheusApiBinding, value)
+		}
+	}
+
+	return handler, nil
+}
+
+type PrometheusApiHandler struct {
+	dispatcher *Dispatcher
+	options    map[interface{}]interface{}
+	path       string
+}
+
+func (self *PrometheusApiHandler) Binding() string {
+	return PrometheusApiBinding
+}
+
+func (self *PrometheusApiHandler) Options() map[interface{}]interface{} {
+	return self.options
+}
+
+func (self *PrometheusApiHandler) RootPath() string {
+	return self.path
+}
+
+func (self *PrometheusApiHandler) IsHandler(r *http.Request) bool {
+	return r.URL.Path == self.path
+}
+
+// ServeHTTP looks the event handler up on each request, as event handlers are wired after the web listeners are
+// created
+func (self *PrometheusApiHandler) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	handler := self.dispatcher.prometheusHandlers.Get(self.path)
+	if handler == nil {
+		http.NotFound(rw, r)
+		return
+	}
+	handler.ServeHTTP(rw, r)
+}
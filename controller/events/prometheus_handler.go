@@ -0,0 +1,444 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/ziti/controller/event"
+	"github.com/pkg/errors"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+const (
+	PrometheusTextContentType   = "text/plain; version=0.0.4; charset=utf-8"
+	OpenMetricsTextContentType  = "application/openmetrics-text; version=1.0.0; charset=utf-8"
+	defaultPrometheusPath       = "/prometheus"
+	defaultPrometheusStaleAfter = 5 * time.Minute
+)
+
+var promLabelValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+var summaryQuantiles = []struct {
+	key      string
+	quantile string
+}{
+	{"p50", "0.5"}, {"p75", "0.75"}, {"p95", "0.95"}, {"p99", "0.99"}, {"p999", "0.999"}, {"p9999", "0.9999"},
+}
+
+// PrometheusEventHandlerFactory creates handlers which keep the latest value of each metric they're sent and serve
+// them in the Prometheus text exposition format, or OpenMetrics if the scraper asks for it. Metrics events are
+// exported as gauges and summaries, while usage (v3) and service events are accumulated into counters.
+// Series are labeled with router_id, link_id and service_id where those can be determined. Handlers don't listen on
+// their own, they're served by the events-prometheus API binding with the same path.
+/**
+Example configuration:
+  subscriptions:
+    - type: metrics
+      sourceFilter: .*
+      metricFilter: .*
+    - type: fabric.usage
+      version: 3
+    - type: services
+  handler:
+    type: prometheus
+    path: /prometheus           # default: /prometheus, must match the path option of an events-prometheus binding
+    includeTimestamps: false    # default: false
+    staleAfter: 5m              # default: 5m, series not updated in this long are dropped
+*/
+type PrometheusEventHandlerFactory struct {
+	dispatcher *Dispatcher
+}
+
+func (self *PrometheusEventHandlerFactory) NewEventHandler(config map[interface{}]interface{}) (interface{}, error) {
+	handler, err := newPrometheusEventHandler(config)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to parse prometheus handler config")
+	}
+
+	if self.dispatcher.prometheusHandlers.Get(handler.path) != nil {
+		return nil, errors.Errorf("a prometheus handler is already configured for path %v", handler.path)
+	}
+	self.dispatcher.prometheusHandlers.Put(handler.path, handler)
+
+	pfxlog.Logger().WithField("path", handler.path).
+		Infof("prometheus metrics available on the %v api binding", PrometheusApiBinding)
+
+	return handler, nil
+}
+
+type promMetricType string
+
+const (
+	promGauge   promMetricType = "gauge"
+	promCounter promMetricType = "counter"
+	promSummary promMetricType = "summary"
+)
+
+type promSeries struct {
+	labels  string
+	values  map[string]float64
+	updated time.Time
+}
+
+type promFamily struct {
+	metricType promMetricType
+	series     map[string]*promSeries
+}
+
+type prometheusEventHandler struct {
+	path              string
+	includeTimestamps bool
+	staleAfter        time.Duration
+
+	lock     sync.Mutex
+	families map[string]*promFamily
+}
+
+func newPrometheusEventHandler(config map[interface{}]interface{}) (*prometheusEventHandler, error) {
+	result := &prometheusEventHandler{
+		path:       defaultPrometheusPath,
+		staleAfter: defaultPrometheusStaleAfter,
+		families:   map[string]*promFamily{},
+	}
+
+	if _, found := config["listen"]; found {
+		return nil, errors.Errorf("prometheus handlers no longer listen on their own, bind the %v api instead", PrometheusApiBinding)
+	}
+
+	if value, found := config["path"]; found {
+		if path, ok := value.(string); ok && strings.HasPrefix(path, "/") {
+			result.path = path
+		} else {
+			return nil, errors.Errorf("invalid prometheus path, must start with '/': %v", value)
+		}
+	}
+
+	if value, found := config["includeTimestamps"]; found {
+		if include, ok := value.(bool); ok {
+			result.includeTimestamps = include
+		} else {
+			return nil, errors.Errorf("invalid includeTimestamps value, must be a boolean: %v", value)
+		}
+	}
+
+	if value, found := config["staleAfter"]; found {
+		staleAfter, err := parsePositiveDuration("staleAfter", value)
+		if err != nil {
+			return nil, err
+		}
+		result.staleAfter = staleAfter
+	}
+
+	return result, nil
+}
+
+func (self *prometheusEventHandler) AcceptMetricsEvent(evt *event.MetricsEvent) {
+	family := (*PrometheusMetricsEvent)(evt).getMetricName()
+	labels := promMetricsLabels(evt)
+
+	switch evt.MetricType {
+	case "intValue", "floatValue":
+		if v, ok := promFloat(evt.Metrics["value"]); ok {
+			self.set(family, promGauge, labels, map[string]float64{"": v}, evt.Timestamp)
+		}
+	case "meter":
+		if v, ok := promFloat(evt.Metrics["m1_rate"]); ok {
+			self.set(family, promGauge, labels, map[string]float64{"": v}, evt.Timestamp)
+		}
+		if v, ok := promFloat(evt.Metrics["count"]); ok {
+			self.set(family+"_events", promCounter, labels, map[string]float64{"": v}, evt.Timestamp)
+		}
+	case "histogram", "timer":
+		values := map[string]float64{}
+		for _, q := range summaryQuantiles {
+			if v, ok := promFloat(evt.Metrics[q.key]); ok {
+				values[q.quantile] = v
+			}
+		}
+		if v, ok := promFloat(evt.Metrics["count"]); ok {
+			values["count"] = v
+			// metrics only carry the mean, so the sum is derived from it
+			if mean, ok := promFloat(evt.Metrics["mean"]); ok {
+				values["sum"] = mean * v
+			}
+		}
+		if len(values) > 0 {
+			self.set(family, promSummary, labels, values, evt.Timestamp)
+		}
+	}
+}
+
+func (self *prometheusEventHandler) AcceptUsageEventV3(evt *event.UsageEventV3) {
+	labels := map[string]string{"router_id": evt.SourceId}
+	if serviceId := evt.Tags["serviceId"]; serviceId != "" {
+		labels["service_id"] = serviceId
+	}
+	labelStr := promLabelString(labels)
+	ts := time.Unix(evt.IntervalStartUTC+int64(evt.IntervalLength), 0)
+
+	for usageType, usage := range evt.Usage {
+		family := promSanitizeName("ziti_usage_" + usageType + "_bytes")
+		self.add(family, labelStr, float64(usage), ts)
+	}
+}
+
+func (self *prometheusEventHandler) AcceptServiceEvent(evt *event.ServiceEvent) {
+	labels := map[string]string{"service_id": evt.ServiceId}
+	if evt.TerminatorId != "" {
+		labels["terminator_id"] = evt.TerminatorId
+	}
+	family := promSanitizeName("ziti_" + evt.EventType)
+	ts := time.Unix(evt.IntervalStartUTC+int64(evt.IntervalLength), 0)
+	self.add(family, promLabelString(labels), float64(evt.Count), ts)
+}
+
+func (self *prometheusEventHandler) set(family string, metricType promMetricType, labels string, values map[string]float64, ts time.Time) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	series := self.getSeriesLocked(family, metricType, labels)
+	series.values = values
+	series.updated = ts
+}
+
+func (self *prometheusEventHandler) add(family string, labels string, delta float64, ts time.Time) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	series := self.getSeriesLocked(family, promCounter, labels)
+	series.values[""] += delta
+	if ts.After(series.updated) {
+		series.updated = ts
+	}
+}
+
+func (self *prometheusEventHandler) getSeriesLocked(family string, metricType promMetricType, labels string) *promSeries {
+	f, found := self.families[family]
+	if !found {
+		f = &promFamily{
+			metricType: metricType,
+			series:     map[string]*promSeries{},
+		}
+		self.families[family] = f
+	}
+
+	series, found := f.series[labels]
+	if !found {
+		series = &promSeries{
+			labels: labels,
+			values: map[string]float64{},
+		}
+		f.series[labels] = series
+	}
+	return series
+}
+
+func (self *prometheusEventHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	openMetrics := strings.Contains(r.Header.Get("Accept"), "application/openmetrics-text")
+
+	body := self.Render(openMetrics)
+	if openMetrics {
+		w.Header().Set("Content-Type", OpenMetricsTextContentType)
+	} else {
+		w.Header().Set("Content-Type", PrometheusTextContentType)
+	}
+	_, _ = w.Write(body)
+}
+
+// Render writes out all current series, in OpenMetrics format if openMetrics is true and in the Prometheus text format
+// otherwise. Series which haven't been updated within the staleness window are dropped.
+func (self *prometheusEventHandler) Render(openMetrics bool) []byte {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	staleCutoff := time.Now().Add(-self.staleAfter)
+
+	var names []string
+	for name, family := range self.families {
+		for key, series := range family.series {
+			if series.updated.Before(staleCutoff) {
+				delete(family.series, key)
+			}
+		}
+		if len(family.series) == 0 {
+			delete(self.families, name)
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	buf := &bytes.Buffer{}
+	for _, name := range names {
+		family := self.families[name]
+		self.writeFamily(buf, name, family, openMetrics)
+	}
+
+	if openMetrics {
+		buf.WriteString("# EOF\n")
+	}
+	return buf.Bytes()
+}
+
+func (self *prometheusEventHandler) writeFamily(buf *bytes.Buffer, name string, family *promFamily, openMetrics bool) {
+	_, _ = fmt.Fprintf(buf, "# HELP %[1]s %[1]s\n# TYPE %[1]s %[2]s\n", name, family.metricType)
+
+	var keys []string
+	for key := range family.series {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		series := family.series[key]
+		ts := self.timestamp(series.updated, openMetrics)
+
+		switch family.metricType {
+		case promGauge:
+			writePromSample(buf, name, series.labels, "", series.values[""], ts)
+		case promCounter:
+			writePromSample(buf, name+"_total", series.labels, "", series.values[""], ts)
+		case promSummary:
+			for _, q := range summaryQuantiles {
+				if v, ok := series.values[q.quantile]; ok {
+					writePromSample(buf, name, series.labels, `quantile="`+q.quantile+`"`, v, ts)
+				}
+			}
+			if v, ok := series.values["sum"]; ok {
+				writePromSample(buf, name+"_sum", series.labels, "", v, ts)
+			}
+			if v, ok := series.values["count"]; ok {
+				writePromSample(buf, name+"_count", series.labels, "", v, ts)
+			}
+		}
+	}
+}
+
+func (self *prometheusEventHandler) timestamp(t time.Time, openMetrics bool) string {
+	if !self.includeTimestamps || t.IsZero() {
+		return ""
+	}
+	if openMetrics {
+		return " " + strconv.FormatFloat(float64(t.UnixMilli())/1000, 'f', -1, 64)
+	}
+	return " " + strconv.FormatInt(t.UnixMilli(), 10)
+}
+
+func writePromSample(buf *bytes.Buffer, name, labels, extraLabel string, value float64, ts string) {
+	buf.WriteString(name)
+	if labels != "" || extraLabel != "" {
+		buf.WriteByte('{')
+		buf.WriteString(labels)
+		if labels != "" && extraLabel != "" {
+			buf.WriteByte(',')
+		}
+		buf.WriteString(extraLabel)
+		buf.WriteByte('}')
+	}
+	buf.WriteByte(' ')
+	buf.WriteString(strconv.FormatFloat(value, 'g', -1, 64))
+	buf.WriteString(ts)
+	buf.WriteByte('\n')
+}
+
+// promMetricsLabels derives labels for a metrics event. The source is always a router or controller, link metrics
+// have had their link id and routers extracted by the linkMetricsMapper, and any remaining tags are passed through.
+func promMetricsLabels(evt *event.MetricsEvent) string {
+	labels := map[string]string{"router_id": evt.SourceAppId}
+	if evt.SourceEntityId != "" {
+		if strings.HasPrefix(evt.Metric, "link.") {
+			labels["link_id"] = evt.SourceEntityId
+		} else {
+			labels["entity_id"] = evt.SourceEntityId
+		}
+	}
+
+	for k, v := range evt.Tags {
+		if k == "serviceId" {
+			labels["service_id"] = v
+		} else {
+			labels[promLabelName(k)] = v
+		}
+	}
+	return promLabelString(labels)
+}
+
+func promLabelString(labels map[string]string) string {
+	var keys []string
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var parts []string
+	for _, k := range keys {
+		parts = append(parts, k+`="`+promLabelValueEscaper.Replace(labels[k])+`"`)
+	}
+	return strings.Join(parts, ",")
+}
+
+// promLabelName converts a camel case tag name such as sourceRouterId to a snake case label name
+func promLabelName(name string) string {
+	var result strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				result.WriteByte('_')
+			}
+			result.WriteRune(unicode.ToLower(r))
+		} else if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' {
+			result.WriteRune(r)
+		} else {
+			result.WriteByte('_')
+		}
+	}
+	return result.String()
+}
+
+func promSanitizeName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_' {
+			return r
+		}
+		return '_'
+	}, name)
+}
+
+func promFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int64:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	default:
+		return 0, false
+	}
+}
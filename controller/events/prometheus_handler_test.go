@@ -0,0 +1,154 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"github.com/openziti/ziti/controller/event"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPrometheusHandler(t *testing.T) {
+	req := require.New(t)
+
+	dispatcher := NewDispatcher(make(chan struct{}))
+	factory := &PrometheusEventHandlerFactory{dispatcher: dispatcher}
+	result, err := factory.NewEventHandler(map[interface{}]interface{}{})
+	req.NoError(err)
+	handler := result.(*prometheusEventHandler)
+
+	_, err = factory.NewEventHandler(map[interface{}]interface{}{})
+	req.Error(err, "paths must be unique")
+
+	now := time.Now()
+
+	handler.AcceptMetricsEvent(&event.MetricsEvent{
+		MetricType:     "intValue",
+		SourceAppId:    "router1",
+		SourceEntityId: "link1",
+		Timestamp:      now,
+		Metric:         "link.queue_size",
+		Metrics:        map[string]interface{}{"value": int64(12)},
+		Tags:           map[string]string{"sourceRouterId": "router1", "targetRouterId": "router2"},
+	})
+
+	handler.AcceptMetricsEvent(&event.MetricsEvent{
+		MetricType:     "timer",
+		SourceAppId:    "router1",
+		SourceEntityId: "link1",
+		Timestamp:      now,
+		Metric:         "link.latency",
+		Metrics:        map[string]interface{}{"count": int64(3), "mean": float64(120), "p50": float64(100), "p99": float64(250)},
+	})
+
+	for i := 0; i < 2; i++ {
+		handler.AcceptUsageEventV3(&event.UsageEventV3{
+			SourceId:         "router1",
+			CircuitId:        "circuit1",
+			Usage:            map[string]uint64{"ingress.rx": 100},
+			IntervalStartUTC: now.Unix(),
+			IntervalLength:   60,
+			Tags:             map[string]string{"serviceId": "svc1"},
+		})
+	}
+
+	handler.AcceptServiceEvent(&event.ServiceEvent{
+		EventType:        "service.dial.success",
+		ServiceId:        "svc1",
+		TerminatorId:     "t1",
+		Count:            5,
+		IntervalStartUTC: now.Unix(),
+		IntervalLength:   60,
+	})
+
+	output := string(handler.Render(false))
+	req.Contains(output, "# TYPE ziti_link_queue_size gauge\n")
+	req.Contains(output, `ziti_link_queue_size{link_id="link1",router_id="router1",source_router_id="router1",target_router_id="router2"} 12`)
+	req.Contains(output, "# TYPE ziti_link_latency summary\n")
+	req.Contains(output, `ziti_link_latency{link_id="link1",router_id="router1",quantile="0.99"} 250`)
+	req.Contains(output, `ziti_link_latency_sum{link_id="link1",router_id="router1"} 360`)
+	req.Contains(output, `ziti_link_latency_count{link_id="link1",router_id="router1"} 3`)
+	req.Contains(output, `ziti_usage_ingress_rx_bytes_total{router_id="router1",service_id="svc1"} 200`)
+	req.Contains(output, `ziti_service_dial_success_total{service_id="svc1",terminator_id="t1"} 5`)
+	req.False(strings.Contains(output, "# EOF"))
+
+	apiHandler, err := NewPrometheusApiFactory(dispatcher).New(nil, map[interface{}]interface{}{})
+	req.NoError(err)
+	req.Equal(defaultPrometheusPath, apiHandler.RootPath())
+
+	server := httptest.NewServer(apiHandler)
+	defer server.Close()
+
+	httpReq, err := http.NewRequest(http.MethodGet, server.URL+defaultPrometheusPath, nil)
+	req.NoError(err)
+	httpReq.Header.Set("Accept", "application/openmetrics-text; version=1.0.0")
+	resp, err := http.DefaultClient.Do(httpReq)
+	req.NoError(err)
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(resp.Body)
+	req.NoError(err)
+	req.Equal(OpenMetricsTextContentType, resp.Header.Get("Content-Type"))
+	req.True(strings.HasSuffix(string(body), "# EOF\n"))
+}
+
+func TestPrometheusHandlerDropsStaleSeries(t *testing.T) {
+	req := require.New(t)
+
+	handler, err := newPrometheusEventHandler(map[interface{}]interface{}{
+		"staleAfter": "1m",
+	})
+	req.NoError(err)
+
+	handler.AcceptMetricsEvent(&event.MetricsEvent{
+		MetricType:  "floatValue",
+		SourceAppId: "router1",
+		Timestamp:   time.Now().Add(-2 * time.Minute),
+		Metric:      "xgress.acker.queue_size",
+		Metrics:     map[string]interface{}{"value": float64(1)},
+	})
+
+	req.Empty(handler.Render(false))
+}
+
+func TestPrometheusApiServesOnlyConfiguredPaths(t *testing.T) {
+	req := require.New(t)
+
+	dispatcher := NewDispatcher(make(chan struct{}))
+	apiHandler, err := NewPrometheusApiFactory(dispatcher).New(nil, map[interface{}]interface{}{"path": "/events"})
+	req.NoError(err)
+
+	recorder := httptest.NewRecorder()
+	apiHandler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/events", nil))
+	req.Equal(http.StatusNotFound, recorder.Code)
+
+	_, err = (&PrometheusEventHandlerFactory{dispatcher: dispatcher}).NewEventHandler(map[interface{}]interface{}{"path": "/events"})
+	req.NoError(err)
+
+	recorder = httptest.NewRecorder()
+	apiHandler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/events", nil))
+	req.Equal(http.StatusOK, recorder.Code)
+	req.Equal(PrometheusTextContentType, recorder.Header().Get("Content-Type"))
+
+	_, err = newPrometheusEventHandler(map[interface{}]interface{}{"listen": "127.0.0.1:9191"})
+	req.Error(err)
+}
@@ -0,0 +1,804 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/openziti/ziti/common/pb/event_pb"
+	"github.com/openziti/ziti/controller/event"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"io"
+	"time"
+)
+
+const ProtobufFormat = "protobuf"
+
+// ProtobufFormatter writes events as varint length-delimited event_pb.Event messages. Files written in this format
+// can be converted back to json with `ziti ops decode-events`.
+type ProtobufFormatter struct {
+	BaseFormatter
+}
+
+func NewProtobufFormatter(queueDepth int, sink event.FormattedEventSink) *ProtobufFormatter {
+	result := &ProtobufFormatter{
+		BaseFormatter: BaseFormatter{
+			events:      make(chan FormatterEvent, queueDepth),
+			closeNotify: make(chan struct{}),
+			sink:        sink,
+		},
+	}
+	go result.Run()
+	return result
+}
+
+type protobufEvent struct {
+	eventType string
+	toPb      func() (*event_pb.Event, error)
+}
+
+func (self *protobufEvent) GetEventType() string {
+	return self.eventType
+}
+
+func (self *protobufEvent) Format() ([]byte, error) {
+	msg, err := self.toPb()
+	if err != nil {
+		return nil, err
+	}
+	buf := &bytes.Buffer{}
+	if _, err = protodelim.MarshalTo(buf, msg); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (formatter *ProtobufFormatter) accept(eventType string, toPb func() (*event_pb.Event, error)) {
+	formatter.AcceptLoggingEvent(&protobufEvent{eventType: eventType, toPb: toPb})
+}
+
+func (formatter *ProtobufFormatter) AcceptCircuitEvent(evt *event.CircuitEvent) {
+	formatter.accept("circuit", func() (*event_pb.Event, error) {
+		return &event_pb.Event{Event: &event_pb.Event_Circuit{Circuit: CircuitEventToPb(evt)}}, nil
+	})
+}
+
+func (formatter *ProtobufFormatter) AcceptLinkEvent(evt *event.LinkEvent) {
+	formatter.accept("link", func() (*event_pb.Event, error) {
+		return &event_pb.Event{Event: &event_pb.Event_Link{Link: LinkEventToPb(evt)}}, nil
+	})
+}
+
+func (formatter *ProtobufFormatter) AcceptMetricsEvent(evt *event.MetricsEvent) {
+	formatter.accept("metrics", func() (*event_pb.Event, error) {
+		msg, err := MetricsEventToPb(evt)
+		if err != nil {
+			return nil, err
+		}
+		return &event_pb.Event{Event: &event_pb.Event_Metrics{Metrics: msg}}, nil
+	})
+}
+
+func (formatter *ProtobufFormatter) AcceptServiceEvent(evt *event.ServiceEvent) {
+	formatter.accept("service", func() (*event_pb.Event, error) {
+		return &event_pb.Event{Event: &event_pb.Event_Service{Service: ServiceEventToPb(evt)}}, nil
+	})
+}
+
+func (formatter *ProtobufFormatter) AcceptTerminatorEvent(evt *event.TerminatorEvent) {
+	formatter.accept("terminator", func() (*event_pb.Event, error) {
+		return &event_pb.Event{Event: &event_pb.Event_Terminator{Terminator: TerminatorEventToPb(evt)}}, nil
+	})
+}
+
+func (formatter *ProtobufFormatter) AcceptRouterEvent(evt *event.RouterEvent) {
+	formatter.accept("router", func() (*event_pb.Event, error) {
+		return &event_pb.Event{Event: &event_pb.Event_Router{Router: RouterEventToPb(evt)}}, nil
+	})
+}
+
+func (formatter *ProtobufFormatter) AcceptUsageEvent(evt *event.UsageEvent) {
+	formatter.accept("usage", func() (*event_pb.Event, error) {
+		return &event_pb.Event{Event: &event_pb.Event_Usage{Usage: UsageEventToPb(evt)}}, nil
+	})
+}
+
+func (formatter *ProtobufFormatter) AcceptUsageEventV3(evt *event.UsageEventV3) {
+	formatter.accept("usage.v3", func() (*event_pb.Event, error) {
+		return &event_pb.Event{Event: &event_pb.Event_UsageV3{UsageV3: UsageEventV3ToPb(evt)}}, nil
+	})
+}
+
+func (formatter *ProtobufFormatter) AcceptClusterEvent(evt *event.ClusterEvent) {
+	formatter.accept("cluster", func() (*event_pb.Event, error) {
+		return &event_pb.Event{Event: &event_pb.Event_Cluster{Cluster: ClusterEventToPb(evt)}}, nil
+	})
+}
+
+func (formatter *ProtobufFormatter) AcceptEntityChangeEvent(evt *event.EntityChangeEvent) {
+	formatter.accept("entity.change", func() (*event_pb.Event, error) {
+		msg, err := EntityChangeEventToPb(evt)
+		if err != nil {
+			return nil, err
+		}
+		return &event_pb.Event{Event: &event_pb.Event_EntityChange{EntityChange: msg}}, nil
+	})
+}
+
+func (formatter *ProtobufFormatter) AcceptApiSessionEvent(evt *event.ApiSessionEvent) {
+	formatter.accept("apiSession", func() (*event_pb.Event, error) {
+		return &event_pb.Event{Event: &event_pb.Event_ApiSession{ApiSession: ApiSessionEventToPb(evt)}}, nil
+	})
+}
+
+func (formatter *ProtobufFormatter) AcceptSessionEvent(evt *event.SessionEvent) {
+	formatter.accept("session", func() (*event_pb.Event, error) {
+		return &event_pb.Event{Event: &event_pb.Event_Session{Session: SessionEventToPb(evt)}}, nil
+	})
+}
+
+func (formatter *ProtobufFormatter) AcceptPostureWarningEvent(evt *event.PostureWarningEvent) {
+	formatter.accept("postureWarning", func() (*event_pb.Event, error) {
+		return &event_pb.Event{Event: &event_pb.Event_PostureWarning{PostureWarning: PostureWarningEventToPb(evt)}}, nil
+	})
+}
+
+func (formatter *ProtobufFormatter) AcceptEntityCountEvent(evt *event.EntityCountEvent) {
+	formatter.accept("entityCount", func() (*event_pb.Event, error) {
+		return &event_pb.Event{Event: &event_pb.Event_EntityCount{EntityCount: EntityCountEventToPb(evt)}}, nil
+	})
+}
+
+// ProtobufEventReader reads events written by the ProtobufFormatter
+type ProtobufEventReader struct {
+	reader protodelim.Reader
+}
+
+func NewProtobufEventReader(reader io.Reader) *ProtobufEventReader {
+	if r, ok := reader.(protodelim.Reader); ok {
+		return &ProtobufEventReader{reader: r}
+	}
+	return &ProtobufEventReader{reader: newByteReader(reader)}
+}
+
+// Next returns the next event, converted to the type the json formatter would output, or io.EOF once all events
+// have been read
+func (self *ProtobufEventReader) Next() (FormatterEvent, error) {
+	msg := &event_pb.Event{}
+	if err := protodelim.UnmarshalFrom(self.reader, msg); err != nil {
+		return nil, err
+	}
+	return PbToFormatterEvent(msg)
+}
+
+type byteReader struct {
+	io.Reader
+	buf [1]byte
+}
+
+func newByteReader(reader io.Reader) *byteReader {
+	return &byteReader{Reader: reader}
+}
+
+func (self *byteReader) ReadByte() (byte, error) {
+	if _, err := io.ReadFull(self.Reader, self.buf[:]); err != nil {
+		return 0, err
+	}
+	return self.buf[0], nil
+}
+
+// PbToFormatterEvent converts a protobuf event back to the corresponding event type, wrapped so that it formats as json
+func PbToFormatterEvent(msg *event_pb.Event) (FormatterEvent, error) {
+	switch v := msg.Event.(type) {
+	case *event_pb.Event_Circuit:
+		return (*JsonCircuitEvent)(CircuitEventFromPb(v.Circuit)), nil
+	case *event_pb.Event_Link:
+		return (*JsonLinkEvent)(LinkEventFromPb(v.Link)), nil
+	case *event_pb.Event_Metrics:
+		return (*JsonMetricsEvent)(MetricsEventFromPb(v.Metrics)), nil
+	case *event_pb.Event_Usage:
+		return (*JsonUsageEvent)(UsageEventFromPb(v.Usage)), nil
+	case *event_pb.Event_UsageV3:
+		return (*JsonUsageEventV3)(UsageEventV3FromPb(v.UsageV3)), nil
+	case *event_pb.Event_EntityChange:
+		evt, err := EntityChangeEventFromPb(v.EntityChange)
+		if err != nil {
+			return nil, err
+		}
+		return (*JsonEntityChangeEvent)(evt), nil
+	case *event_pb.Event_Session:
+		return (*JsonSessionEvent)(SessionEventFromPb(v.Session)), nil
+	case *event_pb.Event_ApiSession:
+		return (*JsonApiSessionEvent)(ApiSessionEventFromPb(v.ApiSession)), nil
+	case *event_pb.Event_PostureWarning:
+		return (*JsonPostureWarningEvent)(PostureWarningEventFromPb(v.PostureWarning)), nil
+	case *event_pb.Event_Cluster:
+		return (*JsonClusterEvent)(ClusterEventFromPb(v.Cluster)), nil
+	case *event_pb.Event_EntityCount:
+		return (*JsonEntityCountEvent)(EntityCountEventFromPb(v.EntityCount)), nil
+	case *event_pb.Event_Router:
+		return (*JsonRouterEvent)(RouterEventFromPb(v.Router)), nil
+	case *event_pb.Event_Service:
+		return (*JsonServiceEvent)(ServiceEventFromPb(v.Service)), nil
+	case *event_pb.Event_Terminator:
+		return (*JsonTerminatorEvent)(TerminatorEventFromPb(v.Terminator)), nil
+	default:
+		return nil, errors.Errorf("unsupported protobuf event type %T", msg.Event)
+	}
+}
+
+func toPbTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func fromPbTimestamp(t *timestamppb.Timestamp) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return t.AsTime()
+}
+
+func toPbDuration(d *time.Duration) *int64 {
+	if d == nil {
+		return nil
+	}
+	result := int64(*d)
+	return &result
+}
+
+func fromPbDuration(d *int64) *time.Duration {
+	if d == nil {
+		return nil
+	}
+	result := time.Duration(*d)
+	return &result
+}
+
+func CircuitEventToPb(evt *event.CircuitEvent) *event_pb.CircuitEvent {
+	return &event_pb.CircuitEvent{
+		Namespace:        evt.Namespace,
+		Version:          evt.Version,
+		EventType:        string(evt.EventType),
+		CircuitId:        evt.CircuitId,
+		Timestamp:        toPbTimestamp(evt.Timestamp),
+		ClientId:         evt.ClientId,
+		ServiceId:        evt.ServiceId,
+		TerminatorId:     evt.TerminatorId,
+		InstanceId:       evt.InstanceId,
+		CreationTimespan: toPbDuration(evt.CreationTimespan),
+		Path: &event_pb.CircuitPath{
+			Nodes:                evt.Path.Nodes,
+			Links:                evt.Path.Links,
+			IngressId:            evt.Path.IngressId,
+			EgressId:             evt.Path.EgressId,
+			InitiatorLocalAddr:   evt.Path.InitiatorLocalAddr,
+			InitiatorRemoteAddr:  evt.Path.InitiatorRemoteAddr,
+			TerminatorLocalAddr:  evt.Path.TerminatorLocalAddr,
+			TerminatorRemoteAddr: evt.Path.TerminatorRemoteAddr,
+		},
+		LinkCount:    int64(evt.LinkCount),
+		PathCost:     evt.Cost,
+		FailureCause: evt.FailureCause,
+		Duration:     toPbDuration(evt.Duration),
+		Tags:         evt.Tags,
+	}
+}
+
+func CircuitEventFromPb(msg *event_pb.CircuitEvent) *event.CircuitEvent {
+	result := &event.CircuitEvent{
+		Namespace:        msg.Namespace,
+		Version:          msg.Version,
+		EventType:        event.CircuitEventType(msg.EventType),
+		CircuitId:        msg.CircuitId,
+		Timestamp:        fromPbTimestamp(msg.Timestamp),
+		ClientId:         msg.ClientId,
+		ServiceId:        msg.ServiceId,
+		TerminatorId:     msg.TerminatorId,
+		InstanceId:       msg.InstanceId,
+		CreationTimespan: fromPbDuration(msg.CreationTimespan),
+		LinkCount:        int(msg.LinkCount),
+		Cost:             msg.PathCost,
+		FailureCause:     msg.FailureCause,
+		Duration:         fromPbDuration(msg.Duration),
+		Tags:             msg.Tags,
+	}
+	if path := msg.Path; path != nil {
+		result.Path = event.CircuitPath{
+			Nodes:                path.Nodes,
+			Links:                path.Links,
+			IngressId:            path.IngressId,
+			EgressId:             path.EgressId,
+			InitiatorLocalAddr:   path.InitiatorLocalAddr,
+			InitiatorRemoteAddr:  path.InitiatorRemoteAddr,
+			TerminatorLocalAddr:  path.TerminatorLocalAddr,
+			TerminatorRemoteAddr: path.TerminatorRemoteAddr,
+		}
+	}
+	return result
+}
+
+func LinkEventToPb(evt *event.LinkEvent) *event_pb.LinkEvent {
+	result := &event_pb.LinkEvent{
+		Namespace:   evt.Namespace,
+		EventType:   string(evt.EventType),
+		Timestamp:   toPbTimestamp(evt.Timestamp),
+		LinkId:      evt.LinkId,
+		SrcRouterId: evt.SrcRouterId,
+		DstRouterId: evt.DstRouterId,
+		Protocol:    evt.Protocol,
+		DialAddress: evt.DialAddress,
+		Cost:        evt.Cost,
+	}
+	for _, conn := range evt.Connections {
+		result.Connections = append(result.Connections, &event_pb.LinkConnection{
+			Id:         conn.Id,
+			LocalAddr:  conn.LocalAddr,
+			RemoteAddr: conn.RemoteAddr,
+		})
+	}
+	return result
+}
+
+func LinkEventFromPb(msg *event_pb.LinkEvent) *event.LinkEvent {
+	result := &event.LinkEvent{
+		Namespace:   msg.Namespace,
+		EventType:   event.LinkEventType(msg.EventType),
+		Timestamp:   fromPbTimestamp(msg.Timestamp),
+		LinkId:      msg.LinkId,
+		SrcRouterId: msg.SrcRouterId,
+		DstRouterId: msg.DstRouterId,
+		Protocol:    msg.Protocol,
+		DialAddress: msg.DialAddress,
+		Cost:        msg.Cost,
+	}
+	for _, conn := range msg.Connections {
+		result.Connections = append(result.Connections, &event.LinkConnection{
+			Id:         conn.Id,
+			LocalAddr:  conn.LocalAddr,
+			RemoteAddr: conn.RemoteAddr,
+		})
+	}
+	return result
+}
+
+func MetricsEventToPb(evt *event.MetricsEvent) (*event_pb.MetricsEvent, error) {
+	result := &event_pb.MetricsEvent{
+		MetricType:     evt.MetricType,
+		Namespace:      evt.Namespace,
+		SourceId:       evt.SourceAppId,
+		SourceEntityId: evt.SourceEntityId,
+		Version:        evt.Version,
+		Timestamp:      toPbTimestamp(evt.Timestamp),
+		Metric:         evt.Metric,
+		Tags:           evt.Tags,
+		SourceEventId:  evt.SourceEventId,
+	}
+
+	if len(evt.Metrics) > 0 {
+		result.Metrics = map[string]*event_pb.MetricValue{}
+	}
+
+	for k, v := range evt.Metrics {
+		switch val := v.(type) {
+		case int64:
+			result.Metrics[k] = &event_pb.MetricValue{Value: &event_pb.MetricValue_IntValue{IntValue: val}}
+		case int:
+			result.Metrics[k] = &event_pb.MetricValue{Value: &event_pb.MetricValue_IntValue{IntValue: int64(val)}}
+		case uint64:
+			result.Metrics[k] = &event_pb.MetricValue{Value: &event_pb.MetricValue_UintValue{UintValue: val}}
+		case float64:
+			result.Metrics[k] = &event_pb.MetricValue{Value: &event_pb.MetricValue_FloatValue{FloatValue: val}}
+		case float32:
+			result.Metrics[k] = &event_pb.MetricValue{Value: &event_pb.MetricValue_FloatValue{FloatValue: float64(val)}}
+		default:
+			return nil, errors.Errorf("unsupported metric value type %T for %v.%v", v, evt.Metric, k)
+		}
+	}
+
+	return result, nil
+}
+
+func MetricsEventFromPb(msg *event_pb.MetricsEvent) *event.MetricsEvent {
+	result := &event.MetricsEvent{
+		MetricType:     msg.MetricType,
+		Namespace:      msg.Namespace,
+		SourceAppId:    msg.SourceId,
+		SourceEntityId: msg.SourceEntityId,
+		Version:        msg.Version,
+		Timestamp:      fromPbTimestamp(msg.Timestamp),
+		Metric:         msg.Metric,
+		Tags:           msg.Tags,
+		SourceEventId:  msg.SourceEventId,
+	}
+
+	if len(msg.Metrics) > 0 {
+		result.Metrics = map[string]interface{}{}
+	}
+
+	for k, v := range msg.Metrics {
+		switch val := v.Value.(type) {
+		case *event_pb.MetricValue_IntValue:
+			result.Metrics[k] = val.IntValue
+		case *event_pb.MetricValue_UintValue:
+			result.Metrics[k] = val.UintValue
+		case *event_pb.MetricValue_FloatValue:
+			result.Metrics[k] = val.FloatValue
+		}
+	}
+
+	return result
+}
+
+func UsageEventToPb(evt *event.UsageEvent) *event_pb.UsageEvent {
+	return &event_pb.UsageEvent{
+		Namespace:        evt.Namespace,
+		Version:          evt.Version,
+		EventType:        evt.EventType,
+		SourceId:         evt.SourceId,
+		CircuitId:        evt.CircuitId,
+		Usage:            evt.Usage,
+		IntervalStartUTC: evt.IntervalStartUTC,
+		IntervalLength:   evt.IntervalLength,
+		Tags:             evt.Tags,
+	}
+}
+
+func UsageEventFromPb(msg *event_pb.UsageEvent) *event.UsageEvent {
+	return &event.UsageEvent{
+		Namespace:        msg.Namespace,
+		Version:          msg.Version,
+		EventType:        msg.EventType,
+		SourceId:         msg.SourceId,
+		CircuitId:        msg.CircuitId,
+		Usage:            msg.Usage,
+		IntervalStartUTC: msg.IntervalStartUTC,
+		IntervalLength:   msg.IntervalLength,
+		Tags:             msg.Tags,
+	}
+}
+
+func UsageEventV3ToPb(evt *event.UsageEventV3) *event_pb.UsageEventV3 {
+	return &event_pb.UsageEventV3{
+		Namespace:        evt.Namespace,
+		Version:          evt.Version,
+		SourceId:         evt.SourceId,
+		CircuitId:        evt.CircuitId,
+		Usage:            evt.Usage,
+		IntervalStartUTC: evt.IntervalStartUTC,
+		IntervalLength:   evt.IntervalLength,
+		Tags:             evt.Tags,
+	}
+}
+
+func UsageEventV3FromPb(msg *event_pb.UsageEventV3) *event.UsageEventV3 {
+	return &event.UsageEventV3{
+		Namespace:        msg.Namespace,
+		Version:          msg.Version,
+		SourceId:         msg.SourceId,
+		CircuitId:        msg.CircuitId,
+		Usage:            msg.Usage,
+		IntervalStartUTC: msg.IntervalStartUTC,
+		IntervalLength:   msg.IntervalLength,
+		Tags:             msg.Tags,
+	}
+}
+
+func marshalOptionalJson(v any) ([]byte, error) {
+	if v == nil {
+		return nil, nil
+	}
+	return json.Marshal(v)
+}
+
+func EntityChangeEventToPb(evt *event.EntityChangeEvent) (*event_pb.EntityChangeEvent, error) {
+	result := &event_pb.EntityChangeEvent{
+		Namespace:     evt.Namespace,
+		EventId:       evt.EventId,
+		EventType:     string(evt.EventType),
+		Timestamp:     toPbTimestamp(evt.Timestamp),
+		EntityType:    evt.EntityType,
+		IsParentEvent: evt.IsParentEvent,
+	}
+
+	var err error
+	if len(evt.Metadata) > 0 {
+		if result.Metadata, err = json.Marshal(evt.Metadata); err != nil {
+			return nil, errors.Wrap(err, "unable to marshal entity change metadata")
+		}
+	}
+	if result.InitialState, err = marshalOptionalJson(evt.InitialState); err != nil {
+		return nil, errors.Wrap(err, "unable to marshal entity change initial state")
+	}
+	if result.FinalState, err = marshalOptionalJson(evt.FinalState); err != nil {
+		return nil, errors.Wrap(err, "unable to marshal entity change final state")
+	}
+	return result, nil
+}
+
+func EntityChangeEventFromPb(msg *event_pb.EntityChangeEvent) (*event.EntityChangeEvent, error) {
+	result := &event.EntityChangeEvent{
+		Namespace:     msg.Namespace,
+		EventId:       msg.EventId,
+		EventType:     event.EntityChangeEventType(msg.EventType),
+		Timestamp:     fromPbTimestamp(msg.Timestamp),
+		EntityType:    msg.EntityType,
+		IsParentEvent: msg.IsParentEvent,
+	}
+
+	if len(msg.Metadata) > 0 {
+		if err := json.Unmarshal(msg.Metadata, &result.Metadata); err != nil {
+			return nil, errors.Wrap(err, "unable to unmarshal entity change metadata")
+		}
+	}
+	if len(msg.InitialState) > 0 {
+		result.InitialState = json.RawMessage(msg.InitialState)
+	}
+	if len(msg.FinalState) > 0 {
+		result.FinalState = json.RawMessage(msg.FinalState)
+	}
+	return result, nil
+}
+
+func SessionEventToPb(evt *event.SessionEvent) *event_pb.SessionEvent {
+	return &event_pb.SessionEvent{
+		Namespace:    evt.Namespace,
+		EventType:    evt.EventType,
+		SessionType:  evt.SessionType,
+		Id:           evt.Id,
+		Timestamp:    toPbTimestamp(evt.Timestamp),
+		Token:        evt.Token,
+		ApiSessionId: evt.ApiSessionId,
+		IdentityId:   evt.IdentityId,
+		ServiceId:    evt.ServiceId,
+	}
+}
+
+func SessionEventFromPb(msg *event_pb.SessionEvent) *event.SessionEvent {
+	return &event.SessionEvent{
+		Namespace:    msg.Namespace,
+		EventType:    msg.EventType,
+		SessionType:  msg.SessionType,
+		Id:           msg.Id,
+		Timestamp:    fromPbTimestamp(msg.Timestamp),
+		Token:        msg.Token,
+		ApiSessionId: msg.ApiSessionId,
+		IdentityId:   msg.IdentityId,
+		ServiceId:    msg.ServiceId,
+	}
+}
+
+func PostureWarningEventToPb(evt *event.PostureWarningEvent) *event_pb.PostureWarningEvent {
+	result := &event_pb.PostureWarningEvent{
+		Namespace:          evt.Namespace,
+		EventType:          evt.EventType,
+		Timestamp:          toPbTimestamp(evt.Timestamp),
+		IdentityId:         evt.IdentityId,
+		ApiSessionId:       evt.ApiSessionId,
+		PostureCheckId:     evt.PostureCheckId,
+		PostureCheckName:   evt.PostureCheckName,
+		PostureCheckType:   evt.PostureCheckType,
+		GracePeriodSeconds: evt.GracePeriodSeconds,
+	}
+	if evt.ExpiresAt != nil {
+		result.ExpiresAt = toPbTimestamp(*evt.ExpiresAt)
+	}
+	return result
+}
+
+func PostureWarningEventFromPb(msg *event_pb.PostureWarningEvent) *event.PostureWarningEvent {
+	result := &event.PostureWarningEvent{
+		Namespace:          msg.Namespace,
+		EventType:          msg.EventType,
+		Timestamp:          fromPbTimestamp(msg.Timestamp),
+		IdentityId:         msg.IdentityId,
+		ApiSessionId:       msg.ApiSessionId,
+		PostureCheckId:     msg.PostureCheckId,
+		PostureCheckName:   msg.PostureCheckName,
+		PostureCheckType:   msg.PostureCheckType,
+		GracePeriodSeconds: msg.GracePeriodSeconds,
+	}
+	if msg.ExpiresAt != nil {
+		expiresAt := fromPbTimestamp(msg.ExpiresAt)
+		result.ExpiresAt = &expiresAt
+	}
+	return result
+}
+
+func ApiSessionEventToPb(evt *event.ApiSessionEvent) *event_pb.ApiSessionEvent {
+	return &event_pb.ApiSessionEvent{
+		Namespace:  evt.Namespace,
+		EventType:  evt.EventType,
+		Id:         evt.Id,
+		Timestamp:  toPbTimestamp(evt.Timestamp),
+		Token:      evt.Token,
+		IdentityId: evt.IdentityId,
+		IpAddress:  evt.IpAddress,
+	}
+}
+
+func ApiSessionEventFromPb(msg *event_pb.ApiSessionEvent) *event.ApiSessionEvent {
+	return &event.ApiSessionEvent{
+		Namespace:  msg.Namespace,
+		EventType:  msg.EventType,
+		Id:         msg.Id,
+		Timestamp:  fromPbTimestamp(msg.Timestamp),
+		Token:      msg.Token,
+		IdentityId: msg.IdentityId,
+		IpAddress:  msg.IpAddress,
+	}
+}
+
+func ClusterEventToPb(evt *event.ClusterEvent) *event_pb.ClusterEvent {
+	result := &event_pb.ClusterEvent{
+		Namespace: evt.Namespace,
+		EventType: string(evt.EventType),
+		Timestamp: toPbTimestamp(evt.Timestamp),
+		Index:     evt.Index,
+	}
+	for _, peer := range evt.Peers {
+		pbPeer := &event_pb.ClusterPeer{
+			Id:      peer.Id,
+			Addr:    peer.Addr,
+			Version: peer.Version,
+		}
+		if len(peer.ApiAddresses) > 0 {
+			pbPeer.ApiAddresses = map[string]*event_pb.ApiAddressList{}
+		}
+		for k, addresses := range peer.ApiAddresses {
+			list := &event_pb.ApiAddressList{}
+			for _, addr := range addresses {
+				list.Addresses = append(list.Addresses, &event_pb.ApiAddress{Url: addr.Url, Version: addr.Version})
+			}
+			pbPeer.ApiAddresses[k] = list
+		}
+		result.Peers = append(result.Peers, pbPeer)
+	}
+	return result
+}
+
+func ClusterEventFromPb(msg *event_pb.ClusterEvent) *event.ClusterEvent {
+	result := &event.ClusterEvent{
+		Namespace: msg.Namespace,
+		EventType: event.ClusterEventType(msg.EventType),
+		Timestamp: fromPbTimestamp(msg.Timestamp),
+		Index:     msg.Index,
+	}
+	for _, pbPeer := range msg.Peers {
+		peer := &event.ClusterPeer{
+			Id:      pbPeer.Id,
+			Addr:    pbPeer.Addr,
+			Version: pbPeer.Version,
+		}
+		if len(pbPeer.ApiAddresses) > 0 {
+			peer.ApiAddresses = map[string][]event.ApiAddress{}
+		}
+		for k, list := range pbPeer.ApiAddresses {
+			for _, addr := range list.Addresses {
+				peer.ApiAddresses[k] = append(peer.ApiAddresses[k], event.ApiAddress{Url: addr.Url, Version: addr.Version})
+			}
+		}
+		result.Peers = append(result.Peers, peer)
+	}
+	return result
+}
+
+func EntityCountEventToPb(evt *event.EntityCountEvent) *event_pb.EntityCountEvent {
+	return &event_pb.EntityCountEvent{
+		Namespace: evt.Namespace,
+		Timestamp: toPbTimestamp(evt.Timestamp),
+		Counts:    evt.Counts,
+		Error:     evt.Error,
+	}
+}
+
+func EntityCountEventFromPb(msg *event_pb.EntityCountEvent) *event.EntityCountEvent {
+	return &event.EntityCountEvent{
+		Namespace: msg.Namespace,
+		Timestamp: fromPbTimestamp(msg.Timestamp),
+		Counts:    msg.Counts,
+		Error:     msg.Error,
+	}
+}
+
+func RouterEventToPb(evt *event.RouterEvent) *event_pb.RouterEvent {
+	return &event_pb.RouterEvent{
+		Namespace:    evt.Namespace,
+		EventType:    string(evt.EventType),
+		Timestamp:    toPbTimestamp(evt.Timestamp),
+		RouterId:     evt.RouterId,
+		RouterOnline: evt.RouterOnline,
+	}
+}
+
+func RouterEventFromPb(msg *event_pb.RouterEvent) *event.RouterEvent {
+	return &event.RouterEvent{
+		Namespace:    msg.Namespace,
+		EventType:    event.RouterEventType(msg.EventType),
+		Timestamp:    fromPbTimestamp(msg.Timestamp),
+		RouterId:     msg.RouterId,
+		RouterOnline: msg.RouterOnline,
+	}
+}
+
+func ServiceEventToPb(evt *event.ServiceEvent) *event_pb.ServiceEvent {
+	return &event_pb.ServiceEvent{
+		Namespace:        evt.Namespace,
+		Version:          evt.Version,
+		EventType:        evt.EventType,
+		ServiceId:        evt.ServiceId,
+		TerminatorId:     evt.TerminatorId,
+		Count:            evt.Count,
+		IntervalStartUTC: evt.IntervalStartUTC,
+		IntervalLength:   evt.IntervalLength,
+	}
+}
+
+func ServiceEventFromPb(msg *event_pb.ServiceEvent) *event.ServiceEvent {
+	return &event.ServiceEvent{
+		Namespace:        msg.Namespace,
+		Version:          msg.Version,
+		EventType:        msg.EventType,
+		ServiceId:        msg.ServiceId,
+		TerminatorId:     msg.TerminatorId,
+		Count:            msg.Count,
+		IntervalStartUTC: msg.IntervalStartUTC,
+		IntervalLength:   msg.IntervalLength,
+	}
+}
+
+func TerminatorEventToPb(evt *event.TerminatorEvent) *event_pb.TerminatorEvent {
+	return &event_pb.TerminatorEvent{
+		Namespace:                 evt.Namespace,
+		EventType:                 string(evt.EventType),
+		Timestamp:                 toPbTimestamp(evt.Timestamp),
+		ServiceId:                 evt.ServiceId,
+		TerminatorId:              evt.TerminatorId,
+		RouterId:                  evt.RouterId,
+		HostId:                    evt.HostId,
+		RouterOnline:              evt.RouterOnline,
+		Precedence:                evt.Precedence,
+		StaticCost:                uint32(evt.StaticCost),
+		DynamicCost:               uint32(evt.DynamicCost),
+		TotalTerminators:          int64(evt.TotalTerminators),
+		UsableDefaultTerminators:  int64(evt.UsableDefaultTerminators),
+		UsableRequiredTerminators: int64(evt.UsableRequiredTerminators),
+	}
+}
+
+func TerminatorEventFromPb(msg *event_pb.TerminatorEvent) *event.TerminatorEvent {
+	return &event.TerminatorEvent{
+		Namespace:                 msg.Namespace,
+		EventType:                 event.TerminatorEventType(msg.EventType),
+		Timestamp:                 fromPbTimestamp(msg.Timestamp),
+		ServiceId:                 msg.ServiceId,
+		TerminatorId:              msg.TerminatorId,
+		RouterId:                  msg.RouterId,
+		HostId:                    msg.HostId,
+		RouterOnline:              msg.RouterOnline,
+		Precedence:                msg.Precedence,
+		StaticCost:                uint16(msg.StaticCost),
+		DynamicCost:               uint16(msg.DynamicCost),
+		TotalTerminators:          int(msg.TotalTerminators),
+		UsableDefaultTerminators:  int(msg.UsableDefaultTerminators),
+		UsableRequiredTerminators: int(msg.UsableRequiredTerminators),
+	}
+}
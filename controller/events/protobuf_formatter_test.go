@@ -0,0 +1,188 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"bytes"
+	"github.com/openziti/ziti/controller/event"
+	"github.com/stretchr/testify/require"
+	"io"
+	"testing"
+	"time"
+)
+
+type collectingEventSink struct {
+	events chan []byte
+}
+
+func (self *collectingEventSink) AcceptFormattedEvent(_ string, formattedEvent []byte) {
+	self.events <- formattedEvent
+}
+
+func TestProtobufFormatterRoundTrip(t *testing.T) {
+	req := require.New(t)
+
+	now := time.Now().UTC()
+	cost := uint32(12)
+	duration := 150 * time.Millisecond
+	isParent := true
+
+	circuitEvent := &event.CircuitEvent{
+		Namespace: event.CircuitEventsNs,
+		Version:   event.CircuitEventsVersion,
+		EventType: event.CircuitCreated,
+		CircuitId: "c1",
+		Timestamp: now,
+		ClientId:  "client",
+		ServiceId: "svc1",
+		Path: event.CircuitPath{
+			Nodes:     []string{"r1", "r2"},
+			Links:     []string{"l1"},
+			IngressId: "in",
+			EgressId:  "out",
+		},
+		LinkCount:        1,
+		Cost:             &cost,
+		CreationTimespan: &duration,
+		Tags:             map[string]string{"region": "east"},
+	}
+
+	metricsEvent := &event.MetricsEvent{
+		MetricType:  "intValue",
+		Namespace:   "metrics",
+		SourceAppId: "r1",
+		Timestamp:   now,
+		Metric:      "link.latency",
+		Metrics: map[string]interface{}{
+			"count": int64(5),
+			"max":   uint64(10),
+			"mean":  2.5,
+		},
+		Tags: map[string]string{"linkId": "l1"},
+	}
+
+	entityChangeEvent := &event.EntityChangeEvent{
+		Namespace:     event.EntityChangeEventsNs,
+		EventId:       "e1",
+		EventType:     event.EntityChangeTypeEntityUpdated,
+		Timestamp:     now,
+		Metadata:      map[string]any{"author": "admin"},
+		EntityType:    "services",
+		IsParentEvent: &isParent,
+		InitialState:  map[string]any{"name": "before"},
+		FinalState:    map[string]any{"name": "after"},
+	}
+
+	clusterEvent := &event.ClusterEvent{
+		Namespace: event.ClusterEventsNs,
+		EventType: event.ClusterMembersChanged,
+		Timestamp: now,
+		Index:     42,
+		Peers: []*event.ClusterPeer{{
+			Id:           "ctrl1",
+			Addr:         "tls:localhost:6262",
+			Version:      "v1.0.0",
+			ApiAddresses: map[string][]event.ApiAddress{"edge-client": {{Url: "https://localhost:1280", Version: "v1"}}},
+		}},
+	}
+
+	terminatorEvent := &event.TerminatorEvent{
+		Namespace:        event.TerminatorEventsNs,
+		EventType:        event.TerminatorCreated,
+		Timestamp:        now,
+		ServiceId:        "svc1",
+		TerminatorId:     "t1",
+		RouterId:         "r2",
+		RouterOnline:     true,
+		Precedence:       "default",
+		StaticCost:       7,
+		DynamicCost:      300,
+		TotalTerminators: 3,
+	}
+
+	usageEvent := &event.UsageEventV3{
+		Namespace:        event.UsageEventsNs,
+		Version:          3,
+		SourceId:         "r1",
+		CircuitId:        "c1",
+		Usage:            map[string]uint64{"ingress.rx": 100},
+		IntervalStartUTC: now.Unix(),
+		IntervalLength:   60,
+	}
+
+	expiresAt := now.Add(5 * time.Minute)
+	postureWarningEvent := &event.PostureWarningEvent{
+		Namespace:          event.PostureWarningEventNS,
+		EventType:          event.PostureWarningEventTypeWarning,
+		Timestamp:          now,
+		IdentityId:         "i1",
+		ApiSessionId:       "as1",
+		PostureCheckId:     "pc1",
+		PostureCheckName:   "os-check",
+		PostureCheckType:   "OS",
+		GracePeriodSeconds: 300,
+		ExpiresAt:          &expiresAt,
+	}
+
+	sink := &collectingEventSink{events: make(chan []byte, 10)}
+	formatter := NewProtobufFormatter(10, sink)
+	defer func() { _ = formatter.Close() }()
+
+	formatter.AcceptCircuitEvent(circuitEvent)
+	formatter.AcceptMetricsEvent(metricsEvent)
+	formatter.AcceptEntityChangeEvent(entityChangeEvent)
+	formatter.AcceptClusterEvent(clusterEvent)
+	formatter.AcceptTerminatorEvent(terminatorEvent)
+	formatter.AcceptUsageEventV3(usageEvent)
+	formatter.AcceptPostureWarningEvent(postureWarningEvent)
+
+	expected := []FormatterEvent{
+		(*JsonCircuitEvent)(circuitEvent),
+		(*JsonMetricsEvent)(metricsEvent),
+		(*JsonEntityChangeEvent)(entityChangeEvent),
+		(*JsonClusterEvent)(clusterEvent),
+		(*JsonTerminatorEvent)(terminatorEvent),
+		(*JsonUsageEventV3)(usageEvent),
+		(*JsonPostureWarningEvent)(postureWarningEvent),
+	}
+
+	buf := &bytes.Buffer{}
+	for range expected {
+		select {
+		case formatted := <-sink.events:
+			buf.Write(formatted)
+		case <-time.After(time.Second):
+			req.Fail("timed out waiting for formatted event")
+		}
+	}
+
+	reader := NewProtobufEventReader(buf)
+	for _, expectedEvent := range expected {
+		decoded, err := reader.Next()
+		req.NoError(err)
+		req.Equal(expectedEvent.GetEventType(), decoded.GetEventType())
+
+		expectedJson, err := expectedEvent.Format()
+		req.NoError(err)
+		decodedJson, err := decoded.Format()
+		req.NoError(err)
+		req.JSONEq(string(expectedJson), string(decodedJson))
+	}
+
+	_, err := reader.Next()
+	req.ErrorIs(err, io.EOF)
+}
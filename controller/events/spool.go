@@ -0,0 +1,368 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"github.com/michaelquigley/pfxlog"
+	"github.com/pkg/errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	spoolSegmentSuffix          = ".seg"
+	defaultSpoolMaxBytes        = 1024 * 1024 * 1024
+	defaultSpoolMaxSegmentBytes = 4 * 1024 * 1024
+	defaultSpoolMaxSegmentAge   = time.Second
+)
+
+var errSpoolFull = errors.New("event spool is full")
+
+// diskSpool is a directory of append-only segment files holding length prefixed event records. Records are appended
+// to the newest segment and consumed a whole segment at a time from the oldest, so a segment is only deleted once
+// everything in it has been delivered. Segments left behind by a previous run are picked up when the spool is opened.
+//
+// The segment being written to is sealed once it reaches the max segment size or age, and only sealed segments are
+// read. Segments are synced to disk when sealed, and appends are synced every sync interval. With a sync interval of
+// zero every append is synced before it returns, so no accepted record is lost if the host crashes.
+type diskSpool struct {
+	dir             string
+	maxBytes        int64
+	maxSegmentBytes int64
+	maxSegmentAge   time.Duration
+	syncInterval    time.Duration
+
+	lock     sync.Mutex
+	segments []uint64
+	sizes    map[uint64]int64
+	size     int64
+	counts   map[uint64]int64
+	count    int64
+	nextSeq  uint64
+	writer   *os.File
+	writeSeq uint64
+	openedAt time.Time
+	dirty    bool
+	syncedAt time.Time
+}
+
+func newDiskSpool(dir string, maxBytes, maxSegmentBytes int64, maxSegmentAge, syncInterval time.Duration) (*diskSpool, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, errors.Wrapf(err, "unable to create event spool directory %v", dir)
+	}
+
+	if maxBytes <= 0 {
+		maxBytes = defaultSpoolMaxBytes
+	}
+
+	if maxSegmentBytes <= 0 {
+		maxSegmentBytes = defaultSpoolMaxSegmentBytes
+	}
+
+	if maxSegmentAge <= 0 {
+		maxSegmentAge = defaultSpoolMaxSegmentAge
+	}
+
+	result := &diskSpool{
+		dir:             dir,
+		maxBytes:        maxBytes,
+		maxSegmentBytes: maxSegmentBytes,
+		maxSegmentAge:   maxSegmentAge,
+		syncInterval:    syncInterval,
+		sizes:           map[uint64]int64{},
+		counts:          map[uint64]int64{},
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read event spool directory %v", dir)
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), spoolSegmentSuffix) {
+			continue
+		}
+		seq, err := strconv.ParseUint(strings.TrimSuffix(entry.Name(), spoolSegmentSuffix), 10, 64)
+		if err != nil {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to stat event spool segment %v", entry.Name())
+		}
+		count, err := countSpoolRecords(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		result.segments = append(result.segments, seq)
+		result.sizes[seq] = info.Size()
+		result.size += info.Size()
+		result.counts[seq] = count
+		result.count += count
+		if seq >= result.nextSeq {
+			result.nextSeq = seq + 1
+		}
+	}
+
+	sort.Slice(result.segments, func(i, j int) bool {
+		return result.segments[i] < result.segments[j]
+	})
+
+	if len(result.segments) > 0 {
+		pfxlog.Logger().WithField("dir", dir).WithField("segments", len(result.segments)).
+			WithField("bytes", result.size).Info("found existing event spool segments, will replay")
+	}
+
+	return result, nil
+}
+
+// countSpoolRecords returns the number of complete records in the given segment file
+func countSpoolRecords(path string) (int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, errors.Wrapf(err, "unable to open event spool segment %v", path)
+	}
+	defer func() { _ = f.Close() }()
+
+	var count int64
+	reader := bufio.NewReader(f)
+	lenBuf := make([]byte, 4)
+	for {
+		if _, err = io.ReadFull(reader, lenBuf); err != nil {
+			return count, nil
+		}
+		if _, err = reader.Discard(int(binary.BigEndian.Uint32(lenBuf))); err != nil {
+			return count, nil
+		}
+		count++
+	}
+}
+
+func (self *diskSpool) segmentPath(seq uint64) string {
+	return filepath.Join(self.dir, fmt.Sprintf("%020d%s", seq, spoolSegmentSuffix))
+}
+
+// Append writes the given records to the spool. Either all records are written or, if the spool doesn't have room,
+// none are and errSpoolFull is returned.
+func (self *diskSpool) Append(records ...[]byte) error {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	var total int64
+	for _, record := range records {
+		total += int64(len(record)) + 4
+	}
+
+	if self.size+total > self.maxBytes {
+		return errSpoolFull
+	}
+
+	if self.writer == nil || self.isWriterFullLocked(time.Now()) {
+		if err := self.rollLocked(); err != nil {
+			return err
+		}
+	}
+
+	buf := make([]byte, 0, total)
+	for _, record := range records {
+		buf = binary.BigEndian.AppendUint32(buf, uint32(len(record)))
+		buf = append(buf, record...)
+	}
+
+	if _, err := self.writer.Write(buf); err != nil {
+		return errors.Wrap(err, "unable to write to event spool")
+	}
+
+	self.sizes[self.writeSeq] += total
+	self.size += total
+	self.counts[self.writeSeq] += int64(len(records))
+	self.count += int64(len(records))
+	self.dirty = true
+
+	if self.syncInterval == 0 || time.Since(self.syncedAt) >= self.syncInterval {
+		return self.syncLocked()
+	}
+	return nil
+}
+
+// Sync flushes appended records to disk, if any have been appended since the last sync
+func (self *diskSpool) Sync() error {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	return self.syncLocked()
+}
+
+func (self *diskSpool) syncLocked() error {
+	if self.writer == nil || !self.dirty {
+		return nil
+	}
+	if err := self.writer.Sync(); err != nil {
+		return errors.Wrap(err, "unable to sync event spool segment")
+	}
+	self.dirty = false
+	self.syncedAt = time.Now()
+	return nil
+}
+
+// isWriterFullLocked returns true if the segment being written to has reached the max segment size or age
+func (self *diskSpool) isWriterFullLocked(now time.Time) bool {
+	return self.sizes[self.writeSeq] >= self.maxSegmentBytes || now.Sub(self.openedAt) >= self.maxSegmentAge
+}
+
+func (self *diskSpool) rollLocked() error {
+	self.closeWriterLocked()
+
+	seq := self.nextSeq
+	f, err := os.OpenFile(self.segmentPath(seq), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return errors.Wrap(err, "unable to create event spool segment")
+	}
+
+	self.nextSeq++
+	self.writer = f
+	self.writeSeq = seq
+	self.openedAt = time.Now()
+	self.segments = append(self.segments, seq)
+	self.sizes[seq] = 0
+	self.counts[seq] = 0
+	return nil
+}
+
+// closeWriterLocked seals the segment being written to, syncing it to disk first
+func (self *diskSpool) closeWriterLocked() {
+	if self.writer != nil {
+		if err := self.syncLocked(); err != nil {
+			pfxlog.Logger().WithError(err).WithField("dir", self.dir).Error("error syncing event spool segment")
+		}
+		if err := self.writer.Close(); err != nil {
+			pfxlog.Logger().WithError(err).WithField("dir", self.dir).Error("error closing event spool segment")
+		}
+		self.writer = nil
+	}
+}
+
+// ReadOldest returns the records in the oldest segment along with the segment's sequence number, which should be
+// passed to Remove once the records have been handled. If the oldest segment is still being written to, it is only
+// read once it has reached the max segment size or age, at which point it's sealed so that it isn't appended to after
+// being read. Returns false if the spool is empty or the oldest segment isn't ready to be read.
+func (self *diskSpool) ReadOldest() ([][]byte, uint64, bool, error) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if len(self.segments) == 0 {
+		return nil, 0, false, nil
+	}
+
+	seq := self.segments[0]
+	if self.writer != nil && self.writeSeq == seq {
+		if !self.isWriterFullLocked(time.Now()) {
+			return nil, 0, false, nil
+		}
+		self.closeWriterLocked()
+	}
+
+	f, err := os.Open(self.segmentPath(seq))
+	if err != nil {
+		return nil, seq, true, errors.Wrapf(err, "unable to open event spool segment %v", seq)
+	}
+	defer func() { _ = f.Close() }()
+
+	var records [][]byte
+	reader := bufio.NewReader(f)
+	lenBuf := make([]byte, 4)
+	for {
+		if _, err = io.ReadFull(reader, lenBuf); err != nil {
+			if err == io.EOF {
+				break
+			}
+			// a torn write at the end of a segment, likely from a crash. Keep what we have
+			pfxlog.Logger().WithError(err).WithField("segment", seq).Warn("truncated event spool segment")
+			break
+		}
+		record := make([]byte, binary.BigEndian.Uint32(lenBuf))
+		if _, err = io.ReadFull(reader, record); err != nil {
+			pfxlog.Logger().WithError(err).WithField("segment", seq).Warn("truncated event spool segment")
+			break
+		}
+		records = append(records, record)
+	}
+
+	return records, seq, true, nil
+}
+
+// Remove deletes the segment with the given sequence number
+func (self *diskSpool) Remove(seq uint64) error {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if self.writer != nil && self.writeSeq == seq {
+		self.closeWriterLocked()
+	}
+
+	if err := os.Remove(self.segmentPath(seq)); err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "unable to remove event spool segment %v", seq)
+	}
+
+	for idx, s := range self.segments {
+		if s == seq {
+			self.segments = append(self.segments[:idx], self.segments[idx+1:]...)
+			break
+		}
+	}
+
+	self.size -= self.sizes[seq]
+	self.count -= self.counts[seq]
+	delete(self.sizes, seq)
+	delete(self.counts, seq)
+	return nil
+}
+
+func (self *diskSpool) IsEmpty() bool {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	return len(self.segments) == 0
+}
+
+func (self *diskSpool) Size() int64 {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	return self.size
+}
+
+// Count returns the number of records in the spool, including those in segments which have been read but not yet
+// removed
+func (self *diskSpool) Count() int64 {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	return self.count
+}
+
+func (self *diskSpool) Close() error {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	self.closeWriterLocked()
+	return nil
+}
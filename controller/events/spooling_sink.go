@@ -0,0 +1,296 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/metrics"
+	"github.com/openziti/ziti/controller/event"
+	"github.com/pkg/errors"
+	"io"
+	"sync/atomic"
+	"time"
+)
+
+// writeAheadSink is implemented by sinks which persist events as soon as they are accepted. Formatters hand events
+// to these sinks directly, rather than queueing them in memory, so that accepted events survive a controller restart
+type writeAheadSink interface {
+	event.FormattedEventSink
+	IsWriteAhead() bool
+}
+
+type spoolingSinkConfig struct {
+	name             string
+	spoolDir         string
+	spoolMaxBytes    int64
+	spoolSegmentSize int64
+	spoolSegmentAge  time.Duration
+	syncInterval     time.Duration
+	flushInterval    time.Duration
+	retryInterval    time.Duration
+}
+
+// parseSpoolingSinkConfig returns nil if no spool directory is configured
+func parseSpoolingSinkConfig(handlerType string, config map[interface{}]interface{}) (*spoolingSinkConfig, error) {
+	value, found := config["spoolDir"]
+	if !found {
+		return nil, nil
+	}
+
+	result := &spoolingSinkConfig{
+		name:          handlerType,
+		flushInterval: 100 * time.Millisecond,
+		retryInterval: 5 * time.Second,
+	}
+
+	var ok bool
+	if result.spoolDir, ok = value.(string); !ok || result.spoolDir == "" {
+		return nil, errors.Errorf("invalid value for 'spoolDir': %v", value)
+	}
+
+	if value, found = config["name"]; found {
+		if result.name, ok = value.(string); !ok || result.name == "" {
+			return nil, errors.Errorf("invalid 'name' for %s event handler", handlerType)
+		}
+	}
+
+	if value, found = config["spoolMaxSizeMb"]; found {
+		mb, err := parsePositiveInt("spoolMaxSizeMb", value)
+		if err != nil {
+			return nil, err
+		}
+		result.spoolMaxBytes = int64(mb) * 1024 * 1024
+	}
+
+	if value, found = config["spoolSegmentSizeMb"]; found {
+		mb, err := parsePositiveInt("spoolSegmentSizeMb", value)
+		if err != nil {
+			return nil, err
+		}
+		result.spoolSegmentSize = int64(mb) * 1024 * 1024
+	}
+
+	var err error
+	if value, found = config["spoolSegmentMaxAge"]; found {
+		if result.spoolSegmentAge, err = parsePositiveDuration("spoolSegmentMaxAge", value); err != nil {
+			return nil, err
+		}
+	}
+
+	if value, found = config["spoolSyncInterval"]; found {
+		if result.syncInterval, err = parsePositiveDuration("spoolSyncInterval", value); err != nil {
+			return nil, err
+		}
+	}
+
+	if value, found = config["spoolFlushInterval"]; found {
+		if result.flushInterval, err = parsePositiveDuration("spoolFlushInterval", value); err != nil {
+			return nil, err
+		}
+	}
+
+	if value, found = config["spoolRetryInterval"]; found {
+		if result.retryInterval, err = parsePositiveDuration("spoolRetryInterval", value); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// spoolingEventSink writes formatted events to a disk spool as they are accepted, and copies them from the spool to
+// the output in the background. A slow or unavailable output causes the spool to grow, rather than holding up the
+// event dispatcher or losing events. Events still in the spool when the controller stops are delivered once it's
+// restarted. Delivery is at-least-once: a segment which was partially delivered before a restart is delivered in full.
+//
+// By default every event is synced to disk before it's accepted. If a sync interval is configured, events accepted
+// during the interval before a host crash may be lost.
+type spoolingEventSink struct {
+	config      *spoolingSinkConfig
+	out         io.Writer
+	spool       *diskSpool
+	closeNotify <-chan struct{}
+
+	// records from the segment currently being delivered
+	pending    [][]byte
+	pendingSeq uint64
+	delivered  atomic.Int64
+
+	writtenMeter metrics.Meter
+	droppedMeter metrics.Meter
+	errorsMeter  metrics.Meter
+	queueGauge   metrics.Gauge
+	spoolGauge   metrics.Gauge
+}
+
+func newSpoolingEventSink(config *spoolingSinkConfig, out io.Writer, registry metrics.Registry, closeNotify <-chan struct{}) (*spoolingEventSink, error) {
+	spool, err := newDiskSpool(config.spoolDir, config.spoolMaxBytes, config.spoolSegmentSize, config.spoolSegmentAge, config.syncInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &spoolingEventSink{
+		config:      config,
+		out:         out,
+		spool:       spool,
+		closeNotify: closeNotify,
+	}
+
+	prefix := "events.sink." + config.name + "."
+	result.writtenMeter = registry.Meter(prefix + "written")
+	result.droppedMeter = registry.Meter(prefix + "dropped")
+	result.errorsMeter = registry.Meter(prefix + "write_errors")
+	result.queueGauge = registry.FuncGauge(prefix+"queue_size", result.QueueSize)
+	result.spoolGauge = registry.FuncGauge(prefix+"spool_bytes", spool.Size)
+
+	go result.run()
+
+	return result, nil
+}
+
+func (self *spoolingEventSink) IsWriteAhead() bool {
+	return true
+}
+
+func (self *spoolingEventSink) AcceptFormattedEvent(eventType string, formattedEvent []byte) {
+	if err := self.spool.Append(formattedEvent); err != nil {
+		self.droppedMeter.Mark(1)
+		pfxlog.Logger().WithField("sink", self.config.name).WithField("eventType", eventType).WithError(err).
+			Error("unable to spool event, dropping")
+	}
+}
+
+// QueueSize returns the number of events which have been accepted but not yet written to the output
+func (self *spoolingEventSink) QueueSize() int64 {
+	return self.spool.Count() - self.delivered.Load()
+}
+
+func (self *spoolingEventSink) run() {
+	log := pfxlog.Logger().WithField("sink", self.config.name)
+	log.WithField("dir", self.config.spoolDir).Info("spooling event sink started")
+
+	ticker := time.NewTicker(self.config.flushInterval)
+	defer ticker.Stop()
+
+	var retryAt time.Time
+
+	for {
+		select {
+		case now := <-ticker.C:
+			if err := self.spool.Sync(); err != nil {
+				log.WithError(err).Error("unable to sync event spool")
+			}
+			if now.Before(retryAt) {
+				continue
+			}
+			if err := self.deliver(); err != nil {
+				self.errorsMeter.Mark(1)
+				log.WithError(err).WithField("queued", self.QueueSize()).
+					Errorf("unable to write spooled events, will retry in %v", self.config.retryInterval)
+				retryAt = now.Add(self.config.retryInterval)
+			}
+		case <-self.closeNotify:
+			self.shutdown()
+			log.Info("spooling event sink stopped")
+			return
+		}
+	}
+}
+
+// deliver writes spooled events to the output, oldest first, until the spool is empty or a write fails
+func (self *spoolingEventSink) deliver() error {
+	for {
+		select {
+		case <-self.closeNotify:
+			return nil
+		default:
+		}
+
+		if self.pending == nil {
+			if self.spool.IsEmpty() {
+				return nil
+			}
+
+			records, seq, found, err := self.spool.ReadOldest()
+			if !found {
+				return nil
+			}
+
+			if err != nil {
+				pfxlog.Logger().WithField("sink", self.config.name).WithError(err).
+					Error("unable to read event spool segment, discarding")
+				if err = self.spool.Remove(seq); err != nil {
+					return err
+				}
+				continue
+			}
+
+			self.pending = records
+			self.pendingSeq = seq
+			self.delivered.Store(0)
+		}
+
+		for len(self.pending) > 0 {
+			if _, err := self.out.Write(self.pending[0]); err != nil {
+				return err
+			}
+			self.pending = self.pending[1:]
+			self.delivered.Add(1)
+			self.writtenMeter.Mark(1)
+		}
+
+		if err := self.spool.Remove(self.pendingSeq); err != nil {
+			return err
+		}
+		self.pending = nil
+		self.delivered.Store(0)
+	}
+}
+
+func (self *spoolingEventSink) shutdown() {
+	if err := self.spool.Close(); err != nil {
+		pfxlog.Logger().WithField("sink", self.config.name).WithError(err).Error("error closing event spool")
+	}
+
+	self.writtenMeter.Dispose()
+	self.droppedMeter.Dispose()
+	self.errorsMeter.Dispose()
+	self.queueGauge.Dispose()
+	self.spoolGauge.Dispose()
+}
+
+// newOutputEventSink returns a sink which writes to the given output, through a disk spool if the handler config
+// specifies one
+func (self *Dispatcher) newOutputEventSink(handlerType string, config map[interface{}]interface{}, out io.Writer) (event.FormattedEventSink, error) {
+	spoolConfig, err := parseSpoolingSinkConfig(handlerType, config)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to parse %s spool config", handlerType)
+	}
+
+	if spoolConfig == nil {
+		return NewWriterEventSink(out), nil
+	}
+
+	var registry metrics.Registry
+	if self.network != nil {
+		registry = self.network.GetMetricsRegistry()
+	} else {
+		registry = metrics.NewRegistry(spoolConfig.name, nil)
+	}
+
+	return newSpoolingEventSink(spoolConfig, out, registry, self.closeNotify)
+}
@@ -0,0 +1,124 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"errors"
+	"fmt"
+	"github.com/openziti/metrics"
+	"github.com/openziti/ziti/controller/event"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type stallingWriter struct {
+	sync.Mutex
+	stalled atomic.Bool
+	written []string
+}
+
+func (self *stallingWriter) Write(p []byte) (int, error) {
+	if self.stalled.Load() {
+		return 0, errors.New("output unavailable")
+	}
+	self.Lock()
+	defer self.Unlock()
+	self.written = append(self.written, string(p))
+	return len(p), nil
+}
+
+func (self *stallingWriter) count() int {
+	self.Lock()
+	defer self.Unlock()
+	return len(self.written)
+}
+
+func newTestSpoolingHandler(t *testing.T, spoolDir string, out *stallingWriter, closeNotify <-chan struct{}) (event.CircuitEventHandler, *spoolingEventSink) {
+	config := &spoolingSinkConfig{
+		name:          "test",
+		spoolDir:      spoolDir,
+		flushInterval: 10 * time.Millisecond,
+		retryInterval: 20 * time.Millisecond,
+	}
+	sink, err := newSpoolingEventSink(config, out, metrics.NewRegistry("test", nil), closeNotify)
+	require.NoError(t, err)
+	return NewJsonFormatter(1, sink), sink
+}
+
+func TestSpoolingSinkSurvivesStalledOutputAndRestart(t *testing.T) {
+	req := require.New(t)
+
+	out := &stallingWriter{}
+	out.stalled.Store(true)
+
+	spoolDir := t.TempDir()
+	closeNotify := make(chan struct{})
+	handler, sink := newTestSpoolingHandler(t, spoolDir, out, closeNotify)
+
+	// the formatter queue only holds one event, so these would back up or be dropped without the spool
+	sendCircuitEvents(handler, 0, 20)
+	req.Equal(int64(20), sink.QueueSize())
+	req.True(sink.spool.Size() > 0)
+
+	// simulate a controller restart while the output is still unavailable
+	close(closeNotify)
+	time.Sleep(50 * time.Millisecond)
+	out.stalled.Store(false)
+
+	closeNotify = make(chan struct{})
+	defer close(closeNotify)
+	handler, sink = newTestSpoolingHandler(t, spoolDir, out, closeNotify)
+	sendCircuitEvents(handler, 20, 5)
+
+	req.Eventually(func() bool { return out.count() >= 25 }, 5*time.Second, 10*time.Millisecond)
+	req.Eventually(func() bool { return sink.QueueSize() == 0 }, time.Second, 10*time.Millisecond)
+
+	out.Lock()
+	defer out.Unlock()
+	for i := 0; i < 25; i++ {
+		req.True(strings.Contains(out.written[i], fmt.Sprintf(`"circuit-%d"`, i)), "circuit-%d out of order or missing", i)
+	}
+}
+
+func TestParseSpoolingSinkConfig(t *testing.T) {
+	req := require.New(t)
+
+	config, err := parseSpoolingSinkConfig("file", map[interface{}]interface{}{})
+	req.NoError(err)
+	req.Nil(config)
+
+	config, err = parseSpoolingSinkConfig("file", map[interface{}]interface{}{
+		"spoolDir":           "/tmp/spool",
+		"spoolMaxSizeMb":     10,
+		"spoolRetryInterval": "1s",
+		"spoolSegmentMaxAge": "2s",
+		"spoolSyncInterval":  "500ms",
+	})
+	req.NoError(err)
+	req.Equal("file", config.name)
+	req.Equal(int64(10*1024*1024), config.spoolMaxBytes)
+	req.Equal(time.Second, config.retryInterval)
+	req.Equal(2*time.Second, config.spoolSegmentAge)
+	req.Equal(500*time.Millisecond, config.syncInterval)
+
+	_, err = parseSpoolingSinkConfig("file", map[interface{}]interface{}{"spoolDir": "/tmp/spool", "spoolMaxSizeMb": -1})
+	req.Error(err)
+}
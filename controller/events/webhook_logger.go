@@ -0,0 +1,170 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/pkg/errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	WebhookSignatureHeader = "X-Ziti-Signature"
+	WebhookTimestampHeader = "X-Ziti-Timestamp"
+)
+
+// WebhookEventLoggerFactory creates handlers which POST batches of events to an HTTP endpoint. Each request body
+// contains the batch's formatted events separated by newlines.
+/**
+Example configuration:
+    handler:
+      type: webhook
+      format: json
+      url: https://events.example.com/ziti
+      secret: my-hmac-secret       # optional, signs requests with HMAC-SHA256
+      headers:                     # optional, extra request headers
+        Authorization: Bearer xyz
+      batchSize: 100               # default: 100
+      batchInterval: 1s            # default: 1s
+      bufferSize: 1000             # default: 1000
+      maxAttempts: 3               # default: 3
+      spoolDir: /var/lib/ziti/webhook-spool  # optional, enables the disk-backed retry buffer
+      spoolMaxSizeMb: 1024         # default: 1024
+*/
+type WebhookEventLoggerFactory struct {
+	dispatcher *Dispatcher
+}
+
+func (self *WebhookEventLoggerFactory) NewEventHandler(config map[interface{}]interface{}) (interface{}, error) {
+	sender, err := newWebhookSender(config)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to parse webhook config")
+	}
+	return self.dispatcher.newBatchingHandler("webhook", config, sender)
+}
+
+type webhookSender struct {
+	url         string
+	secret      []byte
+	headers     map[string]string
+	contentType string
+	client      *http.Client
+}
+
+func newWebhookSender(config map[interface{}]interface{}) (*webhookSender, error) {
+	result := &webhookSender{
+		headers:     map[string]string{},
+		contentType: "application/x-ndjson",
+		client:      &http.Client{},
+	}
+
+	if value, found := config["url"]; !found {
+		return nil, errors.New("missing webhook url")
+	} else if u, ok := value.(string); !ok {
+		return nil, errors.Errorf("invalid webhook url: %v", value)
+	} else if parsed, err := url.Parse(u); err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+		return nil, errors.Errorf("invalid webhook url, must be an http or https url: %v", u)
+	} else {
+		result.url = u
+	}
+
+	if value, found := config["secret"]; found {
+		if secret, ok := value.(string); ok && secret != "" {
+			result.secret = []byte(secret)
+		} else {
+			return nil, errors.New("invalid webhook secret")
+		}
+	}
+
+	if value, found := config["contentType"]; found {
+		if contentType, ok := value.(string); ok {
+			result.contentType = contentType
+		}
+	}
+
+	if value, found := config["headers"]; found {
+		headers, ok := value.(map[interface{}]interface{})
+		if !ok {
+			return nil, errors.Errorf("invalid webhook headers, must be a map: %v", value)
+		}
+		for k, v := range headers {
+			result.headers[fmt.Sprintf("%v", k)] = fmt.Sprintf("%v", v)
+		}
+	}
+
+	return result, nil
+}
+
+func (self *webhookSender) SendBatch(ctx context.Context, batch []*sinkEvent) error {
+	body := &bytes.Buffer{}
+	for _, evt := range batch {
+		body.Write(evt.data)
+		body.WriteByte('\n')
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, self.url, bytes.NewReader(body.Bytes()))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", self.contentType)
+	for k, v := range self.headers {
+		req.Header.Set(k, v)
+	}
+
+	if len(self.secret) > 0 {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		req.Header.Set(WebhookTimestampHeader, timestamp)
+		req.Header.Set(WebhookSignatureHeader, "sha256="+SignWebhookPayload(self.secret, timestamp, body.Bytes()))
+	}
+
+	resp, err := self.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return errors.Errorf("webhook returned unexpected status %v", resp.Status)
+	}
+	return nil
+}
+
+func (self *webhookSender) Close() error {
+	self.client.CloseIdleConnections()
+	return nil
+}
+
+// SignWebhookPayload computes the hex encoded HMAC-SHA256 signature sent in the X-Ziti-Signature header. The
+// timestamp is included so that receivers can reject replayed requests.
+func SignWebhookPayload(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
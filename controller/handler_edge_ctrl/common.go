@@ -3,6 +3,7 @@ package handler_edge_ctrl
 import (
 	"fmt"
 	"math"
+	"strconv"
 	"strings"
 	"time"
 
@@ -24,6 +25,7 @@ import (
 	"github.com/openziti/ziti/controller/network"
 	"github.com/openziti/ziti/controller/oidc_auth"
 	"github.com/openziti/ziti/controller/xt"
+	"github.com/openziti/ziti/router/xgress"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
@@ -118,6 +120,7 @@ type baseSessionRequestContext struct {
 	logContext   logcontext.Context
 	env          model.Env
 	accessClaims *common.AccessClaims
+	serviceTags  map[string]string
 }
 
 func (self *baseSessionRequestContext) newChangeContext() *change.Context {
@@ -426,6 +429,34 @@ func (self *baseSessionRequestContext) loadService() {
 	}
 }
 
+// getServiceCircuitTags returns the circuit tags derived from the service's xgress.v1 config, which let routers override
+// their xgress options for the service's circuits
+func (self *baseSessionRequestContext) getServiceCircuitTags() map[string]string {
+	if self.serviceTags != nil || self.service == nil {
+		return self.serviceTags
+	}
+
+	self.serviceTags = map[string]string{}
+	for _, configId := range self.service.Configs {
+		config, err := self.handler.getAppEnv().Managers.Config.Read(configId)
+		if err != nil {
+			pfxlog.Logger().WithField("serviceId", self.service.Id).WithField("configId", configId).
+				WithError(err).Error("unable to read service config")
+			continue
+		}
+
+		if config.TypeId == db.XgressV1ConfigTypeId {
+			if algorithm, ok := config.Data["congestionControl"].(string); ok && algorithm != "" {
+				self.serviceTags[xgress.CircuitTagCongestionControl] = algorithm
+			}
+			if fec, ok := config.Data["fec"].(bool); ok {
+				self.serviceTags[xgress.CircuitTagFec] = strconv.FormatBool(fec)
+			}
+		}
+	}
+	return self.serviceTags
+}
+
 func (self *baseSessionRequestContext) verifyTerminator(terminatorId string, binding string) *network.Terminator {
 	if self.err == nil {
 		var terminator *network.Terminator
@@ -626,19 +657,19 @@ func (self *sessionCircuitParams) GetClientId() *identity.TokenId {
 }
 
 func (self *sessionCircuitParams) GetCircuitTags(t xt.CostedTerminator) map[string]string {
-	if t == nil {
-		return map[string]string{
-			"serviceId": self.serviceId,
-			"clientId":  self.reqCtx.session.IdentityId,
-		}
-	}
-
-	hostId := t.GetHostId()
-	return map[string]string{
+	tags := map[string]string{
 		"serviceId": self.serviceId,
 		"clientId":  self.reqCtx.session.IdentityId,
-		"hostId":    hostId,
 	}
+
+	if t != nil {
+		tags["hostId"] = t.GetHostId()
+	}
+
+	for k, v := range self.reqCtx.getServiceCircuitTags() {
+		tags[k] = v
+	}
+	return tags
 }
 
 func (self *sessionCircuitParams) GetLogContext() logcontext.Context {
@@ -671,19 +702,19 @@ func (self *tunnelCircuitParams) GetClientId() *identity.TokenId {
 }
 
 func (self *tunnelCircuitParams) GetCircuitTags(t xt.CostedTerminator) map[string]string {
-	if t == nil {
-		return map[string]string{
-			"serviceId": self.serviceId,
-			"clientId":  self.sourceRouter.Id,
-		}
-	}
-
-	hostId := t.GetHostId()
-	return map[string]string{
+	tags := map[string]string{
 		"serviceId": self.serviceId,
 		"clientId":  self.sourceRouter.Id,
-		"hostId":    hostId,
 	}
+
+	if t != nil {
+		tags["hostId"] = t.GetHostId()
+	}
+
+	for k, v := range self.reqCtx.getServiceCircuitTags() {
+		tags[k] = v
+	}
+	return tags
 }
 
 func (self *tunnelCircuitParams) GetLogContext() logcontext.Context {
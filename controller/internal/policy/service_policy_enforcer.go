@@ -22,6 +22,7 @@ import (
 	"github.com/openziti/ziti/common/runner"
 	"github.com/openziti/ziti/controller/change"
 	"github.com/openziti/ziti/controller/db"
+	"github.com/openziti/ziti/controller/model"
 	"time"
 
 	"github.com/openziti/ziti/controller/env"
@@ -33,12 +34,18 @@ const (
 	SessionPolicyEnforcerEvent        = "service.policy.enforcer.event"
 	SessionPolicyEnforcerEventDeletes = "service.policy.enforcer.event.deletes"
 	SessionPolicyEnforcerRunDeletes   = "service.policy.enforcer.run.deletes"
+
+	// maxScheduleCheckInterval bounds how long a change to a policy schedule can go unnoticed
+	maxScheduleCheckInterval = time.Minute
 )
 
 type ServicePolicyEnforcer struct {
 	appEnv *env.AppEnv
 	*runner.BaseOperation
 	notify chan struct{}
+
+	nextScheduleCheck time.Time
+	scheduledPolicies map[string]bool
 }
 
 func NewServicePolicyEnforcer(appEnv *env.AppEnv, f time.Duration) *ServicePolicyEnforcer {
@@ -111,7 +118,48 @@ func (enforcer *ServicePolicyEnforcer) handleServiceEvent(event *db.ServiceEvent
 	enforcer.appEnv.GetMetricsRegistry().Meter(SessionPolicyEnforcerEventDeletes).Mark(int64(len(sessionsToDelete)))
 }
 
+// checkSchedules requests a full scan when a scheduled policy has gone out of force since the last check. Checks are
+// made at the next point any schedule may change, or at least every maxScheduleCheckInterval.
+func (enforcer *ServicePolicyEnforcer) checkSchedules() {
+	now := time.Now()
+	if now.Before(enforcer.nextScheduleCheck) {
+		return
+	}
+
+	next := now.Add(maxScheduleCheckInterval)
+	scheduledPolicies := map[string]bool{}
+	scanRequired := false
+
+	_ = enforcer.appEnv.GetDbProvider().GetDb().View(func(tx *bbolt.Tx) error {
+		for _, policy := range model.ListScheduledPolicies(enforcer.appEnv, tx) {
+			active := policy.Schedule.IsActive(now)
+			scheduledPolicies[policy.Id] = active
+			if wasActive, found := enforcer.scheduledPolicies[policy.Id]; !active && (wasActive || !found) {
+				pfxlog.Logger().WithField("policyId", policy.Id).WithField("policyType", policy.Type).
+					Debug("policy is out of schedule, checking sessions")
+				scanRequired = true
+			}
+			if nextChange := policy.Schedule.NextChange(now); nextChange != nil && nextChange.Before(next) {
+				next = *nextChange
+			}
+		}
+		return nil
+	})
+
+	enforcer.scheduledPolicies = scheduledPolicies
+	enforcer.nextScheduleCheck = next
+
+	if scanRequired {
+		select {
+		case enforcer.notify <- struct{}{}:
+		default:
+		}
+	}
+}
+
 func (enforcer *ServicePolicyEnforcer) Run() error {
+	enforcer.checkSchedules()
+
 	// if we haven't been notified to run b/c of startup or handler error, skip run
 	select {
 	case <-enforcer.notify:
@@ -152,12 +200,8 @@ func (enforcer *ServicePolicyEnforcer) Run() error {
 			if session.Type == db.SessionTypeBind {
 				policyType = db.PolicyTypeBind
 			}
-			query := fmt.Sprintf(`id = "%v" and not isEmpty(from servicePolicies where type = %v and anyOf(services) = "%v")`, identity.Id, policyType.Id(), session.ServiceId)
-			_, count, err := enforcer.appEnv.GetStores().Identity.QueryIds(tx, query)
-			if err != nil {
-				return err
-			}
-			if count == 0 {
+			accessInSchedule, _ := enforcer.appEnv.GetManagers().ServicePolicy.IsAccessInScheduleInTx(tx, identity.Id, session.ServiceId, policyType, startTime)
+			if !accessInSchedule || enforcer.appEnv.GetManagers().EdgeRouter.IsEdgeRouterAccessOutOfScheduleInTx(tx, identity.Id, session.ServiceId) {
 				sessionsToRemove = append(sessionsToRemove, session.Id)
 			}
 		}
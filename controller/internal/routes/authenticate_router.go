@@ -217,6 +217,7 @@ func (ro *AuthRouter) authHandler(ae *env.AppEnv, rc *response.RequestContext, h
 	}
 
 	ae.GetManagers().PostureResponse.SetSdkInfo(identity.Id, sessionId, identity.SdkInfo)
+	ae.GetManagers().PostureResponse.SetEnvInfo(identity.Id, sessionId, identity.EnvInfo)
 
 	rc.ApiSession = filledApiSession
 
@@ -17,13 +17,18 @@
 package routes
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
 	clientCurrentApiSession "github.com/openziti/edge-api/rest_client_api_server/operations/current_api_session"
 	managementCurrentApiSession "github.com/openziti/edge-api/rest_management_api_server/operations/current_api_session"
 	"github.com/openziti/edge-api/rest_model"
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/ziti/controller/apierror"
 	"github.com/openziti/ziti/controller/env"
 	"github.com/openziti/ziti/controller/internal/permissions"
+	"github.com/openziti/ziti/controller/model"
 	"github.com/openziti/ziti/controller/response"
 	"net/http"
 	"time"
@@ -74,6 +79,37 @@ func (router *CurrentSessionRouter) Register(ae *env.AppEnv) {
 		return ae.IsAllowed(router.ListServiceUpdates, params.HTTPRequest, "", "", permissions.IsAuthenticated())
 	})
 
+	// Device Keys, not yet in the edge-api spec
+	ae.ClientApiRoutes.Add(&env.ApiRoute{
+		Method:      http.MethodPost,
+		Path:        "/current-api-session/device-key-challenge",
+		Handler:     router.CreateDeviceKeyChallenge,
+		Permissions: []permissions.Resolver{permissions.IsAuthenticated()},
+	})
+
+	ae.ClientApiRoutes.Add(&env.ApiRoute{
+		Method:      http.MethodPost,
+		Path:        "/current-api-session/device-key-proof",
+		Handler:     router.ProveDeviceKey,
+		Permissions: []permissions.Resolver{permissions.IsAuthenticated()},
+	})
+
+	// WebAuthn, not yet in the edge-api spec. Asserting a credential is how a partially authenticated API session
+	// completes MFA.
+	ae.ClientApiRoutes.Add(&env.ApiRoute{
+		Method:      http.MethodPost,
+		Path:        "/current-api-session/mfa/webauthn/assertion-options",
+		Handler:     router.StartWebAuthnAssertion,
+		Permissions: []permissions.Resolver{permissions.HasOneOf(permissions.IsAuthenticated(), permissions.IsPartiallyAuthenticated())},
+	})
+
+	ae.ClientApiRoutes.Add(&env.ApiRoute{
+		Method:      http.MethodPost,
+		Path:        "/current-api-session/mfa/webauthn/assertion",
+		Handler:     router.CompleteWebAuthnAssertion,
+		Permissions: []permissions.Resolver{permissions.HasOneOf(permissions.IsAuthenticated(), permissions.IsPartiallyAuthenticated())},
+	})
+
 	//Management
 	ae.ManagementApi.CurrentAPISessionGetCurrentAPISessionHandler = managementCurrentApiSession.GetCurrentAPISessionHandlerFunc(func(params managementCurrentApiSession.GetCurrentAPISessionParams, i interface{}) middleware.Responder {
 		return ae.IsAllowed(router.Detail, params.HTTPRequest, "", "", permissions.HasOneOf(permissions.IsAuthenticated(), permissions.IsPartiallyAuthenticated()))
@@ -189,6 +225,133 @@ func (router *CurrentSessionRouter) ListServiceUpdates(ae *env.AppEnv, rc *respo
 	rc.RespondWithOk(data, &rest_model.Meta{})
 }
 
+type deviceKeyChallengeDetail struct {
+	Challenge string          `json:"challenge"`
+	ExpiresAt strfmt.DateTime `json:"expiresAt"`
+}
+
+type deviceKeyProof struct {
+	Signature string `json:"signature"`
+}
+
+// CreateDeviceKeyChallenge issues a challenge which the current API session must sign with its identity's device key
+func (router *CurrentSessionRouter) CreateDeviceKeyChallenge(ae *env.AppEnv, rc *response.RequestContext) {
+	if rc.Identity.DeviceKey == nil {
+		rc.RespondWithFieldError(errorz.NewFieldError("identity has no enrolled device key", "deviceKey", nil))
+		return
+	}
+
+	challenge, err := ae.Managers.PostureResponse.CreateDeviceKeyChallenge(rc.ApiSession.Id)
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	rc.RespondWithOk(&deviceKeyChallengeDetail{
+		Challenge: challenge.EncodedChallenge(),
+		ExpiresAt: strfmt.DateTime(challenge.ExpiresAt),
+	}, &rest_model.Meta{})
+}
+
+// ProveDeviceKey accepts the signed challenge, satisfying device key posture checks for the current API session
+func (router *CurrentSessionRouter) ProveDeviceKey(ae *env.AppEnv, rc *response.RequestContext) {
+	proof := &deviceKeyProof{}
+	if err := json.Unmarshal(rc.Body, proof); err != nil {
+		rc.RespondWithCouldNotParseBody(err)
+		return
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(proof.Signature)
+	if proof.Signature == "" || err != nil {
+		rc.RespondWithFieldError(errorz.NewFieldError("base64 encoded signature expected", "signature", proof.Signature))
+		return
+	}
+
+	if err = ae.Managers.PostureResponse.ProveDeviceKey(rc.Identity.Id, rc.ApiSession.Id, signature); err != nil {
+		rc.RespondWithFieldError(errorz.NewFieldError(err.Error(), "signature", proof.Signature))
+		return
+	}
+
+	rc.RespondWithEmptyOk()
+}
+
+// StartWebAuthnAssertion issues the options for asserting a WebAuthn credential for the current API session
+func (router *CurrentSessionRouter) StartWebAuthnAssertion(ae *env.AppEnv, rc *response.RequestContext) {
+	mfa, err := ae.Managers.Mfa.ReadOneByIdentityId(rc.Identity.Id)
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	if !mfa.HasWebAuthn() {
+		rc.RespondWithError(apierror.NewMfaNotEnrolledError())
+		return
+	}
+
+	options, err := ae.Managers.Mfa.StartWebAuthnAssertion(mfa, rc.ApiSession.Id)
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	rc.RespondWithOk(options, &rest_model.Meta{})
+}
+
+// CompleteWebAuthnAssertion accepts the response to the assertion options, completing MFA for the current API session
+func (router *CurrentSessionRouter) CompleteWebAuthnAssertion(ae *env.AppEnv, rc *response.RequestContext) {
+	body := &webAuthnAssertion{}
+	if err := json.Unmarshal(rc.Body, body); err != nil {
+		rc.RespondWithCouldNotParseBody(err)
+		return
+	}
+
+	assertion := &model.WebAuthnAssertion{
+		CredentialId: body.Id,
+	}
+
+	var ok bool
+	if assertion.ClientDataJSON, ok = decodeWebAuthnField(rc, "clientDataJSON", body.ClientDataJSON); !ok {
+		return
+	}
+
+	if assertion.AuthenticatorData, ok = decodeWebAuthnField(rc, "authenticatorData", body.AuthenticatorData); !ok {
+		return
+	}
+
+	if assertion.Signature, ok = decodeWebAuthnField(rc, "signature", body.Signature); !ok {
+		return
+	}
+
+	mfa, err := ae.Managers.Mfa.ReadOneByIdentityId(rc.Identity.Id)
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	if !mfa.HasWebAuthn() {
+		rc.RespondWithError(apierror.NewMfaNotEnrolledError())
+		return
+	}
+
+	changeCtx := rc.NewChangeContext()
+	if err = ae.Managers.Mfa.VerifyWebAuthnAssertion(mfa, rc.ApiSession.Id, assertion, changeCtx); err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	// API sessions backed by OIDC tokens aren't stored, their MFA state is carried in the token's amr claim
+	if rc.Claims == nil {
+		if err = ae.Managers.ApiSession.MfaCompleted(rc.ApiSession, changeCtx); err != nil {
+			rc.RespondWithError(err)
+			return
+		}
+	}
+
+	ae.Managers.PostureResponse.SetMfaPosture(rc.Identity.Id, rc.ApiSession.Id, true)
+
+	rc.RespondWithEmptyOk()
+}
+
 type ApiSessionCertificateCreateResponder struct {
 	response.Responder
 	ae *env.AppEnv
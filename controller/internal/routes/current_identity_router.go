@@ -17,10 +17,12 @@
 package routes
 
 import (
+	"encoding/json"
 	"github.com/go-openapi/runtime/middleware"
 	clientCurrentIdentity "github.com/openziti/edge-api/rest_client_api_server/operations/current_identity"
 	managementCurrentIdentity "github.com/openziti/edge-api/rest_management_api_server/operations/current_identity"
 	"github.com/openziti/edge-api/rest_model"
+	"github.com/openziti/foundation/v2/errorz"
 	"github.com/openziti/foundation/v2/stringz"
 	"github.com/openziti/ziti/controller/apierror"
 	"github.com/openziti/ziti/controller/env"
@@ -93,6 +95,35 @@ func (r *CurrentIdentityRouter) Register(ae *env.AppEnv) {
 		}, params.HTTPRequest, "", "", permissions.IsAuthenticated())
 	})
 
+	// WebAuthn, not yet in the edge-api spec
+	ae.ClientApiRoutes.Add(&env.ApiRoute{
+		Method:      http.MethodPost,
+		Path:        r.BasePath + "/mfa/webauthn/registration-options",
+		Handler:     r.startWebAuthnRegistration,
+		Permissions: []permissions.Resolver{permissions.IsAuthenticated()},
+	})
+
+	ae.ClientApiRoutes.Add(&env.ApiRoute{
+		Method:      http.MethodPost,
+		Path:        r.BasePath + "/mfa/webauthn/registrations",
+		Handler:     r.completeWebAuthnRegistration,
+		Permissions: []permissions.Resolver{permissions.IsAuthenticated()},
+	})
+
+	ae.ClientApiRoutes.Add(&env.ApiRoute{
+		Method:      http.MethodGet,
+		Path:        r.BasePath + "/mfa/webauthn/credentials",
+		Handler:     r.listWebAuthnCredentials,
+		Permissions: []permissions.Resolver{permissions.IsAuthenticated()},
+	})
+
+	ae.ClientApiRoutes.Add(&env.ApiRoute{
+		Method:      http.MethodDelete,
+		Path:        r.BasePath + "/mfa/webauthn/credentials/{id}",
+		Handler:     r.removeWebAuthnCredential,
+		Permissions: []permissions.Resolver{permissions.IsAuthenticated()},
+	})
+
 	//Management
 	ae.ManagementApi.CurrentIdentityGetCurrentIdentityHandler = managementCurrentIdentity.GetCurrentIdentityHandlerFunc(func(params managementCurrentIdentity.GetCurrentIdentityParams, _ interface{}) middleware.Responder {
 		return ae.IsAllowed(detailCurrentUser, params.HTTPRequest, "", "", permissions.IsAuthenticated())
@@ -310,6 +341,72 @@ func (r *CurrentIdentityRouter) detailMfaRecoveryCodes(ae *env.AppEnv, rc *respo
 	rc.RespondWithOk(data, &rest_model.Meta{})
 }
 
+func (r *CurrentIdentityRouter) startWebAuthnRegistration(ae *env.AppEnv, rc *response.RequestContext) {
+	options, err := ae.Managers.Mfa.StartWebAuthnRegistration(rc.Identity)
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	rc.RespondWithOk(options, &rest_model.Meta{})
+}
+
+func (r *CurrentIdentityRouter) completeWebAuthnRegistration(ae *env.AppEnv, rc *response.RequestContext) {
+	registration := &webAuthnRegistration{}
+	if err := json.Unmarshal(rc.Body, registration); err != nil {
+		rc.RespondWithCouldNotParseBody(err)
+		return
+	}
+
+	clientDataJSON, ok := decodeWebAuthnField(rc, "clientDataJSON", registration.ClientDataJSON)
+	if !ok {
+		return
+	}
+
+	attestationObject, ok := decodeWebAuthnField(rc, "attestationObject", registration.AttestationObject)
+	if !ok {
+		return
+	}
+
+	result, err := ae.Managers.Mfa.CompleteWebAuthnRegistration(rc.Identity.Id, registration.Name, clientDataJSON, attestationObject, rc.NewChangeContext())
+	if err != nil {
+		if fieldErr, ok := err.(*errorz.FieldError); ok {
+			rc.RespondWithFieldError(fieldErr)
+			return
+		}
+		rc.RespondWithError(err)
+		return
+	}
+
+	rc.RespondWithOk(&WebAuthnRegistrationDetail{
+		Credential:    MapWebAuthnCredentialToRestModel(result.Credential),
+		RecoveryCodes: result.RecoveryCodes,
+	}, &rest_model.Meta{})
+}
+
+func (r *CurrentIdentityRouter) listWebAuthnCredentials(ae *env.AppEnv, rc *response.RequestContext) {
+	mfa, err := ae.Managers.Mfa.ReadOneByIdentityId(rc.Identity.Id)
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	result := []*WebAuthnCredentialDetail{}
+	if mfa != nil {
+		for _, credential := range mfa.WebAuthnCredentials {
+			result = append(result, MapWebAuthnCredentialToRestModel(credential))
+		}
+	}
+
+	rc.RespondWithOk(result, &rest_model.Meta{})
+}
+
+func (r *CurrentIdentityRouter) removeWebAuthnCredential(ae *env.AppEnv, rc *response.RequestContext) {
+	Delete(rc, func(rc *response.RequestContext, id string) error {
+		return ae.Managers.Mfa.DeleteWebAuthnCredential(rc.Identity.Id, id, rc.NewChangeContext())
+	})
+}
+
 func (r *CurrentIdentityRouter) listEdgeRouters(ae *env.AppEnv, rc *response.RequestContext) {
 	if rc.Identity.IsAdmin {
 		filterTemplate := `isVerified = true`
@@ -19,11 +19,13 @@ package routes
 import (
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/openziti/edge-api/rest_management_api_server/operations/edge_router_policy"
+	"github.com/openziti/ziti/common/schedule"
 	"github.com/openziti/ziti/controller/env"
 	"github.com/openziti/ziti/controller/internal/permissions"
 	"github.com/openziti/ziti/controller/model"
 	"github.com/openziti/ziti/controller/response"
 	"github.com/openziti/ziti/controller/fields"
+	"net/http"
 )
 
 func init() {
@@ -75,6 +77,21 @@ func (r *EdgeRouterPolicyRouter) Register(ae *env.AppEnv) {
 	ae.ManagementApi.EdgeRouterPolicyListEdgeRouterPolicyIdentitiesHandler = edge_router_policy.ListEdgeRouterPolicyIdentitiesHandlerFunc(func(params edge_router_policy.ListEdgeRouterPolicyIdentitiesParams, _ interface{}) middleware.Responder {
 		return ae.IsAllowed(r.ListIdentities, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
 	})
+
+	//Not yet in the edge-api spec
+	ae.ManagementApiRoutes.Add(&env.ApiRoute{
+		Method:      http.MethodGet,
+		Path:        r.BasePath + "/{id}/schedule",
+		Handler:     r.DetailSchedule,
+		Permissions: []permissions.Resolver{permissions.IsAdmin()},
+	})
+
+	ae.ManagementApiRoutes.Add(&env.ApiRoute{
+		Method:      http.MethodPut,
+		Path:        r.BasePath + "/{id}/schedule",
+		Handler:     r.UpdateSchedule,
+		Permissions: []permissions.Resolver{permissions.IsAdmin()},
+	})
 }
 
 func (r *EdgeRouterPolicyRouter) List(ae *env.AppEnv, rc *response.RequestContext) {
@@ -114,3 +131,17 @@ func (r *EdgeRouterPolicyRouter) ListEdgeRouters(ae *env.AppEnv, rc *response.Re
 func (r *EdgeRouterPolicyRouter) ListIdentities(ae *env.AppEnv, rc *response.RequestContext) {
 	ListAssociationWithHandler[*model.EdgeRouterPolicy, *model.Identity](ae, rc, ae.Managers.EdgeRouterPolicy, ae.Managers.Identity, MapIdentityToRestEntity)
 }
+
+func (r *EdgeRouterPolicyRouter) DetailSchedule(ae *env.AppEnv, rc *response.RequestContext) {
+	detailPolicySchedule(rc, func(id string) (string, *schedule.Schedule, error) {
+		policy, err := ae.Managers.EdgeRouterPolicy.Read(id)
+		if err != nil {
+			return "", nil, err
+		}
+		return policy.Name, policy.Schedule, nil
+	})
+}
+
+func (r *EdgeRouterPolicyRouter) UpdateSchedule(ae *env.AppEnv, rc *response.RequestContext) {
+	updatePolicySchedule(rc, ae.Managers.EdgeRouterPolicy)
+}
@@ -402,7 +402,15 @@ func MapServiceConfigToModel(config rest_model.ServiceConfigAssign) model.Servic
 		Config:  stringz.OrEmpty(config.ConfigID),
 	}
 }
-func MapAdvisorServiceReachabilityToRestEntity(entity *model.AdvisorServiceReachability) *rest_model.PolicyAdvice {
+
+// PolicyAdviceDetail extends the edge API policy advice with the deny service policies which revoke access the
+// identity would otherwise have to the service
+type PolicyAdviceDetail struct {
+	rest_model.PolicyAdvice
+	DeniedBy []*rest_model.EntityRef `json:"deniedBy,omitempty"`
+}
+
+func MapAdvisorServiceReachabilityToRestEntity(entity *model.AdvisorServiceReachability) *PolicyAdviceDetail {
 
 	var commonRouters []*rest_model.RouterEntityRef
 
@@ -413,16 +421,24 @@ func MapAdvisorServiceReachabilityToRestEntity(entity *model.AdvisorServiceReach
 		})
 	}
 
-	result := &rest_model.PolicyAdvice{
-		IdentityID:          entity.Identity.Id,
-		Identity:            ToEntityRef(entity.Identity.Name, entity.Identity, IdentityLinkFactory),
-		ServiceID:           entity.Service.Id,
-		Service:             ToEntityRef(entity.Service.Name, entity.Service, ServiceLinkFactory),
-		IsBindAllowed:       entity.IsBindAllowed,
-		IsDialAllowed:       entity.IsDialAllowed,
-		IdentityRouterCount: int32(entity.IdentityRouterCount),
-		ServiceRouterCount:  int32(entity.ServiceRouterCount),
-		CommonRouters:       commonRouters,
+	var deniedBy []*rest_model.EntityRef
+	for _, policy := range entity.DenyingPolicies {
+		deniedBy = append(deniedBy, ToEntityRef(policy.Name, policy, ServicePolicyLinkFactory))
+	}
+
+	result := &PolicyAdviceDetail{
+		PolicyAdvice: rest_model.PolicyAdvice{
+			IdentityID:          entity.Identity.Id,
+			Identity:            ToEntityRef(entity.Identity.Name, entity.Identity, IdentityLinkFactory),
+			ServiceID:           entity.Service.Id,
+			Service:             ToEntityRef(entity.Service.Name, entity.Service, ServiceLinkFactory),
+			IsBindAllowed:       entity.IsBindAllowed,
+			IsDialAllowed:       entity.IsDialAllowed,
+			IdentityRouterCount: int32(entity.IdentityRouterCount),
+			ServiceRouterCount:  int32(entity.ServiceRouterCount),
+			CommonRouters:       commonRouters,
+		},
+		DeniedBy: deniedBy,
 	}
 
 	return result
@@ -37,6 +37,7 @@ import (
 	"github.com/openziti/ziti/controller/models"
 	"github.com/openziti/ziti/controller/response"
 	"github.com/sirupsen/logrus"
+	"net/http"
 	"strings"
 	"time"
 )
@@ -170,6 +171,21 @@ func (r *IdentityRouter) Register(ae *env.AppEnv) {
 			r.Disable(ae, rc, params)
 		}, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
 	})
+
+	// device keys, not yet in the edge-api spec
+	ae.ManagementApiRoutes.Add(&env.ApiRoute{
+		Method:      http.MethodPost,
+		Path:        r.BasePath + "/{id}/device-key-enrollment",
+		Handler:     r.createDeviceKeyEnrollment,
+		Permissions: []permissions.Resolver{permissions.IsAdmin()},
+	})
+
+	ae.ManagementApiRoutes.Add(&env.ApiRoute{
+		Method:      http.MethodDelete,
+		Path:        r.BasePath + "/{id}/device-key",
+		Handler:     r.removeDeviceKey,
+		Permissions: []permissions.Resolver{permissions.IsAdmin()},
+	})
 }
 
 func (r *IdentityRouter) List(ae *env.AppEnv, rc *response.RequestContext) {
@@ -451,3 +467,51 @@ func (r *IdentityRouter) Disable(ae *env.AppEnv, rc *response.RequestContext, pa
 		return
 	}
 }
+
+type deviceKeyEnrollmentDetail struct {
+	Id         string           `json:"id"`
+	IdentityId string           `json:"identityId"`
+	Token      string           `json:"token"`
+	Jwt        string           `json:"jwt,omitempty"`
+	ExpiresAt  *strfmt.DateTime `json:"expiresAt"`
+}
+
+func (r *IdentityRouter) createDeviceKeyEnrollment(ae *env.AppEnv, rc *response.RequestContext) {
+	Detail(rc, func(rc *response.RequestContext, id string) (interface{}, error) {
+		identity, err := ae.Managers.Identity.Read(id)
+		if err != nil {
+			return nil, err
+		}
+
+		expiresAt := time.Now().Add(ae.GetConfig().Enrollment.EdgeIdentity.Duration).UTC()
+		enrollment := &model.Enrollment{
+			Method:     db.MethodEnrollDeviceKey,
+			IdentityId: &identity.Id,
+			ExpiresAt:  &expiresAt,
+		}
+
+		if err = ae.Managers.Enrollment.Create(enrollment, rc.NewChangeContext()); err != nil {
+			return nil, err
+		}
+
+		// the enrollment token and jwt are filled in when the create is applied, which may be on another controller
+		if enrollment, err = ae.Managers.Enrollment.Read(enrollment.Id); err != nil {
+			return nil, err
+		}
+
+		enrollmentExpiresAt := strfmt.DateTime(*enrollment.ExpiresAt)
+		return &deviceKeyEnrollmentDetail{
+			Id:         enrollment.Id,
+			IdentityId: identity.Id,
+			Token:      enrollment.Token,
+			Jwt:        enrollment.Jwt,
+			ExpiresAt:  &enrollmentExpiresAt,
+		}, nil
+	})
+}
+
+func (r *IdentityRouter) removeDeviceKey(ae *env.AppEnv, rc *response.RequestContext) {
+	Delete(rc, func(rc *response.RequestContext, id string) error {
+		return ae.Managers.Identity.RemoveDeviceKey(id, rc.NewChangeContext())
+	})
+}
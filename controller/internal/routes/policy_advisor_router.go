@@ -0,0 +1,155 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package routes
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/openziti/edge-api/rest_model"
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/controller/apierror"
+	"github.com/openziti/ziti/controller/env"
+	"github.com/openziti/ziti/controller/internal/permissions"
+	"github.com/openziti/ziti/controller/model"
+	"github.com/openziti/ziti/controller/response"
+)
+
+func init() {
+	r := NewPolicyAdvisorRouter()
+	env.AddRouter(r)
+}
+
+type PolicyAdvisorRouter struct {
+	BasePath string
+}
+
+func NewPolicyAdvisorRouter() *PolicyAdvisorRouter {
+	return &PolicyAdvisorRouter{
+		BasePath: "/policy-advisor",
+	}
+}
+
+func (r *PolicyAdvisorRouter) Register(ae *env.AppEnv) {
+	ae.ManagementApiRoutes.Add(&env.ApiRoute{
+		Method:      http.MethodPost,
+		Path:        r.BasePath + "/simulate",
+		Handler:     r.Simulate,
+		Permissions: []permissions.Resolver{permissions.IsAdmin()},
+	})
+}
+
+type policySimulation struct {
+	Changes []*policySimulationChange `json:"changes"`
+}
+
+type policySimulationChange struct {
+	Action            string   `json:"action"`
+	EntityType        string   `json:"entityType"`
+	Id                string   `json:"id,omitempty"`
+	Name              string   `json:"name,omitempty"`
+	Semantic          string   `json:"semantic,omitempty"`
+	PolicyType        string   `json:"policyType,omitempty"`
+	Effect            string   `json:"effect,omitempty"`
+	IdentityRoles     []string `json:"identityRoles,omitempty"`
+	ServiceRoles      []string `json:"serviceRoles,omitempty"`
+	EdgeRouterRoles   []string `json:"edgeRouterRoles,omitempty"`
+	PostureCheckRoles []string `json:"postureCheckRoles,omitempty"`
+	RoleAttributes    []string `json:"roleAttributes,omitempty"`
+}
+
+type policySimulationResult struct {
+	ServiceAccess           []*simulatedAccessChange `json:"serviceAccess"`
+	EdgeRouterAccess        []*simulatedAccessChange `json:"edgeRouterAccess"`
+	ServiceEdgeRouterAccess []*simulatedAccessChange `json:"serviceEdgeRouterAccess"`
+}
+
+type simulatedAccessChange struct {
+	SubjectId   string `json:"subjectId"`
+	SubjectName string `json:"subjectName"`
+	TargetId    string `json:"targetId"`
+	TargetName  string `json:"targetName"`
+	Permission  string `json:"permission,omitempty"`
+	Granted     bool   `json:"granted"`
+}
+
+// Simulate reports the service and edge router access which would be gained or lost if the proposed policy changes
+// were made, without making them
+func (r *PolicyAdvisorRouter) Simulate(ae *env.AppEnv, rc *response.RequestContext) {
+	simulation := &policySimulation{}
+	if err := json.Unmarshal(rc.Body, simulation); err != nil {
+		rc.RespondWithApiError(apierror.NewCouldNotParseBody(err))
+		return
+	}
+
+	var changes []*model.PolicySimulationChange
+	for _, simChange := range simulation.Changes {
+		if simChange == nil {
+			continue
+		}
+		changes = append(changes, &model.PolicySimulationChange{
+			Action:            simChange.Action,
+			EntityType:        simChange.EntityType,
+			Id:                simChange.Id,
+			Name:              simChange.Name,
+			Semantic:          simChange.Semantic,
+			PolicyType:        simChange.PolicyType,
+			Effect:            simChange.Effect,
+			IdentityRoles:     simChange.IdentityRoles,
+			ServiceRoles:      simChange.ServiceRoles,
+			EdgeRouterRoles:   simChange.EdgeRouterRoles,
+			PostureCheckRoles: simChange.PostureCheckRoles,
+			RoleAttributes:    simChange.RoleAttributes,
+		})
+	}
+
+	result, err := ae.Managers.PolicyAdvisor.SimulatePolicyChanges(changes)
+	if err != nil {
+		var fieldErr *errorz.FieldError
+		if boltz.IsErrNotFoundErr(err) {
+			rc.RespondWithNotFoundWithCause(err)
+		} else if errors.As(err, &fieldErr) {
+			rc.RespondWithApiError(apierror.NewBadRequestFieldError(*fieldErr))
+		} else {
+			rc.RespondWithError(err)
+		}
+		return
+	}
+
+	rc.RespondWithOk(&policySimulationResult{
+		ServiceAccess:           mapSimulatedAccessChangesToRestModel(result.ServiceAccess),
+		EdgeRouterAccess:        mapSimulatedAccessChangesToRestModel(result.EdgeRouterAccess),
+		ServiceEdgeRouterAccess: mapSimulatedAccessChangesToRestModel(result.ServiceEdgeRouterAccess),
+	}, &rest_model.Meta{})
+}
+
+func mapSimulatedAccessChangesToRestModel(changes []*model.SimulatedAccessChange) []*simulatedAccessChange {
+	result := make([]*simulatedAccessChange, 0, len(changes))
+	for _, accessChange := range changes {
+		result = append(result, &simulatedAccessChange{
+			SubjectId:   accessChange.SubjectId,
+			SubjectName: accessChange.SubjectName,
+			TargetId:    accessChange.TargetId,
+			TargetName:  accessChange.TargetName,
+			Permission:  accessChange.Permission,
+			Granted:     accessChange.Granted,
+		})
+	}
+	return result
+}
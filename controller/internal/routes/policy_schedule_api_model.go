@@ -0,0 +1,138 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package routes
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/ziti/common/schedule"
+	"github.com/openziti/ziti/controller/change"
+	"github.com/openziti/ziti/controller/response"
+)
+
+// PolicySchedule limits when a service or edge router policy is in effect. It isn't part of the edge management API
+// spec yet.
+type PolicySchedule struct {
+	Timezone   string           `json:"timezone,omitempty"`
+	ValidFrom  *strfmt.DateTime `json:"validFrom,omitempty"`
+	ValidUntil *strfmt.DateTime `json:"validUntil,omitempty"`
+	Windows    []string         `json:"windows"`
+}
+
+type PolicyScheduleDetail struct {
+	Id         string           `json:"id"`
+	Name       string           `json:"name"`
+	Active     bool             `json:"active"`
+	NextChange *strfmt.DateTime `json:"nextChange,omitempty"`
+	Schedule   *PolicySchedule  `json:"schedule,omitempty"`
+}
+
+// policyScheduleUpdater is implemented by the managers of policies which may have a schedule
+type policyScheduleUpdater interface {
+	UpdateSchedule(id string, policySchedule *schedule.Schedule, ctx *change.Context) error
+}
+
+func detailPolicySchedule(rc *response.RequestContext, loader func(id string) (string, *schedule.Schedule, error)) {
+	Detail(rc, func(rc *response.RequestContext, id string) (interface{}, error) {
+		name, modelSchedule, err := loader(id)
+		if err != nil {
+			return nil, err
+		}
+		return MapPolicyScheduleToRestModel(id, name, modelSchedule), nil
+	})
+}
+
+func updatePolicySchedule(rc *response.RequestContext, updater policyScheduleUpdater) {
+	apiSchedule := &PolicySchedule{}
+	if err := json.Unmarshal(rc.Body, apiSchedule); err != nil {
+		rc.RespondWithCouldNotParseBody(err)
+		return
+	}
+
+	Update(rc, func(id string) error {
+		modelSchedule, err := MapPolicyScheduleToModel(apiSchedule)
+		if err != nil {
+			return err
+		}
+		return updater.UpdateSchedule(id, modelSchedule, rc.NewChangeContext())
+	})
+}
+
+// MapPolicyScheduleToModel returns nil if the schedule doesn't limit when the policy is in effect
+func MapPolicyScheduleToModel(apiSchedule *PolicySchedule) (*schedule.Schedule, error) {
+	ret := &schedule.Schedule{
+		Windows:  apiSchedule.Windows,
+		Timezone: apiSchedule.Timezone,
+	}
+
+	if apiSchedule.ValidFrom != nil {
+		validFrom := time.Time(*apiSchedule.ValidFrom)
+		ret.ValidFrom = &validFrom
+	}
+
+	if apiSchedule.ValidUntil != nil {
+		validUntil := time.Time(*apiSchedule.ValidUntil)
+		ret.ValidUntil = &validUntil
+	}
+
+	if err := ret.Validate(); err != nil {
+		return nil, errorz.NewFieldError(err.Error(), "windows", apiSchedule.Windows)
+	}
+
+	if !ret.IsSet() {
+		return nil, nil
+	}
+
+	return ret, nil
+}
+
+func MapPolicyScheduleToRestModel(id, name string, modelSchedule *schedule.Schedule) *PolicyScheduleDetail {
+	now := time.Now()
+
+	ret := &PolicyScheduleDetail{
+		Id:     id,
+		Name:   name,
+		Active: modelSchedule.IsActive(now),
+	}
+
+	if modelSchedule != nil {
+		ret.Schedule = &PolicySchedule{
+			Timezone: modelSchedule.Timezone,
+			Windows:  modelSchedule.Windows,
+		}
+
+		if modelSchedule.ValidFrom != nil {
+			validFrom := strfmt.DateTime(*modelSchedule.ValidFrom)
+			ret.Schedule.ValidFrom = &validFrom
+		}
+
+		if modelSchedule.ValidUntil != nil {
+			validUntil := strfmt.DateTime(*modelSchedule.ValidUntil)
+			ret.Schedule.ValidUntil = &validUntil
+		}
+
+		if nextChange := modelSchedule.NextChange(now); nextChange != nil {
+			next := strfmt.DateTime(*nextChange)
+			ret.NextChange = &next
+		}
+	}
+
+	return ret
+}
@@ -17,6 +17,8 @@
 package routes
 
 import (
+	"fmt"
+	"github.com/blang/semver"
 	"github.com/go-openapi/strfmt"
 	"github.com/michaelquigley/pfxlog"
 	"github.com/openziti/edge-api/rest_model"
@@ -24,7 +26,9 @@ import (
 	"github.com/openziti/ziti/controller/model"
 	"github.com/openziti/ziti/controller/response"
 	"github.com/openziti/ziti/controller/models"
+	"github.com/openziti/foundation/v2/errorz"
 	"github.com/openziti/foundation/v2/stringz"
+	"github.com/openziti/ziti/common"
 	"strings"
 )
 
@@ -371,6 +375,35 @@ func MapPostureCheckToRestModel(ae *env.AppEnv, rc *response.RequestContext, i *
 
 		ret = detail
 		setBaseEntityDetailsOnPostureCheck(ret, i)
+	case *model.PostureCheckDeviceKey:
+		ret = &PostureCheckDeviceKeyDetail{
+			TimeoutSeconds: subType.TimeoutSeconds,
+		}
+		setBaseEntityDetailsOnPostureCheck(ret, i)
+	case *model.PostureCheckSdkVersion:
+		appIds := subType.AppIds
+		if appIds == nil {
+			appIds = []string{}
+		}
+		ret = &PostureCheckSdkVersionDetail{
+			Constraints: MapSdkVersionConstraintsToRestModel(subType.Constraints),
+			AppIds:      appIds,
+		}
+		setBaseEntityDetailsOnPostureCheck(ret, i)
+	case *model.PostureCheckSourceIp:
+		allowedCidrs := subType.AllowedCidrs
+		if allowedCidrs == nil {
+			allowedCidrs = []string{}
+		}
+		deniedCidrs := subType.DeniedCidrs
+		if deniedCidrs == nil {
+			deniedCidrs = []string{}
+		}
+		ret = &PostureCheckSourceIpDetail{
+			AllowedCidrs: allowedCidrs,
+			DeniedCidrs:  deniedCidrs,
+		}
+		setBaseEntityDetailsOnPostureCheck(ret, i)
 	}
 
 	return ret, nil
@@ -420,3 +453,94 @@ func GetNamedPostureCheckRoles(postureCheckHandler *model.PostureCheckManager, r
 
 	return result
 }
+
+// postureCheckSubTypeChange is the body of creates, updates and patches of the posture check types which aren't in
+// the edge-api spec yet, which the generated models can't parse
+type postureCheckSubTypeChange struct {
+	Name           string                 `json:"name"`
+	TypeId         string                 `json:"typeId"`
+	RoleAttributes *rest_model.Attributes `json:"roleAttributes"`
+	Tags           *rest_model.Tags       `json:"tags"`
+
+	TimeoutSeconds        int64                        `json:"timeoutSeconds"`
+	SdkVersionConstraints []SdkVersionConstraintDetail `json:"sdkVersionConstraints"`
+	AppIds                []string                     `json:"appIds"`
+	AllowedCidrs          []string                     `json:"allowedCidrs"`
+	DeniedCidrs           []string                     `json:"deniedCidrs"`
+}
+
+func isPostureCheckSubTypeOutsideSpec(typeId string) bool {
+	switch typeId {
+	case model.PostureCheckTypeDeviceKey, model.PostureCheckTypeSdkVersion, model.PostureCheckTypeSourceIp:
+		return true
+	}
+	return false
+}
+
+func mapPostureCheckSubTypeChangeToModel(change *postureCheckSubTypeChange) (model.PostureCheckSubType, error) {
+	switch change.TypeId {
+	case model.PostureCheckTypeDeviceKey:
+		return &model.PostureCheckDeviceKey{
+			TimeoutSeconds: change.TimeoutSeconds,
+		}, nil
+	case model.PostureCheckTypeSdkVersion:
+		ret := &model.PostureCheckSdkVersion{
+			AppIds: change.AppIds,
+		}
+
+		for i, constraint := range change.SdkVersionConstraints {
+			if _, err := semver.ParseRange(constraint.Versions); err != nil {
+				return nil, errorz.NewFieldError("invalid semantic version range: "+err.Error(), fmt.Sprintf("sdkVersionConstraints[%d].versions", i), constraint.Versions)
+			}
+
+			ret.Constraints = append(ret.Constraints, model.SdkVersionConstraint{
+				SdkType:  constraint.SdkType,
+				OsType:   constraint.OsType,
+				Versions: constraint.Versions,
+			})
+		}
+
+		return ret, nil
+	case model.PostureCheckTypeSourceIp:
+		if err := common.ValidateCidrs(change.AllowedCidrs); err != nil {
+			return nil, errorz.NewFieldError("invalid cidr: "+err.Error(), "allowedCidrs", change.AllowedCidrs)
+		}
+
+		if err := common.ValidateCidrs(change.DeniedCidrs); err != nil {
+			return nil, errorz.NewFieldError("invalid cidr: "+err.Error(), "deniedCidrs", change.DeniedCidrs)
+		}
+
+		if len(change.AllowedCidrs) == 0 && len(change.DeniedCidrs) == 0 {
+			return nil, errorz.NewFieldError("at least one allowed or denied cidr is required", "allowedCidrs", change.AllowedCidrs)
+		}
+
+		return &model.PostureCheckSourceIp{
+			AllowedCidrs: change.AllowedCidrs,
+			DeniedCidrs:  change.DeniedCidrs,
+		}, nil
+	}
+
+	return nil, errorz.NewFieldError("unsupported posture check type", "typeId", change.TypeId)
+}
+
+// mapPostureCheckToSubTypeChange fills change with the current values of a posture check, so that a patch only changes
+// the fields it contains
+func mapPostureCheckToSubTypeChange(i *model.PostureCheck, change *postureCheckSubTypeChange) {
+	roleAttributes := rest_model.Attributes(i.RoleAttributes)
+
+	change.Name = i.Name
+	change.TypeId = i.TypeId
+	change.RoleAttributes = &roleAttributes
+	change.Tags = &rest_model.Tags{SubTags: i.Tags}
+
+	switch subType := i.SubType.(type) {
+	case *model.PostureCheckDeviceKey:
+		change.TimeoutSeconds = subType.TimeoutSeconds
+	case *model.PostureCheckSdkVersion:
+		change.SdkVersionConstraints = MapSdkVersionConstraintsToRestModel(subType.Constraints)
+		change.AppIds = subType.AppIds
+	case *model.PostureCheckSourceIp:
+		change.AllowedCidrs = subType.AllowedCidrs
+		change.DeniedCidrs = subType.DeniedCidrs
+	}
+}
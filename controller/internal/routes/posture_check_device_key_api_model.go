@@ -0,0 +1,149 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package routes
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/openziti/edge-api/rest_model"
+	"github.com/openziti/ziti/controller/model"
+)
+
+var _ rest_model.PostureCheckDetail = &PostureCheckDeviceKeyDetail{}
+
+// PostureCheckDeviceKeyDetail represents DEVICE_KEY posture checks on the edge management API. The type isn't part of
+// the edge management API spec, so it lives here rather than in rest_model.
+type PostureCheckDeviceKeyDetail struct {
+	linksField          rest_model.Links
+	createdAtField      *strfmt.DateTime
+	idField             *string
+	nameField           *string
+	roleAttributesField *rest_model.Attributes
+	tagsField           *rest_model.Tags
+	updatedAtField      *strfmt.DateTime
+	versionField        *int64
+
+	TimeoutSeconds int64
+}
+
+func (self *PostureCheckDeviceKeyDetail) Validate(strfmt.Registry) error {
+	return nil
+}
+
+func (self *PostureCheckDeviceKeyDetail) ContextValidate(context.Context, strfmt.Registry) error {
+	return nil
+}
+
+func (self *PostureCheckDeviceKeyDetail) Links() rest_model.Links {
+	return self.linksField
+}
+
+func (self *PostureCheckDeviceKeyDetail) SetLinks(val rest_model.Links) {
+	self.linksField = val
+}
+
+func (self *PostureCheckDeviceKeyDetail) CreatedAt() *strfmt.DateTime {
+	return self.createdAtField
+}
+
+func (self *PostureCheckDeviceKeyDetail) SetCreatedAt(val *strfmt.DateTime) {
+	self.createdAtField = val
+}
+
+func (self *PostureCheckDeviceKeyDetail) ID() *string {
+	return self.idField
+}
+
+func (self *PostureCheckDeviceKeyDetail) SetID(val *string) {
+	self.idField = val
+}
+
+func (self *PostureCheckDeviceKeyDetail) Name() *string {
+	return self.nameField
+}
+
+func (self *PostureCheckDeviceKeyDetail) SetName(val *string) {
+	self.nameField = val
+}
+
+func (self *PostureCheckDeviceKeyDetail) RoleAttributes() *rest_model.Attributes {
+	return self.roleAttributesField
+}
+
+func (self *PostureCheckDeviceKeyDetail) SetRoleAttributes(val *rest_model.Attributes) {
+	self.roleAttributesField = val
+}
+
+func (self *PostureCheckDeviceKeyDetail) Tags() *rest_model.Tags {
+	return self.tagsField
+}
+
+func (self *PostureCheckDeviceKeyDetail) SetTags(val *rest_model.Tags) {
+	self.tagsField = val
+}
+
+func (self *PostureCheckDeviceKeyDetail) TypeID() string {
+	return model.PostureCheckTypeDeviceKey
+}
+
+// SetTypeID is a no-op, the type of device key checks is fixed
+func (self *PostureCheckDeviceKeyDetail) SetTypeID(string) {
+}
+
+func (self *PostureCheckDeviceKeyDetail) UpdatedAt() *strfmt.DateTime {
+	return self.updatedAtField
+}
+
+func (self *PostureCheckDeviceKeyDetail) SetUpdatedAt(val *strfmt.DateTime) {
+	self.updatedAtField = val
+}
+
+func (self *PostureCheckDeviceKeyDetail) Version() *int64 {
+	return self.versionField
+}
+
+func (self *PostureCheckDeviceKeyDetail) SetVersion(val *int64) {
+	self.versionField = val
+}
+
+func (self *PostureCheckDeviceKeyDetail) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Links          rest_model.Links       `json:"_links"`
+		CreatedAt      *strfmt.DateTime       `json:"createdAt"`
+		ID             *string                `json:"id"`
+		Name           *string                `json:"name"`
+		RoleAttributes *rest_model.Attributes `json:"roleAttributes"`
+		Tags           *rest_model.Tags       `json:"tags"`
+		TypeID         string                 `json:"typeId"`
+		UpdatedAt      *strfmt.DateTime       `json:"updatedAt"`
+		Version        *int64                 `json:"version"`
+		TimeoutSeconds int64                  `json:"timeoutSeconds"`
+	}{
+		Links:          self.linksField,
+		CreatedAt:      self.createdAtField,
+		ID:             self.idField,
+		Name:           self.nameField,
+		RoleAttributes: self.roleAttributesField,
+		Tags:           self.tagsField,
+		TypeID:         self.TypeID(),
+		UpdatedAt:      self.updatedAtField,
+		Version:        self.versionField,
+		TimeoutSeconds: self.TimeoutSeconds,
+	})
+}
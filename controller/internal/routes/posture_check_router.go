@@ -17,8 +17,13 @@
 package routes
 
 import (
+	"encoding/json"
+	"net/http"
+
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/openziti/edge-api/rest_management_api_server/operations/posture_checks"
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/ziti/controller/apierror"
 	"github.com/openziti/ziti/controller/db"
 	"github.com/openziti/ziti/controller/env"
 	"github.com/openziti/ziti/controller/fields"
@@ -67,6 +72,38 @@ func (r *PostureCheckRouter) Register(ae *env.AppEnv) {
 	ae.ManagementApi.PostureChecksPatchPostureCheckHandler = posture_checks.PatchPostureCheckHandlerFunc(func(params posture_checks.PatchPostureCheckParams, _ interface{}) middleware.Responder {
 		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.Patch(ae, rc, params) }, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
 	})
+
+	//Not yet in the edge-api spec
+	ae.ManagementApiRoutes.Add(&env.ApiRoute{
+		Method:      http.MethodPost,
+		Path:        r.BasePath,
+		Accepts:     r.isSubTypeOutsideSpec,
+		Handler:     r.CreateSubType,
+		Permissions: []permissions.Resolver{permissions.IsAdmin()},
+	})
+
+	ae.ManagementApiRoutes.Add(&env.ApiRoute{
+		Method:      http.MethodPut,
+		Path:        r.BasePath + "/{id}",
+		Accepts:     r.isSubTypeOutsideSpec,
+		Handler:     r.UpdateSubType,
+		Permissions: []permissions.Resolver{permissions.IsAdmin()},
+	})
+
+	ae.ManagementApiRoutes.Add(&env.ApiRoute{
+		Method:      http.MethodPatch,
+		Path:        r.BasePath + "/{id}",
+		Accepts:     r.isSubTypeOutsideSpec,
+		Handler:     r.PatchSubType,
+		Permissions: []permissions.Resolver{permissions.IsAdmin()},
+	})
+
+	ae.ManagementApiRoutes.Add(&env.ApiRoute{
+		Method:      http.MethodPut,
+		Path:        r.BasePath + "/{id}/grace-period",
+		Handler:     r.UpdateGracePeriod,
+		Permissions: []permissions.Resolver{permissions.IsAdmin()},
+	})
 }
 
 func (r *PostureCheckRouter) List(ae *env.AppEnv, rc *response.RequestContext) {
@@ -167,3 +204,108 @@ func (r *PostureCheckRouter) Patch(ae *env.AppEnv, rc *response.RequestContext,
 		return ae.Managers.PostureCheck.Update(check, fields.FilterMaps("tags"), rc.NewChangeContext())
 	})
 }
+
+// isSubTypeOutsideSpec limits the sub type routes to bodies with a posture check type which isn't in the edge-api spec
+// yet. Bodies of the other types are left to the generated handlers.
+func (r *PostureCheckRouter) isSubTypeOutsideSpec(rc *response.RequestContext) bool {
+	postureCheck := &struct {
+		TypeId string `json:"typeId"`
+	}{}
+
+	if err := json.Unmarshal(rc.Body, postureCheck); err != nil {
+		return false
+	}
+
+	return isPostureCheckSubTypeOutsideSpec(postureCheck.TypeId)
+}
+
+func (r *PostureCheckRouter) CreateSubType(ae *env.AppEnv, rc *response.RequestContext) {
+	create := &postureCheckSubTypeChange{}
+	if err := json.Unmarshal(rc.Body, create); err != nil {
+		rc.RespondWithCouldNotParseBody(err)
+		return
+	}
+
+	Create(rc, rc, PostureCheckLinkFactory, func() (string, error) {
+		subType, err := mapPostureCheckSubTypeChangeToModel(create)
+		if err != nil {
+			return "", err
+		}
+
+		postureCheck := &model.PostureCheck{
+			BaseEntity: models.BaseEntity{
+				Tags: TagsOrDefault(create.Tags),
+			},
+			Name:           create.Name,
+			TypeId:         create.TypeId,
+			Version:        1,
+			RoleAttributes: AttributesOrDefault(create.RoleAttributes),
+			SubType:        subType,
+		}
+
+		return MapCreate(ae.Managers.PostureCheck.Create, postureCheck, rc)
+	})
+}
+
+func (r *PostureCheckRouter) UpdateSubType(ae *env.AppEnv, rc *response.RequestContext) {
+	r.updateSubType(ae, rc, false)
+}
+
+func (r *PostureCheckRouter) PatchSubType(ae *env.AppEnv, rc *response.RequestContext) {
+	r.updateSubType(ae, rc, true)
+}
+
+func (r *PostureCheckRouter) updateSubType(ae *env.AppEnv, rc *response.RequestContext, isPatch bool) {
+	Update(rc, func(id string) error {
+		postureCheck, err := ae.Managers.PostureCheck.Read(id)
+		if err != nil {
+			return err
+		}
+
+		update := &postureCheckSubTypeChange{}
+		if isPatch {
+			mapPostureCheckToSubTypeChange(postureCheck, update)
+		}
+
+		if err = json.Unmarshal(rc.Body, update); err != nil {
+			return apierror.NewCouldNotParseBody(err)
+		}
+
+		if update.TypeId != postureCheck.TypeId {
+			return errorz.NewFieldError("the type of a posture check can't be changed", "typeId", update.TypeId)
+		}
+
+		subType, err := mapPostureCheckSubTypeChangeToModel(update)
+		if err != nil {
+			return err
+		}
+
+		return ae.Managers.PostureCheck.Update(&model.PostureCheck{
+			BaseEntity: models.BaseEntity{
+				Id:   id,
+				Tags: TagsOrDefault(update.Tags),
+			},
+			Name:           update.Name,
+			TypeId:         update.TypeId,
+			RoleAttributes: AttributesOrDefault(update.RoleAttributes),
+			SubType:        subType,
+		}, nil, rc.NewChangeContext())
+	})
+}
+
+type postureCheckGracePeriod struct {
+	GracePeriodSeconds int64 `json:"gracePeriodSeconds"`
+}
+
+// UpdateGracePeriod sets how long a posture check may fail for a previously passing API session before access is cut
+func (r *PostureCheckRouter) UpdateGracePeriod(ae *env.AppEnv, rc *response.RequestContext) {
+	gracePeriod := &postureCheckGracePeriod{}
+	if err := json.Unmarshal(rc.Body, gracePeriod); err != nil {
+		rc.RespondWithCouldNotParseBody(err)
+		return
+	}
+
+	Update(rc, func(id string) error {
+		return ae.Managers.PostureCheck.UpdateGracePeriod(id, gracePeriod.GracePeriodSeconds, rc.NewChangeContext())
+	})
+}
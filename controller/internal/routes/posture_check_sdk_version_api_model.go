@@ -0,0 +1,267 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package routes
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/openziti/edge-api/rest_model"
+	"github.com/openziti/ziti/controller/model"
+)
+
+var _ rest_model.PostureCheckDetail = &PostureCheckSdkVersionDetail{}
+
+// PostureCheckSdkVersionDetail represents SDK_VERSION posture checks on the edge management API. The type isn't part of
+// the edge management API spec, so it lives here rather than in rest_model.
+type PostureCheckSdkVersionDetail struct {
+	linksField          rest_model.Links
+	createdAtField      *strfmt.DateTime
+	idField             *string
+	nameField           *string
+	roleAttributesField *rest_model.Attributes
+	tagsField           *rest_model.Tags
+	updatedAtField      *strfmt.DateTime
+	versionField        *int64
+
+	Constraints []SdkVersionConstraintDetail
+	AppIds      []string
+}
+
+type SdkVersionConstraintDetail struct {
+	SdkType  string `json:"sdkType"`
+	OsType   string `json:"osType"`
+	Versions string `json:"versions"`
+}
+
+func (self *PostureCheckSdkVersionDetail) Validate(strfmt.Registry) error {
+	return nil
+}
+
+func (self *PostureCheckSdkVersionDetail) ContextValidate(context.Context, strfmt.Registry) error {
+	return nil
+}
+
+func (self *PostureCheckSdkVersionDetail) Links() rest_model.Links {
+	return self.linksField
+}
+
+func (self *PostureCheckSdkVersionDetail) SetLinks(val rest_model.Links) {
+	self.linksField = val
+}
+
+func (self *PostureCheckSdkVersionDetail) CreatedAt() *strfmt.DateTime {
+	return self.createdAtField
+}
+
+func (self *PostureCheckSdkVersionDetail) SetCreatedAt(val *strfmt.DateTime) {
+	self.createdAtField = val
+}
+
+func (self *PostureCheckSdkVersionDetail) ID() *string {
+	return self.idField
+}
+
+func (self *PostureCheckSdkVersionDetail) SetID(val *string) {
+	self.idField = val
+}
+
+func (self *PostureCheckSdkVersionDetail) Name() *string {
+	return self.nameField
+}
+
+func (self *PostureCheckSdkVersionDetail) SetName(val *string) {
+	self.nameField = val
+}
+
+func (self *PostureCheckSdkVersionDetail) RoleAttributes() *rest_model.Attributes {
+	return self.roleAttributesField
+}
+
+func (self *PostureCheckSdkVersionDetail) SetRoleAttributes(val *rest_model.Attributes) {
+	self.roleAttributesField = val
+}
+
+func (self *PostureCheckSdkVersionDetail) Tags() *rest_model.Tags {
+	return self.tagsField
+}
+
+func (self *PostureCheckSdkVersionDetail) SetTags(val *rest_model.Tags) {
+	self.tagsField = val
+}
+
+func (self *PostureCheckSdkVersionDetail) TypeID() string {
+	return model.PostureCheckTypeSdkVersion
+}
+
+// SetTypeID is a no-op, the type of sdk version checks is fixed
+func (self *PostureCheckSdkVersionDetail) SetTypeID(string) {
+}
+
+func (self *PostureCheckSdkVersionDetail) UpdatedAt() *strfmt.DateTime {
+	return self.updatedAtField
+}
+
+func (self *PostureCheckSdkVersionDetail) SetUpdatedAt(val *strfmt.DateTime) {
+	self.updatedAtField = val
+}
+
+func (self *PostureCheckSdkVersionDetail) Version() *int64 {
+	return self.versionField
+}
+
+func (self *PostureCheckSdkVersionDetail) SetVersion(val *int64) {
+	self.versionField = val
+}
+
+func (self *PostureCheckSdkVersionDetail) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Links          rest_model.Links             `json:"_links"`
+		CreatedAt      *strfmt.DateTime             `json:"createdAt"`
+		ID             *string                      `json:"id"`
+		Name           *string                      `json:"name"`
+		RoleAttributes *rest_model.Attributes       `json:"roleAttributes"`
+		Tags           *rest_model.Tags             `json:"tags"`
+		TypeID         string                       `json:"typeId"`
+		UpdatedAt      *strfmt.DateTime             `json:"updatedAt"`
+		Version        *int64                       `json:"version"`
+		Constraints    []SdkVersionConstraintDetail `json:"sdkVersionConstraints"`
+		AppIds         []string                     `json:"appIds"`
+	}{
+		Links:          self.linksField,
+		CreatedAt:      self.createdAtField,
+		ID:             self.idField,
+		Name:           self.nameField,
+		RoleAttributes: self.roleAttributesField,
+		Tags:           self.tagsField,
+		TypeID:         self.TypeID(),
+		UpdatedAt:      self.updatedAtField,
+		Version:        self.versionField,
+		Constraints:    self.Constraints,
+		AppIds:         self.AppIds,
+	})
+}
+
+var _ rest_model.PostureCheckFailure = &PostureCheckFailureSdkVersion{}
+
+// PostureCheckFailureSdkVersion reports why an SDK_VERSION posture check failed in the failed service requests of an
+// identity's posture data
+type PostureCheckFailureSdkVersion struct {
+	postureCheckIdField   *string
+	postureCheckNameField *string
+
+	ActualValue   *PostureCheckFailureSdkVersionActual
+	ExpectedValue *PostureCheckFailureSdkVersionExpected
+	Reason        string
+}
+
+type PostureCheckFailureSdkVersionActual struct {
+	SdkType string `json:"sdkType"`
+	Version string `json:"version"`
+	AppId   string `json:"appId"`
+	OsType  string `json:"osType"`
+}
+
+type PostureCheckFailureSdkVersionExpected struct {
+	Constraints []SdkVersionConstraintDetail `json:"sdkVersionConstraints"`
+	AppIds      []string                     `json:"appIds"`
+}
+
+func (self *PostureCheckFailureSdkVersion) Validate(strfmt.Registry) error {
+	return nil
+}
+
+func (self *PostureCheckFailureSdkVersion) ContextValidate(context.Context, strfmt.Registry) error {
+	return nil
+}
+
+func (self *PostureCheckFailureSdkVersion) PostureCheckID() *string {
+	return self.postureCheckIdField
+}
+
+func (self *PostureCheckFailureSdkVersion) SetPostureCheckID(val *string) {
+	self.postureCheckIdField = val
+}
+
+func (self *PostureCheckFailureSdkVersion) PostureCheckName() *string {
+	return self.postureCheckNameField
+}
+
+func (self *PostureCheckFailureSdkVersion) SetPostureCheckName(val *string) {
+	self.postureCheckNameField = val
+}
+
+func (self *PostureCheckFailureSdkVersion) PostureCheckType() string {
+	return model.PostureCheckTypeSdkVersion
+}
+
+// SetPostureCheckType is a no-op, the type of sdk version checks is fixed
+func (self *PostureCheckFailureSdkVersion) SetPostureCheckType(string) {
+}
+
+func (self *PostureCheckFailureSdkVersion) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		PostureCheckID   *string                                `json:"postureCheckId"`
+		PostureCheckName *string                                `json:"postureCheckName"`
+		PostureCheckType string                                 `json:"postureCheckType"`
+		ActualValue      *PostureCheckFailureSdkVersionActual   `json:"actualValue"`
+		ExpectedValue    *PostureCheckFailureSdkVersionExpected `json:"expectedValue"`
+		Reason           string                                 `json:"reason"`
+	}{
+		PostureCheckID:   self.postureCheckIdField,
+		PostureCheckName: self.postureCheckNameField,
+		PostureCheckType: self.PostureCheckType(),
+		ActualValue:      self.ActualValue,
+		ExpectedValue:    self.ExpectedValue,
+		Reason:           self.Reason,
+	})
+}
+
+func MapSdkVersionConstraintsToRestModel(constraints []model.SdkVersionConstraint) []SdkVersionConstraintDetail {
+	ret := []SdkVersionConstraintDetail{}
+	for _, constraint := range constraints {
+		ret = append(ret, SdkVersionConstraintDetail{
+			SdkType:  constraint.SdkType,
+			OsType:   constraint.OsType,
+			Versions: constraint.Versions,
+		})
+	}
+	return ret
+}
+
+func MapPostureCheckFailureSdkVersionToRestModel(failure *model.PostureCheckFailure) *PostureCheckFailureSdkVersion {
+	ret := &PostureCheckFailureSdkVersion{}
+	ret.SetPostureCheckID(&failure.PostureCheckId)
+	ret.SetPostureCheckName(&failure.PostureCheckName)
+
+	if val, ok := failure.PostureCheckFailureValues.(*model.PostureCheckFailureValuesSdkVersion); ok {
+		ret.ActualValue = &PostureCheckFailureSdkVersionActual{
+			SdkType: val.ActualValue.SdkType,
+			Version: val.ActualValue.Version,
+			AppId:   val.ActualValue.AppId,
+			OsType:  val.ActualValue.OsType,
+		}
+		ret.ExpectedValue = &PostureCheckFailureSdkVersionExpected{
+			Constraints: MapSdkVersionConstraintsToRestModel(val.ExpectedValue.Constraints),
+			AppIds:      val.ExpectedValue.AppIds,
+		}
+		ret.Reason = val.Reason
+	}
+
+	return ret
+}
@@ -0,0 +1,237 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package routes
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/openziti/edge-api/rest_model"
+	"github.com/openziti/ziti/controller/model"
+)
+
+var _ rest_model.PostureCheckDetail = &PostureCheckSourceIpDetail{}
+
+// PostureCheckSourceIpDetail represents SOURCE_IP posture checks on the edge management API. The type isn't part of
+// the edge management API spec, so it lives here rather than in rest_model.
+type PostureCheckSourceIpDetail struct {
+	linksField          rest_model.Links
+	createdAtField      *strfmt.DateTime
+	idField             *string
+	nameField           *string
+	roleAttributesField *rest_model.Attributes
+	tagsField           *rest_model.Tags
+	updatedAtField      *strfmt.DateTime
+	versionField        *int64
+
+	AllowedCidrs []string
+	DeniedCidrs  []string
+}
+
+func (self *PostureCheckSourceIpDetail) Validate(strfmt.Registry) error {
+	return nil
+}
+
+func (self *PostureCheckSourceIpDetail) ContextValidate(context.Context, strfmt.Registry) error {
+	return nil
+}
+
+func (self *PostureCheckSourceIpDetail) Links() rest_model.Links {
+	return self.linksField
+}
+
+func (self *PostureCheckSourceIpDetail) SetLinks(val rest_model.Links) {
+	self.linksField = val
+}
+
+func (self *PostureCheckSourceIpDetail) CreatedAt() *strfmt.DateTime {
+	return self.createdAtField
+}
+
+func (self *PostureCheckSourceIpDetail) SetCreatedAt(val *strfmt.DateTime) {
+	self.createdAtField = val
+}
+
+func (self *PostureCheckSourceIpDetail) ID() *string {
+	return self.idField
+}
+
+func (self *PostureCheckSourceIpDetail) SetID(val *string) {
+	self.idField = val
+}
+
+func (self *PostureCheckSourceIpDetail) Name() *string {
+	return self.nameField
+}
+
+func (self *PostureCheckSourceIpDetail) SetName(val *string) {
+	self.nameField = val
+}
+
+func (self *PostureCheckSourceIpDetail) RoleAttributes() *rest_model.Attributes {
+	return self.roleAttributesField
+}
+
+func (self *PostureCheckSourceIpDetail) SetRoleAttributes(val *rest_model.Attributes) {
+	self.roleAttributesField = val
+}
+
+func (self *PostureCheckSourceIpDetail) Tags() *rest_model.Tags {
+	return self.tagsField
+}
+
+func (self *PostureCheckSourceIpDetail) SetTags(val *rest_model.Tags) {
+	self.tagsField = val
+}
+
+func (self *PostureCheckSourceIpDetail) TypeID() string {
+	return model.PostureCheckTypeSourceIp
+}
+
+// SetTypeID is a no-op, the type of source ip checks is fixed
+func (self *PostureCheckSourceIpDetail) SetTypeID(string) {
+}
+
+func (self *PostureCheckSourceIpDetail) UpdatedAt() *strfmt.DateTime {
+	return self.updatedAtField
+}
+
+func (self *PostureCheckSourceIpDetail) SetUpdatedAt(val *strfmt.DateTime) {
+	self.updatedAtField = val
+}
+
+func (self *PostureCheckSourceIpDetail) Version() *int64 {
+	return self.versionField
+}
+
+func (self *PostureCheckSourceIpDetail) SetVersion(val *int64) {
+	self.versionField = val
+}
+
+func (self *PostureCheckSourceIpDetail) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Links          rest_model.Links       `json:"_links"`
+		CreatedAt      *strfmt.DateTime       `json:"createdAt"`
+		ID             *string                `json:"id"`
+		Name           *string                `json:"name"`
+		RoleAttributes *rest_model.Attributes `json:"roleAttributes"`
+		Tags           *rest_model.Tags       `json:"tags"`
+		TypeID         string                 `json:"typeId"`
+		UpdatedAt      *strfmt.DateTime       `json:"updatedAt"`
+		Version        *int64                 `json:"version"`
+		AllowedCidrs   []string               `json:"allowedCidrs"`
+		DeniedCidrs    []string               `json:"deniedCidrs"`
+	}{
+		Links:          self.linksField,
+		CreatedAt:      self.createdAtField,
+		ID:             self.idField,
+		Name:           self.nameField,
+		RoleAttributes: self.roleAttributesField,
+		Tags:           self.tagsField,
+		TypeID:         self.TypeID(),
+		UpdatedAt:      self.updatedAtField,
+		Version:        self.versionField,
+		AllowedCidrs:   self.AllowedCidrs,
+		DeniedCidrs:    self.DeniedCidrs,
+	})
+}
+
+var _ rest_model.PostureCheckFailure = &PostureCheckFailureSourceIp{}
+
+// PostureCheckFailureSourceIp reports why a SOURCE_IP posture check failed in the failed service requests of an
+// identity's posture data
+type PostureCheckFailureSourceIp struct {
+	postureCheckIdField   *string
+	postureCheckNameField *string
+
+	ActualValue   string
+	ExpectedValue *PostureCheckFailureSourceIpExpected
+	Reason        string
+}
+
+type PostureCheckFailureSourceIpExpected struct {
+	AllowedCidrs []string `json:"allowedCidrs"`
+	DeniedCidrs  []string `json:"deniedCidrs"`
+}
+
+func (self *PostureCheckFailureSourceIp) Validate(strfmt.Registry) error {
+	return nil
+}
+
+func (self *PostureCheckFailureSourceIp) ContextValidate(context.Context, strfmt.Registry) error {
+	return nil
+}
+
+func (self *PostureCheckFailureSourceIp) PostureCheckID() *string {
+	return self.postureCheckIdField
+}
+
+func (self *PostureCheckFailureSourceIp) SetPostureCheckID(val *string) {
+	self.postureCheckIdField = val
+}
+
+func (self *PostureCheckFailureSourceIp) PostureCheckName() *string {
+	return self.postureCheckNameField
+}
+
+func (self *PostureCheckFailureSourceIp) SetPostureCheckName(val *string) {
+	self.postureCheckNameField = val
+}
+
+func (self *PostureCheckFailureSourceIp) PostureCheckType() string {
+	return model.PostureCheckTypeSourceIp
+}
+
+// SetPostureCheckType is a no-op, the type of source ip checks is fixed
+func (self *PostureCheckFailureSourceIp) SetPostureCheckType(string) {
+}
+
+func (self *PostureCheckFailureSourceIp) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		PostureCheckID   *string                              `json:"postureCheckId"`
+		PostureCheckName *string                              `json:"postureCheckName"`
+		PostureCheckType string                               `json:"postureCheckType"`
+		ActualValue      string                               `json:"actualValue"`
+		ExpectedValue    *PostureCheckFailureSourceIpExpected `json:"expectedValue"`
+		Reason           string                               `json:"reason"`
+	}{
+		PostureCheckID:   self.postureCheckIdField,
+		PostureCheckName: self.postureCheckNameField,
+		PostureCheckType: self.PostureCheckType(),
+		ActualValue:      self.ActualValue,
+		ExpectedValue:    self.ExpectedValue,
+		Reason:           self.Reason,
+	})
+}
+
+func MapPostureCheckFailureSourceIpToRestModel(failure *model.PostureCheckFailure) *PostureCheckFailureSourceIp {
+	ret := &PostureCheckFailureSourceIp{}
+	ret.SetPostureCheckID(&failure.PostureCheckId)
+	ret.SetPostureCheckName(&failure.PostureCheckName)
+
+	if val, ok := failure.PostureCheckFailureValues.(*model.PostureCheckFailureValuesSourceIp); ok {
+		ret.ActualValue = val.ActualValue
+		ret.ExpectedValue = &PostureCheckFailureSourceIpExpected{
+			AllowedCidrs: val.ExpectedValue.AllowedCidrs,
+			DeniedCidrs:  val.ExpectedValue.DeniedCidrs,
+		}
+		ret.Reason = val.Reason
+	}
+
+	return ret
+}
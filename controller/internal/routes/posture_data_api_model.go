@@ -374,6 +374,10 @@ func MapPostureDataFailedSessionRequestToRestModel(modelFailedSessionRequests []
 					checks = append(checks, MapPostureCheckFailureOsToRestModel(pdCheck))
 				case string(rest_model.PostureCheckTypeMFA):
 					checks = append(checks, MapPostureCheckFailureMfaToRestModel(pdCheck))
+				case model.PostureCheckTypeSdkVersion:
+					checks = append(checks, MapPostureCheckFailureSdkVersionToRestModel(pdCheck))
+				case model.PostureCheckTypeSourceIp:
+					checks = append(checks, MapPostureCheckFailureSourceIpToRestModel(pdCheck))
 				}
 				policyFailure.SetChecks(checks)
 			}
@@ -205,6 +205,21 @@ func MapServiceToRestModel(ae *env.AppEnv, rc *response.RequestContext, service
 			query.IsPassing = &isCheckPassing
 			query.TimeoutRemaining = &timeoutRemaining
 			query.Timeout = &timeout
+
+			// checks within their grace period still grant access, but are reported as failing with the grace period
+			// as the timeout so SDKs prompt the user to remediate before access is cut
+			if graceExpiresAt := ae.Managers.PostureResponse.GetGraceExpiresAt(rc.Identity.Id, rc.ApiSession.Id, postureCheck); graceExpiresAt != nil {
+				isQueryPassing := false
+				gracePeriod := postureCheck.GracePeriodSeconds
+				graceRemaining := int64(time.Until(*graceExpiresAt).Seconds())
+				if graceRemaining < 0 {
+					graceRemaining = 0
+				}
+				query.IsPassing = &isQueryPassing
+				query.TimeoutRemaining = &graceRemaining
+				query.Timeout = &gracePeriod
+			}
+
 			querySet.PostureQueries = append(querySet.PostureQueries, query)
 
 			if !isCheckPassing {
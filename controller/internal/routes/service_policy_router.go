@@ -17,13 +17,17 @@
 package routes
 
 import (
+	"encoding/json"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/openziti/edge-api/rest_management_api_server/operations/service_policy"
+	"github.com/openziti/ziti/common/schedule"
+	"github.com/openziti/ziti/controller/apierror"
 	"github.com/openziti/ziti/controller/env"
 	"github.com/openziti/ziti/controller/internal/permissions"
 	"github.com/openziti/ziti/controller/model"
 	"github.com/openziti/ziti/controller/response"
 	"github.com/openziti/ziti/controller/fields"
+	"net/http"
 )
 
 func init() {
@@ -79,6 +83,28 @@ func (r *ServicePolicyRouter) Register(ae *env.AppEnv) {
 	ae.ManagementApi.ServicePolicyListServicePolicyPostureChecksHandler = service_policy.ListServicePolicyPostureChecksHandlerFunc(func(params service_policy.ListServicePolicyPostureChecksParams, _ interface{}) middleware.Responder {
 		return ae.IsAllowed(r.ListPostureChecks, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
 	})
+
+	//Not yet in the edge-api spec
+	ae.ManagementApiRoutes.Add(&env.ApiRoute{
+		Method:      http.MethodPut,
+		Path:        r.BasePath + "/{id}/effect",
+		Handler:     r.UpdateEffect,
+		Permissions: []permissions.Resolver{permissions.IsAdmin()},
+	})
+
+	ae.ManagementApiRoutes.Add(&env.ApiRoute{
+		Method:      http.MethodGet,
+		Path:        r.BasePath + "/{id}/schedule",
+		Handler:     r.DetailSchedule,
+		Permissions: []permissions.Resolver{permissions.IsAdmin()},
+	})
+
+	ae.ManagementApiRoutes.Add(&env.ApiRoute{
+		Method:      http.MethodPut,
+		Path:        r.BasePath + "/{id}/schedule",
+		Handler:     r.UpdateSchedule,
+		Permissions: []permissions.Resolver{permissions.IsAdmin()},
+	})
 }
 
 func (r *ServicePolicyRouter) List(ae *env.AppEnv, rc *response.RequestContext) {
@@ -122,3 +148,34 @@ func (r *ServicePolicyRouter) ListIdentities(ae *env.AppEnv, rc *response.Reques
 func (r *ServicePolicyRouter) ListPostureChecks(ae *env.AppEnv, rc *response.RequestContext) {
 	ListAssociationWithHandler[*model.ServicePolicy, *model.PostureCheck](ae, rc, ae.Managers.ServicePolicy, ae.Managers.PostureCheck, MapPostureCheckToRestEntity)
 }
+
+type servicePolicyEffect struct {
+	Effect string `json:"effect"`
+}
+
+// UpdateEffect sets whether a service policy allows or denies access
+func (r *ServicePolicyRouter) UpdateEffect(ae *env.AppEnv, rc *response.RequestContext) {
+	effect := &servicePolicyEffect{}
+	if err := json.Unmarshal(rc.Body, effect); err != nil {
+		rc.RespondWithApiError(apierror.NewCouldNotParseBody(err))
+		return
+	}
+
+	Update(rc, func(id string) error {
+		return ae.Managers.ServicePolicy.UpdateEffect(id, effect.Effect, rc.NewChangeContext())
+	})
+}
+
+func (r *ServicePolicyRouter) DetailSchedule(ae *env.AppEnv, rc *response.RequestContext) {
+	detailPolicySchedule(rc, func(id string) (string, *schedule.Schedule, error) {
+		policy, err := ae.Managers.ServicePolicy.Read(id)
+		if err != nil {
+			return "", nil, err
+		}
+		return policy.Name, policy.Schedule, nil
+	})
+}
+
+func (r *ServicePolicyRouter) UpdateSchedule(ae *env.AppEnv, rc *response.RequestContext) {
+	updatePolicySchedule(rc, ae.Managers.ServicePolicy)
+}
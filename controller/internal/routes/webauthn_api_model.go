@@ -0,0 +1,73 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package routes
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/ziti/controller/model"
+	"github.com/openziti/ziti/controller/response"
+)
+
+// The WebAuthn types aren't part of the edge client API spec yet. Binary values are exchanged base64url encoded.
+
+type webAuthnRegistration struct {
+	Name              string `json:"name"`
+	ClientDataJSON    string `json:"clientDataJSON"`
+	AttestationObject string `json:"attestationObject"`
+}
+
+type WebAuthnRegistrationDetail struct {
+	Credential    *WebAuthnCredentialDetail `json:"credential"`
+	RecoveryCodes []string                  `json:"recoveryCodes,omitempty"`
+}
+
+type WebAuthnCredentialDetail struct {
+	Id        string          `json:"id"`
+	Name      string          `json:"name"`
+	Algorithm int64           `json:"algorithm"`
+	Aaguid    string          `json:"aaguid"`
+	CreatedAt strfmt.DateTime `json:"createdAt"`
+}
+
+type webAuthnAssertion struct {
+	Id                string `json:"id"`
+	ClientDataJSON    string `json:"clientDataJSON"`
+	AuthenticatorData string `json:"authenticatorData"`
+	Signature         string `json:"signature"`
+}
+
+func MapWebAuthnCredentialToRestModel(credential *model.WebAuthnCredential) *WebAuthnCredentialDetail {
+	return &WebAuthnCredentialDetail{
+		Id:        credential.Id,
+		Name:      credential.Name,
+		Algorithm: credential.Algorithm,
+		Aaguid:    credential.Aaguid,
+		CreatedAt: strfmt.DateTime(credential.CreatedAt),
+	}
+}
+
+// decodeWebAuthnField decodes a base64url encoded field, responding with a field error and returning false if it
+// can't be decoded
+func decodeWebAuthnField(rc *response.RequestContext, field, value string) ([]byte, bool) {
+	result, err := model.DecodeWebAuthnValue(value)
+	if value == "" || err != nil {
+		rc.RespondWithFieldError(errorz.NewFieldError("base64url encoded value expected", field, value))
+		return nil, false
+	}
+	return result, true
+}
@@ -0,0 +1,61 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/controller/db"
+	"github.com/openziti/ziti/controller/models"
+	"go.etcd.io/bbolt"
+)
+
+type BandwidthLimit struct {
+	models.BaseEntity
+	Name               string
+	IdentityRoles      []string
+	ServiceRoles       []string
+	RateBytesPerSecond int64
+	BurstBytes         int64
+	Scope              string
+}
+
+func (entity *BandwidthLimit) fillFrom(_ Env, _ *bbolt.Tx, boltBandwidthLimit *db.BandwidthLimit) error {
+	entity.FillCommon(boltBandwidthLimit)
+	entity.Name = boltBandwidthLimit.Name
+	entity.IdentityRoles = boltBandwidthLimit.IdentityRoles
+	entity.ServiceRoles = boltBandwidthLimit.ServiceRoles
+	entity.RateBytesPerSecond = boltBandwidthLimit.RateBytesPerSecond
+	entity.BurstBytes = boltBandwidthLimit.BurstBytes
+	entity.Scope = boltBandwidthLimit.Scope
+	return nil
+}
+
+func (entity *BandwidthLimit) toBoltEntityForCreate(*bbolt.Tx, Env) (*db.BandwidthLimit, error) {
+	return &db.BandwidthLimit{
+		BaseExtEntity:      *boltz.NewExtEntity(entity.Id, entity.Tags),
+		Name:               entity.Name,
+		IdentityRoles:      entity.IdentityRoles,
+		ServiceRoles:       entity.ServiceRoles,
+		RateBytesPerSecond: entity.RateBytesPerSecond,
+		BurstBytes:         entity.BurstBytes,
+		Scope:              entity.Scope,
+	}, nil
+}
+
+func (entity *BandwidthLimit) toBoltEntityForUpdate(tx *bbolt.Tx, env Env, _ boltz.FieldChecker) (*db.BandwidthLimit, error) {
+	return entity.toBoltEntityForCreate(tx, env)
+}
@@ -0,0 +1,339 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"fmt"
+	"github.com/michaelquigley/pfxlog"
+	nfpem "github.com/openziti/foundation/v2/pem"
+	"github.com/openziti/foundation/v2/stringz"
+	"github.com/openziti/storage/ast"
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/controller/db"
+	cmap "github.com/orcaman/concurrent-map/v2"
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+	"golang.org/x/crypto/ocsp"
+	"math/big"
+	"sync"
+	"time"
+)
+
+// NewCertificateRevocationManager returns a manager for the certificate revocations recorded when identities and
+// routers are deleted or re-enrolled. Revocations are written by the store as part of those changes, so this manager
+// only reads them.
+func NewCertificateRevocationManager(env Env) *CertificateRevocationManager {
+	manager := &CertificateRevocationManager{
+		baseEntityManager: newBaseEntityManager[*CertificateRevocation, *db.CertificateRevocation](env, env.GetStores().CertificateRevocation),
+		crls:              cmap.New[*cachedResponse](),
+		ocspResponses:     cmap.New[*cachedResponse](),
+	}
+	manager.impl = manager
+
+	manager.Store.AddEntityIdListener(func(string) {
+		manager.crls.Clear()
+		manager.ocspResponses.Clear()
+	}, boltz.EntityCreated, boltz.EntityDeleted)
+
+	// certificates being issued or replaced change which serial numbers are known
+	invalidateIssued := func(string) {
+		manager.clearIssued()
+	}
+	env.GetStores().Authenticator.AddEntityIdListener(invalidateIssued, boltz.EntityCreated, boltz.EntityUpdated, boltz.EntityDeleted)
+	env.GetStores().EdgeRouter.AddEntityIdListener(invalidateIssued, boltz.EntityCreated, boltz.EntityUpdated, boltz.EntityDeleted)
+
+	return manager
+}
+
+type CertificateRevocationManager struct {
+	baseEntityManager[*CertificateRevocation, *db.CertificateRevocation]
+	crls          cmap.ConcurrentMap[string, *cachedResponse]
+	ocspResponses cmap.ConcurrentMap[string, *cachedResponse]
+
+	issuedLock sync.Mutex
+	issued     map[string]struct{}
+}
+
+type cachedResponse struct {
+	der       []byte
+	refreshAt time.Time
+}
+
+func (self *CertificateRevocationManager) newModelEntity() *CertificateRevocation {
+	return &CertificateRevocation{}
+}
+
+// ReadByCertificate returns the revocation of the certificate issued by the given issuer with the given serial number
+// or nil if the certificate hasn't been revoked
+func (self *CertificateRevocationManager) ReadByCertificate(issuer *x509.Certificate, serialNumber *big.Int) (*CertificateRevocation, error) {
+	id := db.CertificateRevocationId(db.CertificateIssuerHash(issuer.RawSubject), fmt.Sprintf("%X", serialNumber))
+
+	revocation, err := self.Read(id)
+	if err != nil {
+		if boltz.IsErrNotFoundErr(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return revocation, nil
+}
+
+// ListForIssuer returns the revocations of unexpired certificates issued by the given issuer
+func (self *CertificateRevocationManager) ListForIssuer(issuer *x509.Certificate) ([]*CertificateRevocation, error) {
+	query := fmt.Sprintf(`%s = "%s" and %s > datetime(%s) limit none`,
+		db.FieldCertificateRevocationIssuer, db.CertificateIssuerHash(issuer.RawSubject),
+		db.FieldCertificateRevocationNotAfter, time.Now().UTC().Format(time.RFC3339))
+
+	var result []*CertificateRevocation
+	err := self.GetDb().View(func(tx *bbolt.Tx) error {
+		ids, _, err := self.Store.QueryIds(tx, query)
+		if err != nil {
+			return err
+		}
+
+		for _, id := range ids {
+			revocation, err := self.readInTx(tx, id)
+			if err != nil {
+				return err
+			}
+			result = append(result, revocation)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetCrl returns a DER encoded CRL of the unexpired certificates revoked for the given issuer, signed by the issuer.
+// The CRL is reused until a revocation is recorded or half of its validity has passed.
+func (self *CertificateRevocationManager) GetCrl(issuer *x509.Certificate, key crypto.Signer, validity time.Duration) ([]byte, error) {
+	issuerHash := db.CertificateIssuerHash(issuer.RawSubject)
+	now := time.Now()
+
+	if cached, found := self.crls.Get(issuerHash); found && now.Before(cached.refreshAt) {
+		return cached.der, nil
+	}
+
+	revocations, err := self.ListForIssuer(issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	template := &x509.RevocationList{
+		// CRL numbers must increase with each CRL issued
+		Number:     big.NewInt(now.UnixMilli()),
+		ThisUpdate: now,
+		NextUpdate: now.Add(validity),
+	}
+
+	for _, revocation := range revocations {
+		serialNumber, err := revocation.GetSerialNumber()
+		if err != nil {
+			pfxlog.Logger().WithError(err).Error("skipping certificate revocation in CRL")
+			continue
+		}
+
+		template.RevokedCertificateEntries = append(template.RevokedCertificateEntries, x509.RevocationListEntry{
+			SerialNumber:   serialNumber,
+			RevocationTime: revocation.CreatedAt,
+			ReasonCode:     int(revocation.Reason),
+		})
+	}
+
+	der, err := x509.CreateRevocationList(rand.Reader, template, issuer, key)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create certificate revocation list")
+	}
+
+	self.crls.Set(issuerHash, &cachedResponse{
+		der:       der,
+		refreshAt: now.Add(validity / 2),
+	})
+
+	return der, nil
+}
+
+// RespondOcsp returns a DER encoded OCSP response for a DER encoded OCSP request, signed by the given issuer. Requests
+// for certificates of other issuers are answered as unauthorized. Certificates held by an identity or edge router are
+// reported as good unless revoked, and all other serial numbers as unknown. Responses are reused until a revocation is
+// recorded, a certificate is issued or replaced, or half of their validity has passed. The error is only set for
+// internal failures, in which case an internal error response is returned.
+func (self *CertificateRevocationManager) RespondOcsp(issuer *x509.Certificate, key crypto.Signer, validity time.Duration, requestDer []byte) ([]byte, error) {
+	request, err := ocsp.ParseRequest(requestDer)
+	if err != nil {
+		return ocsp.MalformedRequestErrorResponse, nil
+	}
+
+	if !isOcspRequestForIssuer(request, issuer) {
+		return ocsp.UnauthorizedErrorResponse, nil
+	}
+
+	// the issuer hashes in the response use the request's algorithm, so it is part of the cache key
+	cacheKey := fmt.Sprintf("%d:%X", request.HashAlgorithm, request.SerialNumber)
+	now := time.Now()
+
+	if cached, found := self.ocspResponses.Get(cacheKey); found && now.Before(cached.refreshAt) {
+		return cached.der, nil
+	}
+
+	revocation, err := self.ReadByCertificate(issuer, request.SerialNumber)
+	if err != nil {
+		return ocsp.InternalErrorErrorResponse, err
+	}
+
+	template := ocsp.Response{
+		Status:       ocsp.Good,
+		SerialNumber: request.SerialNumber,
+		ThisUpdate:   now,
+		NextUpdate:   now.Add(validity),
+		IssuerHash:   request.HashAlgorithm,
+	}
+
+	if revocation != nil {
+		template.Status = ocsp.Revoked
+		template.RevokedAt = revocation.CreatedAt
+		template.RevocationReason = int(revocation.Reason)
+	} else {
+		issued, err := self.isIssued(issuer, request.SerialNumber)
+		if err != nil {
+			return ocsp.InternalErrorErrorResponse, err
+		}
+		if !issued {
+			template.Status = ocsp.Unknown
+		}
+	}
+
+	response, err := ocsp.CreateResponse(issuer, issuer, template, key)
+	if err != nil {
+		return ocsp.InternalErrorErrorResponse, errors.Wrap(err, "could not create ocsp response")
+	}
+
+	self.ocspResponses.Set(cacheKey, &cachedResponse{
+		der:       response,
+		refreshAt: now.Add(validity / 2),
+	})
+
+	return response, nil
+}
+
+// isIssued checks whether a certificate with the given issuer and serial number is currently held by an identity
+// authenticator or an edge router. The known serial numbers are loaded once and kept until a certificate changes.
+func (self *CertificateRevocationManager) isIssued(issuer *x509.Certificate, serialNumber *big.Int) (bool, error) {
+	self.issuedLock.Lock()
+	defer self.issuedLock.Unlock()
+
+	if self.issued == nil {
+		issued, err := self.loadIssued()
+		if err != nil {
+			return false, err
+		}
+		self.issued = issued
+	}
+
+	id := db.CertificateRevocationId(db.CertificateIssuerHash(issuer.RawSubject), fmt.Sprintf("%X", serialNumber))
+	_, found := self.issued[id]
+	return found, nil
+}
+
+func (self *CertificateRevocationManager) clearIssued() {
+	self.issuedLock.Lock()
+	defer self.issuedLock.Unlock()
+	self.issued = nil
+	self.ocspResponses.Clear()
+}
+
+// loadIssued collects the issuer and serial number of every certificate held by an authenticator or edge router,
+// keyed the same way as revocations
+func (self *CertificateRevocationManager) loadIssued() (map[string]struct{}, error) {
+	result := map[string]struct{}{}
+
+	addPem := func(certPem string) {
+		if certPem == "" {
+			return
+		}
+		if certs := nfpem.PemStringToCertificates(certPem); len(certs) > 0 {
+			cert := certs[0]
+			id := db.CertificateRevocationId(db.CertificateIssuerHash(cert.RawIssuer), fmt.Sprintf("%X", cert.SerialNumber))
+			result[id] = struct{}{}
+		}
+	}
+
+	stores := self.env.GetStores()
+	err := self.GetDb().View(func(tx *bbolt.Tx) error {
+		for cursor := stores.Authenticator.IterateIds(tx, ast.BoolNodeTrue); cursor.IsValid(); cursor.Next() {
+			authenticator, err := stores.Authenticator.LoadById(tx, string(cursor.Current()))
+			if err != nil {
+				return err
+			}
+			if authCert, ok := authenticator.SubType.(*db.AuthenticatorCert); ok {
+				addPem(authCert.Pem)
+				addPem(authCert.UnverifiedPem)
+			}
+		}
+
+		for cursor := stores.EdgeRouter.IterateIds(tx, ast.BoolNodeTrue); cursor.IsValid(); cursor.Next() {
+			edgeRouter, err := stores.EdgeRouter.LoadById(tx, string(cursor.Current()))
+			if err != nil {
+				return err
+			}
+			addPem(stringz.OrEmpty(edgeRouter.CertPem))
+			addPem(stringz.OrEmpty(edgeRouter.UnverifiedCertPem))
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// isOcspRequestForIssuer checks the issuer name and key hashes in the request against the given issuer
+func isOcspRequestForIssuer(request *ocsp.Request, issuer *x509.Certificate) bool {
+	if !request.HashAlgorithm.Available() {
+		return false
+	}
+
+	var publicKeyInfo struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}
+
+	if _, err := asn1.Unmarshal(issuer.RawSubjectPublicKeyInfo, &publicKeyInfo); err != nil {
+		return false
+	}
+
+	nameHash := request.HashAlgorithm.New()
+	nameHash.Write(issuer.RawSubject)
+
+	keyHash := request.HashAlgorithm.New()
+	keyHash.Write(publicKeyInfo.PublicKey.RightAlign())
+
+	return bytes.Equal(nameHash.Sum(nil), request.IssuerNameHash) && bytes.Equal(keyHash.Sum(nil), request.IssuerKeyHash)
+}
@@ -0,0 +1,103 @@
+package model
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/storage/boltztest"
+	"github.com/openziti/ziti/common/eid"
+	"github.com/openziti/ziti/controller/db"
+	"golang.org/x/crypto/ocsp"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestCertificateRevocationManager(t *testing.T) {
+	ctx := NewTestContext(t)
+	defer ctx.Cleanup()
+	ctx.Init()
+
+	t.Run("ocsp responses report issued, revoked and unknown certificates", ctx.testRespondOcsp)
+}
+
+func (ctx *TestContext) testRespondOcsp(*testing.T) {
+	issuerKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	ctx.NoError(err)
+
+	issuerTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	issuerDer, err := x509.CreateCertificate(rand.Reader, issuerTemplate, issuerTemplate, issuerKey.Public(), issuerKey)
+	ctx.NoError(err)
+	issuer, err := x509.ParseCertificate(issuerDer)
+	ctx.NoError(err)
+
+	issue := func(serial int64) (string, *x509.Certificate) {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		ctx.NoError(err)
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(serial),
+			Subject:      pkix.Name{CommonName: eid.New()},
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+			KeyUsage:     x509.KeyUsageDigitalSignature,
+			ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, issuer, key.Public(), issuerKey)
+		ctx.NoError(err)
+		cert, err := x509.ParseCertificate(der)
+		ctx.NoError(err)
+		return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})), cert
+	}
+
+	respond := func(cert *x509.Certificate) *ocsp.Response {
+		request, err := ocsp.CreateRequest(cert, issuer, &ocsp.RequestOptions{Hash: crypto.SHA256})
+		ctx.NoError(err)
+		der, err := ctx.managers.CertificateRevocation.RespondOcsp(issuer, issuerKey, time.Hour, request)
+		ctx.NoError(err)
+		response, err := ocsp.ParseResponseForCert(der, cert, issuer)
+		ctx.NoError(err)
+		return response
+	}
+
+	certPem, cert := issue(2)
+	_, unknownCert := issue(3)
+
+	ctx.Equal(ocsp.Unknown, respond(cert).Status)
+	ctx.Equal(ocsp.Unknown, respond(unknownCert).Status)
+
+	identity := ctx.requireNewIdentity(false)
+	authenticator := &db.Authenticator{
+		BaseExtEntity: boltz.BaseExtEntity{Id: eid.New()},
+		Type:          db.MethodAuthenticatorCert,
+		IdentityId:    identity.Id,
+		SubType:       &db.AuthenticatorCert{Pem: certPem},
+	}
+	boltztest.RequireCreate(ctx, authenticator)
+
+	// issuing a certificate replaces the cached unknown response
+	good := respond(cert)
+	ctx.Equal(ocsp.Good, good.Status)
+	ctx.Equal(ocsp.Unknown, respond(unknownCert).Status)
+
+	// responses are reused until something changes
+	ctx.Equal(good.ThisUpdate, respond(cert).ThisUpdate)
+
+	boltztest.RequireDelete(ctx, authenticator)
+
+	revoked := respond(cert)
+	ctx.Equal(ocsp.Revoked, revoked.Status)
+	ctx.Equal(ocsp.CessationOfOperation, revoked.RevocationReason)
+}
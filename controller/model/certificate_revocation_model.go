@@ -0,0 +1,76 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/controller/db"
+	"github.com/openziti/ziti/controller/models"
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+	"math/big"
+	"time"
+)
+
+type CertificateRevocation struct {
+	models.BaseEntity
+	SerialNumber string
+	Issuer       string
+	Fingerprint  string
+	Reason       int64
+	NotAfter     time.Time
+	OwnerType    string
+	OwnerId      string
+}
+
+// GetSerialNumber returns the revoked certificate's serial number, which is stored hex encoded
+func (entity *CertificateRevocation) GetSerialNumber() (*big.Int, error) {
+	serialNumber, ok := new(big.Int).SetString(entity.SerialNumber, 16)
+	if !ok {
+		return nil, errors.Errorf("invalid serial number %s for certificate revocation %s", entity.SerialNumber, entity.Id)
+	}
+	return serialNumber, nil
+}
+
+func (entity *CertificateRevocation) toBoltEntityForUpdate(tx *bbolt.Tx, env Env, _ boltz.FieldChecker) (*db.CertificateRevocation, error) {
+	return entity.toBoltEntityForCreate(tx, env)
+}
+
+func (entity *CertificateRevocation) fillFrom(_ Env, _ *bbolt.Tx, boltEntity *db.CertificateRevocation) error {
+	entity.FillCommon(boltEntity)
+	entity.SerialNumber = boltEntity.SerialNumber
+	entity.Issuer = boltEntity.Issuer
+	entity.Fingerprint = boltEntity.Fingerprint
+	entity.Reason = boltEntity.Reason
+	entity.NotAfter = boltEntity.NotAfter
+	entity.OwnerType = boltEntity.OwnerType
+	entity.OwnerId = boltEntity.OwnerId
+	return nil
+}
+
+func (entity *CertificateRevocation) toBoltEntityForCreate(*bbolt.Tx, Env) (*db.CertificateRevocation, error) {
+	return &db.CertificateRevocation{
+		BaseExtEntity: *boltz.NewExtEntity(entity.Id, entity.Tags),
+		SerialNumber:  entity.SerialNumber,
+		Issuer:        entity.Issuer,
+		Fingerprint:   entity.Fingerprint,
+		Reason:        entity.Reason,
+		NotAfter:      entity.NotAfter,
+		OwnerType:     entity.OwnerType,
+		OwnerId:       entity.OwnerId,
+	}, nil
+}
@@ -0,0 +1,142 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"time"
+
+	"github.com/openziti/ziti/controller/db"
+	"github.com/pkg/errors"
+)
+
+const (
+	// DeviceKeyChallengeSize is the number of random bytes in a device key challenge
+	DeviceKeyChallengeSize = 32
+
+	// DeviceKeyChallengeDuration is how long an endpoint has to sign a device key challenge
+	DeviceKeyChallengeDuration = 2 * time.Minute
+)
+
+// DeviceKey is the public half of a key bound to the device an identity runs on. It is enrolled separately from the
+// identity's certificate, so possession of the identity file alone is not enough to prove the device key.
+type DeviceKey struct {
+	PublicKey   string
+	Fingerprint string
+	EnrolledAt  time.Time
+}
+
+func (self *DeviceKey) toBolt() *db.DeviceKey {
+	if self == nil {
+		return nil
+	}
+	return &db.DeviceKey{
+		PublicKey:   self.PublicKey,
+		Fingerprint: self.Fingerprint,
+		EnrolledAt:  self.EnrolledAt,
+	}
+}
+
+func newDeviceKeyFromBolt(deviceKey *db.DeviceKey) *DeviceKey {
+	if deviceKey == nil {
+		return nil
+	}
+	return &DeviceKey{
+		PublicKey:   deviceKey.PublicKey,
+		Fingerprint: deviceKey.Fingerprint,
+		EnrolledAt:  deviceKey.EnrolledAt,
+	}
+}
+
+// DeviceKeyChallenge is a nonce issued to an API session which must be signed with the identity's device key
+type DeviceKeyChallenge struct {
+	Challenge []byte
+	ExpiresAt time.Time
+}
+
+func (self *DeviceKeyChallenge) EncodedChallenge() string {
+	return base64.StdEncoding.EncodeToString(self.Challenge)
+}
+
+func newDeviceKeyChallenge() (*DeviceKeyChallenge, error) {
+	challenge := make([]byte, DeviceKeyChallengeSize)
+	if _, err := rand.Read(challenge); err != nil {
+		return nil, errors.Wrap(err, "unable to generate device key challenge")
+	}
+
+	return &DeviceKeyChallenge{
+		Challenge: challenge,
+		ExpiresAt: time.Now().Add(DeviceKeyChallengeDuration),
+	}, nil
+}
+
+// ParseDeviceKey parses a PEM encoded PKIX public key. ECDSA, RSA and Ed25519 keys are supported.
+func ParseDeviceKey(publicKeyPem string) (crypto.PublicKey, []byte, error) {
+	block, _ := pem.Decode([]byte(publicKeyPem))
+	if block == nil {
+		return nil, nil, errors.New("device key is not PEM encoded")
+	}
+
+	publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "unable to parse device key")
+	}
+
+	switch publicKey.(type) {
+	case *ecdsa.PublicKey, *rsa.PublicKey, ed25519.PublicKey:
+		return publicKey, block.Bytes, nil
+	default:
+		return nil, nil, errors.Errorf("unsupported device key type %T", publicKey)
+	}
+}
+
+// VerifyDeviceKeySignature checks that the signature over the challenge was made with the private half of the given
+// device key. ECDSA signatures are ASN.1 encoded and, like RSA PKCS #1 v1.5 signatures, are made over the SHA-256
+// digest of the challenge. Ed25519 signatures are made over the challenge itself.
+func VerifyDeviceKeySignature(publicKeyPem string, challenge, signature []byte) error {
+	publicKey, _, err := ParseDeviceKey(publicKeyPem)
+	if err != nil {
+		return err
+	}
+
+	digest := sha256.Sum256(challenge)
+
+	switch key := publicKey.(type) {
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(key, digest[:], signature) {
+			return errors.New("invalid device key signature")
+		}
+	case *rsa.PublicKey:
+		if err = rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature); err != nil {
+			return errors.New("invalid device key signature")
+		}
+	case ed25519.PublicKey:
+		if !ed25519.Verify(key, challenge, signature) {
+			return errors.New("invalid device key signature")
+		}
+	}
+
+	return nil
+}
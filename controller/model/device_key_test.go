@@ -0,0 +1,100 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyDeviceKeySignature(t *testing.T) {
+	challenge := []byte("device key challenge")
+	digest := sha256.Sum256(challenge)
+
+	t.Run("ecdsa", func(t *testing.T) {
+		req := require.New(t)
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		req.NoError(err)
+
+		signature, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+		req.NoError(err)
+
+		publicKeyPem := newDeviceKeyTestPem(t, key.Public())
+		req.NoError(VerifyDeviceKeySignature(publicKeyPem, challenge, signature))
+		req.Error(VerifyDeviceKeySignature(publicKeyPem, []byte("other challenge"), signature))
+	})
+
+	t.Run("rsa", func(t *testing.T) {
+		req := require.New(t)
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		req.NoError(err)
+
+		signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+		req.NoError(err)
+
+		publicKeyPem := newDeviceKeyTestPem(t, key.Public())
+		req.NoError(VerifyDeviceKeySignature(publicKeyPem, challenge, signature))
+		req.Error(VerifyDeviceKeySignature(publicKeyPem, []byte("other challenge"), signature))
+	})
+
+	t.Run("ed25519", func(t *testing.T) {
+		req := require.New(t)
+		publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+		req.NoError(err)
+
+		signature := ed25519.Sign(privateKey, challenge)
+
+		publicKeyPem := newDeviceKeyTestPem(t, publicKey)
+		req.NoError(VerifyDeviceKeySignature(publicKeyPem, challenge, signature))
+		req.Error(VerifyDeviceKeySignature(publicKeyPem, []byte("other challenge"), signature))
+	})
+
+	t.Run("signature from another key", func(t *testing.T) {
+		req := require.New(t)
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		req.NoError(err)
+
+		otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		req.NoError(err)
+
+		signature, err := ecdsa.SignASN1(rand.Reader, otherKey, digest[:])
+		req.NoError(err)
+
+		req.Error(VerifyDeviceKeySignature(newDeviceKeyTestPem(t, key.Public()), challenge, signature))
+	})
+
+	t.Run("not PEM encoded", func(t *testing.T) {
+		req := require.New(t)
+		req.Error(VerifyDeviceKeySignature("not a key", challenge, []byte("signature")))
+	})
+}
+
+func newDeviceKeyTestPem(t *testing.T, publicKey crypto.PublicKey) string {
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
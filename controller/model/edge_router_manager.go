@@ -29,6 +29,7 @@ import (
 	"github.com/openziti/ziti/controller/network"
 	"google.golang.org/protobuf/proto"
 	"strconv"
+	"time"
 
 	"github.com/michaelquigley/pfxlog"
 	"github.com/openziti/storage/boltz"
@@ -205,7 +206,10 @@ func (self *EdgeRouterManager) ListForIdentityAndService(identityId, serviceId s
 func (self *EdgeRouterManager) ListForIdentityAndServiceWithTx(tx *bbolt.Tx, identityId, serviceId string, limit *int) (*EdgeRouterListResult, error) {
 	query := fmt.Sprintf(`anyOf(identities) = "%v" and anyOf(services) = "%v"`, identityId, serviceId)
 
-	if limit != nil {
+	access := self.newEdgeRouterAccess(tx, identityId, time.Now())
+
+	// if some of the identity's edge router policies are out of schedule, the limit is applied after filtering
+	if limit != nil && !access.hasOutOfSchedule {
 		query += " limit " + strconv.Itoa(*limit)
 	}
 
@@ -213,18 +217,31 @@ func (self *EdgeRouterManager) ListForIdentityAndServiceWithTx(tx *bbolt.Tx, ide
 	if err := self.ListWithTx(tx, query, result.collect); err != nil {
 		return nil, err
 	}
+
+	if access.hasOutOfSchedule {
+		var edgeRouters []*EdgeRouter
+		for _, edgeRouter := range result.EdgeRouters {
+			if access.isAllowed(tx, []byte(edgeRouter.Id)) {
+				edgeRouters = append(edgeRouters, edgeRouter)
+			}
+		}
+		if limit != nil && len(edgeRouters) > *limit {
+			edgeRouters = edgeRouters[:*limit]
+		}
+		result.EdgeRouters = edgeRouters
+		result.Count = int64(len(edgeRouters))
+	}
+
 	return result, nil
 }
 
 func (self *EdgeRouterManager) IsAccessToEdgeRouterAllowed(identityId, serviceId, edgeRouterId string) (bool, error) {
 	var result bool
 	err := self.GetDb().View(func(tx *bbolt.Tx) error {
-		identityEdgeRouters := self.env.GetStores().Identity.GetRefCountedLinkCollection(db.EntityTypeRouters)
 		serviceEdgeRouters := self.env.GetStores().EdgeService.GetRefCountedLinkCollection(db.FieldEdgeRouters)
 
-		identityCount := identityEdgeRouters.GetLinkCount(tx, []byte(identityId), []byte(edgeRouterId))
 		serviceCount := serviceEdgeRouters.GetLinkCount(tx, []byte(serviceId), []byte(edgeRouterId))
-		result = identityCount != nil && *identityCount > 0 && serviceCount != nil && *serviceCount > 0
+		result = serviceCount != nil && *serviceCount > 0 && self.newEdgeRouterAccess(tx, identityId, time.Now()).isAllowed(tx, []byte(edgeRouterId))
 		return nil
 	})
 	if err != nil {
@@ -236,13 +253,13 @@ func (self *EdgeRouterManager) IsAccessToEdgeRouterAllowed(identityId, serviceId
 func (self *EdgeRouterManager) IsSharedEdgeRouterPresent(identityId, serviceId string) (bool, error) {
 	var result bool
 	err := self.GetDb().View(func(tx *bbolt.Tx) error {
-		identityEdgeRouters := self.env.GetStores().Identity.GetRefCountedLinkCollection(db.EntityTypeRouters)
+		access := self.newEdgeRouterAccess(tx, identityId, time.Now())
 		serviceEdgeRouters := self.env.GetStores().EdgeService.GetRefCountedLinkCollection(db.FieldEdgeRouters)
 
-		cursor := identityEdgeRouters.IterateLinks(tx, []byte(identityId), true)
+		cursor := access.identityRouters.IterateLinks(tx, access.identityId, true)
 		for cursor.IsValid() {
 			serviceCount := serviceEdgeRouters.GetLinkCount(tx, []byte(serviceId), cursor.Current())
-			if result = serviceCount != nil && *serviceCount > 0; result {
+			if result = serviceCount != nil && *serviceCount > 0 && access.isAllowed(tx, cursor.Current()); result {
 				return nil
 			}
 			cursor.Next()
@@ -77,6 +77,7 @@ func (self *EdgeRouterPolicyManager) Marshall(entity *EdgeRouterPolicy) ([]byte,
 		Semantic:        entity.Semantic,
 		EdgeRouterRoles: entity.EdgeRouterRoles,
 		IdentityRoles:   entity.IdentityRoles,
+		Schedule:        scheduleToPb(entity.Schedule),
 	}
 
 	return proto.Marshal(msg)
@@ -97,5 +98,6 @@ func (self *EdgeRouterPolicyManager) Unmarshall(bytes []byte) (*EdgeRouterPolicy
 		Semantic:        msg.Semantic,
 		IdentityRoles:   msg.IdentityRoles,
 		EdgeRouterRoles: msg.EdgeRouterRoles,
+		Schedule:        pbToSchedule(msg.Schedule),
 	}, nil
 }
@@ -18,6 +18,7 @@ package model
 
 import (
 	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/common/schedule"
 	"github.com/openziti/ziti/controller/db"
 	"github.com/openziti/ziti/controller/models"
 	"go.etcd.io/bbolt"
@@ -29,6 +30,7 @@ type EdgeRouterPolicy struct {
 	Semantic        string
 	IdentityRoles   []string
 	EdgeRouterRoles []string
+	Schedule        *schedule.Schedule
 }
 
 func (entity *EdgeRouterPolicy) toBoltEntity() (*db.EdgeRouterPolicy, error) {
@@ -38,6 +40,7 @@ func (entity *EdgeRouterPolicy) toBoltEntity() (*db.EdgeRouterPolicy, error) {
 		Semantic:        entity.Semantic,
 		IdentityRoles:   entity.IdentityRoles,
 		EdgeRouterRoles: entity.EdgeRouterRoles,
+		Schedule:        entity.Schedule,
 	}, nil
 }
 
@@ -45,8 +48,18 @@ func (entity *EdgeRouterPolicy) toBoltEntityForCreate(*bbolt.Tx, Env) (*db.EdgeR
 	return entity.toBoltEntity()
 }
 
-func (entity *EdgeRouterPolicy) toBoltEntityForUpdate(*bbolt.Tx, Env, boltz.FieldChecker) (*db.EdgeRouterPolicy, error) {
-	return entity.toBoltEntity()
+func (entity *EdgeRouterPolicy) toBoltEntityForUpdate(tx *bbolt.Tx, env Env, checker boltz.FieldChecker) (*db.EdgeRouterPolicy, error) {
+	edgeRouterPolicy, err := entity.toBoltEntity()
+	if err != nil {
+		return nil, err
+	}
+
+	// schedules are set through the policy schedule endpoint, so carry them over rather than clearing them on a full update
+	if checker == nil {
+		edgeRouterPolicy.Schedule = env.GetStores().EdgeRouterPolicy.GetSchedule(tx, []byte(entity.Id))
+	}
+
+	return edgeRouterPolicy, nil
 }
 
 func (entity *EdgeRouterPolicy) fillFrom(_ Env, _ *bbolt.Tx, boltEdgeRouterPolicy *db.EdgeRouterPolicy) error {
@@ -55,5 +68,6 @@ func (entity *EdgeRouterPolicy) fillFrom(_ Env, _ *bbolt.Tx, boltEdgeRouterPolic
 	entity.Semantic = boltEdgeRouterPolicy.Semantic
 	entity.EdgeRouterRoles = boltEdgeRouterPolicy.EdgeRouterRoles
 	entity.IdentityRoles = boltEdgeRouterPolicy.IdentityRoles
+	entity.Schedule = boltEdgeRouterPolicy.Schedule
 	return nil
 }
@@ -21,6 +21,7 @@ import (
 	"github.com/openziti/storage/ast"
 	"github.com/openziti/storage/boltz"
 	"github.com/openziti/ziti/common/pb/edge_cmd_pb"
+	"github.com/openziti/ziti/common/schedule"
 	"github.com/openziti/ziti/controller/change"
 	"github.com/openziti/ziti/controller/command"
 	"github.com/openziti/ziti/controller/db"
@@ -310,6 +311,7 @@ type PolicyPostureChecks struct {
 	PostureChecks []*PostureCheck
 	PolicyType    db.PolicyType
 	PolicyName    string
+	Schedule      *schedule.Schedule
 }
 
 func (self *EdgeServiceManager) GetPolicyPostureChecks(identityId, serviceId string) map[string]*PolicyPostureChecks {
@@ -331,7 +333,8 @@ func (self *EdgeServiceManager) GetPolicyPostureChecks(identityId, serviceId str
 
 		policyCursor := self.env.GetStores().Identity.GetRelatedEntitiesCursor(tx, identityId, db.EntityTypeServicePolicies, true)
 		policyCursor = ast.NewFilteredCursor(policyCursor, func(policyId []byte) bool {
-			return serviceLinks.IsLinked(tx, policyId, []byte(serviceId))
+			// deny policies don't grant access, so they have no posture checks to satisfy
+			return serviceLinks.IsLinked(tx, policyId, []byte(serviceId)) && !servicePolicyStore.GetEffect(tx, policyId).IsDeny()
 		})
 
 		for policyCursor.IsValid() {
@@ -350,6 +353,7 @@ func (self *EdgeServiceManager) GetPolicyPostureChecks(identityId, serviceId str
 				PostureChecks: []*PostureCheck{},
 				PolicyType:    policyType,
 				PolicyName:    *policyName,
+				Schedule:      servicePolicyStore.GetSchedule(tx, policyIdBytes),
 			}
 
 			cursor := postureCheckLinks.IterateLinks(tx, policyIdBytes)
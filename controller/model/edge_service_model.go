@@ -83,7 +83,22 @@ func (entity *Service) validateConfigs(tx *bbolt.Tx, env Env) error {
 }
 
 func (entity *Service) toBoltEntityForUpdate(tx *bbolt.Tx, env Env, _ boltz.FieldChecker) (*db.EdgeService, error) {
-	return entity.toBoltEntity(tx, env)
+	edgeService, err := entity.toBoltEntity(tx, env)
+	if err != nil {
+		return nil, err
+	}
+
+	// routing constraints and priority class are managed through the fabric API, so carry them over rather than
+	// clearing them
+	if current, _ := env.GetStores().Service.LoadById(tx, entity.Id); current != nil {
+		edgeService.RouterRoles = current.RouterRoles
+		edgeService.RouterRolesSemantic = current.RouterRolesSemantic
+		edgeService.ExcludedLinks = current.ExcludedLinks
+		edgeService.MaxHops = current.MaxHops
+		edgeService.PriorityClass = current.PriorityClass
+	}
+
+	return edgeService, nil
 }
 
 func (entity *Service) fillFrom(_ Env, _ *bbolt.Tx, boltService *db.EdgeService) error {
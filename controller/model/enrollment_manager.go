@@ -103,7 +103,7 @@ func (self *EnrollmentManager) ApplyCreate(cmd *command.CreateEntityCommand[*Enr
 		if model.Username == nil || *model.Username == "" {
 			return apierror.NewBadRequestFieldError(*errorz.NewFieldError("username not provided", "username", model.Username))
 		}
-	case db.MethodEnrollOtt:
+	case db.MethodEnrollOtt, db.MethodEnrollDeviceKey:
 	default:
 		return apierror.NewBadRequestFieldError(*errorz.NewFieldError("unsupported enrollment method", "method", model.Method))
 	}
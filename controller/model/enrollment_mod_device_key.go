@@ -0,0 +1,114 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"encoding/base64"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/ziti/controller/apierror"
+	"github.com/openziti/ziti/controller/change"
+	"github.com/openziti/ziti/controller/db"
+)
+
+// EnrollModuleDeviceKey enrolls a device bound key for an identity which already has its own credentials. The
+// enrolling endpoint supplies the PEM encoded public key along with a signature over the enrollment token, made with
+// the matching private key, to show it holds the key being enrolled.
+type EnrollModuleDeviceKey struct {
+	env    Env
+	method string
+}
+
+func NewEnrollModuleDeviceKey(env Env) *EnrollModuleDeviceKey {
+	return &EnrollModuleDeviceKey{
+		env:    env,
+		method: db.MethodEnrollDeviceKey,
+	}
+}
+
+func (module *EnrollModuleDeviceKey) CanHandle(method string) bool {
+	return method == module.method
+}
+
+func (module *EnrollModuleDeviceKey) Process(ctx EnrollmentContext) (*EnrollmentResult, error) {
+	enrollment, err := module.env.GetManagers().Enrollment.ReadByToken(ctx.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	if enrollment == nil || enrollment.IdentityId == nil || enrollment.Method != module.method {
+		return nil, apierror.NewInvalidEnrollmentToken()
+	}
+
+	identity, err := module.env.GetManagers().Identity.Read(*enrollment.IdentityId)
+	if err != nil {
+		return nil, err
+	}
+
+	if identity == nil {
+		return nil, apierror.NewInvalidEnrollmentToken()
+	}
+
+	ctx.GetChangeContext().
+		SetChangeAuthorType(change.AuthorTypeIdentity).
+		SetChangeAuthorId(identity.Id).
+		SetChangeAuthorName(identity.Name)
+
+	if enrollment.ExpiresAt != nil && time.Now().After(*enrollment.ExpiresAt) {
+		return nil, apierror.NewEnrollmentExpired()
+	}
+
+	data := ctx.GetDataAsMap()
+
+	publicKey, _ := data["publicKey"].(string)
+	if publicKey == "" {
+		return nil, apierror.NewBadRequestFieldError(*errorz.NewFieldError("publicKey expected for device key enrollment", "publicKey", nil))
+	}
+
+	encodedSignature, _ := data["signature"].(string)
+	signature, err := base64.StdEncoding.DecodeString(encodedSignature)
+	if encodedSignature == "" || err != nil {
+		return nil, apierror.NewBadRequestFieldError(*errorz.NewFieldError("base64 encoded signature of the enrollment token expected for device key enrollment", "signature", encodedSignature))
+	}
+
+	if err = VerifyDeviceKeySignature(publicKey, []byte(enrollment.Token), signature); err != nil {
+		return nil, apierror.NewBadRequestFieldError(*errorz.NewFieldError(err.Error(), "signature", encodedSignature))
+	}
+
+	if err = module.env.GetManagers().Identity.SetDeviceKey(identity.Id, publicKey, ctx.GetChangeContext()); err != nil {
+		return nil, err
+	}
+
+	if err = module.env.GetManagers().Enrollment.Delete(enrollment.Id, ctx.GetChangeContext()); err != nil {
+		pfxlog.Logger().WithError(err).WithField("identityId", identity.Id).Error("unable to delete device key enrollment after enrolling device key")
+	}
+
+	identity, err = module.env.GetManagers().Identity.Read(identity.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EnrollmentResult{
+		Identity: identity,
+		Content: map[string]interface{}{
+			"fingerprint": identity.DeviceKey.Fingerprint,
+		},
+		Status: 200,
+	}, nil
+}
@@ -560,6 +560,41 @@ func (self *IdentityManager) Enable(identityId string, ctx *change.Context) erro
 	}, fieldMap, ctx)
 }
 
+// SetDeviceKey enrolls the given PEM encoded public key as the device key of the identity, replacing any existing key
+func (self *IdentityManager) SetDeviceKey(identityId string, publicKeyPem string, ctx *change.Context) error {
+	_, publicKeyDer, err := ParseDeviceKey(publicKeyPem)
+	if err != nil {
+		return errorz.NewFieldApiError(errorz.NewFieldError(err.Error(), "publicKey", publicKeyPem))
+	}
+
+	return self.updateDeviceKey(identityId, &DeviceKey{
+		PublicKey:   publicKeyPem,
+		Fingerprint: self.env.GetFingerprintGenerator().FromRaw(publicKeyDer),
+		EnrolledAt:  time.Now().UTC(),
+	}, ctx)
+}
+
+// RemoveDeviceKey removes the device key of the identity. Device key posture checks will fail for the identity
+// until a new key is enrolled.
+func (self *IdentityManager) RemoveDeviceKey(identityId string, ctx *change.Context) error {
+	return self.updateDeviceKey(identityId, nil, ctx)
+}
+
+func (self *IdentityManager) updateDeviceKey(identityId string, deviceKey *DeviceKey, ctx *change.Context) error {
+	fieldMap := fields.UpdatedFieldsMap{
+		db.FieldIdentityDeviceKeyPublicKey:   struct{}{},
+		db.FieldIdentityDeviceKeyFingerprint: struct{}{},
+		db.FieldIdentityDeviceKeyEnrolledAt:  struct{}{},
+	}
+
+	return self.Update(&Identity{
+		BaseEntity: models.BaseEntity{
+			Id: identityId,
+		},
+		DeviceKey: deviceKey,
+	}, fieldMap, ctx)
+}
+
 func (self *IdentityManager) IdentityToProtobuf(entity *Identity) (*edge_cmd_pb.Identity, error) {
 	tags, err := edge_cmd_pb.EncodeTags(entity.Tags)
 	if err != nil {
@@ -627,6 +662,14 @@ func (self *IdentityManager) IdentityToProtobuf(entity *Identity) (*edge_cmd_pb.
 		DisabledUntil:             timePtrToPb(entity.DisabledUntil),
 	}
 
+	if entity.DeviceKey != nil {
+		msg.DeviceKey = &edge_cmd_pb.Identity_DeviceKey{
+			PublicKey:   entity.DeviceKey.PublicKey,
+			Fingerprint: entity.DeviceKey.Fingerprint,
+			EnrolledAt:  timePtrToPb(&entity.DeviceKey.EnrolledAt),
+		}
+	}
+
 	return msg, nil
 }
 
@@ -678,6 +721,17 @@ func (self *IdentityManager) ProtobufToIdentity(msg *edge_cmd_pb.Identity) (*Ide
 		return nil, err
 	}
 
+	var deviceKey *DeviceKey
+	if msg.DeviceKey != nil {
+		deviceKey = &DeviceKey{
+			PublicKey:   msg.DeviceKey.PublicKey,
+			Fingerprint: msg.DeviceKey.Fingerprint,
+		}
+		if enrolledAt := pbTimeToTimePtr(msg.DeviceKey.EnrolledAt); enrolledAt != nil {
+			deviceKey.EnrolledAt = *enrolledAt
+		}
+	}
+
 	return &Identity{
 		BaseEntity: models.BaseEntity{
 			Id:   msg.Id,
@@ -700,6 +754,7 @@ func (self *IdentityManager) ProtobufToIdentity(msg *edge_cmd_pb.Identity) (*Ide
 		Disabled:                  msg.Disabled,
 		DisabledAt:                pbTimeToTimePtr(msg.DisabledAt),
 		DisabledUntil:             pbTimeToTimePtr(msg.DisabledUntil),
+		DeviceKey:                 deviceKey,
 	}, nil
 }
 
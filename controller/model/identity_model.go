@@ -94,6 +94,7 @@ type Identity struct {
 	Disabled                  bool
 	DisabledAt                *time.Time
 	DisabledUntil             *time.Time
+	DeviceKey                 *DeviceKey
 }
 
 func (entity *Identity) toBoltEntityForCreate(_ *bbolt.Tx, env Env) (*db.Identity, error) {
@@ -133,6 +134,7 @@ func (entity *Identity) toBoltEntityForCreate(_ *bbolt.Tx, env Env) (*db.Identit
 		ExternalId:                entity.ExternalId,
 		DisabledAt:                entity.DisabledAt,
 		DisabledUntil:             entity.DisabledUntil,
+		DeviceKey:                 entity.DeviceKey.toBolt(),
 	}
 
 	if entity.EnvInfo != nil {
@@ -242,6 +244,15 @@ func (entity *Identity) toBoltEntityForUpdate(tx *bbolt.Tx, env Env, checker bol
 		DisabledAt:                entity.DisabledAt,
 		DisabledUntil:             entity.DisabledUntil,
 		IsAdmin:                   entity.IsAdmin,
+		DeviceKey:                 entity.DeviceKey.toBolt(),
+	}
+
+	// device keys are managed through device key enrollment, so carry them over rather than clearing them on a full
+	// update. Full updates are checked by the identity manager itself, see IdentityManager.ApplyUpdate
+	if checker == nil || checker == env.GetManagers().Identity {
+		if current, _ := env.GetStores().Identity.LoadById(tx, entity.Id); current != nil {
+			boltEntity.DeviceKey = current.DeviceKey
+		}
 	}
 
 	identityStore := env.GetManagers().Identity.GetStore()
@@ -285,6 +296,7 @@ func (entity *Identity) fillFrom(env Env, _ *bbolt.Tx, boltIdentity *db.Identity
 	entity.DisabledUntil = boltIdentity.DisabledUntil
 	entity.DisabledAt = boltIdentity.DisabledAt
 	entity.Disabled = boltIdentity.Disabled
+	entity.DeviceKey = newDeviceKeyFromBolt(boltIdentity.DeviceKey)
 	fillModelInfo(entity, boltIdentity.EnvInfo, boltIdentity.SdkInfo)
 
 	return nil
@@ -47,6 +47,7 @@ type Managers struct {
 	ServiceEdgeRouterPolicy *ServiceEdgeRouterPolicyManager
 	ServicePolicy           *ServicePolicyManager
 	Revocation              *RevocationManager
+	CertificateRevocation   *CertificateRevocationManager
 	TransitRouter           *TransitRouterManager
 	Session                 *SessionManager
 	Authenticator           *AuthenticatorManager
@@ -56,6 +57,7 @@ type Managers struct {
 	PostureResponse         *PostureResponseManager
 	Mfa                     *MfaManager
 	AuthPolicy              *AuthPolicyManager
+	BandwidthLimit          *BandwidthLimitManager
 }
 
 func InitEntityManagers(env Env) *Managers {
@@ -70,6 +72,7 @@ func InitEntityManagers(env Env) *Managers {
 	managers.ApiSessionCertificate = NewApiSessionCertificateManager(env)
 	managers.Authenticator = NewAuthenticatorManager(env)
 	managers.AuthPolicy = NewAuthPolicyManager(env)
+	managers.BandwidthLimit = NewBandwidthLimitManager(env)
 	managers.Ca = NewCaManager(env)
 	managers.Config = NewConfigManager(env)
 	managers.ConfigType = NewConfigTypeManager(env)
@@ -83,6 +86,7 @@ func InitEntityManagers(env Env) *Managers {
 	managers.IdentityType = NewIdentityTypeManager(env)
 	managers.PolicyAdvisor = NewPolicyAdvisor(env)
 	managers.Revocation = NewRevocationManager(env)
+	managers.CertificateRevocation = NewCertificateRevocationManager(env)
 	managers.ServiceEdgeRouterPolicy = NewServiceEdgeRouterPolicyManager(env)
 	managers.ServicePolicy = NewServicePolicyManager(env)
 	managers.Session = NewSessionManager(env)
@@ -32,10 +32,12 @@ import (
 	"github.com/openziti/ziti/controller/fields"
 	"github.com/openziti/ziti/controller/models"
 	"github.com/openziti/ziti/controller/network"
+	cmap "github.com/orcaman/concurrent-map/v2"
 	"github.com/pkg/errors"
 	"github.com/skip2/go-qrcode"
 	"go.etcd.io/bbolt"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"strings"
 )
 
@@ -45,7 +47,8 @@ const (
 
 func NewMfaManager(env Env) *MfaManager {
 	manager := &MfaManager{
-		baseEntityManager: newBaseEntityManager[*Mfa, *db.Mfa](env, env.GetStores().Mfa),
+		baseEntityManager:  newBaseEntityManager[*Mfa, *db.Mfa](env, env.GetStores().Mfa),
+		webAuthnChallenges: cmap.New[*webAuthnChallenge](),
 	}
 	manager.impl = manager
 
@@ -56,6 +59,7 @@ func NewMfaManager(env Env) *MfaManager {
 
 type MfaManager struct {
 	baseEntityManager[*Mfa, *db.Mfa]
+	webAuthnChallenges cmap.ConcurrentMap[string, *webAuthnChallenge]
 }
 
 func (self *MfaManager) newModelEntity() *Mfa {
@@ -134,7 +138,7 @@ func (self *MfaManager) ApplyUpdate(cmd *command.UpdateEntityCommand[*Mfa], ctx
 }
 
 func (self *MfaManager) IsUpdated(field string) bool {
-	return field == db.FieldMfaIsVerified || field == db.FieldMfaRecoveryCodes
+	return field == db.FieldMfaIsVerified || field == db.FieldMfaRecoveryCodes || field == db.FieldMfaSecret || field == db.FieldMfaWebAuthnCredentials
 }
 
 func (self *MfaManager) Query(query string) (*MfaListResult, error) {
@@ -183,6 +187,11 @@ func (self *MfaManager) Verify(mfa *Mfa, code string, ctx *change.Context) (bool
 
 // VerifyTOTP verifies TOTP values only, not recovery codes
 func (self *MfaManager) VerifyTOTP(mfa *Mfa, code string) (bool, error) {
+	if mfa.Secret == "" {
+		// MFA enabled through WebAuthn only
+		return false, nil
+	}
+
 	otp := dgoogauth.OTPConfig{
 		Secret:     mfa.Secret,
 		WindowSize: WindowSizeTOTP,
@@ -280,6 +289,18 @@ func (self *MfaManager) Marshall(entity *Mfa) ([]byte, error) {
 		RecoveryCodes: entity.RecoveryCodes,
 	}
 
+	for _, credential := range entity.WebAuthnCredentials {
+		msg.WebAuthnCredentials = append(msg.WebAuthnCredentials, &edge_cmd_pb.Mfa_WebAuthnCredential{
+			Id:        credential.Id,
+			Name:      credential.Name,
+			PublicKey: credential.PublicKey,
+			Algorithm: credential.Algorithm,
+			SignCount: credential.SignCount,
+			Aaguid:    credential.Aaguid,
+			CreatedAt: timestamppb.New(credential.CreatedAt),
+		})
+	}
+
 	return proto.Marshal(msg)
 }
 
@@ -294,7 +315,7 @@ func (self *MfaManager) Unmarshall(bytes []byte) (*Mfa, error) {
 		return nil, errors.Wrapf(err, "unable to lookup identity for mfa with id=[%v]", msg.Id)
 	}
 
-	return &Mfa{
+	result := &Mfa{
 		BaseEntity: models.BaseEntity{
 			Id:   msg.Id,
 			Tags: edge_cmd_pb.DecodeTags(msg.Tags),
@@ -304,7 +325,21 @@ func (self *MfaManager) Unmarshall(bytes []byte) (*Mfa, error) {
 		Identity:      identity,
 		Secret:        msg.Secret,
 		RecoveryCodes: msg.RecoveryCodes,
-	}, nil
+	}
+
+	for _, credential := range msg.WebAuthnCredentials {
+		result.WebAuthnCredentials = append(result.WebAuthnCredentials, &WebAuthnCredential{
+			Id:        credential.Id,
+			Name:      credential.Name,
+			PublicKey: credential.PublicKey,
+			Algorithm: credential.Algorithm,
+			SignCount: credential.SignCount,
+			Aaguid:    credential.Aaguid,
+			CreatedAt: credential.CreatedAt.AsTime(),
+		})
+	}
+
+	return result, nil
 }
 
 // DeleteAllForIdentity is meant for administrators to remove all MFAs (enrolled or not) from an identity
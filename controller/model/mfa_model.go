@@ -36,6 +36,8 @@ type Mfa struct {
 	Identity      *Identity
 	Secret        string
 	RecoveryCodes []string
+
+	WebAuthnCredentials []*WebAuthnCredential
 }
 
 func (entity *Mfa) toBoltEntity(tx *bbolt.Tx, env Env) (*db.Mfa, error) {
@@ -51,6 +53,10 @@ func (entity *Mfa) toBoltEntity(tx *bbolt.Tx, env Env) (*db.Mfa, error) {
 		Secret:        entity.Secret,
 	}
 
+	for _, credential := range entity.WebAuthnCredentials {
+		boltEntity.WebAuthnCredentials = append(boltEntity.WebAuthnCredentials, credential.toBolt())
+	}
+
 	return boltEntity, nil
 }
 
@@ -68,6 +74,10 @@ func (entity *Mfa) fillFrom(env Env, tx *bbolt.Tx, boltMfa *db.Mfa) error {
 	entity.IdentityId = boltMfa.IdentityId
 	entity.RecoveryCodes = boltMfa.RecoveryCodes
 	entity.Secret = boltMfa.Secret
+	entity.WebAuthnCredentials = nil
+	for _, boltCredential := range boltMfa.WebAuthnCredentials {
+		entity.WebAuthnCredentials = append(entity.WebAuthnCredentials, newWebAuthnCredentialFromBolt(boltCredential))
+	}
 	boltIdentity, err := env.GetStores().Identity.LoadById(tx, boltMfa.IdentityId)
 	if err != nil {
 		return err
@@ -0,0 +1,548 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"encoding/base64"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
+	"github.com/go-webauthn/webauthn/protocol/webauthncose"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/ziti/controller/apierror"
+	"github.com/openziti/ziti/controller/change"
+	"github.com/openziti/ziti/controller/db"
+	"github.com/openziti/ziti/controller/fields"
+	"github.com/openziti/ziti/controller/models"
+	"github.com/pkg/errors"
+	"strings"
+	"time"
+)
+
+const (
+	// WebAuthnChallengeDuration is how long a client has to complete a WebAuthn registration or assertion
+	WebAuthnChallengeDuration = 2 * time.Minute
+
+	// WebAuthnCredentialNameMaxLength bounds the user supplied name of a credential
+	WebAuthnCredentialNameMaxLength = 128
+
+	webAuthnRegistrationKeyPrefix = "registration:"
+	webAuthnAssertionKeyPrefix    = "assertion:"
+)
+
+// EncodeWebAuthnValue encodes binary WebAuthn values, such as credential ids, the way WebAuthn clients expect them
+func EncodeWebAuthnValue(value []byte) string {
+	return base64.RawURLEncoding.EncodeToString(value)
+}
+
+// DecodeWebAuthnValue decodes base64url WebAuthn values, tolerating padding as some clients include it
+func DecodeWebAuthnValue(value string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(value, "="))
+}
+
+// WebAuthnCredential is a FIDO2/WebAuthn credential registered as a second factor for an identity
+type WebAuthnCredential struct {
+	Id        string
+	Name      string
+	PublicKey string
+	Algorithm int64
+	SignCount int64
+	Aaguid    string
+	CreatedAt time.Time
+}
+
+func newWebAuthnCredentialFromBolt(entity *db.WebAuthnCredential) *WebAuthnCredential {
+	return &WebAuthnCredential{
+		Id:        entity.Id,
+		Name:      entity.Name,
+		PublicKey: entity.PublicKey,
+		Algorithm: entity.Algorithm,
+		SignCount: entity.SignCount,
+		Aaguid:    entity.Aaguid,
+		CreatedAt: entity.CreatedAt,
+	}
+}
+
+func (self *WebAuthnCredential) toBolt() *db.WebAuthnCredential {
+	return &db.WebAuthnCredential{
+		Id:        self.Id,
+		Name:      self.Name,
+		PublicKey: self.PublicKey,
+		Algorithm: self.Algorithm,
+		SignCount: self.SignCount,
+		Aaguid:    self.Aaguid,
+		CreatedAt: self.CreatedAt,
+	}
+}
+
+func (self *WebAuthnCredential) toLibraryCredential() (webauthn.Credential, error) {
+	id, err := DecodeWebAuthnValue(self.Id)
+	if err != nil {
+		return webauthn.Credential{}, err
+	}
+
+	publicKey, err := DecodeWebAuthnValue(self.PublicKey)
+	if err != nil {
+		return webauthn.Credential{}, err
+	}
+
+	aaguid, err := DecodeWebAuthnValue(self.Aaguid)
+	if err != nil {
+		return webauthn.Credential{}, err
+	}
+
+	return webauthn.Credential{
+		ID:        id,
+		PublicKey: publicKey,
+		Authenticator: webauthn.Authenticator{
+			AAGUID:    aaguid,
+			SignCount: uint32(self.SignCount),
+		},
+	}, nil
+}
+
+// webAuthnUser exposes an identity and its registered credentials to the WebAuthn library
+type webAuthnUser struct {
+	identityId  string
+	name        string
+	credentials []webauthn.Credential
+}
+
+func newWebAuthnUser(identityId, name string, mfa *Mfa) (*webAuthnUser, error) {
+	result := &webAuthnUser{
+		identityId: identityId,
+		name:       name,
+	}
+
+	if mfa != nil {
+		for _, credential := range mfa.WebAuthnCredentials {
+			libCredential, err := credential.toLibraryCredential()
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid webauthn credential [%s]", credential.Id)
+			}
+			result.credentials = append(result.credentials, libCredential)
+		}
+	}
+
+	return result, nil
+}
+
+func (self *webAuthnUser) WebAuthnID() []byte {
+	return []byte(self.identityId)
+}
+
+func (self *webAuthnUser) WebAuthnName() string {
+	return self.name
+}
+
+func (self *webAuthnUser) WebAuthnDisplayName() string {
+	return self.name
+}
+
+func (self *webAuthnUser) WebAuthnCredentials() []webauthn.Credential {
+	return self.credentials
+}
+
+func (self *webAuthnUser) WebAuthnIcon() string {
+	return ""
+}
+
+func (self *webAuthnUser) credentialDescriptors() []protocol.CredentialDescriptor {
+	var result []protocol.CredentialDescriptor
+	for _, credential := range self.credentials {
+		result = append(result, credential.Descriptor())
+	}
+	return result
+}
+
+// HasWebAuthn returns true if the MFA has at least one registered WebAuthn credential
+func (entity *Mfa) HasWebAuthn() bool {
+	return entity != nil && len(entity.WebAuthnCredentials) > 0
+}
+
+func (entity *Mfa) GetWebAuthnCredential(id string) *WebAuthnCredential {
+	for _, credential := range entity.WebAuthnCredentials {
+		if credential.Id == id {
+			return credential
+		}
+	}
+	return nil
+}
+
+// WebAuthnRegistration is the result of registering a WebAuthn credential. RecoveryCodes are only set when the
+// registration enabled MFA for the identity.
+type WebAuthnRegistration struct {
+	Credential    *WebAuthnCredential
+	RecoveryCodes []string
+}
+
+// WebAuthnAssertion is a client's response to a WebAuthn assertion challenge
+type WebAuthnAssertion struct {
+	CredentialId      string
+	ClientDataJSON    []byte
+	AuthenticatorData []byte
+	Signature         []byte
+}
+
+type webAuthnChallenge struct {
+	identityId string
+	session    *webauthn.SessionData
+	expiresAt  time.Time
+}
+
+// GetWebAuthn returns the WebAuthn relying party WebAuthn credentials are scoped to
+func (self *MfaManager) GetWebAuthn() (*webauthn.WebAuthn, error) {
+	config := self.env.GetConfig().WebAuthn
+
+	userVerification := protocol.VerificationPreferred
+	if config.RequireUserVerification {
+		userVerification = protocol.VerificationRequired
+	}
+
+	timeout := webauthn.TimeoutConfig{
+		Timeout:    WebAuthnChallengeDuration,
+		TimeoutUVD: WebAuthnChallengeDuration,
+	}
+
+	return webauthn.New(&webauthn.Config{
+		RPID:                  config.RpId,
+		RPDisplayName:         config.RpName,
+		RPOrigins:             config.Origins,
+		AttestationPreference: protocol.PreferNoAttestation,
+		AuthenticatorSelection: protocol.AuthenticatorSelection{
+			UserVerification: userVerification,
+		},
+		Timeouts: webauthn.TimeoutsConfig{
+			Login:        timeout,
+			Registration: timeout,
+		},
+	})
+}
+
+func (self *MfaManager) storeWebAuthnChallenge(key, identityId string, session *webauthn.SessionData) {
+	now := time.Now()
+	for entry := range self.webAuthnChallenges.IterBuffered() {
+		if entry.Val.expiresAt.Before(now) {
+			self.webAuthnChallenges.Remove(entry.Key)
+		}
+	}
+
+	self.webAuthnChallenges.Set(key, &webAuthnChallenge{
+		identityId: identityId,
+		session:    session,
+		expiresAt:  now.Add(WebAuthnChallengeDuration),
+	})
+}
+
+// popWebAuthnChallenge removes and returns an outstanding challenge, challenges may only be answered once
+func (self *MfaManager) popWebAuthnChallenge(key, identityId string) (*webauthn.SessionData, error) {
+	challenge, found := self.webAuthnChallenges.Pop(key)
+	if !found || challenge.identityId != identityId {
+		return nil, errors.New("no outstanding webauthn challenge")
+	}
+
+	if challenge.expiresAt.Before(time.Now()) {
+		return nil, errors.New("webauthn challenge has expired")
+	}
+
+	return challenge.session, nil
+}
+
+// StartWebAuthnRegistration issues the options for registering a new WebAuthn credential for an identity, replacing
+// any outstanding registration for the identity
+func (self *MfaManager) StartWebAuthnRegistration(identity *Identity) (*protocol.PublicKeyCredentialCreationOptions, error) {
+	mfa, err := self.ReadOneByIdentityId(identity.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := newWebAuthnUser(identity.Id, identity.Name, mfa)
+	if err != nil {
+		return nil, err
+	}
+
+	wa, err := self.GetWebAuthn()
+	if err != nil {
+		return nil, err
+	}
+
+	// existing credentials are excluded so that an authenticator can't be registered twice
+	creation, session, err := wa.BeginRegistration(user, webauthn.WithExclusions(user.credentialDescriptors()))
+	if err != nil {
+		return nil, err
+	}
+
+	self.storeWebAuthnChallenge(webAuthnRegistrationKeyPrefix+identity.Id, identity.Id, session)
+
+	return &creation.Response, nil
+}
+
+// CompleteWebAuthnRegistration verifies the response to the identity's outstanding registration and stores the new
+// credential. If the identity has no MFA, MFA is enabled with the credential as its only factor and recovery codes are
+// issued. An MFA with a TOTP enrollment which was never verified has the pending TOTP enrollment replaced.
+func (self *MfaManager) CompleteWebAuthnRegistration(identityId, name string, clientDataJSON, attestationObject []byte, ctx *change.Context) (*WebAuthnRegistration, error) {
+	name = strings.TrimSpace(name)
+	if len(name) > WebAuthnCredentialNameMaxLength {
+		return nil, errorz.NewFieldError("name is too long", "name", name)
+	}
+
+	session, err := self.popWebAuthnChallenge(webAuthnRegistrationKeyPrefix+identityId, identityId)
+	if err != nil {
+		return nil, apierror.NewInvalidWebAuthnResponseError(err)
+	}
+
+	response := protocol.AuthenticatorAttestationResponse{
+		AuthenticatorResponse: protocol.AuthenticatorResponse{
+			ClientDataJSON: clientDataJSON,
+		},
+		AttestationObject: attestationObject,
+	}
+
+	// clients only send the attestation, the credential id is taken from its attested credential data
+	attestation, err := response.Parse()
+	if err != nil {
+		return nil, apierror.NewInvalidWebAuthnResponseError(err)
+	}
+
+	credentialId := attestation.AttestationObject.AuthData.AttData.CredentialID
+	if len(credentialId) == 0 {
+		return nil, apierror.NewInvalidWebAuthnResponseError(errors.New("authenticator data does not contain attested credential data"))
+	}
+
+	parsedResponse, err := protocol.CredentialCreationResponse{
+		PublicKeyCredential: protocol.PublicKeyCredential{
+			Credential: protocol.Credential{
+				ID:   EncodeWebAuthnValue(credentialId),
+				Type: string(protocol.PublicKeyCredentialType),
+			},
+			RawID: credentialId,
+		},
+		AttestationResponse: response,
+	}.Parse()
+	if err != nil {
+		return nil, apierror.NewInvalidWebAuthnResponseError(err)
+	}
+
+	wa, err := self.GetWebAuthn()
+	if err != nil {
+		return nil, err
+	}
+
+	verified, err := wa.CreateCredential(&webAuthnUser{identityId: identityId}, *session, parsedResponse)
+	if err != nil {
+		return nil, apierror.NewInvalidWebAuthnResponseError(err)
+	}
+
+	algorithm, err := webAuthnKeyAlgorithm(verified.PublicKey)
+	if err != nil {
+		return nil, apierror.NewInvalidWebAuthnResponseError(err)
+	}
+
+	credential := &WebAuthnCredential{
+		Id:        EncodeWebAuthnValue(verified.ID),
+		Name:      name,
+		PublicKey: EncodeWebAuthnValue(verified.PublicKey),
+		Algorithm: algorithm,
+		SignCount: int64(verified.Authenticator.SignCount),
+		Aaguid:    EncodeWebAuthnValue(verified.Authenticator.AAGUID),
+		CreatedAt: time.Now().UTC(),
+	}
+
+	if credential.Name == "" {
+		credential.Name = "security key " + credential.CreatedAt.Format(time.DateOnly)
+	}
+
+	mfa, err := self.ReadOneByIdentityId(identityId)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &WebAuthnRegistration{
+		Credential: credential,
+	}
+
+	if mfa == nil {
+		recoveryCodes, err := self.generateRecoveryCodes()
+		if err != nil {
+			return nil, err
+		}
+
+		mfa = &Mfa{
+			BaseEntity:          models.BaseEntity{},
+			IsVerified:          true,
+			IdentityId:          identityId,
+			RecoveryCodes:       recoveryCodes,
+			WebAuthnCredentials: []*WebAuthnCredential{credential},
+		}
+
+		if err = self.Create(mfa, ctx); err != nil {
+			return nil, err
+		}
+
+		result.RecoveryCodes = recoveryCodes
+		return result, nil
+	}
+
+	if mfa.GetWebAuthnCredential(credential.Id) != nil {
+		return nil, apierror.NewInvalidWebAuthnResponseError(errors.New("credential is already registered"))
+	}
+
+	if !mfa.IsVerified {
+		mfa.IsVerified = true
+		mfa.Secret = ""
+		result.RecoveryCodes = mfa.RecoveryCodes
+	}
+
+	mfa.WebAuthnCredentials = append(mfa.WebAuthnCredentials, credential)
+
+	if err = self.Update(mfa, nil, ctx); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// StartWebAuthnAssertion issues the options for asserting one of the identity's WebAuthn credentials. The session key
+// scopes the challenge to the session being authenticated, e.g. an API session or an OIDC auth request.
+func (self *MfaManager) StartWebAuthnAssertion(mfa *Mfa, sessionKey string) (*protocol.PublicKeyCredentialRequestOptions, error) {
+	if !mfa.HasWebAuthn() {
+		return nil, apierror.NewMfaNotEnrolledError()
+	}
+
+	user, err := newWebAuthnUser(mfa.IdentityId, mfa.IdentityId, mfa)
+	if err != nil {
+		return nil, err
+	}
+
+	wa, err := self.GetWebAuthn()
+	if err != nil {
+		return nil, err
+	}
+
+	assertion, session, err := wa.BeginLogin(user, webauthn.WithUserVerification(wa.Config.AuthenticatorSelection.UserVerification))
+	if err != nil {
+		return nil, err
+	}
+
+	self.storeWebAuthnChallenge(webAuthnAssertionKeyPrefix+sessionKey, mfa.IdentityId, session)
+
+	return &assertion.Response, nil
+}
+
+// VerifyWebAuthnAssertion verifies the response to the session's outstanding assertion challenge and records the
+// credential's new signature counter. A counter which does not increase indicates a cloned authenticator and fails
+// verification.
+func (self *MfaManager) VerifyWebAuthnAssertion(mfa *Mfa, sessionKey string, assertion *WebAuthnAssertion, ctx *change.Context) error {
+	session, err := self.popWebAuthnChallenge(webAuthnAssertionKeyPrefix+sessionKey, mfa.IdentityId)
+	if err != nil {
+		return apierror.NewInvalidWebAuthnResponseError(err)
+	}
+
+	credential := mfa.GetWebAuthnCredential(assertion.CredentialId)
+	if credential == nil {
+		return apierror.NewInvalidWebAuthnResponseError(errors.New("unknown credential"))
+	}
+
+	rawCredentialId, err := DecodeWebAuthnValue(assertion.CredentialId)
+	if err != nil {
+		return apierror.NewInvalidWebAuthnResponseError(err)
+	}
+
+	response := protocol.CredentialAssertionResponse{
+		PublicKeyCredential: protocol.PublicKeyCredential{
+			Credential: protocol.Credential{
+				ID:   EncodeWebAuthnValue(rawCredentialId),
+				Type: string(protocol.PublicKeyCredentialType),
+			},
+			RawID: rawCredentialId,
+		},
+		AssertionResponse: protocol.AuthenticatorAssertionResponse{
+			AuthenticatorResponse: protocol.AuthenticatorResponse{
+				ClientDataJSON: assertion.ClientDataJSON,
+			},
+			AuthenticatorData: assertion.AuthenticatorData,
+			Signature:         assertion.Signature,
+		},
+	}
+
+	parsedResponse, err := response.Parse()
+	if err != nil {
+		return apierror.NewInvalidWebAuthnResponseError(err)
+	}
+
+	user, err := newWebAuthnUser(mfa.IdentityId, mfa.IdentityId, mfa)
+	if err != nil {
+		return err
+	}
+
+	wa, err := self.GetWebAuthn()
+	if err != nil {
+		return err
+	}
+
+	verified, err := wa.ValidateLogin(user, *session, parsedResponse)
+	if err != nil {
+		return apierror.NewInvalidWebAuthnResponseError(err)
+	}
+
+	if verified.Authenticator.CloneWarning {
+		return apierror.NewInvalidWebAuthnResponseError(errors.Errorf("signature counter %d did not increase from %d, the authenticator may have been cloned",
+			parsedResponse.Response.AuthenticatorData.Counter, credential.SignCount))
+	}
+
+	if int64(verified.Authenticator.SignCount) != credential.SignCount {
+		credential.SignCount = int64(verified.Authenticator.SignCount)
+		return self.Update(mfa, fields.UpdatedFieldsMap{}.AddFields(db.FieldMfaWebAuthnCredentials), ctx)
+	}
+
+	return nil
+}
+
+// webAuthnKeyAlgorithm returns the COSE algorithm of a credential public key
+func webAuthnKeyAlgorithm(publicKey []byte) (int64, error) {
+	keyData := webauthncose.PublicKeyData{}
+	if err := webauthncbor.Unmarshal(publicKey, &keyData); err != nil {
+		return 0, errors.Wrap(err, "invalid credential public key")
+	}
+	return keyData.Algorithm, nil
+}
+
+// DeleteWebAuthnCredential removes a WebAuthn credential. If it was the only second factor, the MFA is removed.
+func (self *MfaManager) DeleteWebAuthnCredential(identityId, credentialId string, ctx *change.Context) error {
+	mfa, err := self.ReadOneByIdentityId(identityId)
+	if err != nil {
+		return err
+	}
+
+	if mfa == nil || mfa.GetWebAuthnCredential(credentialId) == nil {
+		return errorz.NewNotFound()
+	}
+
+	var remaining []*WebAuthnCredential
+	for _, credential := range mfa.WebAuthnCredentials {
+		if credential.Id != credentialId {
+			remaining = append(remaining, credential)
+		}
+	}
+
+	if len(remaining) == 0 && mfa.Secret == "" {
+		return self.Delete(mfa.Id, ctx)
+	}
+
+	mfa.WebAuthnCredentials = remaining
+	return self.Update(mfa, fields.UpdatedFieldsMap{}.AddFields(db.FieldMfaWebAuthnCredentials), ctx)
+}
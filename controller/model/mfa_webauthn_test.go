@@ -0,0 +1,258 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"github.com/go-webauthn/webauthn/protocol/webauthncose"
+	"github.com/openziti/ziti/controller/change"
+	"github.com/openziti/ziti/controller/config"
+	"github.com/openziti/ziti/controller/webauthn/webauthntest"
+	"testing"
+)
+
+const (
+	webAuthnTestRpId   = "ctrl.example.com"
+	webAuthnTestOrigin = "https://ctrl.example.com:1280"
+)
+
+func TestMfaWebAuthn(t *testing.T) {
+	ctx := NewTestContext(t)
+	defer ctx.Cleanup()
+	ctx.Init()
+
+	ctx.config.WebAuthn = config.WebAuthn{
+		RpId:    webAuthnTestRpId,
+		RpName:  "test",
+		Origins: []string{webAuthnTestOrigin},
+	}
+
+	t.Run("registration enables mfa and assertions verify", ctx.testWebAuthnRegistrationAndAssertion)
+	t.Run("registration replaces a pending totp enrollment", ctx.testWebAuthnReplacesPendingTotp)
+	t.Run("challenges are single use and scoped to the session", ctx.testWebAuthnChallengeScope)
+	t.Run("responses for another relying party are rejected", ctx.testWebAuthnRejectsWrongRelyingParty)
+	t.Run("user verification is enforced when required", ctx.testWebAuthnRequiresUserVerification)
+	t.Run("assertions from a cloned authenticator are rejected", ctx.testWebAuthnRejectsClonedAuthenticator)
+}
+
+func (ctx *TestContext) requireWebAuthnRegistration(identity *Identity, authenticator *webauthntest.Authenticator) *WebAuthnRegistration {
+	options, err := ctx.managers.Mfa.StartWebAuthnRegistration(identity)
+	ctx.NoError(err)
+	ctx.Equal(webAuthnTestRpId, options.RelyingParty.ID)
+
+	attestation, err := authenticator.Create(options.Challenge)
+	ctx.NoError(err)
+
+	registration, err := ctx.managers.Mfa.CompleteWebAuthnRegistration(identity.Id, "key", attestation.ClientDataJSON, attestation.AttestationObject, change.New())
+	ctx.NoError(err)
+	ctx.Equal(EncodeWebAuthnValue(attestation.CredentialId), registration.Credential.Id)
+
+	return registration
+}
+
+func (ctx *TestContext) requireWebAuthnAssertion(mfa *Mfa, sessionKey string, authenticator *webauthntest.Authenticator, credentialId string) *WebAuthnAssertion {
+	options, err := ctx.managers.Mfa.StartWebAuthnAssertion(mfa, sessionKey)
+	ctx.NoError(err)
+	ctx.Len(options.AllowedCredentials, len(mfa.WebAuthnCredentials))
+
+	rawCredentialId, err := DecodeWebAuthnValue(credentialId)
+	ctx.NoError(err)
+
+	response, err := authenticator.Get(options.Challenge, rawCredentialId)
+	ctx.NoError(err)
+
+	return &WebAuthnAssertion{
+		CredentialId:      credentialId,
+		ClientDataJSON:    response.ClientDataJSON,
+		AuthenticatorData: response.AuthenticatorData,
+		Signature:         response.Signature,
+	}
+}
+
+func (ctx *TestContext) testWebAuthnRegistrationAndAssertion(t *testing.T) {
+	ctx.NextTest(t)
+	identity := ctx.requireNewIdentity(false)
+	authenticator := webauthntest.NewAuthenticator(webAuthnTestRpId, webAuthnTestOrigin)
+
+	registration := ctx.requireWebAuthnRegistration(identity, authenticator)
+	ctx.Len(registration.RecoveryCodes, 20)
+
+	mfa, err := ctx.managers.Mfa.ReadOneByIdentityId(identity.Id)
+	ctx.NoError(err)
+	ctx.True(mfa.IsVerified)
+	ctx.Empty(mfa.Secret)
+	ctx.Len(mfa.WebAuthnCredentials, 1)
+	ctx.Equal("key", mfa.WebAuthnCredentials[0].Name)
+
+	// totp codes can't be used without a totp secret, recovery codes still can
+	ok, err := ctx.managers.Mfa.VerifyTOTP(mfa, "123456")
+	ctx.NoError(err)
+	ctx.False(ok)
+
+	credentialId := registration.Credential.Id
+	assertion := ctx.requireWebAuthnAssertion(mfa, "session1", authenticator, credentialId)
+	ctx.NoError(ctx.managers.Mfa.VerifyWebAuthnAssertion(mfa, "session1", assertion, change.New()))
+
+	mfa, err = ctx.managers.Mfa.ReadOneByIdentityId(identity.Id)
+	ctx.NoError(err)
+	ctx.Equal(int64(1), mfa.WebAuthnCredentials[0].SignCount)
+
+	// a second key for the same identity
+	secondAuthenticator := webauthntest.NewAuthenticator(webAuthnTestRpId, webAuthnTestOrigin)
+	secondAuthenticator.Algorithm = webauthncose.AlgEdDSA
+	secondRegistration := ctx.requireWebAuthnRegistration(identity, secondAuthenticator)
+	ctx.Empty(secondRegistration.RecoveryCodes)
+
+	mfa, err = ctx.managers.Mfa.ReadOneByIdentityId(identity.Id)
+	ctx.NoError(err)
+	ctx.Len(mfa.WebAuthnCredentials, 2)
+
+	// removing every key removes mfa as there is no totp to fall back to
+	ctx.NoError(ctx.managers.Mfa.DeleteWebAuthnCredential(identity.Id, credentialId, change.New()))
+	mfa, err = ctx.managers.Mfa.ReadOneByIdentityId(identity.Id)
+	ctx.NoError(err)
+	ctx.Len(mfa.WebAuthnCredentials, 1)
+
+	ctx.NoError(ctx.managers.Mfa.DeleteWebAuthnCredential(identity.Id, secondRegistration.Credential.Id, change.New()))
+	mfa, err = ctx.managers.Mfa.ReadOneByIdentityId(identity.Id)
+	ctx.NoError(err)
+	ctx.Nil(mfa)
+}
+
+func (ctx *TestContext) testWebAuthnReplacesPendingTotp(t *testing.T) {
+	ctx.NextTest(t)
+	identity := ctx.requireNewIdentity(false)
+
+	_, err := ctx.managers.Mfa.CreateForIdentity(identity, change.New())
+	ctx.NoError(err)
+
+	pending, err := ctx.managers.Mfa.ReadOneByIdentityId(identity.Id)
+	ctx.NoError(err)
+	ctx.False(pending.IsVerified)
+	ctx.NotEmpty(pending.Secret)
+
+	registration := ctx.requireWebAuthnRegistration(identity, webauthntest.NewAuthenticator(webAuthnTestRpId, webAuthnTestOrigin))
+	ctx.Equal(pending.RecoveryCodes, registration.RecoveryCodes)
+
+	mfa, err := ctx.managers.Mfa.ReadOneByIdentityId(identity.Id)
+	ctx.NoError(err)
+	ctx.Equal(pending.Id, mfa.Id)
+	ctx.True(mfa.IsVerified)
+	ctx.Empty(mfa.Secret)
+}
+
+func (ctx *TestContext) testWebAuthnChallengeScope(t *testing.T) {
+	ctx.NextTest(t)
+	identity := ctx.requireNewIdentity(false)
+	authenticator := webauthntest.NewAuthenticator(webAuthnTestRpId, webAuthnTestOrigin)
+	registration := ctx.requireWebAuthnRegistration(identity, authenticator)
+
+	mfa, err := ctx.managers.Mfa.ReadOneByIdentityId(identity.Id)
+	ctx.NoError(err)
+
+	// an assertion for one session can't complete another
+	assertion := ctx.requireWebAuthnAssertion(mfa, "session1", authenticator, registration.Credential.Id)
+	ctx.Error(ctx.managers.Mfa.VerifyWebAuthnAssertion(mfa, "session2", assertion, change.New()))
+
+	// a challenge can only be answered once
+	ctx.NoError(ctx.managers.Mfa.VerifyWebAuthnAssertion(mfa, "session1", assertion, change.New()))
+	ctx.Error(ctx.managers.Mfa.VerifyWebAuthnAssertion(mfa, "session1", assertion, change.New()))
+
+	// completing a registration without starting one fails
+	_, err = ctx.managers.Mfa.CompleteWebAuthnRegistration(identity.Id, "key", assertion.ClientDataJSON, nil, change.New())
+	ctx.Error(err)
+}
+
+func (ctx *TestContext) testWebAuthnRejectsWrongRelyingParty(t *testing.T) {
+	ctx.NextTest(t)
+	identity := ctx.requireNewIdentity(false)
+
+	for _, authenticator := range []*webauthntest.Authenticator{
+		webauthntest.NewAuthenticator(webAuthnTestRpId, "https://evil.example.com"),
+		webauthntest.NewAuthenticator("evil.example.com", webAuthnTestOrigin),
+	} {
+		options, err := ctx.managers.Mfa.StartWebAuthnRegistration(identity)
+		ctx.NoError(err)
+
+		attestation, err := authenticator.Create(options.Challenge)
+		ctx.NoError(err)
+
+		_, err = ctx.managers.Mfa.CompleteWebAuthnRegistration(identity.Id, "key", attestation.ClientDataJSON, attestation.AttestationObject, change.New())
+		ctx.Error(err)
+	}
+
+	mfa, err := ctx.managers.Mfa.ReadOneByIdentityId(identity.Id)
+	ctx.NoError(err)
+	ctx.Nil(mfa)
+
+	// a valid credential can't be asserted from another origin
+	authenticator := webauthntest.NewAuthenticator(webAuthnTestRpId, webAuthnTestOrigin)
+	registration := ctx.requireWebAuthnRegistration(identity, authenticator)
+
+	mfa, err = ctx.managers.Mfa.ReadOneByIdentityId(identity.Id)
+	ctx.NoError(err)
+
+	authenticator.Origin = "https://evil.example.com"
+	assertion := ctx.requireWebAuthnAssertion(mfa, "session1", authenticator, registration.Credential.Id)
+	ctx.Error(ctx.managers.Mfa.VerifyWebAuthnAssertion(mfa, "session1", assertion, change.New()))
+}
+
+func (ctx *TestContext) testWebAuthnRequiresUserVerification(t *testing.T) {
+	ctx.NextTest(t)
+	identity := ctx.requireNewIdentity(false)
+	authenticator := webauthntest.NewAuthenticator(webAuthnTestRpId, webAuthnTestOrigin)
+	registration := ctx.requireWebAuthnRegistration(identity, authenticator)
+
+	mfa, err := ctx.managers.Mfa.ReadOneByIdentityId(identity.Id)
+	ctx.NoError(err)
+
+	ctx.config.WebAuthn.RequireUserVerification = true
+	defer func() {
+		ctx.config.WebAuthn.RequireUserVerification = false
+	}()
+
+	authenticator.UserVerified = false
+	assertion := ctx.requireWebAuthnAssertion(mfa, "session1", authenticator, registration.Credential.Id)
+	ctx.Error(ctx.managers.Mfa.VerifyWebAuthnAssertion(mfa, "session1", assertion, change.New()))
+
+	authenticator.UserVerified = true
+	assertion = ctx.requireWebAuthnAssertion(mfa, "session1", authenticator, registration.Credential.Id)
+	ctx.NoError(ctx.managers.Mfa.VerifyWebAuthnAssertion(mfa, "session1", assertion, change.New()))
+}
+
+func (ctx *TestContext) testWebAuthnRejectsClonedAuthenticator(t *testing.T) {
+	ctx.NextTest(t)
+	identity := ctx.requireNewIdentity(false)
+	authenticator := webauthntest.NewAuthenticator(webAuthnTestRpId, webAuthnTestOrigin)
+	registration := ctx.requireWebAuthnRegistration(identity, authenticator)
+
+	mfa, err := ctx.managers.Mfa.ReadOneByIdentityId(identity.Id)
+	ctx.NoError(err)
+
+	assertion := ctx.requireWebAuthnAssertion(mfa, "session1", authenticator, registration.Credential.Id)
+	ctx.NoError(ctx.managers.Mfa.VerifyWebAuthnAssertion(mfa, "session1", assertion, change.New()))
+
+	mfa, err = ctx.managers.Mfa.ReadOneByIdentityId(identity.Id)
+	ctx.NoError(err)
+
+	// a second copy of the key reports a counter which has already been seen
+	rawCredentialId, err := DecodeWebAuthnValue(registration.Credential.Id)
+	ctx.NoError(err)
+	authenticator.SetSignCount(rawCredentialId, 0)
+
+	assertion = ctx.requireWebAuthnAssertion(mfa, "session1", authenticator, registration.Credential.Id)
+	ctx.Error(ctx.managers.Mfa.VerifyWebAuthnAssertion(mfa, "session1", assertion, change.New()))
+}
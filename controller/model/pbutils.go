@@ -18,6 +18,7 @@ package model
 
 import (
 	"github.com/openziti/ziti/common/pb/edge_cmd_pb"
+	"github.com/openziti/ziti/common/schedule"
 	"github.com/openziti/ziti/controller/change"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"time"
@@ -39,6 +40,30 @@ func pbTimeToTimePtr(pb *timestamppb.Timestamp) *time.Time {
 	return &result
 }
 
+func scheduleToPb(s *schedule.Schedule) *edge_cmd_pb.PolicySchedule {
+	if !s.IsSet() {
+		return nil
+	}
+	return &edge_cmd_pb.PolicySchedule{
+		ValidFrom:  timePtrToPb(s.ValidFrom),
+		ValidUntil: timePtrToPb(s.ValidUntil),
+		Windows:    s.Windows,
+		Timezone:   s.Timezone,
+	}
+}
+
+func pbToSchedule(pb *edge_cmd_pb.PolicySchedule) *schedule.Schedule {
+	if pb == nil {
+		return nil
+	}
+	return &schedule.Schedule{
+		ValidFrom:  pbTimeToTimePtr(pb.ValidFrom),
+		ValidUntil: pbTimeToTimePtr(pb.ValidUntil),
+		Windows:    pb.Windows,
+		Timezone:   pb.Timezone,
+	}
+}
+
 func ContextToProtobuf(context *change.Context) *edge_cmd_pb.ChangeContext {
 	if context == nil {
 		return nil
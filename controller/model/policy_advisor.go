@@ -4,6 +4,8 @@ import (
 	"github.com/openziti/foundation/v2/stringz"
 	"github.com/openziti/ziti/controller/db"
 	"go.etcd.io/bbolt"
+	"sync"
+	"time"
 )
 
 func NewPolicyAdvisor(env Env) *PolicyAdvisor {
@@ -15,6 +17,9 @@ func NewPolicyAdvisor(env Env) *PolicyAdvisor {
 
 type PolicyAdvisor struct {
 	env Env
+
+	// simulations hold every policy and the entities they select in memory, so only one is run at a time
+	simulationLock sync.Mutex
 }
 
 type AdvisorEdgeRouter struct {
@@ -30,6 +35,7 @@ type AdvisorServiceReachability struct {
 	IdentityRouterCount int
 	ServiceRouterCount  int
 	CommonRouters       []*AdvisorEdgeRouter
+	DenyingPolicies     []*ServicePolicy
 }
 
 func (advisor *PolicyAdvisor) AnalyzeServiceReachability(identityId, serviceId string) (*AdvisorServiceReachability, error) {
@@ -43,7 +49,7 @@ func (advisor *PolicyAdvisor) AnalyzeServiceReachability(identityId, serviceId s
 		return nil, err
 	}
 
-	permissions, err := advisor.getServicePermissions(identityId, serviceId)
+	permissions, denyingPolicies, err := advisor.getServicePermissions(identityId, serviceId)
 
 	if err != nil {
 		return nil, err
@@ -66,6 +72,7 @@ func (advisor *PolicyAdvisor) AnalyzeServiceReachability(identityId, serviceId s
 		IsDialAllowed:       stringz.Contains(permissions, db.PolicyTypeDialName),
 		IdentityRouterCount: len(edgeRouters),
 		ServiceRouterCount:  len(serviceEdgeRouters),
+		DenyingPolicies:     denyingPolicies,
 	}
 
 	for edgeRouterId := range serviceEdgeRouters {
@@ -77,34 +84,62 @@ func (advisor *PolicyAdvisor) AnalyzeServiceReachability(identityId, serviceId s
 	return result, nil
 }
 
-func (advisor *PolicyAdvisor) getServicePermissions(identityId, serviceId string) ([]string, error) {
+// getServicePermissions returns the permissions the identity has on the service along with any deny policies which
+// revoke permissions otherwise granted
+func (advisor *PolicyAdvisor) getServicePermissions(identityId, serviceId string) ([]string, []*ServicePolicy, error) {
 	var permissions []string
+	var denyPolicies []*ServicePolicy
 
+	now := time.Now()
 	servicePolicyStore := advisor.env.GetStores().ServicePolicy
 	servicePolicyIterator := func(tx *bbolt.Tx, servicePolicyId string) error {
-		servicePolicy, err := servicePolicyStore.LoadById(tx, servicePolicyId)
+		if !servicePolicyStore.IsEntityRelated(tx, servicePolicyId, db.EntityTypeServices, serviceId) {
+			return nil
+		}
+		servicePolicy, err := advisor.env.GetManagers().ServicePolicy.readInTx(tx, servicePolicyId)
 		if err != nil {
 			return err
 		}
-		if servicePolicyStore.IsEntityRelated(tx, servicePolicyId, db.EntityTypeServices, serviceId) {
-			if !stringz.Contains(permissions, string(servicePolicy.PolicyType)) {
-				permissions = append(permissions, string(servicePolicy.PolicyType))
-			}
+		if servicePolicy.Effect == db.PolicyEffectDeny.String() {
+			denyPolicies = append(denyPolicies, servicePolicy)
+			return nil
+		}
+		if !servicePolicy.Schedule.IsActive(now) {
+			return nil
+		}
+		if !stringz.Contains(permissions, servicePolicy.PolicyType) {
+			permissions = append(permissions, servicePolicy.PolicyType)
 		}
 		return nil
 	}
 
 	if err := advisor.env.GetManagers().Identity.iterateRelatedEntities(identityId, db.EntityTypeServicePolicies, servicePolicyIterator); err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	// deny policies take precedence, so only report those which revoke a permission which would otherwise be granted
+	var denyingPolicies []*ServicePolicy
+	allowed := permissions
+	for _, denyPolicy := range denyPolicies {
+		if stringz.Contains(allowed, denyPolicy.PolicyType) {
+			denyingPolicies = append(denyingPolicies, denyPolicy)
+			permissions = stringz.Remove(permissions, denyPolicy.PolicyType)
+		}
 	}
 
-	return permissions, nil
+	return permissions, denyingPolicies, nil
 }
 
 func (advisor *PolicyAdvisor) getIdentityEdgeRouters(identityId string) (map[string]*AdvisorEdgeRouter, error) {
 	edgeRouters := map[string]*AdvisorEdgeRouter{}
 
+	now := time.Now()
+	edgeRouterPolicyStore := advisor.env.GetStores().EdgeRouterPolicy
 	edgeRouterPolicyIterator := func(tx *bbolt.Tx, edgeRouterPolicyId string) error {
+		if !edgeRouterPolicyStore.GetSchedule(tx, []byte(edgeRouterPolicyId)).IsActive(now) {
+			return nil
+		}
+
 		edgeRouterIterator := func(tx *bbolt.Tx, edgeRouterId string) error {
 			commonRouter := edgeRouters[edgeRouterId]
 			if commonRouter == nil {
@@ -0,0 +1,70 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openziti/ziti/controller/change"
+	"github.com/openziti/ziti/controller/db"
+	"go.etcd.io/bbolt"
+)
+
+func TestPolicyEffects(t *testing.T) {
+	ctx := NewTestContext(t)
+	defer ctx.Cleanup()
+	ctx.Init()
+
+	t.Run("test deny service policies", ctx.testDenyServicePolicies)
+}
+
+func (ctx *TestContext) testDenyServicePolicies(*testing.T) {
+	identity := ctx.requireNewIdentity(false)
+	service := ctx.requireNewService()
+
+	ctx.requireNewServicePolicy(db.PolicyTypeDialName, ss("@"+identity.Id), ss("@"+service.Id))
+	denyPolicy := ctx.requireNewServicePolicy(db.PolicyTypeDialName, ss("@"+identity.Id), ss("@"+service.Id))
+
+	isDialable := func() bool {
+		result := false
+		ctx.NoError(ctx.GetDbProvider().GetDb().View(func(tx *bbolt.Tx) error {
+			result = ctx.GetStores().EdgeService.IsDialableByIdentity(tx, service.Id, identity.Id)
+			return nil
+		}))
+		return result
+	}
+
+	ctx.True(isDialable())
+
+	ctx.NoError(ctx.managers.ServicePolicy.UpdateEffect(denyPolicy.Id, "deny", change.New()))
+	loaded, err := ctx.managers.ServicePolicy.Read(denyPolicy.Id)
+	ctx.NoError(err)
+	ctx.Equal(db.PolicyEffectDeny.String(), loaded.Effect)
+
+	ctx.False(isDialable())
+
+	active, inactive := ctx.managers.ServicePolicy.IsAccessInSchedule(identity.Id, service.Id, db.PolicyTypeDial, time.Now())
+	ctx.False(active)
+	ctx.Empty(inactive)
+
+	// the advisor reports the deny policy which blocked access
+	reachability, err := ctx.managers.PolicyAdvisor.AnalyzeServiceReachability(identity.Id, service.Id)
+	ctx.NoError(err)
+	ctx.False(reachability.IsDialAllowed)
+	ctx.Len(reachability.DenyingPolicies, 1)
+	ctx.Equal(denyPolicy.Id, reachability.DenyingPolicies[0].Id)
+
+	// other updates leave the effect in place
+	loaded.Name = loaded.Name + "-renamed"
+	ctx.NoError(ctx.managers.ServicePolicy.Update(loaded, nil, change.New()))
+	ctx.False(isDialable())
+
+	ctx.Error(ctx.managers.ServicePolicy.UpdateEffect(denyPolicy.Id, "Maybe", change.New()))
+
+	ctx.NoError(ctx.managers.ServicePolicy.UpdateEffect(denyPolicy.Id, db.PolicyEffectAllow.String(), change.New()))
+	ctx.True(isDialable())
+
+	reachability, err = ctx.managers.PolicyAdvisor.AnalyzeServiceReachability(identity.Id, service.Id)
+	ctx.NoError(err)
+	ctx.True(reachability.IsDialAllowed)
+	ctx.Empty(reachability.DenyingPolicies)
+}
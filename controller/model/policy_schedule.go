@@ -0,0 +1,257 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/openziti/foundation/v2/stringz"
+	"github.com/openziti/storage/ast"
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/common/schedule"
+	"github.com/openziti/ziti/controller/apierror"
+	"github.com/openziti/ziti/controller/change"
+	"github.com/openziti/ziti/controller/db"
+	"github.com/openziti/ziti/controller/fields"
+	"go.etcd.io/bbolt"
+)
+
+// ScheduledPolicy identifies a service or edge router policy along with its schedule
+type ScheduledPolicy struct {
+	Id       string
+	Name     string
+	Type     string
+	Schedule *schedule.Schedule
+}
+
+func policyScheduleFields() fields.UpdatedFields {
+	return fields.UpdatedFieldsMap{}.AddFields(db.PolicyScheduleFields...)
+}
+
+// UpdateSchedule replaces the schedule of the given service policy. A nil or empty schedule leaves the policy always
+// in force.
+func (self *ServicePolicyManager) UpdateSchedule(id string, policySchedule *schedule.Schedule, ctx *change.Context) error {
+	policy, err := self.Read(id)
+	if err != nil {
+		return err
+	}
+	policy.Schedule = policySchedule
+	return self.Update(policy, policyScheduleFields(), ctx)
+}
+
+// UpdateEffect sets whether the given service policy allows or denies access
+func (self *ServicePolicyManager) UpdateEffect(id string, effect string, ctx *change.Context) error {
+	policy, err := self.Read(id)
+	if err != nil {
+		return err
+	}
+	policy.Effect = effect
+	return self.Update(policy, fields.UpdatedFieldsMap{}.AddFields(db.FieldServicePolicyEffect), ctx)
+}
+
+// IsAccessInSchedule returns true if one of the policies of the given type granting the identity access to the service
+// is currently in force
+func (self *ServicePolicyManager) IsAccessInSchedule(identityId, serviceId string, policyType db.PolicyType, at time.Time) (bool, []*ScheduledPolicy) {
+	var result bool
+	var inactive []*ScheduledPolicy
+	_ = self.GetDb().View(func(tx *bbolt.Tx) error {
+		result, inactive = self.IsAccessInScheduleInTx(tx, identityId, serviceId, policyType, at)
+		return nil
+	})
+	return result, inactive
+}
+
+// IsAccessInScheduleInTx returns true if one of the policies of the given type granting the identity access to the
+// service is in force at the given time. If not, the policies which would grant access outside their schedule are
+// returned. Access denied by a deny policy is never in force.
+func (self *ServicePolicyManager) IsAccessInScheduleInTx(tx *bbolt.Tx, identityId, serviceId string, policyType db.PolicyType, at time.Time) (bool, []*ScheduledPolicy) {
+	store := self.env.GetStores().ServicePolicy
+	serviceLinks := store.GetLinkCollection(db.EntityTypeServices)
+	policyTypeSymbol := store.GetSymbol(db.FieldServicePolicyType)
+	policyNameSymbol := store.GetSymbol(db.FieldName)
+
+	var inactive []*ScheduledPolicy
+	active := false
+
+	cursor := self.env.GetStores().Identity.GetRelatedEntitiesCursor(tx, identityId, db.EntityTypeServicePolicies, true)
+	for cursor.IsValid() {
+		policyId := cursor.Current()
+		cursor.Next()
+
+		if !serviceLinks.IsLinked(tx, policyId, []byte(serviceId)) {
+			continue
+		}
+
+		currentPolicyType := db.PolicyTypeDial
+		if fieldType, value := policyTypeSymbol.Eval(tx, policyId); fieldType == boltz.TypeInt32 {
+			currentPolicyType = db.GetPolicyTypeForId(*boltz.BytesToInt32(value))
+		}
+		if currentPolicyType != policyType {
+			continue
+		}
+
+		if store.GetEffect(tx, policyId).IsDeny() {
+			return false, nil
+		}
+
+		policySchedule := store.GetSchedule(tx, policyId)
+		if policySchedule.IsActive(at) {
+			active = true
+			continue
+		}
+
+		inactive = append(inactive, &ScheduledPolicy{
+			Id:       string(policyId),
+			Name:     stringz.OrEmpty(boltz.FieldToString(policyNameSymbol.Eval(tx, policyId))),
+			Type:     db.EntityTypeServicePolicies,
+			Schedule: policySchedule,
+		})
+	}
+
+	if active {
+		return true, nil
+	}
+	return false, inactive
+}
+
+// CheckSchedule returns an error if the identity is only granted access to the service by policies which are outside
+// their schedule
+func (self *ServicePolicyManager) CheckSchedule(identityId, serviceId string, policyType db.PolicyType) error {
+	active, inactive := self.IsAccessInSchedule(identityId, serviceId, policyType, time.Now())
+	if active || len(inactive) == 0 {
+		return nil
+	}
+
+	var names []string
+	for _, policy := range inactive {
+		names = append(names, policy.Name)
+	}
+
+	return apierror.NewPolicyNotInSchedule(fmt.Errorf("%v policies %v are not in force", policyType, names))
+}
+
+// UpdateSchedule replaces the schedule of the given edge router policy. A nil or empty schedule leaves the policy always
+// in force.
+func (self *EdgeRouterPolicyManager) UpdateSchedule(id string, policySchedule *schedule.Schedule, ctx *change.Context) error {
+	policy, err := self.Read(id)
+	if err != nil {
+		return err
+	}
+	policy.Schedule = policySchedule
+	return self.Update(policy, policyScheduleFields(), ctx)
+}
+
+// getOutOfSchedulePolicies returns the identity's edge router policies which aren't in force at the given time
+func (self *EdgeRouterPolicyManager) getOutOfSchedulePolicies(tx *bbolt.Tx, identityId string, at time.Time) [][]byte {
+	store := self.env.GetStores().EdgeRouterPolicy
+
+	var result [][]byte
+	cursor := self.env.GetStores().Identity.GetRelatedEntitiesCursor(tx, identityId, db.EntityTypeEdgeRouterPolicies, true)
+	for cursor.IsValid() {
+		policyId := cursor.Current()
+		if !store.GetSchedule(tx, policyId).IsActive(at) {
+			result = append(result, append([]byte(nil), policyId...))
+		}
+		cursor.Next()
+	}
+	return result
+}
+
+// edgeRouterAccess answers whether an identity may use an edge router, taking edge router policy schedules into
+// account. Identity to edge router links are reference counted by policy, so access is retained as long as at least
+// one policy linking the two is in force.
+type edgeRouterAccess struct {
+	identityId       []byte
+	identityRouters  boltz.RefCountedLinkCollection
+	policyRouters    boltz.LinkCollection
+	outOfSchedule    [][]byte
+	hasOutOfSchedule bool
+}
+
+func (self *EdgeRouterManager) newEdgeRouterAccess(tx *bbolt.Tx, identityId string, at time.Time) *edgeRouterAccess {
+	outOfSchedule := self.env.GetManagers().EdgeRouterPolicy.getOutOfSchedulePolicies(tx, identityId, at)
+	return &edgeRouterAccess{
+		identityId:       []byte(identityId),
+		identityRouters:  self.env.GetStores().Identity.GetRefCountedLinkCollection(db.EntityTypeRouters),
+		policyRouters:    self.env.GetStores().EdgeRouterPolicy.GetLinkCollection(db.EntityTypeRouters),
+		outOfSchedule:    outOfSchedule,
+		hasOutOfSchedule: len(outOfSchedule) > 0,
+	}
+}
+
+func (self *edgeRouterAccess) isAllowed(tx *bbolt.Tx, edgeRouterId []byte) bool {
+	count := self.identityRouters.GetLinkCount(tx, self.identityId, edgeRouterId)
+	if count == nil || *count < 1 {
+		return false
+	}
+
+	remaining := *count
+	for _, policyId := range self.outOfSchedule {
+		if self.policyRouters.IsLinked(tx, policyId, edgeRouterId) {
+			remaining--
+		}
+	}
+	return remaining > 0
+}
+
+// IsEdgeRouterAccessOutOfScheduleInTx returns true if the identity shares edge routers with the service, but only
+// through edge router policies which are currently outside their schedule
+func (self *EdgeRouterManager) IsEdgeRouterAccessOutOfScheduleInTx(tx *bbolt.Tx, identityId, serviceId string) bool {
+	access := self.newEdgeRouterAccess(tx, identityId, time.Now())
+	if !access.hasOutOfSchedule {
+		return false
+	}
+
+	serviceEdgeRouters := self.env.GetStores().EdgeService.GetRefCountedLinkCollection(db.FieldEdgeRouters)
+	sharedRouterFound := false
+
+	cursor := access.identityRouters.IterateLinks(tx, access.identityId, true)
+	for cursor.IsValid() {
+		serviceCount := serviceEdgeRouters.GetLinkCount(tx, []byte(serviceId), cursor.Current())
+		if serviceCount != nil && *serviceCount > 0 {
+			if access.isAllowed(tx, cursor.Current()) {
+				return false
+			}
+			sharedRouterFound = true
+		}
+		cursor.Next()
+	}
+	return sharedRouterFound
+}
+
+// ListScheduledPolicies returns the service and edge router policies which have schedules
+func ListScheduledPolicies(env Env, tx *bbolt.Tx) []*ScheduledPolicy {
+	var result []*ScheduledPolicy
+	for entityType, store := range map[string]db.ScheduledPolicyStore{
+		db.EntityTypeServicePolicies:    env.GetStores().ServicePolicy,
+		db.EntityTypeEdgeRouterPolicies: env.GetStores().EdgeRouterPolicy,
+	} {
+		nameSymbol := store.GetSymbol(db.FieldName)
+		for cursor := store.IterateIds(tx, ast.BoolNodeTrue); cursor.IsValid(); cursor.Next() {
+			if policySchedule := store.GetSchedule(tx, cursor.Current()); policySchedule != nil {
+				result = append(result, &ScheduledPolicy{
+					Id:       string(cursor.Current()),
+					Name:     stringz.OrEmpty(boltz.FieldToString(nameSymbol.Eval(tx, cursor.Current()))),
+					Type:     entityType,
+					Schedule: policySchedule,
+				})
+			}
+		}
+	}
+	return result
+}
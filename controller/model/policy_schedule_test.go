@@ -0,0 +1,84 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openziti/ziti/common/eid"
+	"github.com/openziti/ziti/common/schedule"
+	"github.com/openziti/ziti/controller/change"
+	"github.com/openziti/ziti/controller/db"
+)
+
+func TestPolicySchedules(t *testing.T) {
+	ctx := NewTestContext(t)
+	defer ctx.Cleanup()
+	ctx.Init()
+
+	t.Run("test service policy schedules", ctx.testServicePolicySchedules)
+	t.Run("test edge router policy schedules", ctx.testEdgeRouterPolicySchedules)
+}
+
+func (ctx *TestContext) testServicePolicySchedules(*testing.T) {
+	identity := ctx.requireNewIdentity(false)
+	service := ctx.requireNewService()
+
+	policy := ctx.requireNewServicePolicy(db.PolicyTypeDialName, ss("@"+identity.Id), ss("@"+service.Id))
+
+	now := time.Now()
+	active, inactive := ctx.managers.ServicePolicy.IsAccessInSchedule(identity.Id, service.Id, db.PolicyTypeDial, now)
+	ctx.True(active)
+	ctx.Empty(inactive)
+
+	expired := now.Add(-time.Hour)
+	ctx.NoError(ctx.managers.ServicePolicy.UpdateSchedule(policy.Id, &schedule.Schedule{ValidUntil: &expired}, change.New()))
+
+	active, inactive = ctx.managers.ServicePolicy.IsAccessInSchedule(identity.Id, service.Id, db.PolicyTypeDial, now)
+	ctx.False(active)
+	ctx.Len(inactive, 1)
+	ctx.Equal(policy.Id, inactive[0].Id)
+	ctx.Error(ctx.managers.ServicePolicy.CheckSchedule(identity.Id, service.Id, db.PolicyTypeDial))
+
+	// policies of other types and policies which don't grant access aren't reported
+	active, inactive = ctx.managers.ServicePolicy.IsAccessInSchedule(identity.Id, service.Id, db.PolicyTypeBind, now)
+	ctx.False(active)
+	ctx.Empty(inactive)
+	ctx.NoError(ctx.managers.ServicePolicy.CheckSchedule(identity.Id, service.Id, db.PolicyTypeBind))
+
+	// a second, unrestricted policy restores access
+	ctx.requireNewServicePolicy(db.PolicyTypeDialName, ss("@"+identity.Id), ss("@"+service.Id))
+	ctx.NoError(ctx.managers.ServicePolicy.CheckSchedule(identity.Id, service.Id, db.PolicyTypeDial))
+
+	ctx.NoError(ctx.managers.ServicePolicy.UpdateSchedule(policy.Id, nil, change.New()))
+	loaded, err := ctx.managers.ServicePolicy.Read(policy.Id)
+	ctx.NoError(err)
+	ctx.Nil(loaded.Schedule)
+
+	err = ctx.managers.ServicePolicy.UpdateSchedule(policy.Id, &schedule.Schedule{Windows: []string{"someday 09:00-17:00"}}, change.New())
+	ctx.Error(err)
+}
+
+func (ctx *TestContext) testEdgeRouterPolicySchedules(*testing.T) {
+	edgeRouter := ctx.requireNewEdgeRouter()
+	identity := ctx.requireNewIdentity(false)
+	service := ctx.requireNewService()
+	service.RoleAttributes = []string{eid.New()}
+	ctx.NoError(ctx.managers.EdgeService.Update(service, nil, change.New()))
+
+	ctx.requireNewServiceNewEdgeRouterPolicy(ss("@"+service.Id), ss("@"+edgeRouter.Id))
+	policy := ctx.requireNewEdgeRouterPolicy(ss("@"+identity.Id), ss("@"+edgeRouter.Id))
+
+	ctx.True(ctx.isEdgeRouterAccessible(edgeRouter.Id, identity.Id, service.Id))
+	ctx.True(ctx.managers.EdgeRouter.IsSharedEdgeRouterPresent(identity.Id, service.Id))
+
+	notYet := time.Now().Add(time.Hour)
+	ctx.NoError(ctx.managers.EdgeRouterPolicy.UpdateSchedule(policy.Id, &schedule.Schedule{ValidFrom: &notYet}, change.New()))
+
+	ctx.False(ctx.isEdgeRouterAccessible(edgeRouter.Id, identity.Id, service.Id))
+	ctx.False(ctx.managers.EdgeRouter.IsSharedEdgeRouterPresent(identity.Id, service.Id))
+
+	// a second policy linking the same router keeps it accessible
+	ctx.requireNewEdgeRouterPolicy(ss("@"+identity.Id), ss("@"+edgeRouter.Id))
+	ctx.True(ctx.isEdgeRouterAccessible(edgeRouter.Id, identity.Id, service.Id))
+	ctx.True(ctx.managers.EdgeRouter.IsSharedEdgeRouterPresent(identity.Id, service.Id))
+}
@@ -0,0 +1,558 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/foundation/v2/stringz"
+	"github.com/openziti/storage/ast"
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/common/eid"
+	"github.com/openziti/ziti/controller/db"
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	PolicySimulationActionCreate = "create"
+	PolicySimulationActionUpdate = "update"
+	PolicySimulationActionDelete = "delete"
+
+	SimulatedAccessService           = "service"
+	SimulatedAccessEdgeRouter        = "edgeRouter"
+	SimulatedAccessServiceEdgeRouter = "serviceEdgeRouter"
+)
+
+// PolicySimulationChange is a proposed change to a service policy, edge router policy, service edge router policy
+// or to the role attributes of an identity. On updates, nil role slices and empty strings leave the current value
+// in place.
+type PolicySimulationChange struct {
+	Action            string
+	EntityType        string
+	Id                string
+	Name              string
+	Semantic          string
+	PolicyType        string
+	Effect            string
+	IdentityRoles     []string
+	ServiceRoles      []string
+	EdgeRouterRoles   []string
+	PostureCheckRoles []string
+	RoleAttributes    []string
+}
+
+// SimulatedAccessChange is a single access grant which would be gained or lost. Subjects are identities for service
+// and edge router access and services for service edge router access. Permission is only set for service access.
+type SimulatedAccessChange struct {
+	SubjectId   string
+	SubjectName string
+	TargetId    string
+	TargetName  string
+	Permission  string
+	Granted     bool
+}
+
+type PolicySimulationResult struct {
+	ServiceAccess           []*SimulatedAccessChange
+	EdgeRouterAccess        []*SimulatedAccessChange
+	ServiceEdgeRouterAccess []*SimulatedAccessChange
+}
+
+func (self *PolicySimulationResult) HasChanges() bool {
+	return len(self.ServiceAccess) > 0 || len(self.EdgeRouterAccess) > 0 || len(self.ServiceEdgeRouterAccess) > 0
+}
+
+type simulatedAccess struct {
+	accessType string
+	subjectId  string
+	targetId   string
+	permission string
+}
+
+type accessSnapshot map[simulatedAccess]struct{}
+
+// simulatedEntity is an identity, service or edge router as seen by the policies which select it
+type simulatedEntity struct {
+	id             string
+	name           string
+	roleAttributes []string
+}
+
+// simulatedPolicy is a service policy, edge router policy or service edge router policy. Only the roles relevant to the
+// policy's type are used.
+type simulatedPolicy struct {
+	id                string
+	name              string
+	semantic          string
+	policyType        db.PolicyType
+	effect            db.PolicyEffect
+	scheduled         bool
+	identityRoles     []string
+	serviceRoles      []string
+	edgeRouterRoles   []string
+	postureCheckRoles []string
+}
+
+// policySimulation holds the policies and the entities they select, so that proposed changes can be applied and
+// evaluated in memory, without writing to the datastore
+type policySimulation struct {
+	tx                        *bbolt.Tx
+	stores                    *db.Stores
+	identities                map[string]*simulatedEntity
+	services                  map[string]*simulatedEntity
+	edgeRouters               map[string]*simulatedEntity
+	servicePolicies           map[string]*simulatedPolicy
+	edgeRouterPolicies        map[string]*simulatedPolicy
+	serviceEdgeRouterPolicies map[string]*simulatedPolicy
+}
+
+// SimulatePolicyChanges reports how identity service access, identity edge router access and service edge router
+// access would differ if the given changes were applied. The changes are evaluated in memory against a read
+// transaction, so nothing is written. Schedules are not taken into account, as they only govern when the resulting
+// access is in force.
+func (advisor *PolicyAdvisor) SimulatePolicyChanges(changes []*PolicySimulationChange) (*PolicySimulationResult, error) {
+	if len(changes) == 0 {
+		return nil, errorz.NewFieldError("at least one change must be provided", "changes", changes)
+	}
+
+	advisor.simulationLock.Lock()
+	defer advisor.simulationLock.Unlock()
+
+	var result *PolicySimulationResult
+
+	err := advisor.env.GetDbProvider().GetDb().View(func(tx *bbolt.Tx) error {
+		sim, err := newPolicySimulation(tx, advisor.env.GetStores())
+		if err != nil {
+			return err
+		}
+
+		before := sim.snapshotAccess()
+
+		for idx, simChange := range changes {
+			if err = sim.apply(simChange); err != nil {
+				return errors.Wrapf(err, "unable to apply change %d (%s %s %s)", idx+1, simChange.Action, simChange.EntityType, simChange.Id)
+			}
+		}
+
+		result = sim.diffAccess(before, sim.snapshotAccess())
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func newPolicySimulation(tx *bbolt.Tx, stores *db.Stores) (*policySimulation, error) {
+	result := &policySimulation{
+		tx:                        tx,
+		stores:                    stores,
+		identities:                loadSimulatedEntities(tx, stores.Identity),
+		services:                  loadSimulatedEntities(tx, stores.EdgeService),
+		edgeRouters:               loadSimulatedEntities(tx, stores.EdgeRouter),
+		servicePolicies:           map[string]*simulatedPolicy{},
+		edgeRouterPolicies:        map[string]*simulatedPolicy{},
+		serviceEdgeRouterPolicies: map[string]*simulatedPolicy{},
+	}
+
+	for cursor := stores.ServicePolicy.IterateIds(tx, ast.BoolNodeTrue); cursor.IsValid(); cursor.Next() {
+		policy, err := stores.ServicePolicy.LoadById(tx, string(cursor.Current()))
+		if err != nil {
+			return nil, err
+		}
+		result.servicePolicies[policy.Id] = &simulatedPolicy{
+			id:                policy.Id,
+			name:              policy.Name,
+			semantic:          policy.Semantic,
+			policyType:        policy.PolicyType,
+			effect:            policy.Effect,
+			scheduled:         policy.Schedule.IsSet(),
+			identityRoles:     policy.IdentityRoles,
+			serviceRoles:      policy.ServiceRoles,
+			postureCheckRoles: policy.PostureCheckRoles,
+		}
+	}
+
+	for cursor := stores.EdgeRouterPolicy.IterateIds(tx, ast.BoolNodeTrue); cursor.IsValid(); cursor.Next() {
+		policy, err := stores.EdgeRouterPolicy.LoadById(tx, string(cursor.Current()))
+		if err != nil {
+			return nil, err
+		}
+		result.edgeRouterPolicies[policy.Id] = &simulatedPolicy{
+			id:              policy.Id,
+			name:            policy.Name,
+			semantic:        policy.Semantic,
+			identityRoles:   policy.IdentityRoles,
+			edgeRouterRoles: policy.EdgeRouterRoles,
+		}
+	}
+
+	for cursor := stores.ServiceEdgeRouterPolicy.IterateIds(tx, ast.BoolNodeTrue); cursor.IsValid(); cursor.Next() {
+		policy, err := stores.ServiceEdgeRouterPolicy.LoadById(tx, string(cursor.Current()))
+		if err != nil {
+			return nil, err
+		}
+		result.serviceEdgeRouterPolicies[policy.Id] = &simulatedPolicy{
+			id:              policy.Id,
+			name:            policy.Name,
+			semantic:        policy.Semantic,
+			serviceRoles:    policy.ServiceRoles,
+			edgeRouterRoles: policy.EdgeRouterRoles,
+		}
+	}
+
+	return result, nil
+}
+
+func loadSimulatedEntities(tx *bbolt.Tx, store boltz.Store) map[string]*simulatedEntity {
+	names := store.GetSymbol(db.FieldName)
+	roleAttributes := store.GetSymbol(db.FieldRoleAttributes).(boltz.EntitySetSymbol)
+
+	result := map[string]*simulatedEntity{}
+	for cursor := store.IterateIds(tx, ast.BoolNodeTrue); cursor.IsValid(); cursor.Next() {
+		id := cursor.Current()
+		result[string(id)] = &simulatedEntity{
+			id:             string(id),
+			name:           stringz.OrEmpty(boltz.FieldToString(names.Eval(tx, id))),
+			roleAttributes: roleAttributes.EvalStringList(tx, id),
+		}
+	}
+	return result
+}
+
+func (self *policySimulation) apply(simChange *PolicySimulationChange) error {
+	if simChange == nil {
+		return errors.New("change is empty")
+	}
+
+	if simChange.Action != PolicySimulationActionCreate && simChange.Action != PolicySimulationActionUpdate && simChange.Action != PolicySimulationActionDelete {
+		msg := fmt.Sprintf("invalid action. valid actions are '%v', '%v' and '%v'",
+			PolicySimulationActionCreate, PolicySimulationActionUpdate, PolicySimulationActionDelete)
+		return errorz.NewFieldError(msg, "action", simChange.Action)
+	}
+
+	if simChange.Action != PolicySimulationActionCreate && simChange.Id == "" {
+		return errorz.NewFieldError("id is required for updates and deletes", "id", simChange.Id)
+	}
+
+	switch simChange.EntityType {
+	case db.EntityTypeServicePolicies:
+		return self.applyPolicyChange(self.stores.ServicePolicy, self.servicePolicies, simChange)
+	case db.EntityTypeEdgeRouterPolicies:
+		return self.applyPolicyChange(self.stores.EdgeRouterPolicy, self.edgeRouterPolicies, simChange)
+	case db.EntityTypeServiceEdgeRouterPolicies:
+		return self.applyPolicyChange(self.stores.ServiceEdgeRouterPolicy, self.serviceEdgeRouterPolicies, simChange)
+	case db.EntityTypeIdentities:
+		if simChange.Action != PolicySimulationActionUpdate {
+			return errorz.NewFieldError("only role attribute updates may be simulated for identities", "action", simChange.Action)
+		}
+
+		identity, found := self.identities[simChange.Id]
+		if !found {
+			return boltz.NewNotFoundError(self.stores.Identity.GetSingularEntityType(), "id", simChange.Id)
+		}
+
+		for _, attr := range simChange.RoleAttributes {
+			if strings.HasPrefix(attr, db.RolePrefix) || strings.HasPrefix(attr, db.EntityPrefix) {
+				return errorz.NewFieldError("role attributes may not be prefixed with # or @", db.FieldRoleAttributes, attr)
+			}
+		}
+
+		identity.roleAttributes = simChange.RoleAttributes
+		return nil
+	default:
+		msg := fmt.Sprintf("invalid entity type. valid types are '%v', '%v', '%v' and '%v'", db.EntityTypeServicePolicies,
+			db.EntityTypeEdgeRouterPolicies, db.EntityTypeServiceEdgeRouterPolicies, db.EntityTypeIdentities)
+		return errorz.NewFieldError(msg, "entityType", simChange.EntityType)
+	}
+}
+
+func (self *policySimulation) applyPolicyChange(store boltz.Store, policies map[string]*simulatedPolicy, simChange *PolicySimulationChange) error {
+	policy, found := policies[simChange.Id]
+
+	if simChange.Action == PolicySimulationActionDelete {
+		if !found {
+			return boltz.NewNotFoundError(store.GetSingularEntityType(), "id", simChange.Id)
+		}
+		delete(policies, simChange.Id)
+		return nil
+	}
+
+	if simChange.Action == PolicySimulationActionUpdate {
+		if !found {
+			return boltz.NewNotFoundError(store.GetSingularEntityType(), "id", simChange.Id)
+		}
+		updated := *policy
+		policy = &updated
+	} else {
+		if found {
+			return errorz.NewFieldError("an entity with the given id already exists", "id", simChange.Id)
+		}
+		policy = &simulatedPolicy{
+			id:       simChange.Id,
+			semantic: db.SemanticAllOf,
+			effect:   db.PolicyEffectAllow,
+		}
+		if policy.id == "" {
+			policy.id = eid.New()
+		}
+	}
+
+	isServicePolicy := store == self.stores.ServicePolicy
+
+	if isServicePolicy && (simChange.Action == PolicySimulationActionCreate || simChange.PolicyType != "") {
+		boltPolicy, err := (&ServicePolicy{PolicyType: simChange.PolicyType}).toBoltEntity(nil)
+		if err != nil {
+			return err
+		}
+		policy.policyType = boltPolicy.PolicyType
+	}
+
+	if isServicePolicy && (simChange.Action == PolicySimulationActionCreate || simChange.Effect != "") {
+		effect, err := (&ServicePolicy{Effect: simChange.Effect}).getEffect()
+		if err != nil {
+			return err
+		}
+		policy.effect = effect
+	}
+
+	policy.name = firstNonEmpty(simChange.Name, policy.name, policy.id)
+	policy.semantic = firstNonEmpty(simChange.Semantic, policy.semantic)
+	if simChange.IdentityRoles != nil {
+		policy.identityRoles = simChange.IdentityRoles
+	}
+	if simChange.ServiceRoles != nil {
+		policy.serviceRoles = simChange.ServiceRoles
+	}
+	if simChange.EdgeRouterRoles != nil {
+		policy.edgeRouterRoles = simChange.EdgeRouterRoles
+	}
+	if simChange.PostureCheckRoles != nil {
+		policy.postureCheckRoles = simChange.PostureCheckRoles
+	}
+
+	if !db.IsSemanticValid(policy.semantic) {
+		return errorz.NewFieldError("invalid semantic", db.FieldSemantic, policy.semantic)
+	}
+
+	for _, other := range policies {
+		if other.id != policy.id && other.name == policy.name {
+			return errorz.NewFieldError("name must be unique", db.FieldName, policy.name)
+		}
+	}
+
+	var err error
+	switch store {
+	case self.stores.ServicePolicy:
+		if policy.effect.IsDeny() && len(policy.postureCheckRoles) > 0 {
+			return errorz.NewFieldError("deny policies may not have posture check roles", db.FieldPostureCheckRoles, policy.postureCheckRoles)
+		}
+		if policy.effect.IsDeny() && policy.scheduled {
+			return errorz.NewFieldError("deny policies may not have a schedule", db.FieldPolicyScheduleWindows, nil)
+		}
+		err = self.validateRoles(db.FieldIdentityRoles, policy.identityRoles, self.stores.Identity)
+		if err == nil {
+			err = self.validateRoles(db.FieldServiceRoles, policy.serviceRoles, self.stores.EdgeService)
+		}
+		if err == nil {
+			err = self.validateRoles(db.FieldPostureCheckRoles, policy.postureCheckRoles, self.stores.PostureCheck)
+		}
+	case self.stores.EdgeRouterPolicy:
+		err = self.validateRoles(db.FieldIdentityRoles, policy.identityRoles, self.stores.Identity)
+		if err == nil {
+			err = self.validateRoles(db.FieldEdgeRouterRoles, policy.edgeRouterRoles, self.stores.EdgeRouter)
+		}
+	default:
+		err = self.validateRoles(db.FieldServiceRoles, policy.serviceRoles, self.stores.EdgeService)
+		if err == nil {
+			err = self.validateRoles(db.FieldEdgeRouterRoles, policy.edgeRouterRoles, self.stores.EdgeRouter)
+		}
+	}
+
+	if err != nil {
+		return err
+	}
+
+	policies[policy.id] = policy
+	return nil
+}
+
+// validateRoles checks that roles are prefixed correctly and that the entities they reference by id exist
+func (self *policySimulation) validateRoles(field string, roles []string, store boltz.Store) error {
+	_, ids, err := db.SplitRolesAndIds(field, roles)
+	if err != nil {
+		return err
+	}
+
+	var invalid []string
+	for _, id := range ids {
+		if !store.IsEntityPresent(self.tx, id) {
+			invalid = append(invalid, id)
+		}
+	}
+
+	if len(invalid) > 0 {
+		return errorz.NewFieldError(fmt.Sprintf("no %v found with the given ids", store.GetEntityType()), field, invalid)
+	}
+
+	return nil
+}
+
+// selectEntities returns the entities which the given policy roles select
+func selectEntities(semantic string, policyRoles []string, entities map[string]*simulatedEntity) []*simulatedEntity {
+	// roles are validated when loaded or changed, so they can be split without checking again
+	roles, ids, _ := db.SplitRolesAndIds("", policyRoles)
+
+	var result []*simulatedEntity
+	for _, entity := range entities {
+		if db.IsEntitySelected(semantic, entity.id, ids, roles, entity.roleAttributes) {
+			result = append(result, entity)
+		}
+	}
+	return result
+}
+
+func (self *policySimulation) snapshotAccess() accessSnapshot {
+	result := accessSnapshot{}
+	denied := accessSnapshot{}
+
+	addLinks := func(snapshot accessSnapshot, accessType string, subjects, targets []*simulatedEntity, permission string) {
+		for _, subject := range subjects {
+			for _, target := range targets {
+				snapshot[simulatedAccess{
+					accessType: accessType,
+					subjectId:  subject.id,
+					targetId:   target.id,
+					permission: permission,
+				}] = struct{}{}
+			}
+		}
+	}
+
+	for _, policy := range self.servicePolicies {
+		permission := string(policy.policyType)
+
+		snapshot := result
+		if policy.effect.IsDeny() {
+			snapshot = denied
+		}
+
+		identities := selectEntities(policy.semantic, policy.identityRoles, self.identities)
+		services := selectEntities(policy.semantic, policy.serviceRoles, self.services)
+		addLinks(snapshot, SimulatedAccessService, identities, services, permission)
+	}
+
+	// deny policies take precedence over allow policies
+	for access := range denied {
+		delete(result, access)
+	}
+
+	for _, policy := range self.edgeRouterPolicies {
+		identities := selectEntities(policy.semantic, policy.identityRoles, self.identities)
+		edgeRouters := selectEntities(policy.semantic, policy.edgeRouterRoles, self.edgeRouters)
+		addLinks(result, SimulatedAccessEdgeRouter, identities, edgeRouters, "")
+	}
+
+	for _, policy := range self.serviceEdgeRouterPolicies {
+		services := selectEntities(policy.semantic, policy.serviceRoles, self.services)
+		edgeRouters := selectEntities(policy.semantic, policy.edgeRouterRoles, self.edgeRouters)
+		addLinks(result, SimulatedAccessServiceEdgeRouter, services, edgeRouters, "")
+	}
+
+	return result
+}
+
+func (self *policySimulation) diffAccess(before, after accessSnapshot) *PolicySimulationResult {
+	nameOf := func(entities map[string]*simulatedEntity, id string) string {
+		if entity, found := entities[id]; found {
+			return entity.name
+		}
+		return ""
+	}
+
+	result := &PolicySimulationResult{}
+
+	addChange := func(access simulatedAccess, granted bool) {
+		accessChange := &SimulatedAccessChange{
+			SubjectId:  access.subjectId,
+			TargetId:   access.targetId,
+			Permission: access.permission,
+			Granted:    granted,
+		}
+
+		switch access.accessType {
+		case SimulatedAccessService:
+			accessChange.SubjectName = nameOf(self.identities, access.subjectId)
+			accessChange.TargetName = nameOf(self.services, access.targetId)
+			result.ServiceAccess = append(result.ServiceAccess, accessChange)
+		case SimulatedAccessEdgeRouter:
+			accessChange.SubjectName = nameOf(self.identities, access.subjectId)
+			accessChange.TargetName = nameOf(self.edgeRouters, access.targetId)
+			result.EdgeRouterAccess = append(result.EdgeRouterAccess, accessChange)
+		case SimulatedAccessServiceEdgeRouter:
+			accessChange.SubjectName = nameOf(self.services, access.subjectId)
+			accessChange.TargetName = nameOf(self.edgeRouters, access.targetId)
+			result.ServiceEdgeRouterAccess = append(result.ServiceEdgeRouterAccess, accessChange)
+		}
+	}
+
+	for access := range before {
+		if _, found := after[access]; !found {
+			addChange(access, false)
+		}
+	}
+
+	for access := range after {
+		if _, found := before[access]; !found {
+			addChange(access, true)
+		}
+	}
+
+	sortAccessChanges(result.ServiceAccess)
+	sortAccessChanges(result.EdgeRouterAccess)
+	sortAccessChanges(result.ServiceEdgeRouterAccess)
+
+	return result
+}
+
+func sortAccessChanges(changes []*SimulatedAccessChange) {
+	sort.Slice(changes, func(i, j int) bool {
+		a, b := changes[i], changes[j]
+		if a.SubjectName != b.SubjectName {
+			return a.SubjectName < b.SubjectName
+		}
+		if a.TargetName != b.TargetName {
+			return a.TargetName < b.TargetName
+		}
+		return a.Permission < b.Permission
+	})
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,212 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/openziti/ziti/common/eid"
+	"github.com/openziti/ziti/controller/change"
+	"github.com/openziti/ziti/controller/db"
+)
+
+func TestPolicySimulator(t *testing.T) {
+	ctx := NewTestContext(t)
+	defer ctx.Cleanup()
+	ctx.Init()
+
+	t.Run("test simulating policy changes", ctx.testSimulatePolicyChanges)
+	t.Run("test simulating identity role attribute changes", ctx.testSimulateRoleAttributeChanges)
+	t.Run("test simulating deny policies", ctx.testSimulateDenyPolicies)
+	t.Run("test invalid simulations", ctx.testInvalidPolicySimulations)
+}
+
+func (ctx *TestContext) testSimulatePolicyChanges(*testing.T) {
+	identity := ctx.requireNewIdentity(false)
+	service := ctx.requireNewService()
+	edgeRouter := ctx.requireNewEdgeRouter()
+
+	advisor := ctx.managers.PolicyAdvisor
+
+	result, err := advisor.SimulatePolicyChanges([]*PolicySimulationChange{
+		{
+			Action:        PolicySimulationActionCreate,
+			EntityType:    db.EntityTypeServicePolicies,
+			PolicyType:    db.PolicyTypeDialName,
+			IdentityRoles: ss("@" + identity.Id),
+			ServiceRoles:  ss("@" + service.Id),
+		},
+		{
+			Action:          PolicySimulationActionCreate,
+			EntityType:      db.EntityTypeEdgeRouterPolicies,
+			IdentityRoles:   ss("@" + identity.Id),
+			EdgeRouterRoles: ss("@" + edgeRouter.Id),
+		},
+		{
+			Action:          PolicySimulationActionCreate,
+			EntityType:      db.EntityTypeServiceEdgeRouterPolicies,
+			ServiceRoles:    ss("@" + service.Id),
+			EdgeRouterRoles: ss("@" + edgeRouter.Id),
+		},
+	})
+	ctx.NoError(err)
+
+	ctx.Len(result.ServiceAccess, 1)
+	ctx.Equal(identity.Id, result.ServiceAccess[0].SubjectId)
+	ctx.Equal(identity.Name, result.ServiceAccess[0].SubjectName)
+	ctx.Equal(service.Id, result.ServiceAccess[0].TargetId)
+	ctx.Equal(db.PolicyTypeDialName, result.ServiceAccess[0].Permission)
+	ctx.True(result.ServiceAccess[0].Granted)
+
+	ctx.Len(result.EdgeRouterAccess, 1)
+	ctx.Equal(edgeRouter.Id, result.EdgeRouterAccess[0].TargetId)
+	ctx.True(result.EdgeRouterAccess[0].Granted)
+
+	ctx.Len(result.ServiceEdgeRouterAccess, 1)
+	ctx.Equal(service.Id, result.ServiceEdgeRouterAccess[0].SubjectId)
+	ctx.True(result.ServiceEdgeRouterAccess[0].Granted)
+
+	// nothing was committed
+	ctx.False(ctx.isEdgeRouterAccessible(edgeRouter.Id, identity.Id, service.Id))
+	permissions, _, err := advisor.getServicePermissions(identity.Id, service.Id)
+	ctx.NoError(err)
+	ctx.Empty(permissions)
+	ctx.True(result.HasChanges())
+
+	policy := ctx.requireNewServicePolicy(db.PolicyTypeBindName, ss("@"+identity.Id), ss("@"+service.Id))
+
+	result, err = advisor.SimulatePolicyChanges([]*PolicySimulationChange{
+		{
+			Action:     PolicySimulationActionUpdate,
+			EntityType: db.EntityTypeServicePolicies,
+			Id:         policy.Id,
+			PolicyType: db.PolicyTypeDialName,
+		},
+	})
+	ctx.NoError(err)
+	ctx.Len(result.ServiceAccess, 2)
+	ctx.Equal(db.PolicyTypeBindName, result.ServiceAccess[0].Permission)
+	ctx.False(result.ServiceAccess[0].Granted)
+	ctx.Equal(db.PolicyTypeDialName, result.ServiceAccess[1].Permission)
+	ctx.True(result.ServiceAccess[1].Granted)
+
+	result, err = advisor.SimulatePolicyChanges([]*PolicySimulationChange{
+		{
+			Action:     PolicySimulationActionDelete,
+			EntityType: db.EntityTypeServicePolicies,
+			Id:         policy.Id,
+		},
+	})
+	ctx.NoError(err)
+	ctx.Len(result.ServiceAccess, 1)
+	ctx.False(result.ServiceAccess[0].Granted)
+
+	loaded, err := ctx.managers.ServicePolicy.Read(policy.Id)
+	ctx.NoError(err)
+	ctx.Equal(db.PolicyTypeBindName, loaded.PolicyType)
+}
+
+func (ctx *TestContext) testSimulateRoleAttributeChanges(*testing.T) {
+	identity := ctx.requireNewIdentity(false)
+	edgeRouter := ctx.requireNewEdgeRouter()
+	role := eid.New()
+
+	ctx.requireNewEdgeRouterPolicy(ss("#"+role), ss("@"+edgeRouter.Id))
+
+	advisor := ctx.managers.PolicyAdvisor
+	result, err := advisor.SimulatePolicyChanges([]*PolicySimulationChange{
+		{
+			Action:         PolicySimulationActionUpdate,
+			EntityType:     db.EntityTypeIdentities,
+			Id:             identity.Id,
+			RoleAttributes: ss(role),
+		},
+	})
+	ctx.NoError(err)
+	ctx.Empty(result.ServiceAccess)
+	ctx.Len(result.EdgeRouterAccess, 1)
+	ctx.Equal(identity.Id, result.EdgeRouterAccess[0].SubjectId)
+	ctx.Equal(edgeRouter.Name, result.EdgeRouterAccess[0].TargetName)
+	ctx.True(result.EdgeRouterAccess[0].Granted)
+
+	identity.RoleAttributes = ss(role)
+	ctx.NoError(ctx.managers.Identity.Update(identity, nil, change.New()))
+
+	result, err = advisor.SimulatePolicyChanges([]*PolicySimulationChange{
+		{
+			Action:         PolicySimulationActionUpdate,
+			EntityType:     db.EntityTypeIdentities,
+			Id:             identity.Id,
+			RoleAttributes: nil,
+		},
+	})
+	ctx.NoError(err)
+	ctx.Len(result.EdgeRouterAccess, 1)
+	ctx.False(result.EdgeRouterAccess[0].Granted)
+}
+
+func (ctx *TestContext) testSimulateDenyPolicies(*testing.T) {
+	identity := ctx.requireNewIdentity(false)
+	service := ctx.requireNewService()
+	ctx.requireNewServicePolicy(db.PolicyTypeDialName, ss("@"+identity.Id), ss("@"+service.Id))
+
+	advisor := ctx.managers.PolicyAdvisor
+
+	result, err := advisor.SimulatePolicyChanges([]*PolicySimulationChange{
+		{
+			Action:        PolicySimulationActionCreate,
+			EntityType:    db.EntityTypeServicePolicies,
+			PolicyType:    db.PolicyTypeDialName,
+			Effect:        db.PolicyEffectDeny.String(),
+			IdentityRoles: ss("@" + identity.Id),
+			ServiceRoles:  ss("@" + service.Id),
+		},
+	})
+	ctx.NoError(err)
+	ctx.Len(result.ServiceAccess, 1)
+	ctx.Equal(identity.Id, result.ServiceAccess[0].SubjectId)
+	ctx.Equal(db.PolicyTypeDialName, result.ServiceAccess[0].Permission)
+	ctx.False(result.ServiceAccess[0].Granted)
+
+	denyPolicy := ctx.requireNewServicePolicy(db.PolicyTypeDialName, ss("@"+identity.Id), ss("@"+service.Id))
+	ctx.NoError(ctx.managers.ServicePolicy.UpdateEffect(denyPolicy.Id, db.PolicyEffectDeny.String(), change.New()))
+
+	result, err = advisor.SimulatePolicyChanges([]*PolicySimulationChange{
+		{
+			Action:     PolicySimulationActionUpdate,
+			EntityType: db.EntityTypeServicePolicies,
+			Id:         denyPolicy.Id,
+			Effect:     db.PolicyEffectAllow.String(),
+		},
+	})
+	ctx.NoError(err)
+	ctx.Len(result.ServiceAccess, 1)
+	ctx.True(result.ServiceAccess[0].Granted)
+
+	_, err = advisor.SimulatePolicyChanges([]*PolicySimulationChange{
+		{
+			Action:     PolicySimulationActionUpdate,
+			EntityType: db.EntityTypeServicePolicies,
+			Id:         denyPolicy.Id,
+			Effect:     "Maybe",
+		},
+	})
+	ctx.Error(err)
+}
+
+func (ctx *TestContext) testInvalidPolicySimulations(*testing.T) {
+	advisor := ctx.managers.PolicyAdvisor
+
+	_, err := advisor.SimulatePolicyChanges(nil)
+	ctx.Error(err)
+
+	_, err = advisor.SimulatePolicyChanges([]*PolicySimulationChange{{Action: "replace", EntityType: db.EntityTypeServicePolicies}})
+	ctx.Error(err)
+
+	_, err = advisor.SimulatePolicyChanges([]*PolicySimulationChange{{Action: PolicySimulationActionCreate, EntityType: db.EntityTypeServices}})
+	ctx.Error(err)
+
+	_, err = advisor.SimulatePolicyChanges([]*PolicySimulationChange{{Action: PolicySimulationActionCreate, EntityType: db.EntityTypeServicePolicies, PolicyType: "Host"}})
+	ctx.Error(err)
+
+	_, err = advisor.SimulatePolicyChanges([]*PolicySimulationChange{{Action: PolicySimulationActionDelete, EntityType: db.EntityTypeEdgeRouterPolicies, Id: eid.New()}})
+	ctx.Error(err)
+}
@@ -0,0 +1,154 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openziti/ziti/controller/event"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	graceTestIdentityId   = "id123"
+	graceTestApiSessionId = "mno345"
+)
+
+func TestPostureCheckGracePeriod(t *testing.T) {
+	t.Run("a failing check without a grace period fails immediately", func(t *testing.T) {
+		check, postureData := newGraceCheckAndPostureData(0)
+		now := time.Now()
+
+		req := require.New(t)
+		isPassing, _, _ := postureData.EvaluateWithGrace(graceTestIdentityId, graceTestApiSessionId, []*PostureCheck{check}, now)
+		req.True(isPassing)
+
+		setGraceTestSourceIp(postureData, "192.168.1.10")
+		isPassing, failures, warnings := postureData.EvaluateWithGrace(graceTestIdentityId, graceTestApiSessionId, []*PostureCheck{check}, now)
+		req.False(isPassing)
+		req.Len(failures, 1)
+		req.Empty(warnings)
+	})
+
+	t.Run("a check which never passed gets no grace period", func(t *testing.T) {
+		check, postureData := newGraceCheckAndPostureData(60)
+		setGraceTestSourceIp(postureData, "192.168.1.10")
+
+		req := require.New(t)
+		isPassing, failures, warnings := postureData.EvaluateWithGrace(graceTestIdentityId, graceTestApiSessionId, []*PostureCheck{check}, time.Now())
+		req.False(isPassing)
+		req.Len(failures, 1)
+		req.Empty(warnings)
+	})
+
+	t.Run("a previously passing check passes with a warning until the grace period expires", func(t *testing.T) {
+		check, postureData := newGraceCheckAndPostureData(60)
+		now := time.Now()
+
+		req := require.New(t)
+		isPassing, _, warnings := postureData.EvaluateWithGrace(graceTestIdentityId, graceTestApiSessionId, []*PostureCheck{check}, now)
+		req.True(isPassing)
+		req.Empty(warnings)
+
+		setGraceTestSourceIp(postureData, "192.168.1.10")
+		isPassing, failures, warnings := postureData.EvaluateWithGrace(graceTestIdentityId, graceTestApiSessionId, []*PostureCheck{check}, now)
+		req.True(isPassing)
+		req.Empty(failures)
+		req.Len(warnings, 1)
+		req.Equal(event.PostureWarningEventTypeWarning, warnings[0].EventType)
+		req.Equal(check.Id, warnings[0].PostureCheckId)
+		req.Equal(graceTestApiSessionId, warnings[0].ApiSessionId)
+		req.NotNil(warnings[0].ExpiresAt)
+		req.Equal(now.Add(time.Minute), *warnings[0].ExpiresAt)
+
+		isPassing, _, warnings = postureData.EvaluateWithGrace(graceTestIdentityId, graceTestApiSessionId, []*PostureCheck{check}, now.Add(30*time.Second))
+		req.True(isPassing)
+		req.Empty(warnings, "warning should only be emitted once")
+
+		isPassing, failures, warnings = postureData.EvaluateWithGrace(graceTestIdentityId, graceTestApiSessionId, []*PostureCheck{check}, now.Add(time.Minute))
+		req.False(isPassing)
+		req.Len(failures, 1)
+		req.Len(warnings, 1)
+		req.Equal(event.PostureWarningEventTypeExpired, warnings[0].EventType)
+
+		isPassing, _, warnings = postureData.EvaluateWithGrace(graceTestIdentityId, graceTestApiSessionId, []*PostureCheck{check}, now.Add(2*time.Minute))
+		req.False(isPassing)
+		req.Empty(warnings, "expiry should only be emitted once")
+	})
+
+	t.Run("a check which passes again during its grace period is cleared", func(t *testing.T) {
+		check, postureData := newGraceCheckAndPostureData(60)
+		now := time.Now()
+
+		req := require.New(t)
+		postureData.EvaluateWithGrace(graceTestIdentityId, graceTestApiSessionId, []*PostureCheck{check}, now)
+
+		setGraceTestSourceIp(postureData, "192.168.1.10")
+		postureData.EvaluateWithGrace(graceTestIdentityId, graceTestApiSessionId, []*PostureCheck{check}, now)
+
+		setGraceTestSourceIp(postureData, "10.1.2.3")
+		isPassing, _, warnings := postureData.EvaluateWithGrace(graceTestIdentityId, graceTestApiSessionId, []*PostureCheck{check}, now.Add(10*time.Second))
+		req.True(isPassing)
+		req.Len(warnings, 1)
+		req.Equal(event.PostureWarningEventTypeCleared, warnings[0].EventType)
+		req.Nil(warnings[0].ExpiresAt)
+
+		grace := postureData.ApiSessions[graceTestApiSessionId].PostureGrace[check.Id]
+		req.Nil(grace.ExpiresAt(check))
+	})
+
+	t.Run("grace periods are tracked per api session", func(t *testing.T) {
+		check, postureData := newGraceCheckAndPostureData(60)
+		now := time.Now()
+
+		req := require.New(t)
+		postureData.EvaluateWithGrace(graceTestIdentityId, graceTestApiSessionId, []*PostureCheck{check}, now)
+
+		otherApiSessionId := "pqr678"
+		postureData.ApiSessions[otherApiSessionId] = &ApiSessionPostureData{SourceIp: "192.168.1.10"}
+
+		isPassing, _, warnings := postureData.EvaluateWithGrace(graceTestIdentityId, otherApiSessionId, []*PostureCheck{check}, now)
+		req.False(isPassing)
+		req.Empty(warnings)
+	})
+}
+
+func newGraceCheckAndPostureData(gracePeriodSeconds int64) (*PostureCheck, *PostureData) {
+	check := &PostureCheck{
+		Name:               "source-ip",
+		TypeId:             PostureCheckTypeSourceIp,
+		GracePeriodSeconds: gracePeriodSeconds,
+		SubType: &PostureCheckSourceIp{
+			AllowedCidrs: []string{"10.0.0.0/8"},
+		},
+	}
+	check.Id = "pc1"
+
+	postureData := newPostureData()
+	setGraceTestSourceIp(postureData, "10.1.2.3")
+
+	return check, postureData
+}
+
+func setGraceTestSourceIp(postureData *PostureData, sourceIp string) {
+	if apiSessionData, ok := postureData.ApiSessions[graceTestApiSessionId]; ok {
+		apiSessionData.SourceIp = sourceIp
+		return
+	}
+	postureData.ApiSessions[graceTestApiSessionId] = &ApiSessionPostureData{SourceIp: sourceIp}
+}
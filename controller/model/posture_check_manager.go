@@ -19,6 +19,7 @@ package model
 import (
 	"fmt"
 	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/openziti/foundation/v2/errorz"
 	"github.com/openziti/storage/ast"
 	"github.com/openziti/storage/boltz"
 	"github.com/openziti/ziti/common/pb/edge_cmd_pb"
@@ -117,6 +118,7 @@ func (self *PostureCheckManager) IsUpdated(field string) bool {
 	return strings.EqualFold(field, db.FieldName) ||
 		strings.EqualFold(field, boltz.FieldTags) ||
 		strings.EqualFold(field, db.FieldRoleAttributes) ||
+		strings.EqualFold(field, db.FieldPostureCheckGracePeriodSeconds) ||
 		strings.EqualFold(field, db.FieldPostureCheckOsType) ||
 		strings.EqualFold(field, db.FieldPostureCheckOsVersions) ||
 		strings.EqualFold(field, db.FieldPostureCheckMacAddresses) ||
@@ -137,6 +139,23 @@ func (self *PostureCheckManager) IsUpdated(field string) bool {
 		strings.EqualFold(field, db.FieldSemantic)
 }
 
+// UpdateGracePeriod sets how long the posture check may fail for a previously passing API session before access is cut
+func (self *PostureCheckManager) UpdateGracePeriod(id string, gracePeriodSeconds int64, ctx *change.Context) error {
+	if gracePeriodSeconds < 0 {
+		return errorz.NewFieldError("grace period may not be negative", "gracePeriodSeconds", gracePeriodSeconds)
+	}
+
+	postureCheck, err := self.Read(id)
+	if err != nil {
+		return err
+	}
+
+	updated := *postureCheck
+	updated.GracePeriodSeconds = gracePeriodSeconds
+
+	return self.Update(&updated, fields.UpdatedFieldsMap{}.AddFields(db.FieldPostureCheckGracePeriodSeconds), ctx)
+}
+
 func (self *PostureCheckManager) Query(query string) (*PostureCheckListResult, error) {
 	result := &PostureCheckListResult{manager: self}
 	if err := self.ListWithHandler(query, result.collect); err != nil {
@@ -161,12 +180,13 @@ func (self *PostureCheckManager) Marshall(entity *PostureCheck) ([]byte, error)
 	}
 
 	msg := &edge_cmd_pb.PostureCheck{
-		Id:             entity.Id,
-		Name:           entity.Name,
-		Tags:           tags,
-		TypeId:         entity.TypeId,
-		Version:        entity.Version,
-		RoleAttributes: entity.RoleAttributes,
+		Id:                 entity.Id,
+		Name:               entity.Name,
+		Tags:               tags,
+		TypeId:             entity.TypeId,
+		Version:            entity.Version,
+		RoleAttributes:     entity.RoleAttributes,
+		GracePeriodSeconds: entity.GracePeriodSeconds,
 	}
 
 	if entity.SubType != nil {
@@ -200,11 +220,12 @@ func (self *PostureCheckManager) Unmarshall(bytes []byte) (*PostureCheck, error)
 			Id:   msg.Id,
 			Tags: edge_cmd_pb.DecodeTags(msg.Tags),
 		},
-		Name:           msg.Name,
-		TypeId:         msg.TypeId,
-		Version:        msg.Version,
-		RoleAttributes: msg.RoleAttributes,
-		SubType:        subType,
+		Name:               msg.Name,
+		TypeId:             msg.TypeId,
+		Version:            msg.Version,
+		RoleAttributes:     msg.RoleAttributes,
+		GracePeriodSeconds: msg.GracePeriodSeconds,
+		SubType:            subType,
 	}, nil
 }
 
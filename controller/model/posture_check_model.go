@@ -32,7 +32,11 @@ type PostureCheck struct {
 	TypeId         string
 	Version        int64
 	RoleAttributes []string
-	SubType        PostureCheckSubType
+	// GracePeriodSeconds is how long a previously passing check may fail before access is cut. While in the grace
+	// period the check is treated as passing, posture warning events are emitted and SDKs are told how long they have
+	// to remediate. Zero disables the grace period.
+	GracePeriodSeconds int64
+	SubType            PostureCheckSubType
 }
 
 type PostureCheckSubType interface {
@@ -65,6 +69,9 @@ const (
 	PostureCheckTypeProcessMulti = "PROCESS_MULTI"
 	PostureCheckTypeMAC          = "MAC"
 	PostureCheckTypeMFA          = "MFA"
+	PostureCheckTypeDeviceKey    = "DEVICE_KEY"
+	PostureCheckTypeSdkVersion   = "SDK_VERSION"
+	PostureCheckTypeSourceIp     = "SOURCE_IP"
 )
 
 var postureCheckSubTypeMap = map[string]newPostureCheckSubType{
@@ -74,6 +81,9 @@ var postureCheckSubTypeMap = map[string]newPostureCheckSubType{
 	PostureCheckTypeProcessMulti: newPostureCheckProcessMulti,
 	PostureCheckTypeMAC:          newPostureCheckMacAddresses,
 	PostureCheckTypeMFA:          newPostureCheckMfa,
+	PostureCheckTypeDeviceKey:    newPostureCheckDeviceKey,
+	PostureCheckTypeSdkVersion:   newPostureCheckSdkVersion,
+	PostureCheckTypeSourceIp:     newPostureCheckSourceIp,
 }
 
 func newSubType(typeId string) PostureCheckSubType {
@@ -89,6 +99,7 @@ func (entity *PostureCheck) fillFrom(env Env, tx *bbolt.Tx, boltPostureCheck *db
 	entity.TypeId = boltPostureCheck.TypeId
 	entity.Version = boltPostureCheck.Version
 	entity.RoleAttributes = boltPostureCheck.RoleAttributes
+	entity.GracePeriodSeconds = boltPostureCheck.GracePeriodSeconds
 
 	subType := newSubType(entity.TypeId)
 
@@ -107,11 +118,12 @@ func (entity *PostureCheck) fillFrom(env Env, tx *bbolt.Tx, boltPostureCheck *db
 
 func (entity *PostureCheck) toBoltEntityForCreate(tx *bbolt.Tx, env Env) (*db.PostureCheck, error) {
 	boltEntity := &db.PostureCheck{
-		BaseExtEntity:  *boltz.NewExtEntity(entity.Id, entity.Tags),
-		Name:           entity.Name,
-		TypeId:         entity.TypeId,
-		Version:        1,
-		RoleAttributes: entity.RoleAttributes,
+		BaseExtEntity:      *boltz.NewExtEntity(entity.Id, entity.Tags),
+		Name:               entity.Name,
+		TypeId:             entity.TypeId,
+		Version:            1,
+		RoleAttributes:     entity.RoleAttributes,
+		GracePeriodSeconds: entity.GracePeriodSeconds,
 	}
 
 	var err error
@@ -122,8 +134,21 @@ func (entity *PostureCheck) toBoltEntityForCreate(tx *bbolt.Tx, env Env) (*db.Po
 	return boltEntity, nil
 }
 
-func (entity *PostureCheck) toBoltEntityForUpdate(tx *bbolt.Tx, env Env, _ boltz.FieldChecker) (*db.PostureCheck, error) {
-	return entity.toBoltEntityForCreate(tx, env)
+func (entity *PostureCheck) toBoltEntityForUpdate(tx *bbolt.Tx, env Env, checker boltz.FieldChecker) (*db.PostureCheck, error) {
+	boltEntity, err := entity.toBoltEntityForCreate(tx, env)
+	if err != nil {
+		return nil, err
+	}
+
+	// grace periods are set through their own endpoint, so carry them over rather than clearing them on a full
+	// update. Full updates are checked by the posture check manager itself, see PostureCheckManager.ApplyUpdate
+	if checker == nil || checker == env.GetManagers().PostureCheck {
+		if current, _ := env.GetStores().PostureCheck.LoadById(tx, entity.Id); current != nil {
+			boltEntity.GracePeriodSeconds = current.GracePeriodSeconds
+		}
+	}
+
+	return boltEntity, nil
 }
 
 func (entity *PostureCheck) Evaluate(apiSessionId string, pd *PostureData) (bool, *PostureCheckFailure) {
@@ -0,0 +1,185 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/openziti/ziti/common/pb/edge_cmd_pb"
+	"github.com/openziti/ziti/controller/db"
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+)
+
+var _ PostureCheckSubType = &PostureCheckDeviceKey{}
+
+// PostureCheckDeviceKey passes when the API session has proven possession of the device key enrolled for its
+// identity by signing a controller issued challenge. Unlike the other posture checks, the outcome doesn't depend on
+// data reported by the SDK, so a copied identity file fails the check on any device which doesn't hold the key.
+type PostureCheckDeviceKey struct {
+	TimeoutSeconds int64
+}
+
+func newPostureCheckDeviceKey() PostureCheckSubType {
+	return &PostureCheckDeviceKey{}
+}
+
+func (p *PostureCheckDeviceKey) TypeId() string {
+	return db.PostureCheckTypeDeviceKey
+}
+
+func (p *PostureCheckDeviceKey) fillProtobuf(msg *edge_cmd_pb.PostureCheck) {
+	msg.Subtype = &edge_cmd_pb.PostureCheck_DeviceKey_{
+		DeviceKey: &edge_cmd_pb.PostureCheck_DeviceKey{
+			TimeoutSeconds: p.TimeoutSeconds,
+		},
+	}
+}
+
+func (p *PostureCheckDeviceKey) fillFromProtobuf(msg *edge_cmd_pb.PostureCheck) error {
+	if deviceKey_, ok := msg.Subtype.(*edge_cmd_pb.PostureCheck_DeviceKey_); ok {
+		if deviceKey := deviceKey_.DeviceKey; deviceKey != nil {
+			p.TimeoutSeconds = deviceKey.TimeoutSeconds
+		}
+	} else {
+		return errors.Errorf("expected posture check sub type data of device key, but got %T", msg.Subtype)
+	}
+	return nil
+}
+
+func (p *PostureCheckDeviceKey) LastUpdatedAt(apiSessionId string, pd *PostureData) *time.Time {
+	apiSessionData := pd.ApiSessions[apiSessionId]
+	if apiSessionData == nil || apiSessionData.DeviceKey == nil {
+		return nil
+	}
+	return apiSessionData.DeviceKey.ProvedAt
+}
+
+func (p *PostureCheckDeviceKey) GetTimeoutSeconds() int64 {
+	if p.TimeoutSeconds > 0 {
+		return p.TimeoutSeconds
+	}
+
+	return PostureCheckNoTimeout
+}
+
+func (p *PostureCheckDeviceKey) GetTimeoutRemainingSeconds(apiSessionId string, pd *PostureData) int64 {
+	return p.getTimeoutRemainingAtSeconds(apiSessionId, pd, time.Now())
+}
+
+func (p *PostureCheckDeviceKey) getTimeoutRemainingAtSeconds(apiSessionId string, pd *PostureData, now time.Time) int64 {
+	if p.GetTimeoutSeconds() == PostureCheckNoTimeout {
+		return PostureCheckNoTimeout
+	}
+
+	provedAt := p.LastUpdatedAt(apiSessionId, pd)
+	if provedAt == nil {
+		return 0
+	}
+
+	return calculateTimeout(now, *provedAt, time.Duration(p.TimeoutSeconds)*time.Second)
+}
+
+func (p *PostureCheckDeviceKey) FailureValues(apiSessionId string, pd *PostureData) PostureCheckFailureValues {
+	ret := &PostureCheckFailureValuesDeviceKey{
+		ActualValue: PostureCheckDeviceKeyValues{
+			ProvedDeviceKey: false,
+			TimedOut:        false,
+		},
+		ExpectedValue: PostureCheckDeviceKeyValues{
+			ProvedDeviceKey: true,
+			TimedOut:        false,
+		},
+		Criteria: PostureCheckDeviceKeyCriteria{
+			TimeoutSeconds:          p.GetTimeoutSeconds(),
+			TimeoutRemainingSeconds: 0,
+		},
+	}
+
+	if provedAt := p.LastUpdatedAt(apiSessionId, pd); provedAt != nil {
+		ret.Criteria.ProvedAt = provedAt
+		ret.Criteria.TimeoutRemainingSeconds = p.GetTimeoutRemainingSeconds(apiSessionId, pd)
+		ret.ActualValue.ProvedDeviceKey = true
+		ret.ActualValue.TimedOut = ret.Criteria.TimeoutRemainingSeconds == 0
+	}
+
+	return ret
+}
+
+func (p *PostureCheckDeviceKey) Evaluate(apiSessionId string, pd *PostureData) bool {
+	return p.evaluateAt(apiSessionId, pd, time.Now())
+}
+
+func (p *PostureCheckDeviceKey) evaluateAt(apiSessionId string, pd *PostureData, now time.Time) bool {
+	provedAt := p.LastUpdatedAt(apiSessionId, pd)
+	if provedAt == nil {
+		return false
+	}
+
+	if p.GetTimeoutSeconds() != PostureCheckNoTimeout {
+		expiresAt := provedAt.Add(time.Duration(p.TimeoutSeconds) * time.Second)
+		if expiresAt.Before(now) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (p *PostureCheckDeviceKey) fillFrom(_ Env, _ *bbolt.Tx, _ *db.PostureCheck, subType db.PostureCheckSubType) error {
+	subCheck, ok := subType.(*db.PostureCheckDeviceKey)
+
+	if !ok || subCheck == nil {
+		return fmt.Errorf("could not convert device key check to bolt type")
+	}
+
+	p.TimeoutSeconds = subCheck.TimeoutSeconds
+
+	return nil
+}
+
+func (p *PostureCheckDeviceKey) toBoltEntityForCreate(*bbolt.Tx, Env) (db.PostureCheckSubType, error) {
+	return &db.PostureCheckDeviceKey{
+		TimeoutSeconds: p.TimeoutSeconds,
+	}, nil
+}
+
+type PostureCheckDeviceKeyValues struct {
+	ProvedDeviceKey bool
+	TimedOut        bool
+}
+
+type PostureCheckDeviceKeyCriteria struct {
+	ProvedAt                *time.Time
+	TimeoutSeconds          int64
+	TimeoutRemainingSeconds int64
+}
+
+type PostureCheckFailureValuesDeviceKey struct {
+	ActualValue   PostureCheckDeviceKeyValues
+	ExpectedValue PostureCheckDeviceKeyValues
+	Criteria      PostureCheckDeviceKeyCriteria
+}
+
+func (p PostureCheckFailureValuesDeviceKey) Expected() interface{} {
+	return p.ExpectedValue
+}
+
+func (p PostureCheckFailureValuesDeviceKey) Actual() interface{} {
+	return p.ActualValue
+}
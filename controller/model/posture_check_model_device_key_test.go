@@ -0,0 +1,123 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	deviceKeyTestApiSessionId = "def456"
+)
+
+func TestPostureCheckModelDeviceKey(t *testing.T) {
+	t.Run("evaluateAt", func(t *testing.T) {
+		t.Run("passes without a timeout", func(t *testing.T) {
+			check, postureData, provedAt := newDeviceKeyCheckAndPostureData()
+
+			result := check.evaluateAt(deviceKeyTestApiSessionId, postureData, provedAt.Add(24*time.Hour))
+
+			req := require.New(t)
+			req.True(result)
+		})
+
+		t.Run("passes before the timeout", func(t *testing.T) {
+			check, postureData, provedAt := newDeviceKeyCheckAndPostureData()
+			check.TimeoutSeconds = 60
+
+			result := check.evaluateAt(deviceKeyTestApiSessionId, postureData, provedAt.Add(59*time.Second))
+
+			req := require.New(t)
+			req.True(result)
+		})
+
+		t.Run("fails after the timeout", func(t *testing.T) {
+			check, postureData, provedAt := newDeviceKeyCheckAndPostureData()
+			check.TimeoutSeconds = 60
+
+			result := check.evaluateAt(deviceKeyTestApiSessionId, postureData, provedAt.Add(61*time.Second))
+
+			req := require.New(t)
+			req.False(result)
+		})
+
+		t.Run("fails if the device key wasn't proven", func(t *testing.T) {
+			check, postureData, provedAt := newDeviceKeyCheckAndPostureData()
+			postureData.ApiSessions[deviceKeyTestApiSessionId].DeviceKey = nil
+
+			result := check.evaluateAt(deviceKeyTestApiSessionId, postureData, provedAt)
+
+			req := require.New(t)
+			req.False(result)
+		})
+
+		t.Run("fails for other API sessions", func(t *testing.T) {
+			check, postureData, provedAt := newDeviceKeyCheckAndPostureData()
+
+			result := check.evaluateAt("other", postureData, provedAt)
+
+			req := require.New(t)
+			req.False(result)
+		})
+	})
+
+	t.Run("FailureValues", func(t *testing.T) {
+		t.Run("reports a missing proof", func(t *testing.T) {
+			check, postureData, _ := newDeviceKeyCheckAndPostureData()
+			postureData.ApiSessions[deviceKeyTestApiSessionId].DeviceKey = nil
+
+			result := check.FailureValues(deviceKeyTestApiSessionId, postureData)
+
+			req := require.New(t)
+			values, ok := result.(*PostureCheckFailureValuesDeviceKey)
+			req.True(ok)
+			req.False(values.ActualValue.ProvedDeviceKey)
+			req.True(values.ExpectedValue.ProvedDeviceKey)
+			req.Nil(values.Criteria.ProvedAt)
+		})
+	})
+}
+
+func newDeviceKeyCheckAndPostureData() (*PostureCheckDeviceKey, *PostureData, time.Time) {
+	provedAt := time.Now().UTC()
+
+	postureResponse := &PostureResponse{
+		PostureCheckId: "abc123",
+		TypeId:         PostureCheckTypeDeviceKey,
+		LastUpdatedAt:  provedAt,
+	}
+
+	postureResponseDeviceKey := &PostureResponseDeviceKey{
+		PostureResponse: postureResponse,
+		ApiSessionId:    deviceKeyTestApiSessionId,
+		ProvedAt:        &provedAt,
+	}
+	postureResponse.SubType = postureResponseDeviceKey
+
+	postureData := &PostureData{
+		ApiSessions: map[string]*ApiSessionPostureData{
+			deviceKeyTestApiSessionId: {
+				DeviceKey: postureResponseDeviceKey,
+			},
+		},
+	}
+
+	return &PostureCheckDeviceKey{}, postureData, provedAt
+}
@@ -0,0 +1,289 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/blang/semver"
+	"github.com/openziti/foundation/v2/stringz"
+	"github.com/openziti/ziti/common/pb/edge_cmd_pb"
+	"github.com/openziti/ziti/controller/db"
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+)
+
+var _ PostureCheckSubType = &PostureCheckSdkVersion{}
+
+// PostureCheckSdkVersion passes when the SDK or tunneler reported at authentication satisfies the version
+// constraints for its SDK type and OS and, if AppIds is not empty, reports one of the allowed application ids.
+// Constraints act as an allow list: when there are constraints, an SDK which matches none of them fails.
+type PostureCheckSdkVersion struct {
+	Constraints []SdkVersionConstraint
+	AppIds      []string
+}
+
+// SdkVersionConstraint applies the semver range in Versions to SDKs of SdkType running on OsType. An empty SdkType
+// or OsType matches any value.
+type SdkVersionConstraint struct {
+	SdkType  string
+	OsType   string
+	Versions string
+}
+
+func (c *SdkVersionConstraint) matches(sdkType, osType string) bool {
+	if c.SdkType != "" && !strings.EqualFold(c.SdkType, sdkType) {
+		return false
+	}
+
+	return c.OsType == "" || normalizeSdkOsType(c.OsType) == osType
+}
+
+func (c *SdkVersionConstraint) String() string {
+	sdkType := c.SdkType
+	if sdkType == "" {
+		sdkType = "any sdk"
+	}
+
+	osType := c.OsType
+	if osType == "" {
+		osType = "any os"
+	}
+
+	return fmt.Sprintf("%s on %s: %s", sdkType, osType, c.Versions)
+}
+
+func newPostureCheckSdkVersion() PostureCheckSubType {
+	return &PostureCheckSdkVersion{}
+}
+
+func (p *PostureCheckSdkVersion) TypeId() string {
+	return db.PostureCheckTypeSdkVersion
+}
+
+func (p *PostureCheckSdkVersion) fillProtobuf(msg *edge_cmd_pb.PostureCheck) {
+	sdkVersion := &edge_cmd_pb.PostureCheck_SdkVersion{
+		AppIds: p.AppIds,
+	}
+
+	for _, constraint := range p.Constraints {
+		sdkVersion.Constraints = append(sdkVersion.Constraints, &edge_cmd_pb.PostureCheck_SdkVersionConstraint{
+			SdkType:  constraint.SdkType,
+			OsType:   constraint.OsType,
+			Versions: constraint.Versions,
+		})
+	}
+
+	msg.Subtype = &edge_cmd_pb.PostureCheck_SdkVersion_{
+		SdkVersion: sdkVersion,
+	}
+}
+
+func (p *PostureCheckSdkVersion) fillFromProtobuf(msg *edge_cmd_pb.PostureCheck) error {
+	if sdkVersion_, ok := msg.Subtype.(*edge_cmd_pb.PostureCheck_SdkVersion_); ok {
+		if sdkVersion := sdkVersion_.SdkVersion; sdkVersion != nil {
+			p.AppIds = sdkVersion.AppIds
+			for _, constraint := range sdkVersion.Constraints {
+				p.Constraints = append(p.Constraints, SdkVersionConstraint{
+					SdkType:  constraint.SdkType,
+					OsType:   constraint.OsType,
+					Versions: constraint.Versions,
+				})
+			}
+		}
+	} else {
+		return errors.Errorf("expected posture check sub type data of sdk version, but got %T", msg.Subtype)
+	}
+	return nil
+}
+
+func (p *PostureCheckSdkVersion) LastUpdatedAt(string, *PostureData) *time.Time {
+	return nil
+}
+
+func (p *PostureCheckSdkVersion) GetTimeoutSeconds() int64 {
+	return PostureCheckNoTimeout
+}
+
+func (p *PostureCheckSdkVersion) GetTimeoutRemainingSeconds(string, *PostureData) int64 {
+	return PostureCheckNoTimeout
+}
+
+func (p *PostureCheckSdkVersion) FailureValues(apiSessionId string, pd *PostureData) PostureCheckFailureValues {
+	ret := &PostureCheckFailureValuesSdkVersion{
+		ExpectedValue: PostureCheckSdkVersionExpected{
+			Constraints: p.Constraints,
+			AppIds:      p.AppIds,
+		},
+	}
+
+	sdkInfo, osType := p.getSdkInfo(apiSessionId, pd)
+	if sdkInfo != nil {
+		ret.ActualValue = PostureCheckSdkVersionActual{
+			SdkType: sdkInfo.Type,
+			Version: sdkInfo.Version,
+			AppId:   sdkInfo.AppId,
+			OsType:  osType,
+		}
+	}
+
+	ret.Reason = p.failureReason(sdkInfo, osType)
+
+	return ret
+}
+
+func (p *PostureCheckSdkVersion) Evaluate(apiSessionId string, pd *PostureData) bool {
+	sdkInfo, osType := p.getSdkInfo(apiSessionId, pd)
+	return p.failureReason(sdkInfo, osType) == ""
+}
+
+// failureReason returns a human-readable description of why the given SDK fails the check, or an empty string if it
+// passes
+func (p *PostureCheckSdkVersion) failureReason(sdkInfo *SdkInfo, osType string) string {
+	if sdkInfo == nil {
+		return "no sdk information was reported for the api session"
+	}
+
+	if len(p.Constraints) > 0 {
+		version, err := semver.ParseTolerant(sdkInfo.Version)
+		if err != nil {
+			return fmt.Sprintf("sdk version %q of sdk type %q is not a valid semantic version", sdkInfo.Version, sdkInfo.Type)
+		}
+
+		matched := false
+		for _, constraint := range p.Constraints {
+			if !constraint.matches(sdkInfo.Type, osType) {
+				continue
+			}
+
+			matched = true
+
+			versionRange, err := semver.ParseRange(constraint.Versions)
+			if err != nil {
+				return fmt.Sprintf("version constraint %q is not a valid semantic version range", constraint.String())
+			}
+
+			if !versionRange(version) {
+				return fmt.Sprintf("sdk version %s of sdk type %q on os %q does not satisfy %q", version, sdkInfo.Type, osType, constraint.String())
+			}
+		}
+
+		if !matched {
+			return fmt.Sprintf("sdk type %q on os %q is not allowed", sdkInfo.Type, osType)
+		}
+	}
+
+	if len(p.AppIds) > 0 && !stringz.Contains(p.AppIds, sdkInfo.AppId) {
+		return fmt.Sprintf("application id %q is not allowed", sdkInfo.AppId)
+	}
+
+	return ""
+}
+
+// getSdkInfo returns the SDK info reported for the API session along with the normalized OS type. The OS reported
+// through posture responses is preferred over the OS in the environment info reported at authentication.
+func (p *PostureCheckSdkVersion) getSdkInfo(apiSessionId string, pd *PostureData) (*SdkInfo, string) {
+	if pd == nil {
+		return nil, ""
+	}
+
+	apiSessionData := pd.ApiSessions[apiSessionId]
+	if apiSessionData == nil {
+		return nil, ""
+	}
+
+	osType := pd.Os.Type
+	if osType == "" && apiSessionData.EnvInfo != nil {
+		osType = apiSessionData.EnvInfo.Os
+	}
+
+	return apiSessionData.SdkInfo, normalizeSdkOsType(osType)
+}
+
+// normalizeSdkOsType maps the OS names reported by SDKs (which are typically the Go runtime names) to the lower cased
+// OS types used by posture checks
+func normalizeSdkOsType(osType string) string {
+	osType = strings.ToLower(osType)
+	if osType == "darwin" {
+		return "macos"
+	}
+	return osType
+}
+
+func (p *PostureCheckSdkVersion) fillFrom(_ Env, _ *bbolt.Tx, _ *db.PostureCheck, subType db.PostureCheckSubType) error {
+	subCheck, ok := subType.(*db.PostureCheckSdkVersion)
+
+	if !ok || subCheck == nil {
+		return fmt.Errorf("could not convert sdk version check to bolt type")
+	}
+
+	p.AppIds = subCheck.AppIds
+	p.Constraints = nil
+	for _, constraint := range subCheck.Constraints {
+		p.Constraints = append(p.Constraints, SdkVersionConstraint{
+			SdkType:  constraint.SdkType,
+			OsType:   constraint.OsType,
+			Versions: constraint.Versions,
+		})
+	}
+
+	return nil
+}
+
+func (p *PostureCheckSdkVersion) toBoltEntityForCreate(*bbolt.Tx, Env) (db.PostureCheckSubType, error) {
+	ret := &db.PostureCheckSdkVersion{
+		AppIds: p.AppIds,
+	}
+
+	for _, constraint := range p.Constraints {
+		ret.Constraints = append(ret.Constraints, &db.SdkVersionConstraint{
+			SdkType:  constraint.SdkType,
+			OsType:   constraint.OsType,
+			Versions: constraint.Versions,
+		})
+	}
+
+	return ret, nil
+}
+
+type PostureCheckSdkVersionActual struct {
+	SdkType string
+	Version string
+	AppId   string
+	OsType  string
+}
+
+type PostureCheckSdkVersionExpected struct {
+	Constraints []SdkVersionConstraint
+	AppIds      []string
+}
+
+type PostureCheckFailureValuesSdkVersion struct {
+	ActualValue   PostureCheckSdkVersionActual
+	ExpectedValue PostureCheckSdkVersionExpected
+	Reason        string
+}
+
+func (p PostureCheckFailureValuesSdkVersion) Expected() interface{} {
+	return p.ExpectedValue
+}
+
+func (p PostureCheckFailureValuesSdkVersion) Actual() interface{} {
+	return p.ActualValue
+}
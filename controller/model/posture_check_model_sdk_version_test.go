@@ -0,0 +1,138 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	sdkVersionTestApiSessionId = "ghi789"
+)
+
+func TestPostureCheckModelSdkVersion(t *testing.T) {
+	t.Run("Evaluate", func(t *testing.T) {
+		t.Run("passes when the version satisfies the matching constraint", func(t *testing.T) {
+			check, postureData := newSdkVersionCheckAndPostureData()
+
+			req := require.New(t)
+			req.True(check.Evaluate(sdkVersionTestApiSessionId, postureData))
+		})
+
+		t.Run("fails when the version is below the matching constraint", func(t *testing.T) {
+			check, postureData := newSdkVersionCheckAndPostureData()
+			postureData.ApiSessions[sdkVersionTestApiSessionId].SdkInfo.Version = "v0.19.3"
+
+			req := require.New(t)
+			req.False(check.Evaluate(sdkVersionTestApiSessionId, postureData))
+		})
+
+		t.Run("uses the os from the environment info when there is no os posture response", func(t *testing.T) {
+			check, postureData := newSdkVersionCheckAndPostureData()
+			postureData.Os.Type = ""
+			postureData.ApiSessions[sdkVersionTestApiSessionId].EnvInfo.Os = "darwin"
+			check.Constraints[0].OsType = "macOS"
+
+			req := require.New(t)
+			req.True(check.Evaluate(sdkVersionTestApiSessionId, postureData))
+		})
+
+		t.Run("fails when no constraint matches the sdk type", func(t *testing.T) {
+			check, postureData := newSdkVersionCheckAndPostureData()
+			postureData.ApiSessions[sdkVersionTestApiSessionId].SdkInfo.Type = "ziti-sdk-c"
+
+			req := require.New(t)
+			req.False(check.Evaluate(sdkVersionTestApiSessionId, postureData))
+		})
+
+		t.Run("fails when the app id is not allowed", func(t *testing.T) {
+			check, postureData := newSdkVersionCheckAndPostureData()
+			postureData.ApiSessions[sdkVersionTestApiSessionId].SdkInfo.AppId = "other-app"
+
+			req := require.New(t)
+			req.False(check.Evaluate(sdkVersionTestApiSessionId, postureData))
+		})
+
+		t.Run("passes any sdk with an allowed app id when there are no constraints", func(t *testing.T) {
+			check, postureData := newSdkVersionCheckAndPostureData()
+			check.Constraints = nil
+			postureData.ApiSessions[sdkVersionTestApiSessionId].SdkInfo.Version = "not-a-version"
+
+			req := require.New(t)
+			req.True(check.Evaluate(sdkVersionTestApiSessionId, postureData))
+		})
+
+		t.Run("fails when no sdk info was reported", func(t *testing.T) {
+			check, postureData := newSdkVersionCheckAndPostureData()
+			postureData.ApiSessions[sdkVersionTestApiSessionId].SdkInfo = nil
+
+			req := require.New(t)
+			req.False(check.Evaluate(sdkVersionTestApiSessionId, postureData))
+		})
+	})
+
+	t.Run("FailureValues", func(t *testing.T) {
+		t.Run("reports the actual sdk and the reason", func(t *testing.T) {
+			check, postureData := newSdkVersionCheckAndPostureData()
+			postureData.ApiSessions[sdkVersionTestApiSessionId].SdkInfo.Version = "0.19.3"
+
+			failureValues := check.FailureValues(sdkVersionTestApiSessionId, postureData).(*PostureCheckFailureValuesSdkVersion)
+
+			req := require.New(t)
+			req.Equal("ziti-sdk-golang", failureValues.ActualValue.SdkType)
+			req.Equal("0.19.3", failureValues.ActualValue.Version)
+			req.Equal("linux", failureValues.ActualValue.OsType)
+			req.Equal(check.Constraints, failureValues.ExpectedValue.Constraints)
+			req.Contains(failureValues.Reason, "does not satisfy")
+		})
+	})
+}
+
+func newSdkVersionCheckAndPostureData() (*PostureCheckSdkVersion, *PostureData) {
+	check := &PostureCheckSdkVersion{
+		Constraints: []SdkVersionConstraint{
+			{
+				SdkType:  "ziti-sdk-golang",
+				OsType:   "Linux",
+				Versions: ">=0.20.0",
+			},
+		},
+		AppIds: []string{"tunneler"},
+	}
+
+	postureData := &PostureData{
+		Os: PostureResponseOs{
+			Type: "Linux",
+		},
+		ApiSessions: map[string]*ApiSessionPostureData{
+			sdkVersionTestApiSessionId: {
+				SdkInfo: &SdkInfo{
+					AppId:   "tunneler",
+					Type:    "ziti-sdk-golang",
+					Version: "v0.20.5",
+				},
+				EnvInfo: &EnvInfo{
+					Os: "linux",
+				},
+			},
+		},
+	}
+
+	return check, postureData
+}
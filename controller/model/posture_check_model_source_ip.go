@@ -0,0 +1,154 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/openziti/ziti/common"
+	"github.com/openziti/ziti/common/pb/edge_cmd_pb"
+	"github.com/openziti/ziti/controller/db"
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+)
+
+var _ PostureCheckSubType = &PostureCheckSourceIp{}
+
+// PostureCheckSourceIp passes when the source IP requests for the API session were last observed from is not in any
+// of the denied CIDRs and, if AllowedCidrs is not empty, is in one of the allowed CIDRs. Edge routers apply the same
+// check to the source IP of each dial.
+type PostureCheckSourceIp struct {
+	AllowedCidrs []string
+	DeniedCidrs  []string
+}
+
+func newPostureCheckSourceIp() PostureCheckSubType {
+	return &PostureCheckSourceIp{}
+}
+
+func (p *PostureCheckSourceIp) TypeId() string {
+	return db.PostureCheckTypeSourceIp
+}
+
+func (p *PostureCheckSourceIp) fillProtobuf(msg *edge_cmd_pb.PostureCheck) {
+	msg.Subtype = &edge_cmd_pb.PostureCheck_SourceIp_{
+		SourceIp: &edge_cmd_pb.PostureCheck_SourceIp{
+			AllowedCidrs: p.AllowedCidrs,
+			DeniedCidrs:  p.DeniedCidrs,
+		},
+	}
+}
+
+func (p *PostureCheckSourceIp) fillFromProtobuf(msg *edge_cmd_pb.PostureCheck) error {
+	if sourceIp_, ok := msg.Subtype.(*edge_cmd_pb.PostureCheck_SourceIp_); ok {
+		if sourceIp := sourceIp_.SourceIp; sourceIp != nil {
+			p.AllowedCidrs = sourceIp.AllowedCidrs
+			p.DeniedCidrs = sourceIp.DeniedCidrs
+		}
+	} else {
+		return errors.Errorf("expected posture check sub type data of source ip, but got %T", msg.Subtype)
+	}
+	return nil
+}
+
+func (p *PostureCheckSourceIp) LastUpdatedAt(string, *PostureData) *time.Time {
+	return nil
+}
+
+func (p *PostureCheckSourceIp) GetTimeoutSeconds() int64 {
+	return PostureCheckNoTimeout
+}
+
+func (p *PostureCheckSourceIp) GetTimeoutRemainingSeconds(string, *PostureData) int64 {
+	return PostureCheckNoTimeout
+}
+
+func (p *PostureCheckSourceIp) FailureValues(apiSessionId string, pd *PostureData) PostureCheckFailureValues {
+	ret := &PostureCheckFailureValuesSourceIp{
+		ActualValue: p.getSourceIp(apiSessionId, pd),
+		ExpectedValue: PostureCheckSourceIpExpected{
+			AllowedCidrs: p.AllowedCidrs,
+			DeniedCidrs:  p.DeniedCidrs,
+		},
+	}
+
+	if err := p.check(ret.ActualValue); err != nil {
+		ret.Reason = err.Error()
+	}
+
+	return ret
+}
+
+func (p *PostureCheckSourceIp) Evaluate(apiSessionId string, pd *PostureData) bool {
+	return p.check(p.getSourceIp(apiSessionId, pd)) == nil
+}
+
+func (p *PostureCheckSourceIp) check(sourceIp string) error {
+	return common.CheckSourceIp(common.ParseSourceIp(sourceIp), p.AllowedCidrs, p.DeniedCidrs)
+}
+
+func (p *PostureCheckSourceIp) getSourceIp(apiSessionId string, pd *PostureData) string {
+	if pd == nil {
+		return ""
+	}
+
+	if apiSessionData := pd.ApiSessions[apiSessionId]; apiSessionData != nil {
+		return apiSessionData.SourceIp
+	}
+
+	return ""
+}
+
+func (p *PostureCheckSourceIp) fillFrom(_ Env, _ *bbolt.Tx, _ *db.PostureCheck, subType db.PostureCheckSubType) error {
+	subCheck, ok := subType.(*db.PostureCheckSourceIp)
+
+	if !ok || subCheck == nil {
+		return fmt.Errorf("could not convert source ip check to bolt type")
+	}
+
+	p.AllowedCidrs = subCheck.AllowedCidrs
+	p.DeniedCidrs = subCheck.DeniedCidrs
+
+	return nil
+}
+
+func (p *PostureCheckSourceIp) toBoltEntityForCreate(*bbolt.Tx, Env) (db.PostureCheckSubType, error) {
+	return &db.PostureCheckSourceIp{
+		AllowedCidrs: p.AllowedCidrs,
+		DeniedCidrs:  p.DeniedCidrs,
+	}, nil
+}
+
+type PostureCheckSourceIpExpected struct {
+	AllowedCidrs []string
+	DeniedCidrs  []string
+}
+
+type PostureCheckFailureValuesSourceIp struct {
+	ActualValue   string
+	ExpectedValue PostureCheckSourceIpExpected
+	Reason        string
+}
+
+func (p PostureCheckFailureValuesSourceIp) Expected() interface{} {
+	return p.ExpectedValue
+}
+
+func (p PostureCheckFailureValuesSourceIp) Actual() interface{} {
+	return p.ActualValue
+}
@@ -0,0 +1,105 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	sourceIpTestApiSessionId = "jkl012"
+)
+
+func TestPostureCheckModelSourceIp(t *testing.T) {
+	t.Run("Evaluate", func(t *testing.T) {
+		t.Run("passes when the source ip is in an allowed range", func(t *testing.T) {
+			check, postureData := newSourceIpCheckAndPostureData("10.1.2.3")
+
+			req := require.New(t)
+			req.True(check.Evaluate(sourceIpTestApiSessionId, postureData))
+		})
+
+		t.Run("fails when the source ip is not in an allowed range", func(t *testing.T) {
+			check, postureData := newSourceIpCheckAndPostureData("192.168.1.10")
+
+			req := require.New(t)
+			req.False(check.Evaluate(sourceIpTestApiSessionId, postureData))
+		})
+
+		t.Run("fails when the source ip is in a denied range which overlaps an allowed range", func(t *testing.T) {
+			check, postureData := newSourceIpCheckAndPostureData("10.9.0.1")
+
+			req := require.New(t)
+			req.False(check.Evaluate(sourceIpTestApiSessionId, postureData))
+		})
+
+		t.Run("passes any source ip which isn't denied when there are no allowed ranges", func(t *testing.T) {
+			check, postureData := newSourceIpCheckAndPostureData("192.168.1.10")
+			check.AllowedCidrs = nil
+
+			req := require.New(t)
+			req.True(check.Evaluate(sourceIpTestApiSessionId, postureData))
+		})
+
+		t.Run("supports ipv6", func(t *testing.T) {
+			check, postureData := newSourceIpCheckAndPostureData("2001:db8::1")
+			check.AllowedCidrs = []string{"2001:db8::/32"}
+
+			req := require.New(t)
+			req.True(check.Evaluate(sourceIpTestApiSessionId, postureData))
+		})
+
+		t.Run("fails when no source ip was observed", func(t *testing.T) {
+			check, postureData := newSourceIpCheckAndPostureData("")
+
+			req := require.New(t)
+			req.False(check.Evaluate(sourceIpTestApiSessionId, postureData))
+		})
+	})
+
+	t.Run("FailureValues", func(t *testing.T) {
+		t.Run("reports the source ip and the reason", func(t *testing.T) {
+			check, postureData := newSourceIpCheckAndPostureData("10.9.0.1")
+
+			failureValues := check.FailureValues(sourceIpTestApiSessionId, postureData).(*PostureCheckFailureValuesSourceIp)
+
+			req := require.New(t)
+			req.Equal("10.9.0.1", failureValues.ActualValue)
+			req.Equal(check.DeniedCidrs, failureValues.ExpectedValue.DeniedCidrs)
+			req.Contains(failureValues.Reason, "10.9.0.0/16")
+		})
+	})
+}
+
+func newSourceIpCheckAndPostureData(sourceIp string) (*PostureCheckSourceIp, *PostureData) {
+	check := &PostureCheckSourceIp{
+		AllowedCidrs: []string{"10.0.0.0/8"},
+		DeniedCidrs:  []string{"10.9.0.0/16"},
+	}
+
+	postureData := &PostureData{
+		ApiSessions: map[string]*ApiSessionPostureData{
+			sourceIpTestApiSessionId: {
+				SourceIp: sourceIp,
+			},
+		},
+	}
+
+	return check, postureData
+}
@@ -22,6 +22,8 @@ import (
 	"github.com/openziti/storage/ast"
 	"github.com/openziti/ziti/controller/change"
 	"github.com/openziti/ziti/controller/db"
+	cmap "github.com/orcaman/concurrent-map/v2"
+	"github.com/pkg/errors"
 	"go.etcd.io/bbolt"
 	"runtime/debug"
 	"time"
@@ -29,8 +31,9 @@ import (
 
 func NewPostureResponseManager(env Env) *PostureResponseManager {
 	manager := &PostureResponseManager{
-		env:          env,
-		postureCache: newPostureCache(env),
+		env:                 env,
+		postureCache:        newPostureCache(env),
+		deviceKeyChallenges: cmap.New[*DeviceKeyChallenge](),
 	}
 
 	manager.AddPostureDataListener(manager.postureDataUpdated)
@@ -38,8 +41,9 @@ func NewPostureResponseManager(env Env) *PostureResponseManager {
 }
 
 type PostureResponseManager struct {
-	env          Env
-	postureCache *PostureCache
+	env                 Env
+	postureCache        *PostureCache
+	deviceKeyChallenges cmap.ConcurrentMap[string, *DeviceKeyChallenge]
 }
 
 func (self *PostureResponseManager) Create(identityId string, postureResponses []*PostureResponse) {
@@ -72,6 +76,70 @@ func (self *PostureResponseManager) SetMfaPosture(identityId string, apiSessionI
 	self.Create(identityId, []*PostureResponse{postureResponse})
 }
 
+// CreateDeviceKeyChallenge issues a new device key challenge for an API session, replacing any outstanding challenge
+func (self *PostureResponseManager) CreateDeviceKeyChallenge(apiSessionId string) (*DeviceKeyChallenge, error) {
+	challenge, err := newDeviceKeyChallenge()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	for entry := range self.deviceKeyChallenges.IterBuffered() {
+		if entry.Val.ExpiresAt.Before(now) {
+			self.deviceKeyChallenges.Remove(entry.Key)
+		}
+	}
+
+	self.deviceKeyChallenges.Set(apiSessionId, challenge)
+	return challenge, nil
+}
+
+// ProveDeviceKey verifies the signature over the API session's outstanding device key challenge against the device
+// key enrolled for the identity. On success, the device key posture of the API session is updated. A challenge may
+// only be used once, whether the proof succeeds or not.
+func (self *PostureResponseManager) ProveDeviceKey(identityId string, apiSessionId string, signature []byte) error {
+	challenge, found := self.deviceKeyChallenges.Pop(apiSessionId)
+	if !found || challenge.ExpiresAt.Before(time.Now()) {
+		return errors.New("no outstanding device key challenge for api session")
+	}
+
+	identity, err := self.env.GetManagers().Identity.Read(identityId)
+	if err != nil {
+		return err
+	}
+
+	if identity.DeviceKey == nil {
+		return errors.Errorf("identity %s has no enrolled device key", identityId)
+	}
+
+	if err = VerifyDeviceKeySignature(identity.DeviceKey.PublicKey, challenge.Challenge, signature); err != nil {
+		return err
+	}
+
+	self.SetDeviceKeyPosture(identityId, apiSessionId, time.Now().UTC())
+	return nil
+}
+
+// SetDeviceKeyPosture records that a specific API Session owned by an identity proved possession of its device key
+func (self *PostureResponseManager) SetDeviceKeyPosture(identityId string, apiSessionId string, provedAt time.Time) {
+	postureResponse := &PostureResponse{
+		PostureCheckId: DeviceKeyProviderZiti,
+		TypeId:         PostureCheckTypeDeviceKey,
+		TimedOut:       false,
+		LastUpdatedAt:  provedAt,
+	}
+
+	postureSubType := &PostureResponseDeviceKey{
+		ApiSessionId: apiSessionId,
+		ProvedAt:     &provedAt,
+	}
+
+	postureResponse.SubType = postureSubType
+	postureSubType.PostureResponse = postureResponse
+
+	self.Create(identityId, []*PostureResponse{postureResponse})
+}
+
 // SetMfaPostureForIdentity sets the MFA passing status for all API Sessions associated to an identity
 func (self *PostureResponseManager) SetMfaPostureForIdentity(identityId string, isPassed bool) {
 	postureResponse := &PostureResponse{
@@ -222,6 +290,24 @@ func (self *PostureResponseManager) Evaluate(identityId, apiSessionId string, ch
 	}
 }
 
+// GetGraceExpiresAt returns when the grace period of a failing posture check runs out for an API session, or nil if the
+// check isn't within its grace period
+func (self *PostureResponseManager) GetGraceExpiresAt(identityId, apiSessionId string, check *PostureCheck) *time.Time {
+	if check.GracePeriodSeconds <= 0 {
+		return nil
+	}
+
+	var result *time.Time
+	self.postureCache.WithPostureData(identityId, func(pd *PostureData) {
+		if apiSessionData, ok := pd.ApiSessions[apiSessionId]; ok {
+			if grace, ok := apiSessionData.PostureGrace[check.Id]; ok && !grace.Expired {
+				result = grace.ExpiresAt(check)
+			}
+		}
+	})
+	return result
+}
+
 func (self *PostureResponseManager) PostureData(id string) *PostureData {
 	return self.postureCache.PostureData(id)
 }
@@ -254,6 +340,64 @@ func (self *PostureResponseManager) SetSdkInfo(identityId, apiSessionId string,
 	})
 }
 
+// SetEnvInfo records the environment reported at authentication for the API session. Posture checks which depend on
+// the client's OS fall back to it when no OS posture response has been received.
+func (self *PostureResponseManager) SetEnvInfo(identityId, apiSessionId string, envInfo *EnvInfo) {
+	if identityId == "" || apiSessionId == "" || envInfo == nil {
+		return
+	}
+
+	self.postureCache.Upsert(identityId, false, func(exist bool, valueInMap *PostureData, newValue *PostureData) *PostureData {
+		var postureData *PostureData
+		if exist {
+			postureData = valueInMap
+		} else {
+			postureData = newValue
+		}
+
+		if _, ok := postureData.ApiSessions[apiSessionId]; !ok {
+			postureData.ApiSessions[apiSessionId] = &ApiSessionPostureData{}
+		}
+
+		apiSessionData := postureData.ApiSessions[apiSessionId]
+		apiSessionData.EnvInfo = envInfo
+
+		return postureData
+	})
+}
+
+// SetSourceIp records the source IP a request for the API session was observed from. When the source IP of an API
+// session changes, posture data listeners are notified so sessions failing source IP checks are removed.
+func (self *PostureResponseManager) SetSourceIp(identityId, apiSessionId string, sourceIp string) {
+	if identityId == "" || apiSessionId == "" || sourceIp == "" {
+		return
+	}
+
+	changed := false
+	self.postureCache.Upsert(identityId, false, func(exist bool, valueInMap *PostureData, newValue *PostureData) *PostureData {
+		var postureData *PostureData
+		if exist {
+			postureData = valueInMap
+		} else {
+			postureData = newValue
+		}
+
+		if _, ok := postureData.ApiSessions[apiSessionId]; !ok {
+			postureData.ApiSessions[apiSessionId] = &ApiSessionPostureData{}
+		}
+
+		apiSessionData := postureData.ApiSessions[apiSessionId]
+		changed = apiSessionData.SourceIp != "" && apiSessionData.SourceIp != sourceIp
+		apiSessionData.SourceIp = sourceIp
+
+		return postureData
+	})
+
+	if changed {
+		self.postureCache.Emit(EventIdentityPostureDataAltered, identityId)
+	}
+}
+
 type ServiceWithTimeout struct {
 	Service *Service
 	Timeout int64
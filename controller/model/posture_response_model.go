@@ -25,6 +25,7 @@ import (
 	"github.com/openziti/storage/boltz"
 	"github.com/openziti/ziti/controller/change"
 	"github.com/openziti/ziti/controller/db"
+	"github.com/openziti/ziti/controller/event"
 	cmap "github.com/orcaman/concurrent-map/v2"
 	"go.etcd.io/bbolt"
 	"regexp"
@@ -227,7 +228,27 @@ func (pc *PostureCache) AddSessionRequestFailure(identityId string, failure *Pos
 
 func (pc *PostureCache) Evaluate(identityId, apiSessionId string, postureChecks []*PostureCheck) (bool, []*PostureCheckFailure) {
 	if postureData, found := pc.identityToPostureData.Get(identityId); found {
-		return postureData.Evaluate(apiSessionId, postureChecks)
+		if !hasGracePeriods(postureChecks) {
+			return postureData.Evaluate(apiSessionId, postureChecks)
+		}
+
+		// grace period tracking alters the posture data, so evaluate under the map lock
+		var isPassing bool
+		var failures []*PostureCheckFailure
+		var warnings []*event.PostureWarningEvent
+
+		pc.Upsert(identityId, false, func(exist bool, valueInMap *PostureData, newValue *PostureData) *PostureData {
+			pd := newValue
+			if exist {
+				pd = valueInMap
+			}
+			isPassing, failures, warnings = pd.EvaluateWithGrace(identityId, apiSessionId, postureChecks, time.Now().UTC())
+			return pd
+		})
+
+		pc.dispatchPostureWarnings(identityId, warnings)
+
+		return isPassing, failures
 	}
 
 	//mock failures with nil provided data, no posture data found
@@ -244,6 +265,28 @@ func (pc *PostureCache) Evaluate(identityId, apiSessionId string, postureChecks
 	return false, failures
 }
 
+// dispatchPostureWarnings emits posture warning events for grace period changes and notifies the identity's SDKs that
+// their service posture queries have changed, so they can prompt the user to remediate before access is cut.
+func (pc *PostureCache) dispatchPostureWarnings(identityId string, warnings []*event.PostureWarningEvent) {
+	if len(warnings) == 0 {
+		return
+	}
+
+	dispatcher := pc.env.GetHostController().GetNetwork().GetEventDispatcher()
+	for _, warning := range warnings {
+		pfxlog.Logger().WithFields(map[string]interface{}{
+			"identityId":     warning.IdentityId,
+			"apiSessionId":   warning.ApiSessionId,
+			"postureCheckId": warning.PostureCheckId,
+			"eventType":      warning.EventType,
+		}).Debug("posture check grace period changed")
+
+		dispatcher.AcceptPostureWarningEvent(warning)
+	}
+
+	pc.env.HandleServiceUpdatedEventForIdentityId(identityId)
+}
+
 // PostureData returns a copy of the current posture data for an identity.
 // Suitable for read only rendering. To alter/update posture data see Upsert.
 func (pc *PostureCache) PostureData(identityId string) *PostureData {
@@ -348,7 +391,29 @@ type PostureSessionData struct {
 type ApiSessionPostureData struct {
 	Mfa           *PostureResponseMfa           `json:"mfa"`
 	EndpointState *PostureResponseEndpointState `json:"endpointState"`
+	DeviceKey     *PostureResponseDeviceKey     `json:"deviceKey"`
 	SdkInfo       *SdkInfo
+	EnvInfo       *EnvInfo
+	SourceIp      string
+	PostureGrace  map[string]*PostureCheckGrace
+}
+
+// PostureCheckGrace tracks the grace period of a posture check with a grace period for an API session
+type PostureCheckGrace struct {
+	// HasPassed is set once the check has passed for the API session. Grace periods only apply to checks which have
+	// passed before, so a client which has never been compliant doesn't gain access.
+	HasPassed    bool
+	FailingSince *time.Time
+	Expired      bool
+}
+
+// ExpiresAt returns when the grace period runs out, or nil if the check isn't failing
+func (self *PostureCheckGrace) ExpiresAt(check *PostureCheck) *time.Time {
+	if self == nil || self.FailingSince == nil || check.GracePeriodSeconds <= 0 {
+		return nil
+	}
+	expiresAt := self.FailingSince.Add(time.Duration(check.GracePeriodSeconds) * time.Second)
+	return &expiresAt
 }
 
 func (self *ApiSessionPostureData) GetPassedMfaAt() *time.Time {
@@ -415,6 +480,102 @@ func (pd *PostureData) Evaluate(apiSessionId string, checks []*PostureCheck) (bo
 	return len(failures) == 0, failures
 }
 
+// EvaluateWithGrace evaluates the posture checks like Evaluate, but checks which have previously passed for the API
+// session are treated as passing until their grace period runs out. Grace period changes are returned as posture
+// warning events.
+func (pd *PostureData) EvaluateWithGrace(identityId, apiSessionId string, checks []*PostureCheck, now time.Time) (bool, []*PostureCheckFailure, []*event.PostureWarningEvent) {
+	var failures []*PostureCheckFailure
+	var warnings []*event.PostureWarningEvent
+
+	for _, check := range checks {
+		isValid, failure := check.Evaluate(apiSessionId, pd)
+
+		if check.GracePeriodSeconds <= 0 || apiSessionId == "" {
+			if !isValid {
+				failures = append(failures, failure)
+			}
+			continue
+		}
+
+		grace := pd.getPostureCheckGrace(apiSessionId, check.Id)
+
+		if isValid {
+			if grace.FailingSince != nil {
+				warnings = append(warnings, newPostureWarningEvent(event.PostureWarningEventTypeCleared, identityId, apiSessionId, check, nil, now))
+			}
+			grace.HasPassed = true
+			grace.FailingSince = nil
+			grace.Expired = false
+			continue
+		}
+
+		if !grace.HasPassed || grace.Expired {
+			failures = append(failures, failure)
+			continue
+		}
+
+		if grace.FailingSince == nil {
+			failingSince := now
+			grace.FailingSince = &failingSince
+			warnings = append(warnings, newPostureWarningEvent(event.PostureWarningEventTypeWarning, identityId, apiSessionId, check, grace.ExpiresAt(check), now))
+		}
+
+		if expiresAt := grace.ExpiresAt(check); now.Before(*expiresAt) {
+			continue
+		}
+
+		grace.Expired = true
+		warnings = append(warnings, newPostureWarningEvent(event.PostureWarningEventTypeExpired, identityId, apiSessionId, check, grace.ExpiresAt(check), now))
+		failures = append(failures, failure)
+	}
+
+	return len(failures) == 0, failures, warnings
+}
+
+func (pd *PostureData) getPostureCheckGrace(apiSessionId, postureCheckId string) *PostureCheckGrace {
+	apiSessionData, ok := pd.ApiSessions[apiSessionId]
+	if !ok {
+		apiSessionData = &ApiSessionPostureData{}
+		pd.ApiSessions[apiSessionId] = apiSessionData
+	}
+
+	if apiSessionData.PostureGrace == nil {
+		apiSessionData.PostureGrace = map[string]*PostureCheckGrace{}
+	}
+
+	grace, ok := apiSessionData.PostureGrace[postureCheckId]
+	if !ok {
+		grace = &PostureCheckGrace{}
+		apiSessionData.PostureGrace[postureCheckId] = grace
+	}
+
+	return grace
+}
+
+func hasGracePeriods(checks []*PostureCheck) bool {
+	for _, check := range checks {
+		if check.GracePeriodSeconds > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func newPostureWarningEvent(eventType, identityId, apiSessionId string, check *PostureCheck, expiresAt *time.Time, now time.Time) *event.PostureWarningEvent {
+	return &event.PostureWarningEvent{
+		Namespace:          event.PostureWarningEventNS,
+		EventType:          eventType,
+		Timestamp:          now,
+		IdentityId:         identityId,
+		ApiSessionId:       apiSessionId,
+		PostureCheckId:     check.Id,
+		PostureCheckName:   check.Name,
+		PostureCheckType:   check.TypeId,
+		GracePeriodSeconds: check.GracePeriodSeconds,
+		ExpiresAt:          expiresAt,
+	}
+}
+
 func (pd *PostureData) Copy() *PostureData {
 	dest := &PostureData{}
 	_ = copier.Copy(dest, pd)
@@ -80,6 +80,8 @@ func (self *ServicePolicyManager) Marshall(entity *ServicePolicy) ([]byte, error
 		ServiceRoles:      entity.ServiceRoles,
 		PostureCheckRoles: entity.PostureCheckRoles,
 		PolicyType:        entity.PolicyType,
+		Schedule:          scheduleToPb(entity.Schedule),
+		Effect:            entity.Effect,
 	}
 
 	return proto.Marshal(msg)
@@ -102,6 +104,8 @@ func (self *ServicePolicyManager) Unmarshall(bytes []byte) (*ServicePolicy, erro
 		ServiceRoles:      msg.ServiceRoles,
 		PostureCheckRoles: msg.PostureCheckRoles,
 		PolicyType:        msg.PolicyType,
+		Schedule:          pbToSchedule(msg.Schedule),
+		Effect:            msg.Effect,
 	}, nil
 }
 
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"github.com/openziti/foundation/v2/errorz"
 	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/common/schedule"
 	"github.com/openziti/ziti/controller/db"
 	"github.com/openziti/ziti/controller/models"
 	"go.etcd.io/bbolt"
@@ -34,6 +35,8 @@ type ServicePolicy struct {
 	IdentityRoles     []string
 	ServiceRoles      []string
 	PostureCheckRoles []string
+	Schedule          *schedule.Schedule
+	Effect            string
 }
 
 func (entity *ServicePolicy) validatePolicyType() error {
@@ -44,6 +47,17 @@ func (entity *ServicePolicy) validatePolicyType() error {
 	return nil
 }
 
+func (entity *ServicePolicy) getEffect() (db.PolicyEffect, error) {
+	if entity.Effect == "" || strings.EqualFold(entity.Effect, db.PolicyEffectAllow.String()) {
+		return db.PolicyEffectAllow, nil
+	}
+	if strings.EqualFold(entity.Effect, db.PolicyEffectDeny.String()) {
+		return db.PolicyEffectDeny, nil
+	}
+	msg := fmt.Sprintf("invalid policy effect. valid effects are '%v' and '%v'", db.PolicyEffectAllow, db.PolicyEffectDeny)
+	return "", errorz.NewFieldError(msg, "effect", entity.Effect)
+}
+
 func (entity *ServicePolicy) toBoltEntity(checker boltz.FieldChecker) (*db.ServicePolicy, error) {
 	if checker == nil || checker.IsUpdated(db.FieldServicePolicyType) {
 		if err := entity.validatePolicyType(); err != nil {
@@ -58,6 +72,14 @@ func (entity *ServicePolicy) toBoltEntity(checker boltz.FieldChecker) (*db.Servi
 		policyType = db.PolicyTypeBind
 	}
 
+	effect := db.PolicyEffectAllow
+	if checker == nil || checker.IsUpdated(db.FieldServicePolicyEffect) {
+		var err error
+		if effect, err = entity.getEffect(); err != nil {
+			return nil, err
+		}
+	}
+
 	return &db.ServicePolicy{
 		BaseExtEntity:     *boltz.NewExtEntity(entity.Id, entity.Tags),
 		Name:              entity.Name,
@@ -66,6 +88,8 @@ func (entity *ServicePolicy) toBoltEntity(checker boltz.FieldChecker) (*db.Servi
 		IdentityRoles:     entity.IdentityRoles,
 		ServiceRoles:      entity.ServiceRoles,
 		PostureCheckRoles: entity.PostureCheckRoles,
+		Schedule:          entity.Schedule,
+		Effect:            effect,
 	}, nil
 }
 
@@ -73,8 +97,20 @@ func (entity *ServicePolicy) toBoltEntityForCreate(*bbolt.Tx, Env) (*db.ServiceP
 	return entity.toBoltEntity(nil)
 }
 
-func (entity *ServicePolicy) toBoltEntityForUpdate(_ *bbolt.Tx, _ Env, checker boltz.FieldChecker) (*db.ServicePolicy, error) {
-	return entity.toBoltEntity(checker)
+func (entity *ServicePolicy) toBoltEntityForUpdate(tx *bbolt.Tx, env Env, checker boltz.FieldChecker) (*db.ServicePolicy, error) {
+	servicePolicy, err := entity.toBoltEntity(checker)
+	if err != nil {
+		return nil, err
+	}
+
+	// schedules and effects are set through their own policy endpoints, so carry them over rather than clearing them on
+	// a full update
+	if checker == nil {
+		servicePolicy.Schedule = env.GetStores().ServicePolicy.GetSchedule(tx, []byte(entity.Id))
+		servicePolicy.Effect = env.GetStores().ServicePolicy.GetEffect(tx, []byte(entity.Id))
+	}
+
+	return servicePolicy, nil
 }
 
 func (entity *ServicePolicy) fillFrom(_ Env, _ *bbolt.Tx, boltServicePolicy *db.ServicePolicy) error {
@@ -85,5 +121,7 @@ func (entity *ServicePolicy) fillFrom(_ Env, _ *bbolt.Tx, boltServicePolicy *db.
 	entity.ServiceRoles = boltServicePolicy.ServiceRoles
 	entity.IdentityRoles = boltServicePolicy.IdentityRoles
 	entity.PostureCheckRoles = boltServicePolicy.PostureCheckRoles
+	entity.Schedule = boltServicePolicy.Schedule
+	entity.Effect = boltServicePolicy.Effect.String()
 	return nil
 }
@@ -72,11 +72,17 @@ func (self *SessionManager) EvaluatePostureForService(identityId, apiSessionId,
 	var failedPolicyIds []string
 	var successPolicyIds []string
 
+	now := time.Now()
 	for policyId, policyPostureCheck := range policyPostureCheckMap {
 
 		if policyPostureCheck.PolicyType.String() != sessionType {
 			continue
 		}
+
+		// policies outside their schedule don't grant access, regardless of posture
+		if !policyPostureCheck.Schedule.IsActive(now) {
+			continue
+		}
 		hasMatchingPolicies = true
 		var failedChecks []*PostureCheckFailure
 
@@ -174,6 +180,10 @@ func (self *SessionManager) CreateJwt(entity *Session, ctx *change.Context) (str
 		return "", errorz.NewFieldError("service not found", "ServiceId", entity.ServiceId)
 	}
 
+	if err = self.GetEnv().GetManagers().ServicePolicy.CheckSchedule(entity.IdentityId, service.Id, db.PolicyType(entity.Type)); err != nil {
+		return "", err
+	}
+
 	policyResult := self.EvaluatePostureForService(entity.IdentityId, entity.ApiSessionId, entity.Type, service.Id, service.Name)
 
 	if !policyResult.Passed {
@@ -241,6 +251,10 @@ func (self *SessionManager) Create(entity *Session, ctx *change.Context) (string
 		return "", errorz.NewFieldError("service not found", "ServiceId", entity.ServiceId)
 	}
 
+	if err = self.GetEnv().GetManagers().ServicePolicy.CheckSchedule(apiSession.IdentityId, service.Id, db.PolicyType(entity.Type)); err != nil {
+		return "", err
+	}
+
 	policyResult := self.EvaluatePostureForService(apiSession.IdentityId, apiSession.Id, entity.Type, service.Id, service.Name)
 
 	if !policyResult.Passed {
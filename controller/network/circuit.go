@@ -17,9 +17,13 @@
 package network
 
 import (
+	"encoding/json"
 	"github.com/openziti/identity"
+	"github.com/openziti/sdk-golang/ziti/edge"
 	"github.com/openziti/storage/objectz"
 	"github.com/openziti/ziti/common/logcontext"
+	"github.com/openziti/ziti/common/pb/ctrl_pb"
+	"github.com/openziti/ziti/common/qos"
 	"github.com/openziti/ziti/controller/idgen"
 	"github.com/openziti/ziti/controller/xt"
 	"github.com/orcaman/concurrent-map/v2"
@@ -28,16 +32,17 @@ import (
 )
 
 type Circuit struct {
-	Id         string
-	ClientId   string
-	ServiceId  string
-	Terminator xt.CostedTerminator
-	Path       *Path
-	Tags       map[string]string
-	Rerouting  atomic.Bool
-	PeerData   xt.PeerData
-	CreatedAt  time.Time
-	UpdatedAt  time.Time
+	Id            string
+	ClientId      string
+	ServiceId     string
+	Terminator    xt.CostedTerminator
+	Path          *Path
+	Tags          map[string]string
+	Rerouting     atomic.Bool
+	PeerData      xt.PeerData
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	PriorityClass qos.PriorityClass
 }
 
 func (self *Circuit) GetId() string {
@@ -72,6 +77,13 @@ func (self *Circuit) cost(minRouterCost uint16) int64 {
 	return self.Path.cost(minRouterCost)
 }
 
+// applyPriorityClass sets the circuit's priority class on route messages, so that reroutes don't reset it
+func (self *Circuit) applyPriorityClass(rms []*ctrl_pb.Route) {
+	for _, rm := range rms {
+		rm.PriorityClass = uint32(self.PriorityClass)
+	}
+}
+
 func (self *Circuit) HasRouter(routerId string) bool {
 	if self == nil || self.Path == nil {
 		return false
@@ -162,3 +174,30 @@ type CreateCircuitParams interface {
 	GetLogContext() logcontext.Context
 	GetDeadline() time.Time
 }
+
+// DialAffinityKeyAppDataField is the field in JSON dial app data which callers can use to supply an affinity key
+// to terminator strategies, such as the sticky strategy
+const DialAffinityKeyAppDataField = "affinity_key"
+
+func newDialContext(params CreateCircuitParams) xt.DialContext {
+	clientId := params.GetClientId()
+
+	dialerId := params.GetCircuitTags(nil)["clientId"]
+	if dialerId == "" && clientId != nil {
+		dialerId = clientId.Token
+	}
+
+	var affinityKey string
+	if clientId != nil {
+		if appData := clientId.Data[edge.AppDataHeader]; len(appData) > 0 && appData[0] == '{' {
+			fields := map[string]any{}
+			if err := json.Unmarshal(appData, &fields); err == nil {
+				if val, ok := fields[DialAffinityKeyAppDataField].(string); ok {
+					affinityKey = val
+				}
+			}
+		}
+	}
+
+	return xt.NewDialContext(dialerId, affinityKey)
+}
@@ -37,6 +37,7 @@ type Link struct {
 	state       LinkState
 	down        bool
 	StaticCost  int32
+	BondMembers []string
 	usable      atomic.Bool
 	lock        sync.Mutex
 }
@@ -64,6 +65,11 @@ func (link *Link) GetId() string {
 	return link.Id
 }
 
+// IsBond returns true if the link is a bonded link, which stripes payloads across its member links
+func (link *Link) IsBond() bool {
+	return len(link.BondMembers) > 0
+}
+
 func (link *Link) GetDest() *Router {
 	return link.Dst.Load()
 }
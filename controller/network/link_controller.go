@@ -135,7 +135,7 @@ func (linkController *linkController) scanForDeadLinks() {
 	}
 }
 
-func (linkController *linkController) routerReportedLink(linkId string, iteration uint32, linkProtocol, dialAddress string, src, dst *Router, dstId string) (*Link, bool) {
+func (linkController *linkController) routerReportedLink(linkId string, iteration uint32, linkProtocol, dialAddress string, bondMembers []string, src, dst *Router, dstId string) (*Link, bool) {
 	linkController.lock.Lock()
 	defer linkController.lock.Unlock()
 
@@ -158,6 +158,7 @@ func (linkController *linkController) routerReportedLink(linkId string, iteratio
 
 	link = newLink(linkId, linkProtocol, dialAddress, linkController.initialLatency)
 	link.Iteration = iteration
+	link.BondMembers = bondMembers
 	link.Src = src
 	link.Dst.Store(dst)
 	link.DstId = dstId
@@ -207,13 +208,19 @@ func (linkController *linkController) connectedNeighborsOfRouter(router *Router)
 }
 
 func (linkController *linkController) leastExpensiveLink(a, b *Router) (*Link, bool) {
+	return linkController.leastExpensiveLinkMatching(a, b, nil)
+}
+
+// leastExpensiveLinkMatching returns the cheapest usable link between the two routers which is accepted by the given
+// filter. A nil filter accepts all links
+func (linkController *linkController) leastExpensiveLinkMatching(a, b *Router, filter func(*Link) bool) (*Link, bool) {
 	var selected *Link
 	var cost int64 = math.MaxInt64
 
 	linksByRouter := a.routerLinks.GetLinksByRouter()
 	links := linksByRouter[b.Id]
 	for _, link := range links {
-		if link.IsUsable() {
+		if link.IsUsable() && (filter == nil || filter(link)) {
 			linkCost := link.GetCost()
 			if link.DstId == b.Id {
 				if linkCost < cost {
@@ -94,3 +94,56 @@ func newTestLink(id string, src, dst *Router) *Link {
 	dst.Connected.Store(true)
 	return l
 }
+
+func TestLeastExpensiveLinkWithBonds(t *testing.T) {
+	linkController := newLinkController(nil)
+
+	r0 := newRouterForTest("r0", "", nil, nil, 0, true)
+	r1 := newRouterForTest("r1", "", nil, nil, 0, true)
+
+	l0 := newTestLink("l0", r0, r1)
+	l0.SetSrcLatency(20_000_000)
+	l0.SetState(Connected)
+	linkController.add(l0)
+
+	l1 := newTestLink("l1", r0, r1)
+	l1.SetSrcLatency(30_000_000)
+	l1.SetState(Connected)
+	linkController.add(l1)
+
+	bond := newTestLink("bond:r0:r1", r0, r1)
+	bond.Protocol = "bond"
+	bond.BondMembers = []string{"l0", "l1"}
+	bond.SetSrcLatency(50_000_000)
+	bond.SetState(Connected)
+	linkController.add(bond)
+
+	// bonds are selected on cost, like any other link
+	link, found := linkController.leastExpensiveLink(r0, r1)
+	assert.True(t, found)
+	assert.Equal(t, l0, link)
+
+	bond.SetSrcLatency(10_000_000)
+
+	link, found = linkController.leastExpensiveLink(r0, r1)
+	assert.True(t, found)
+	assert.Equal(t, bond, link)
+
+	link, found = linkController.leastExpensiveLink(r1, r0)
+	assert.True(t, found)
+	assert.Equal(t, bond, link)
+
+	// a bond isn't used if one of its members is excluded
+	constraints := &pathConstraints{
+		excludedLinks: map[string]struct{}{"l0": {}},
+	}
+	link, found = linkController.leastExpensiveLinkMatching(r0, r1, constraints.allowsLink)
+	assert.True(t, found)
+	assert.Equal(t, l1, link)
+
+	// unusable bonds are skipped
+	bond.SetDown(true)
+	link, found = linkController.leastExpensiveLink(r0, r1)
+	assert.True(t, found)
+	assert.Equal(t, l0, link)
+}
@@ -51,6 +51,7 @@ import (
 	"github.com/openziti/ziti/common/ctrl_msg"
 	"github.com/openziti/ziti/common/logcontext"
 	"github.com/openziti/ziti/common/pb/ctrl_pb"
+	"github.com/openziti/ziti/common/qos"
 	"github.com/openziti/ziti/common/trace"
 	"github.com/openziti/ziti/controller/db"
 	"github.com/openziti/ziti/controller/xt"
@@ -435,7 +436,7 @@ func (network *Network) notifyAssembleAndClean() {
 	}
 }
 
-func (network *Network) NotifyExistingLink(id string, iteration uint32, linkProtocol, dialAddress string, srcRouter *Router, dstRouterId string) {
+func (network *Network) NotifyExistingLink(id string, iteration uint32, linkProtocol, dialAddress string, bondMembers []string, srcRouter *Router, dstRouterId string) {
 	log := pfxlog.Logger().
 		WithField("routerId", srcRouter.Id).
 		WithField("linkId", id).
@@ -458,7 +459,7 @@ func (network *Network) NotifyExistingLink(id string, iteration uint32, linkProt
 		network.NotifyLinkIdEvent(id, event.LinkFromRouterDisconnectedDest)
 	}
 
-	link, created := network.linkController.routerReportedLink(id, iteration, linkProtocol, dialAddress, srcRouter, dst, dstRouterId)
+	link, created := network.linkController.routerReportedLink(id, iteration, linkProtocol, dialAddress, bondMembers, srcRouter, dst, dstRouterId)
 	if created {
 		network.NotifyLinkEvent(link, event.LinkFromRouterNew)
 		log.Info("router reported link added")
@@ -530,6 +531,7 @@ func (network *Network) CreateCircuit(params CreateCircuitParams) (*Circuit, err
 	startTime := time.Now()
 
 	instanceId, serviceId := parseInstanceIdAndService(service)
+	dialCtx := newDialContext(params)
 
 	// 1: Allocate Circuit Identifier
 	circuitId, err := network.circuitController.nextCircuitId()
@@ -548,6 +550,7 @@ func (network *Network) CreateCircuit(params CreateCircuitParams) (*Circuit, err
 	allCleanups := make(map[string]struct{})
 	rs := network.newRouteSender(circuitId)
 	defer func() { network.removeRouteSender(rs) }()
+	var failover *pathFailover
 	for {
 		// 2: Find Service
 		svc, err := network.Services.Read(serviceId)
@@ -558,20 +561,50 @@ func (network *Network) CreateCircuit(params CreateCircuitParams) (*Circuit, err
 		}
 		logger = logger.WithField("serviceName", svc.Name)
 
-		// 3: select terminator
-		strategy, terminator, pathNodes, circuitErr := network.selectPath(srcR, svc, instanceId, ctx)
-		if circuitErr != nil {
-			network.CircuitFailedEvent(circuitId, params, startTime, nil, nil, circuitErr.Cause())
-			network.ServiceDialOtherError(serviceId)
-			return nil, circuitErr
+		priorityClass, err := qos.ParsePriorityClass(svc.PriorityClass)
+		if err != nil {
+			logger.WithError(err).Warn("invalid priority class on service, using standard")
 		}
 
-		// 4: Create Path
-		path, pathErr := network.CreatePathWithNodes(pathNodes)
-		if pathErr != nil {
-			network.CircuitFailedEvent(circuitId, params, startTime, nil, terminator, pathErr.Cause())
+		constraints, err := network.newPathConstraints(svc)
+		if err != nil {
+			network.CircuitFailedEvent(circuitId, params, startTime, nil, nil, CircuitFailureNoPath)
 			network.ServiceDialOtherError(serviceId)
-			return nil, pathErr
+			return nil, newCircuitErrWrap(CircuitFailureNoPath, err)
+		}
+
+		// 3: select terminator, unless we're failing over to an alternate path to the previously selected terminator
+		var strategy xt.Strategy
+		var terminator xt.CostedTerminator
+		var path *Path
+		if failover != nil {
+			strategy, terminator = failover.strategy, failover.terminator
+			path = failover.next(network)
+			if path != nil {
+				logger.WithField("path", path).Info("failing over to alternate path for circuit")
+			}
+		}
+
+		if path == nil {
+			var pathNodes []*Router
+			var circuitErr CircuitError
+			strategy, terminator, pathNodes, circuitErr = network.selectPath(srcR, svc, instanceId, dialCtx, ctx, constraints)
+			if circuitErr != nil {
+				network.CircuitFailedEvent(circuitId, params, startTime, nil, nil, circuitErr.Cause())
+				network.ServiceDialOtherError(serviceId)
+				return nil, circuitErr
+			}
+
+			// 4: Create Path
+			var pathErr CircuitError
+			path, pathErr = network.createConstrainedPath(pathNodes, constraints)
+			if pathErr != nil {
+				network.CircuitFailedEvent(circuitId, params, startTime, nil, terminator, pathErr.Cause())
+				network.ServiceDialOtherError(serviceId)
+				return nil, pathErr
+			}
+
+			failover = network.newPathFailover(strategy, terminator, pathNodes, constraints)
 		}
 
 		// get circuit tags
@@ -586,6 +619,7 @@ func (network *Network) CreateCircuit(params CreateCircuitParams) (*Circuit, err
 				ChannelMask: ctx.GetChannelsMask(),
 			}
 			msg.Tags = tags
+			msg.PriorityClass = uint32(priorityClass)
 		}
 
 		// 5: Routing
@@ -601,6 +635,10 @@ func (network *Network) CreateCircuit(params CreateCircuitParams) (*Circuit, err
 			ctx.WithField("attemptNumber", attempt+1)
 			logger = logger.WithField("attemptNumber", attempt+1)
 			if attempt < network.options.CreateCircuitRetries {
+				// alternate paths won't help if the failure was caused by the terminator
+				if !isPathFailure(circuitErr.Cause()) {
+					failover = nil
+				}
 				continue
 			} else {
 				// revert successful routes
@@ -656,15 +694,16 @@ func (network *Network) CreateCircuit(params CreateCircuitParams) (*Circuit, err
 		now := time.Now()
 		// 6: Create Circuit Object
 		circuit := &Circuit{
-			Id:         circuitId,
-			ClientId:   clientId.Token,
-			ServiceId:  svc.Id,
-			Path:       path,
-			Terminator: terminator,
-			PeerData:   peerData,
-			CreatedAt:  now,
-			UpdatedAt:  now,
-			Tags:       tags,
+			Id:            circuitId,
+			ClientId:      clientId.Token,
+			ServiceId:     svc.Id,
+			Path:          path,
+			Terminator:    terminator,
+			PeerData:      peerData,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+			Tags:          tags,
+			PriorityClass: priorityClass,
 		}
 		network.circuitController.add(circuit)
 		creationTimespan := time.Since(startTime)
@@ -697,7 +736,7 @@ func parseInstanceIdAndService(service string) (string, string) {
 	return identityId, serviceId
 }
 
-func (network *Network) selectPath(srcR *Router, svc *Service, instanceId string, ctx logcontext.Context) (xt.Strategy, xt.CostedTerminator, []*Router, CircuitError) {
+func (network *Network) selectPath(srcR *Router, svc *Service, instanceId string, dialCtx xt.DialContext, ctx logcontext.Context, constraints *pathConstraints) (xt.Strategy, xt.CostedTerminator, []*Router, CircuitError) {
 	paths := map[string]*PathAndCost{}
 	var weightedTerminators []xt.CostedTerminator
 	var errList []error
@@ -725,7 +764,7 @@ func (network *Network) selectPath(srcR *Router, svc *Service, instanceId string
 				continue
 			}
 
-			path, cost, err := network.shortestPath(srcR, dstR)
+			path, cost, err := network.constrainedShortestPath(srcR, dstR, constraints.exclusions())
 			if err != nil {
 				log.Debugf("error while calculating path for service %v: %v", svc.Id, err)
 				errList = append(errList, err)
@@ -772,7 +811,12 @@ func (network *Network) selectPath(srcR *Router, svc *Service, instanceId string
 		return weightedTerminators[i].GetRouteCost() < weightedTerminators[j].GetRouteCost()
 	})
 
-	terminator, err := strategy.Select(weightedTerminators)
+	var terminator xt.CostedTerminator
+	if dialCtxStrategy, ok := strategy.(xt.DialContextStrategy); ok && dialCtx != nil {
+		terminator, err = dialCtxStrategy.SelectForDial(dialCtx, weightedTerminators)
+	} else {
+		terminator, err = strategy.Select(weightedTerminators)
+	}
 
 	if err != nil {
 		return nil, nil, nil, newCircuitErrorf(CircuitFailureStrategyError, "strategy %v errored selecting terminator for service %v: %v", svc.TerminatorStrategy, svc.Id, err)
@@ -822,7 +866,7 @@ func (network *Network) RemoveCircuit(circuitId string, now bool) error {
 
 		if svc, err := network.Services.Read(circuit.ServiceId); err == nil {
 			if strategy, err := network.strategyRegistry.GetStrategy(svc.TerminatorStrategy); strategy != nil {
-				strategy.NotifyEvent(xt.NewCircuitRemoved(circuit.Terminator))
+				strategy.NotifyEvent(xt.NewCircuitRemoved(circuit.Terminator, time.Since(circuit.CreatedAt)))
 			} else if err != nil {
 				log.WithError(err).WithField("terminatorStrategy", svc.TerminatorStrategy).Warn("failed to notify strategy of circuit end, invalid strategy")
 			}
@@ -861,6 +905,16 @@ func (network *Network) CreatePath(srcR, dstR *Router) (*Path, error) {
 }
 
 func (network *Network) CreatePathWithNodes(nodes []*Router) (*Path, CircuitError) {
+	return network.createConstrainedPath(nodes, nil)
+}
+
+// createConstrainedPath creates a path using the given routers, and the cheapest links between them which satisfy
+// the given routing constraints
+func (network *Network) createConstrainedPath(nodes []*Router, constraints *pathConstraints) (*Path, CircuitError) {
+	if err := constraints.check(nodes); err != nil {
+		return nil, newCircuitErrWrap(CircuitFailureNoPath, err)
+	}
+
 	ingressId, err := network.sequence.NextHash()
 	if err != nil {
 		return nil, newCircuitErrWrap(CircuitFailureIdGenerationError, err)
@@ -872,9 +926,10 @@ func (network *Network) CreatePathWithNodes(nodes []*Router) (*Path, CircuitErro
 	}
 
 	path := &Path{
-		Nodes:     nodes,
-		IngressId: ingressId,
-		EgressId:  egressId,
+		Nodes:       nodes,
+		IngressId:   ingressId,
+		EgressId:    egressId,
+		constraints: constraints,
 	}
 	if err := network.setLinks(path); err != nil {
 		return nil, newCircuitErrWrap(CircuitFailurePathMissingLink, err)
@@ -885,11 +940,16 @@ func (network *Network) CreatePathWithNodes(nodes []*Router) (*Path, CircuitErro
 func (network *Network) UpdatePath(path *Path) (*Path, error) {
 	srcR := path.Nodes[0]
 	dstR := path.Nodes[len(path.Nodes)-1]
-	nodes, _, err := network.shortestPath(srcR, dstR)
+	nodes, _, err := network.constrainedShortestPath(srcR, dstR, path.constraints.exclusions())
 	if err != nil {
 		return nil, err
 	}
 
+	return network.updatePathNodes(path, nodes)
+}
+
+// updatePathNodes returns a copy of the given path which uses the given routers
+func (network *Network) updatePathNodes(path *Path, nodes []*Router) (*Path, error) {
 	path2 := &Path{
 		Nodes:                nodes,
 		IngressId:            path.IngressId,
@@ -898,6 +958,7 @@ func (network *Network) UpdatePath(path *Path) (*Path, error) {
 		InitiatorRemoteAddr:  path.InitiatorRemoteAddr,
 		TerminatorLocalAddr:  path.TerminatorLocalAddr,
 		TerminatorRemoteAddr: path.TerminatorRemoteAddr,
+		constraints:          path.constraints,
 	}
 	if err := network.setLinks(path2); err != nil {
 		return nil, err
@@ -908,7 +969,7 @@ func (network *Network) UpdatePath(path *Path) (*Path, error) {
 func (network *Network) setLinks(path *Path) error {
 	if len(path.Nodes) > 1 {
 		for i := 0; i < len(path.Nodes)-1; i++ {
-			if link, found := network.linkController.leastExpensiveLink(path.Nodes[i], path.Nodes[i+1]); found {
+			if link, found := network.leastExpensiveLink(path.Nodes[i], path.Nodes[i+1], path.constraints); found {
 				path.Links = append(path.Links, link)
 			} else {
 				return errors.Errorf("no link from r/%v to r/%v", path.Nodes[i].Id, path.Nodes[i+1].Id)
@@ -1095,38 +1156,6 @@ func (network *Network) rerouteCircuitWithTries(circuit *Circuit, retries int) b
 	return false
 }
 
-func (network *Network) rerouteCircuit(circuit *Circuit, deadline time.Time) error {
-	log := pfxlog.Logger().WithField("circuitId", circuit.Id)
-	if circuit.Rerouting.CompareAndSwap(false, true) {
-		defer circuit.Rerouting.Store(false)
-
-		log.Warn("rerouting circuit")
-
-		if cq, err := network.UpdatePath(circuit.Path); err == nil {
-			circuit.Path = cq
-			circuit.UpdatedAt = time.Now()
-
-			rms := cq.CreateRouteMessages(SmartRerouteAttempt, circuit.Id, circuit.Terminator, deadline)
-
-			for i := 0; i < len(cq.Nodes); i++ {
-				if _, err := sendRoute(cq.Nodes[i], rms[i], network.options.RouteTimeout); err != nil {
-					log.WithError(err).Errorf("error sending route to [r/%s]", cq.Nodes[i].Id)
-				}
-			}
-
-			log.Info("rerouted circuit")
-
-			network.CircuitEvent(event.CircuitUpdated, circuit, nil)
-			return nil
-		} else {
-			return err
-		}
-	} else {
-		log.Info("not rerouting circuit, already in progress")
-		return nil
-	}
-}
-
 func (network *Network) smartReroute(circuit *Circuit, cq *Path, deadline time.Time) bool {
 	retry := false
 	log := pfxlog.Logger().WithField("circuitId", circuit.Id)
@@ -1137,6 +1166,7 @@ func (network *Network) smartReroute(circuit *Circuit, cq *Path, deadline time.T
 		circuit.UpdatedAt = time.Now()
 
 		rms := cq.CreateRouteMessages(SmartRerouteAttempt, circuit.Id, circuit.Terminator, deadline)
+		circuit.applyPriorityClass(rms)
 
 		for i := 0; i < len(cq.Nodes); i++ {
 			if _, err := sendRoute(cq.Nodes[i], rms[i], network.options.RouteTimeout); err != nil {
@@ -1311,6 +1341,22 @@ func (network *Network) Inspect(name string) (*string, error) {
 		}
 		resultStr := string(result)
 		return &resultStr, nil
+	} else if strings.HasPrefix(lc, "terminator-strategy:") {
+		strategyName := strings.TrimPrefix(lc, "terminator-strategy:")
+		strategy, err := network.strategyRegistry.GetStrategy(strategyName)
+		if err != nil {
+			return nil, err
+		}
+		inspectable, ok := strategy.(xt.InspectableStrategy)
+		if !ok {
+			return nil, fmt.Errorf("terminator strategy %s does not support inspection", strategyName)
+		}
+		result, err := json.Marshal(inspectable.Inspect())
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshall terminator strategy state to json (%w)", err)
+		}
+		resultStr := string(result)
+		return &resultStr, nil
 	} else {
 		for _, inspectTarget := range network.inspectionTargets.Value() {
 			if handled, val, err := inspectTarget(lc); handled {
@@ -36,6 +36,8 @@ const (
 	DefaultOptionsRouterMessagingMaxWorkers = 100
 	DefaultOptionsRouterMessagingQueueSize  = 100
 	DefaultOptionsRouteTimeout              = 10 * time.Second
+	DefaultOptionsPathsCount                = 1
+	DefaultOptionsPathsDiversity            = PathDiversityNone
 
 	DefaultOptionsSmartRerouteCap          = 4
 	DefaultOptionsSmartRerouteFraction     = 0.02
@@ -65,6 +67,10 @@ type Options struct {
 		RerouteCap      uint32
 		MinCostDelta    uint32
 	}
+	Paths struct {
+		Count     uint32
+		Diversity PathDiversity
+	}
 }
 
 func DefaultOptions() *Options {
@@ -94,6 +100,13 @@ func DefaultOptions() *Options {
 			RerouteCap:      DefaultOptionsSmartRerouteCap,
 			MinCostDelta:    DefaultOptionsSmartRerouteMinCostDelta,
 		},
+		Paths: struct {
+			Count     uint32
+			Diversity PathDiversity
+		}{
+			Count:     DefaultOptionsPathsCount,
+			Diversity: DefaultOptionsPathsDiversity,
+		},
 	}
 	return options
 }
@@ -158,6 +171,34 @@ func LoadOptions(src map[interface{}]interface{}) (*Options, error) {
 		}
 	}
 
+	if value, found := src["paths"]; found {
+		if submap, ok := value.(map[interface{}]interface{}); ok {
+			if value, found := submap["count"]; found {
+				if count, ok := value.(int); ok && count > 0 {
+					options.Paths.Count = uint32(count)
+				} else {
+					return nil, errors.New("invalid value for 'paths.count', must be greater than 0")
+				}
+			}
+
+			if value, found := submap["diversity"]; found {
+				diversity, ok := value.(string)
+				if !ok {
+					return nil, errors.New("invalid value for 'paths.diversity'")
+				}
+				switch PathDiversity(diversity) {
+				case PathDiversityNone, PathDiversityLinks, PathDiversityRouters:
+					options.Paths.Diversity = PathDiversity(diversity)
+				default:
+					return nil, errors.Errorf("invalid value for 'paths.diversity': %v, must be one of: %v, %v, %v",
+						diversity, PathDiversityNone, PathDiversityLinks, PathDiversityRouters)
+				}
+			}
+		} else {
+			logrus.Errorf("invalid 'paths' stanza")
+		}
+	}
+
 	if value, found := src["routerMessaging"]; found {
 		if submap, ok := value.(map[interface{}]interface{}); ok {
 			if value, found := submap["queueSize"]; found {
@@ -19,11 +19,14 @@ package network
 import (
 	"fmt"
 	"math"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/openziti/ziti/common/pb/ctrl_pb"
 	"github.com/openziti/ziti/controller/xt"
 	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
 )
 
 type Path struct {
@@ -35,6 +38,13 @@ type Path struct {
 	InitiatorRemoteAddr  string
 	TerminatorLocalAddr  string
 	TerminatorRemoteAddr string
+
+	// constraints are the routing constraints of the service the path was created for. Paths computed to replace
+	// this one, when rerouting, are subject to the same constraints
+	constraints *pathConstraints
+
+	// pinned is set when an operator has placed the circuit on this path, so it shouldn't be moved by smart rerouting
+	pinned bool
 }
 
 func (self *Path) cost(minRouterCost uint16) int64 {
@@ -183,15 +193,255 @@ func (self *Path) usesLink(l *Link) bool {
 	return false
 }
 
+// transits returns true if the path passes through the given router, without starting or ending there
+func (self *Path) transits(r *Router) bool {
+	for i := 1; i < len(self.Nodes)-1; i++ {
+		if self.Nodes[i] == r {
+			return true
+		}
+	}
+	return false
+}
+
 func (network *Network) shortestPath(srcR *Router, dstR *Router) ([]*Router, int64, error) {
+	return network.constrainedShortestPath(srcR, dstR, nil)
+}
+
+// pathExclusions are routers and router to router hops which may not be used when computing a path, along with any
+// routing constraints of the service the path is being computed for
+type pathExclusions struct {
+	routers     map[*Router]struct{}
+	hops        map[pathHop]struct{}
+	constraints *pathConstraints
+	maxHops     uint32
+}
+
+type pathHop struct {
+	from *Router
+	to   *Router
+}
+
+func (self *pathExclusions) excludes(from, to *Router) bool {
+	if self == nil {
+		return false
+	}
+	if _, found := self.routers[to]; found {
+		return true
+	}
+	if !self.constraints.allowsRouter(to) {
+		return true
+	}
+	_, found := self.hops[pathHop{from: from, to: to}]
+	return found
+}
+
+func (self *pathExclusions) getConstraints() *pathConstraints {
+	if self == nil {
+		return nil
+	}
+	return self.constraints
+}
+
+func (self *pathExclusions) getMaxHops() uint32 {
+	if self == nil {
+		return 0
+	}
+	return self.maxHops
+}
+
+// pathConstraints restrict the routers and links which may be used by paths for a service, and the number of
+// router to router hops those paths may contain
+type pathConstraints struct {
+	serviceName string
+
+	// allowedRouters is nil if any router may be used
+	allowedRouters  map[string]struct{}
+	excludedRouters map[string]struct{}
+	excludedLinks   map[string]struct{}
+	excludedHops    map[string]struct{}
+	maxHops         uint32
+}
+
+// newPathConstraints resolves the routing constraints of the given service. It returns nil if the service doesn't
+// declare any
+func (network *Network) newPathConstraints(svc *Service) (*pathConstraints, error) {
+	if svc == nil || !svc.HasRoutingConstraints() {
+		return nil, nil
+	}
+
+	result := &pathConstraints{
+		serviceName:   svc.Name,
+		excludedLinks: map[string]struct{}{},
+		excludedHops:  map[string]struct{}{},
+		maxHops:       svc.MaxHops,
+	}
+
+	for _, excluded := range svc.ExcludedLinks {
+		if srcId, dstId, isHop := strings.Cut(excluded, ":"); isHop {
+			result.excludedHops[srcId+":"+dstId] = struct{}{}
+			result.excludedHops[dstId+":"+srcId] = struct{}{}
+		} else {
+			result.excludedLinks[excluded] = struct{}{}
+		}
+	}
+
+	if len(svc.RouterRoles) > 0 {
+		cursorProvider, err := network.GetStores().EdgeRouter.GetRoleAttributesCursorProvider(svc.RouterRoles, svc.RouterRolesSemantic)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid router roles for service %v", svc.Name)
+		}
+
+		result.allowedRouters = map[string]struct{}{}
+		err = network.GetDb().View(func(tx *bbolt.Tx) error {
+			for cursor := cursorProvider(tx, true); cursor.IsValid(); cursor.Next() {
+				result.allowedRouters[string(cursor.Current())] = struct{}{}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func (self *pathConstraints) allowsRouter(r *Router) bool {
+	if self == nil {
+		return true
+	}
+	if _, found := self.excludedRouters[r.Id]; found {
+		return false
+	}
+	if self.allowedRouters == nil {
+		return true
+	}
+	_, found := self.allowedRouters[r.Id]
+	return found
+}
+
+// excluding returns a copy of the constraints which additionally excludes the given router and link, either of which
+// may be nil. The service id is used to identify the service in errors if there are no existing constraints
+func (self *pathConstraints) excluding(serviceId string, router *Router, link *Link) *pathConstraints {
+	result := &pathConstraints{
+		serviceName:     serviceId,
+		excludedRouters: map[string]struct{}{},
+		excludedLinks:   map[string]struct{}{},
+		excludedHops:    map[string]struct{}{},
+	}
+
+	if self != nil {
+		result.serviceName = self.serviceName
+		result.allowedRouters = self.allowedRouters
+		result.maxHops = self.maxHops
+		for k := range self.excludedRouters {
+			result.excludedRouters[k] = struct{}{}
+		}
+		for k := range self.excludedLinks {
+			result.excludedLinks[k] = struct{}{}
+		}
+		for k := range self.excludedHops {
+			result.excludedHops[k] = struct{}{}
+		}
+	}
+
+	if router != nil {
+		result.excludedRouters[router.Id] = struct{}{}
+	}
+	if link != nil {
+		result.excludedLinks[link.Id] = struct{}{}
+	}
+
+	return result
+}
+
+func (self *pathConstraints) allowsLink(l *Link) bool {
+	if self == nil {
+		return true
+	}
+	if _, found := self.excludedLinks[l.Id]; found {
+		return false
+	}
+	// a bond can't be used if it would carry traffic over an excluded link
+	for _, memberId := range l.BondMembers {
+		if _, found := self.excludedLinks[memberId]; found {
+			return false
+		}
+	}
+	_, found := self.excludedHops[l.Src.Id+":"+l.DstId]
+	return !found
+}
+
+func (self *pathConstraints) exclusions() *pathExclusions {
+	if self == nil {
+		return nil
+	}
+	return &pathExclusions{
+		constraints: self,
+		maxHops:     self.maxHops,
+	}
+}
+
+// check verifies that the given path satisfies the constraints. Paths computed by the network already do, so this
+// is used for paths which were selected some other way
+func (self *pathConstraints) check(nodes []*Router) error {
+	if self == nil {
+		return nil
+	}
+	if self.maxHops > 0 && len(nodes)-1 > int(self.maxHops) {
+		return errors.Errorf("path has %v hops, routing constraints for service %v allow at most %v", len(nodes)-1, self.serviceName, self.maxHops)
+	}
+	for _, r := range nodes {
+		if !self.allowsRouter(r) {
+			return errors.Errorf("router %v is not permitted by routing constraints for service %v", r.Id, self.serviceName)
+		}
+	}
+	return nil
+}
+
+// leastExpensiveLink returns the cheapest usable link between the two routers which the given constraints allow
+func (network *Network) leastExpensiveLink(a, b *Router, constraints *pathConstraints) (*Link, bool) {
+	if constraints == nil {
+		return network.linkController.leastExpensiveLink(a, b)
+	}
+	return network.linkController.leastExpensiveLinkMatching(a, b, constraints.allowsLink)
+}
+
+// hopCost returns the cost of moving from router u to router r, when computing a path from srcR to dstR
+func (network *Network) hopCost(u, r, srcR, dstR *Router, constraints *pathConstraints) int64 {
+	var cost int64 = math.MaxInt32 + 1
+	if l, found := network.leastExpensiveLink(r, u, constraints); found {
+		if !r.NoTraversal || r == srcR || r == dstR {
+			cost = l.GetCost() + int64(maxUint16(r.Cost, network.options.MinRouterCost))
+		}
+	}
+	return cost
+}
+
+func (network *Network) constrainedShortestPath(srcR *Router, dstR *Router, exclusions *pathExclusions) ([]*Router, int64, error) {
 	if srcR == nil || dstR == nil {
 		return nil, 0, errors.New("not routable (!srcR||!dstR)")
 	}
 
+	if constraints := exclusions.getConstraints(); constraints != nil {
+		if !constraints.allowsRouter(srcR) {
+			return nil, 0, errors.Errorf("router %v is not permitted by routing constraints for service %v", srcR.Id, constraints.serviceName)
+		}
+		if !constraints.allowsRouter(dstR) {
+			return nil, 0, errors.Errorf("router %v is not permitted by routing constraints for service %v", dstR.Id, constraints.serviceName)
+		}
+	}
+
 	if srcR == dstR {
 		return []*Router{srcR}, 0, nil
 	}
 
+	if exclusions.getMaxHops() > 0 {
+		return network.hopLimitedShortestPath(srcR, dstR, exclusions)
+	}
+
+	constraints := exclusions.getConstraints()
+
 	dist := make(map[*Router]int64)
 	prev := make(map[*Router]*Router)
 	unvisited := make(map[*Router]bool)
@@ -202,8 +452,6 @@ func (network *Network) shortestPath(srcR *Router, dstR *Router) ([]*Router, int
 	}
 	dist[srcR] = 0
 
-	minRouterCost := network.options.MinRouterCost
-
 	for len(unvisited) > 0 {
 		u := minCost(unvisited, dist)
 		if u == dstR { // if the dest router is the lowest cost next link, we can stop evaluating
@@ -213,15 +461,11 @@ func (network *Network) shortestPath(srcR *Router, dstR *Router) ([]*Router, int
 
 		neighbors := network.linkController.connectedNeighborsOfRouter(u)
 		for _, r := range neighbors {
+			if exclusions.excludes(u, r) {
+				continue
+			}
 			if _, found := unvisited[r]; found {
-				var cost int64 = math.MaxInt32 + 1
-				if l, found := network.linkController.leastExpensiveLink(r, u); found {
-					if !r.NoTraversal || r == srcR || r == dstR {
-						cost = l.GetCost() + int64(maxUint16(r.Cost, minRouterCost))
-					}
-				}
-
-				alt := dist[u] + cost
+				alt := dist[u] + network.hopCost(u, r, srcR, dstR, constraints)
 				if alt < dist[r] {
 					dist[r] = alt
 					prev[r] = u
@@ -246,7 +490,7 @@ func (network *Network) shortestPath(srcR *Router, dstR *Router) ([]*Router, int
 	routerPath = append(routerPath, dstR)
 
 	if routerPath[0] != srcR {
-		return nil, 0, fmt.Errorf("can't route from %v -> %v", srcR.Id, dstR.Id)
+		return nil, 0, noRouteError(srcR, dstR, constraints)
 	}
 	if routerPath[len(routerPath)-1] != dstR {
 		return nil, 0, fmt.Errorf("can't route from %v -> %v. destination unreachable", srcR.Id, dstR.Id)
@@ -255,6 +499,257 @@ func (network *Network) shortestPath(srcR *Router, dstR *Router) ([]*Router, int
 	return routerPath, dist[dstR], nil
 }
 
+// hopLimitedShortestPath finds the cheapest path from srcR to dstR with at most exclusions.maxHops router to router
+// hops. Dijkstra only tracks the cheapest path to each router, which may be too long, so this tracks the cheapest
+// path to each router for each hop count instead
+func (network *Network) hopLimitedShortestPath(srcR *Router, dstR *Router, exclusions *pathExclusions) ([]*Router, int64, error) {
+	constraints := exclusions.getConstraints()
+	maxHops := int(exclusions.getMaxHops())
+
+	// dist[h][r] is the cost of the cheapest path from srcR to r with exactly h hops, prev[h][r] is r's predecessor on it
+	dist := []map[*Router]int64{{srcR: 0}}
+	prev := []map[*Router]*Router{{}}
+
+	var bestCost int64 = math.MaxInt64
+	bestHops := -1
+
+	for h := 1; h <= maxHops && len(dist[h-1]) > 0; h++ {
+		dist = append(dist, map[*Router]int64{})
+		prev = append(prev, map[*Router]*Router{})
+
+		for u, uCost := range dist[h-1] {
+			if u == dstR {
+				continue
+			}
+			for _, r := range network.linkController.connectedNeighborsOfRouter(u) {
+				if r == srcR || exclusions.excludes(u, r) {
+					continue
+				}
+				alt := uCost + network.hopCost(u, r, srcR, dstR, constraints)
+				if current, found := dist[h][r]; !found || alt < current {
+					dist[h][r] = alt
+					prev[h][r] = u
+				}
+			}
+		}
+
+		if cost, found := dist[h][dstR]; found && cost < bestCost {
+			bestCost = cost
+			bestHops = h
+		}
+	}
+
+	if bestHops < 0 {
+		return nil, 0, noRouteError(srcR, dstR, constraints)
+	}
+
+	routerPath := make([]*Router, bestHops+1)
+	routerPath[bestHops] = dstR
+	for h := bestHops; h > 0; h-- {
+		routerPath[h-1] = prev[h][routerPath[h]]
+	}
+
+	if !isLoopFree(routerPath) {
+		return nil, 0, noRouteError(srcR, dstR, constraints)
+	}
+
+	return routerPath, bestCost, nil
+}
+
+func noRouteError(srcR, dstR *Router, constraints *pathConstraints) error {
+	if constraints != nil {
+		return errors.Errorf("can't route from %v -> %v. no path satisfies routing constraints for service %v", srcR.Id, dstR.Id, constraints.serviceName)
+	}
+	return fmt.Errorf("can't route from %v -> %v", srcR.Id, dstR.Id)
+}
+
+type PathDiversity string
+
+const (
+	// PathDiversityNone allows alternate paths to share links and routers with the primary path
+	PathDiversityNone PathDiversity = "none"
+	// PathDiversityLinks requires alternate paths to avoid router to router hops used by the primary path
+	PathDiversityLinks PathDiversity = "links"
+	// PathDiversityRouters requires alternate paths to avoid the intermediate routers used by the primary path
+	PathDiversityRouters PathDiversity = "routers"
+)
+
+func (self PathDiversity) isDiverse(primary, candidate []*Router) bool {
+	switch self {
+	case PathDiversityLinks:
+		hops := map[pathHop]struct{}{}
+		for i := 0; i < len(primary)-1; i++ {
+			hops[pathHop{from: primary[i], to: primary[i+1]}] = struct{}{}
+		}
+		for i := 0; i < len(candidate)-1; i++ {
+			if _, found := hops[pathHop{from: candidate[i], to: candidate[i+1]}]; found {
+				return false
+			}
+		}
+		return true
+	case PathDiversityRouters:
+		if len(primary) < 2 || len(candidate) < 2 {
+			return !routerPathsEqual(primary, candidate)
+		}
+		routers := map[*Router]struct{}{}
+		for _, r := range primary[1 : len(primary)-1] {
+			routers[r] = struct{}{}
+		}
+		for _, r := range candidate[1 : len(candidate)-1] {
+			if _, found := routers[r]; found {
+				return false
+			}
+		}
+		// a direct hop between the endpoints has no intermediate routers, so also needs to differ from the primary
+		return !routerPathsEqual(primary, candidate) && PathDiversityLinks.isDiverse(primary, candidate)
+	default:
+		return true
+	}
+}
+
+func routerPathsEqual(a, b []*Router) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// routerPathCost returns the cost of the given path, as calculated by shortestPath
+func (network *Network) routerPathCost(path []*Router, constraints *pathConstraints) (int64, bool) {
+	minRouterCost := network.options.MinRouterCost
+	var cost int64
+	for i := 1; i < len(path); i++ {
+		l, found := network.leastExpensiveLink(path[i-1], path[i], constraints)
+		if !found {
+			return 0, false
+		}
+		cost += l.GetCost() + int64(maxUint16(path[i].Cost, minRouterCost))
+	}
+	return cost, true
+}
+
+// kShortestPaths returns up to k loop-free paths from srcR to dstR, in increasing cost order, using Yen's algorithm.
+// The first path is the shortest path. The remaining paths must satisfy the given diversity constraint relative to
+// the first path. All paths satisfy the given routing constraints, if any.
+func (network *Network) kShortestPaths(srcR *Router, dstR *Router, k int, diversity PathDiversity, constraints *pathConstraints) ([]*PathAndCost, error) {
+	primary, primaryCost, err := network.constrainedShortestPath(srcR, dstR, constraints.exclusions())
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*PathAndCost{newPathAndCost(primary, primaryCost)}
+	if k <= 1 || srcR == dstR {
+		return result, nil
+	}
+
+	// when diversity is required, many candidates may be rejected, so generate more than k before giving up
+	maxGenerated := k
+	if diversity != PathDiversityNone && diversity != "" {
+		maxGenerated = k * 5
+	}
+
+	generated := [][]*Router{primary}
+	var candidates []*kPathCandidate
+
+	for len(generated) < maxGenerated && len(result) < k {
+		last := generated[len(generated)-1]
+
+		for i := 0; i < len(last)-1; i++ {
+			spur := last[i]
+			root := last[:i+1]
+
+			exclusions := &pathExclusions{
+				routers:     map[*Router]struct{}{},
+				hops:        map[pathHop]struct{}{},
+				constraints: constraints,
+			}
+			if constraints != nil && constraints.maxHops > 0 {
+				// the root path already uses i hops
+				if uint32(i) >= constraints.maxHops {
+					break
+				}
+				exclusions.maxHops = constraints.maxHops - uint32(i)
+			}
+			for _, p := range generated {
+				if len(p) > i+1 && routerPathsEqual(p[:i+1], root) {
+					exclusions.hops[pathHop{from: p[i], to: p[i+1]}] = struct{}{}
+				}
+			}
+			for _, r := range root[:i] {
+				exclusions.routers[r] = struct{}{}
+			}
+
+			spurPath, _, err := network.constrainedShortestPath(spur, dstR, exclusions)
+			if err != nil {
+				continue
+			}
+
+			candidatePath := make([]*Router, 0, len(root)+len(spurPath)-1)
+			candidatePath = append(candidatePath, root...)
+			candidatePath = append(candidatePath, spurPath[1:]...)
+
+			if !isLoopFree(candidatePath) {
+				continue
+			}
+
+			cost, ok := network.routerPathCost(candidatePath, constraints)
+			if !ok || cost >= math.MaxInt32 {
+				continue
+			}
+
+			duplicate := false
+			for _, c := range candidates {
+				if routerPathsEqual(c.path, candidatePath) {
+					duplicate = true
+					break
+				}
+			}
+			if !duplicate {
+				candidates = append(candidates, &kPathCandidate{path: candidatePath, cost: cost})
+			}
+		}
+
+		if len(candidates) == 0 {
+			break
+		}
+
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return candidates[i].cost < candidates[j].cost
+		})
+
+		next := candidates[0]
+		candidates = candidates[1:]
+		generated = append(generated, next.path)
+
+		if diversity.isDiverse(primary, next.path) {
+			result = append(result, newPathAndCost(next.path, next.cost))
+		}
+	}
+
+	return result, nil
+}
+
+type kPathCandidate struct {
+	path []*Router
+	cost int64
+}
+
+func isLoopFree(path []*Router) bool {
+	seen := map[*Router]struct{}{}
+	for _, r := range path {
+		if _, found := seen[r]; found {
+			return false
+		}
+		seen[r] = struct{}{}
+	}
+	return true
+}
+
 func minCost(q map[*Router]bool, dist map[*Router]int64) *Router {
 	if dist == nil || len(dist) < 1 {
 		return nil
@@ -278,3 +773,56 @@ func maxUint16(v1, v2 uint16) uint16 {
 	}
 	return v2
 }
+
+// pathFailover holds precomputed alternate paths to a selected terminator, so that a circuit whose route attempt
+// failed somewhere along the path can be retried immediately on a different path
+type pathFailover struct {
+	strategy    xt.Strategy
+	terminator  xt.CostedTerminator
+	constraints *pathConstraints
+	alternates  [][]*Router
+}
+
+// newPathFailover returns nil unless the network is configured to compute more than one path
+func (network *Network) newPathFailover(strategy xt.Strategy, terminator xt.CostedTerminator, primary []*Router, constraints *pathConstraints) *pathFailover {
+	if network.options.Paths.Count < 2 || len(primary) < 2 {
+		return nil
+	}
+
+	paths, err := network.kShortestPaths(primary[0], primary[len(primary)-1], int(network.options.Paths.Count), network.options.Paths.Diversity, constraints)
+	if err != nil {
+		return nil
+	}
+
+	result := &pathFailover{
+		strategy:    strategy,
+		terminator:  terminator,
+		constraints: constraints,
+	}
+
+	for _, p := range paths {
+		if !routerPathsEqual(p.path, primary) && network.options.Paths.Diversity.isDiverse(primary, p.path) {
+			result.alternates = append(result.alternates, p.path)
+		}
+	}
+
+	return result
+}
+
+// next returns the next alternate path which can still be built from the current set of links, or nil if there are
+// none left
+func (self *pathFailover) next(network *Network) *Path {
+	for len(self.alternates) > 0 {
+		nodes := self.alternates[0]
+		self.alternates = self.alternates[1:]
+
+		if path, err := network.createConstrainedPath(nodes, self.constraints); err == nil {
+			return path
+		}
+	}
+	return nil
+}
+
+func isPathFailure(cause CircuitFailureCause) bool {
+	return cause == CircuitFailureRouterResponseTimeout || cause == CircuitFailureRouterErrGeneric
+}
@@ -623,3 +623,166 @@ func newPathTestLink(network *Network, id string, srcR, destR *Router) *Link {
 	network.linkController.add(l)
 	return l
 }
+
+func TestKShortestPaths(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	config := newTestConfig(ctx)
+	config.options.MinRouterCost = 10
+	defer close(config.closeNotify)
+
+	network, err := NewNetwork(config)
+	assert.Nil(t, err)
+
+	r0 := newRouterForTest("r0", "", nil, nil, 0, false)
+	r1 := newRouterForTest("r1", "", nil, nil, 0, false)
+	r2 := newRouterForTest("r2", "", nil, nil, 15, false)
+	r3 := newRouterForTest("r3", "", nil, nil, 40, false)
+	r4 := newRouterForTest("r4", "", nil, nil, 0, false)
+	for _, r := range []*Router{r0, r1, r2, r3, r4} {
+		network.Routers.markConnected(r)
+	}
+
+	newPathTestLink(network, "l0", r0, r1)
+	newPathTestLink(network, "l1", r1, r4)
+	newPathTestLink(network, "l2", r0, r2)
+	newPathTestLink(network, "l3", r2, r4)
+	newPathTestLink(network, "l4", r1, r2)
+	newPathTestLink(network, "l5", r0, r3)
+	newPathTestLink(network, "l6", r3, r4)
+
+	// r0-r1-r4 = 22, r0-r2-r4 = 27, r0-r1-r2-r4 = r0-r2-r1-r4 = 38, r0-r3-r4 = 62
+	paths, err := network.kShortestPaths(r0, r4, 3, PathDiversityNone, nil)
+	req := require.New(t)
+	req.NoError(err)
+	req.Len(paths, 3)
+	req.Equal([]*Router{r0, r1, r4}, paths[0].path)
+	req.Equal(uint32(22), paths[0].cost)
+	req.Equal([]*Router{r0, r2, r4}, paths[1].path)
+	req.Equal(uint32(27), paths[1].cost)
+	req.Len(paths[2].path, 4)
+	req.Equal(uint32(38), paths[2].cost)
+
+	paths, err = network.kShortestPaths(r0, r4, 10, PathDiversityNone, nil)
+	req.NoError(err)
+	req.Len(paths, 5)
+
+	for _, diversity := range []PathDiversity{PathDiversityLinks, PathDiversityRouters} {
+		paths, err = network.kShortestPaths(r0, r4, 3, diversity, nil)
+		req.NoError(err)
+		req.Len(paths, 3, "diversity: %v", diversity)
+		req.Equal([]*Router{r0, r1, r4}, paths[0].path)
+		req.Equal([]*Router{r0, r2, r4}, paths[1].path)
+		req.Equal([]*Router{r0, r3, r4}, paths[2].path)
+	}
+
+	// a second route attempt to the same terminator should use the next best alternate
+	network.options.Paths.Count = 3
+	network.options.Paths.Diversity = PathDiversityLinks
+	failover := network.newPathFailover(nil, nil, []*Router{r0, r1, r4}, nil)
+	req.NotNil(failover)
+	path := failover.next(network)
+	req.NotNil(path)
+	req.Equal([]*Router{r0, r2, r4}, path.Nodes)
+	req.Equal("l2", path.Links[0].Id)
+	req.Equal("l3", path.Links[1].Id)
+}
+
+func TestConstrainedPaths(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	config := newTestConfig(ctx)
+	config.options.MinRouterCost = 10
+	defer close(config.closeNotify)
+
+	network, err := NewNetwork(config)
+	assert.Nil(t, err)
+
+	r0 := newRouterForTest("r0", "", nil, nil, 0, false)
+	r1 := newRouterForTest("r1", "", nil, nil, 0, false)
+	r2 := newRouterForTest("r2", "", nil, nil, 0, false)
+	r3 := newRouterForTest("r3", "", nil, nil, 0, false)
+	r4 := newRouterForTest("r4", "", nil, nil, 0, false)
+	for _, r := range []*Router{r0, r1, r2, r3, r4} {
+		network.Routers.markConnected(r)
+	}
+
+	// r0-r1-r4 = 22, r0-r2-r3-r4 = 33
+	newPathTestLink(network, "l0", r0, r1)
+	newPathTestLink(network, "l1", r1, r4)
+	newPathTestLink(network, "l2", r0, r2)
+	newPathTestLink(network, "l3", r2, r3)
+	newPathTestLink(network, "l4", r3, r4)
+
+	req := require.New(t)
+
+	svc := &Service{
+		BaseEntity: models.BaseEntity{Id: "svc"},
+		Name:       "svc",
+		RoutingConstraints: RoutingConstraints{
+			ExcludedLinks: []string{"l1"},
+		},
+	}
+	constraints, err := network.newPathConstraints(svc)
+	req.NoError(err)
+
+	path, cost, err := network.constrainedShortestPath(r0, r4, constraints.exclusions())
+	req.NoError(err)
+	req.Equal([]*Router{r0, r2, r3, r4}, path)
+	req.Equal(int64(33), cost)
+
+	// router pairs may be excluded in either direction
+	svc.ExcludedLinks = []string{"r3:r2"}
+	constraints, err = network.newPathConstraints(svc)
+	req.NoError(err)
+	path, _, err = network.constrainedShortestPath(r0, r4, constraints.exclusions())
+	req.NoError(err)
+	req.Equal([]*Router{r0, r1, r4}, path)
+
+	// only allowed routers may be used
+	constraints = &pathConstraints{
+		serviceName:    "svc",
+		allowedRouters: map[string]struct{}{"r0": {}, "r2": {}, "r3": {}, "r4": {}},
+	}
+	path, _, err = network.constrainedShortestPath(r0, r4, constraints.exclusions())
+	req.NoError(err)
+	req.Equal([]*Router{r0, r2, r3, r4}, path)
+
+	p, cerr := network.createConstrainedPath(path, constraints)
+	req.NoError(cerr)
+	req.Equal("l2", p.Links[0].Id)
+
+	// reroutes keep the constraints of the original path
+	p, err = network.UpdatePath(p)
+	req.NoError(err)
+	req.Equal([]*Router{r0, r2, r3, r4}, p.Nodes)
+
+	_, cerr = network.createConstrainedPath([]*Router{r0, r1, r4}, constraints)
+	req.Error(cerr)
+	req.Equal(CircuitFailureNoPath, cerr.Cause())
+
+	// the hop limit applies even when the only compliant path is longer
+	constraints.maxHops = 2
+	_, _, err = network.constrainedShortestPath(r0, r4, constraints.exclusions())
+	req.Error(err)
+	req.Contains(err.Error(), "no path satisfies routing constraints for service svc")
+
+	constraints.allowedRouters = nil
+	constraints.excludedLinks = map[string]struct{}{}
+	path, cost, err = network.constrainedShortestPath(r0, r4, constraints.exclusions())
+	req.NoError(err)
+	req.Equal([]*Router{r0, r1, r4}, path)
+	req.Equal(int64(22), cost)
+
+	paths, err := network.kShortestPaths(r0, r4, 3, PathDiversityNone, constraints)
+	req.NoError(err)
+	req.Len(paths, 1)
+
+	constraints.maxHops = 3
+	paths, err = network.kShortestPaths(r0, r4, 3, PathDiversityNone, constraints)
+	req.NoError(err)
+	req.Len(paths, 2)
+	req.Equal([]*Router{r0, r2, r3, r4}, paths[1].path)
+}
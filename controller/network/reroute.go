@@ -0,0 +1,211 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/controller/event"
+	"github.com/pkg/errors"
+)
+
+var errRerouteInProgress = errors.New("reroute already in progress")
+
+// RerouteResult reports the outcome of an operator requested reroute
+type RerouteResult struct {
+	Rerouted []string
+	Failed   map[string]error
+}
+
+func newRerouteResult() *RerouteResult {
+	return &RerouteResult{
+		Failed: map[string]error{},
+	}
+}
+
+func (self *RerouteResult) add(circuitId string, err error) {
+	if err == nil {
+		self.Rerouted = append(self.Rerouted, circuitId)
+	} else {
+		self.Failed[circuitId] = err
+	}
+}
+
+// RerouteCircuit moves the given circuit onto a newly computed path. If router ids are given, the circuit is instead
+// moved onto a path through those routers, which must start and end at the same routers as the current path. A
+// circuit moved onto an explicit path is pinned to it, and will not be moved by smart rerouting. It will still be
+// rerouted if a link on the path fails.
+func (network *Network) RerouteCircuit(circuitId string, routerIds []string) error {
+	circuit, found := network.GetCircuit(circuitId)
+	if !found {
+		return boltz.NewNotFoundError("circuit", "id", circuitId)
+	}
+
+	if len(routerIds) == 0 {
+		return network.moveCircuit(circuit, time.Now().Add(network.options.RouteTimeout), network.UpdatePath)
+	}
+
+	nodes, err := network.explicitPathNodes(circuit, routerIds)
+	if err != nil {
+		return err
+	}
+
+	return network.moveCircuit(circuit, time.Now().Add(network.options.RouteTimeout), func(path *Path) (*Path, error) {
+		if err := path.constraints.check(nodes); err != nil {
+			return nil, errorz.NewFieldError(err.Error(), "path", routerIds)
+		}
+		updatedPath, err := network.updatePathNodes(path, nodes)
+		if err != nil {
+			return nil, errorz.NewFieldError(err.Error(), "path", routerIds)
+		}
+		updatedPath.pinned = true
+		return updatedPath, nil
+	})
+}
+
+// RerouteCircuitsUsingLink moves all circuits using the given link onto paths which avoid it
+func (network *Network) RerouteCircuitsUsingLink(linkId string) (*RerouteResult, error) {
+	link, found := network.GetLink(linkId)
+	if !found {
+		return nil, boltz.NewNotFoundError("link", "id", linkId)
+	}
+
+	result := newRerouteResult()
+	deadline := time.Now().Add(network.options.RouteTimeout)
+
+	for _, circuit := range network.GetAllCircuits() {
+		if circuit.Path.usesLink(link) {
+			err := network.moveCircuit(circuit, deadline, func(path *Path) (*Path, error) {
+				return network.updatePathAvoiding(path, circuit.ServiceId, nil, link)
+			})
+			result.add(circuit.Id, err)
+		}
+	}
+
+	return result, nil
+}
+
+// RerouteCircuitsThroughRouter moves all circuits passing through the given router onto paths which avoid it. Circuits
+// which start or end at the router can't avoid it, and are left alone
+func (network *Network) RerouteCircuitsThroughRouter(routerId string) (*RerouteResult, error) {
+	router := network.Routers.getConnected(routerId)
+	if router == nil {
+		return nil, boltz.NewNotFoundError("router", "id", routerId)
+	}
+
+	result := newRerouteResult()
+	deadline := time.Now().Add(network.options.RouteTimeout)
+
+	for _, circuit := range network.GetAllCircuits() {
+		if circuit.Path.transits(router) {
+			err := network.moveCircuit(circuit, deadline, func(path *Path) (*Path, error) {
+				return network.updatePathAvoiding(path, circuit.ServiceId, router, nil)
+			})
+			result.add(circuit.Id, err)
+		}
+	}
+
+	return result, nil
+}
+
+func (network *Network) explicitPathNodes(circuit *Circuit, routerIds []string) ([]*Router, error) {
+	var nodes []*Router
+	for _, routerId := range routerIds {
+		router := network.Routers.getConnected(routerId)
+		if router == nil {
+			return nil, errorz.NewFieldError("router is not connected", "path", routerId)
+		}
+		nodes = append(nodes, router)
+	}
+
+	current := circuit.Path.Nodes
+	if nodes[0] != current[0] || nodes[len(nodes)-1] != current[len(current)-1] {
+		return nil, errorz.NewFieldError("path must start at the circuit's initiating router and end at its terminating router", "path", routerIds)
+	}
+
+	if !isLoopFree(nodes) {
+		return nil, errorz.NewFieldError("path may not visit a router more than once", "path", routerIds)
+	}
+
+	return nodes, nil
+}
+
+// updatePathAvoiding computes a new path for a circuit which doesn't use the given router or link. The exclusion is
+// kept with the path, so that later reroutes don't move the circuit back
+func (network *Network) updatePathAvoiding(path *Path, serviceId string, router *Router, link *Link) (*Path, error) {
+	constraints := path.constraints.excluding(serviceId, router, link)
+
+	srcR := path.Nodes[0]
+	dstR := path.Nodes[len(path.Nodes)-1]
+	nodes, _, err := network.constrainedShortestPath(srcR, dstR, constraints.exclusions())
+	if err != nil {
+		return nil, err
+	}
+
+	updatedPath, err := network.updatePathNodes(path, nodes)
+	if err != nil {
+		return nil, err
+	}
+	updatedPath.constraints = constraints
+	return updatedPath, nil
+}
+
+func (network *Network) rerouteCircuit(circuit *Circuit, deadline time.Time) error {
+	err := network.moveCircuit(circuit, deadline, network.UpdatePath)
+	if errors.Is(err, errRerouteInProgress) {
+		pfxlog.Logger().WithField("circuitId", circuit.Id).Info("not rerouting circuit, already in progress")
+		return nil
+	}
+	return err
+}
+
+// moveCircuit sends routes for the path computed by the given function to the routers on it, and updates the circuit
+// to use the new path
+func (network *Network) moveCircuit(circuit *Circuit, deadline time.Time, pathF func(path *Path) (*Path, error)) error {
+	log := pfxlog.Logger().WithField("circuitId", circuit.Id)
+	if !circuit.Rerouting.CompareAndSwap(false, true) {
+		return errRerouteInProgress
+	}
+	defer circuit.Rerouting.Store(false)
+
+	log.Warn("rerouting circuit")
+
+	cq, err := pathF(circuit.Path)
+	if err != nil {
+		return err
+	}
+
+	circuit.Path = cq
+	circuit.UpdatedAt = time.Now()
+
+	rms := cq.CreateRouteMessages(SmartRerouteAttempt, circuit.Id, circuit.Terminator, deadline)
+	circuit.applyPriorityClass(rms)
+
+	for i := 0; i < len(cq.Nodes); i++ {
+		if _, err := sendRoute(cq.Nodes[i], rms[i], network.options.RouteTimeout); err != nil {
+			log.WithError(err).Errorf("error sending route to [r/%s]", cq.Nodes[i].Id)
+		}
+	}
+
+	log.Info("rerouted circuit")
+
+	network.CircuitEvent(event.CircuitUpdated, circuit, nil)
+	return nil
+}
@@ -0,0 +1,92 @@
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openziti/ziti/controller/db"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReroutePaths(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	config := newTestConfig(ctx)
+	config.options.MinRouterCost = 10
+	config.options.Smart.MinCostDelta = 5
+	defer close(config.closeNotify)
+
+	network, err := NewNetwork(config)
+	req := require.New(t)
+	req.NoError(err)
+
+	r0 := newRouterForTest("r0", "", nil, nil, 0, false)
+	r1 := newRouterForTest("r1", "", nil, nil, 0, false)
+	r2 := newRouterForTest("r2", "", nil, nil, 0, false)
+	r3 := newRouterForTest("r3", "", nil, nil, 0, false)
+	for _, r := range []*Router{r0, r1, r2, r3} {
+		network.Routers.markConnected(r)
+	}
+
+	newPathTestLink(network, "l0", r0, r1)
+	l1 := newPathTestLink(network, "l1", r1, r3)
+	newPathTestLink(network, "l2", r0, r2)
+	newPathTestLink(network, "l3", r2, r3)
+	newPathTestLink(network, "l4", r1, r2)
+
+	path, cerr := network.CreatePathWithNodes([]*Router{r0, r1, r3})
+	req.NoError(cerr)
+
+	circuit := &Circuit{
+		Id:        uuid.NewString(),
+		ServiceId: "svc",
+		Path:      path,
+		CreatedAt: time.Now(),
+	}
+
+	req.True(path.transits(r1))
+	req.False(path.transits(r0))
+	req.False(path.transits(r3))
+
+	// draining r1 moves the circuit to r2, and later reroutes keep avoiding r1
+	updated, err := network.updatePathAvoiding(path, circuit.ServiceId, r1, nil)
+	req.NoError(err)
+	req.Equal([]*Router{r0, r2, r3}, updated.Nodes)
+	req.Equal(path.IngressId, updated.IngressId)
+
+	updated, err = network.UpdatePath(updated)
+	req.NoError(err)
+	req.Equal([]*Router{r0, r2, r3}, updated.Nodes)
+
+	updated, err = network.updatePathAvoiding(path, circuit.ServiceId, nil, l1)
+	req.NoError(err)
+	req.Equal([]*Router{r0, r2, r3}, updated.Nodes)
+
+	_, err = network.updatePathAvoiding(updated, circuit.ServiceId, r2, nil)
+	req.Error(err)
+
+	// explicit paths must keep the circuit's endpoints and be loop free
+	nodes, err := network.explicitPathNodes(circuit, []string{"r0", "r2", "r1", "r3"})
+	req.NoError(err)
+	req.Equal([]*Router{r0, r2, r1, r3}, nodes)
+
+	_, err = network.explicitPathNodes(circuit, []string{"r0", "r2"})
+	req.Error(err)
+
+	_, err = network.explicitPathNodes(circuit, []string{"r0", "r1", "r0", "r1", "r3"})
+	req.Error(err)
+
+	_, err = network.explicitPathNodes(circuit, []string{"r0", "r4", "r3"})
+	req.Error(err)
+
+	// pinned circuits aren't smart rerouted
+	circuit.Path, err = network.updatePathNodes(path, []*Router{r0, r2, r1, r3})
+	req.NoError(err)
+	network.circuitController.add(circuit)
+	req.Len(network.getRerouteCandidates(), 1)
+
+	circuit.Path.pinned = true
+	req.Len(network.getRerouteCandidates(), 0)
+}
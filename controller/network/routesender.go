@@ -64,6 +64,7 @@ type routeSender struct {
 	attendance      map[string]bool
 	serviceCounters ServiceCounters
 	terminators     *TerminatorManager
+	attemptStart    time.Time
 }
 
 func newRouteSender(circuitId string, timeout time.Duration, serviceCounters ServiceCounters, terminators *TerminatorManager) *routeSender {
@@ -80,6 +81,8 @@ func newRouteSender(circuitId string, timeout time.Duration, serviceCounters Ser
 func (self *routeSender) route(attempt uint32, path *Path, routeMsgs []*ctrl_pb.Route, strategy xt.Strategy, terminator xt.Terminator, ctx logcontext.Context) (peerData xt.PeerData, cleanups map[string]struct{}, err CircuitError) {
 	logger := pfxlog.ChannelLogger(logcontext.EstablishPath).Wire(ctx)
 
+	self.attemptStart = time.Now()
+
 	// send route messages
 	for i := 0; i < len(path.Nodes); i++ {
 		r := path.Nodes[i]
@@ -106,7 +109,7 @@ attendance:
 
 		case <-time.After(timeout):
 			cleanups = self.cleanups(path)
-			strategy.NotifyEvent(xt.NewDialFailedEvent(terminator))
+			strategy.NotifyEvent(xt.NewDialFailedEvent(terminator, time.Since(self.attemptStart)))
 			self.serviceCounters.ServiceDialTimeout(terminator.GetServiceId(), terminator.GetId())
 			return nil, cleanups, newCircuitErrWrap(CircuitFailureRouterResponseTimeout, &routeTimeoutError{circuitId: self.circuitId})
 		}
@@ -139,7 +142,7 @@ func (self *routeSender) handleRouteSend(attempt uint32, path *Path, strategy xt
 			self.attendance[status.Router.Id] = true
 			if status.Router.Id == terminator.GetRouterId() {
 				peerData = status.PeerData
-				strategy.NotifyEvent(xt.NewDialSucceeded(terminator))
+				strategy.NotifyEvent(xt.NewDialSucceeded(terminator, time.Since(self.attemptStart)))
 				self.serviceCounters.ServiceDialSuccess(terminator.GetServiceId(), terminator.GetId())
 			}
 		} else {
@@ -196,7 +199,7 @@ func (self *routeSender) handleRouteSend(attempt uint32, path *Path, strategy xt
 		logger.Warnf("received failed route status from [r/%s] for attempt [#%d] of [s/%s] (%v)", status.Router.Id, status.Attempt, status.CircuitId, status.Err)
 
 		if status.Router.Id == terminator.GetRouterId() {
-			strategy.NotifyEvent(xt.NewDialFailedEvent(terminator))
+			strategy.NotifyEvent(xt.NewDialFailedEvent(terminator, time.Since(self.attemptStart)))
 			self.serviceCounters.ServiceDialFail(terminator.GetServiceId(), terminator.GetId())
 		}
 		cleanups = self.cleanups(path)
@@ -39,6 +39,20 @@ type Service struct {
 	TerminatorStrategy string
 	Terminators        []*Terminator
 	MaxIdleTime        time.Duration
+	PriorityClass      string
+	RoutingConstraints
+}
+
+// RoutingConstraints restrict the paths which may be used by circuits for a service
+type RoutingConstraints struct {
+	RouterRoles         []string
+	RouterRolesSemantic string
+	ExcludedLinks       []string
+	MaxHops             uint32
+}
+
+func (self *RoutingConstraints) HasRoutingConstraints() bool {
+	return len(self.RouterRoles) > 0 || len(self.ExcludedLinks) > 0 || self.MaxHops > 0
 }
 
 func (self *Service) GetName() string {
@@ -47,10 +61,15 @@ func (self *Service) GetName() string {
 
 func (entity *Service) toBolt() *db.Service {
 	return &db.Service{
-		BaseExtEntity:      *boltz.NewExtEntity(entity.Id, entity.Tags),
-		Name:               entity.Name,
-		MaxIdleTime:        entity.MaxIdleTime,
-		TerminatorStrategy: entity.TerminatorStrategy,
+		BaseExtEntity:       *boltz.NewExtEntity(entity.Id, entity.Tags),
+		Name:                entity.Name,
+		MaxIdleTime:         entity.MaxIdleTime,
+		TerminatorStrategy:  entity.TerminatorStrategy,
+		RouterRoles:         entity.RouterRoles,
+		RouterRolesSemantic: entity.RouterRolesSemantic,
+		ExcludedLinks:       entity.ExcludedLinks,
+		MaxHops:             entity.MaxHops,
+		PriorityClass:       entity.PriorityClass,
 	}
 }
 
@@ -173,6 +192,11 @@ func (self *ServiceManager) populateService(entity *Service, tx *bbolt.Tx, boltE
 	entity.Name = boltService.Name
 	entity.MaxIdleTime = boltService.MaxIdleTime
 	entity.TerminatorStrategy = boltService.TerminatorStrategy
+	entity.RouterRoles = boltService.RouterRoles
+	entity.RouterRolesSemantic = boltService.RouterRolesSemantic
+	entity.ExcludedLinks = boltService.ExcludedLinks
+	entity.MaxHops = boltService.MaxHops
+	entity.PriorityClass = boltService.PriorityClass
 	entity.FillCommon(boltService)
 
 	terminatorIds := self.store.GetRelatedEntitiesIdList(tx, entity.Id, db.EntityTypeTerminators)
@@ -209,11 +233,16 @@ func (self *ServiceManager) Marshall(entity *Service) ([]byte, error) {
 	}
 
 	msg := &cmd_pb.Service{
-		Id:                 entity.Id,
-		Name:               entity.Name,
-		MaxIdleTime:        int64(entity.MaxIdleTime),
-		TerminatorStrategy: entity.TerminatorStrategy,
-		Tags:               tags,
+		Id:                  entity.Id,
+		Name:                entity.Name,
+		MaxIdleTime:         int64(entity.MaxIdleTime),
+		TerminatorStrategy:  entity.TerminatorStrategy,
+		Tags:                tags,
+		RouterRoles:         entity.RouterRoles,
+		RouterRolesSemantic: entity.RouterRolesSemantic,
+		ExcludedLinks:       entity.ExcludedLinks,
+		MaxHops:             entity.MaxHops,
+		PriorityClass:       entity.PriorityClass,
 	}
 
 	return proto.Marshal(msg)
@@ -233,5 +262,12 @@ func (self *ServiceManager) Unmarshall(bytes []byte) (*Service, error) {
 		Name:               msg.Name,
 		MaxIdleTime:        time.Duration(msg.MaxIdleTime),
 		TerminatorStrategy: msg.TerminatorStrategy,
+		PriorityClass:      msg.PriorityClass,
+		RoutingConstraints: RoutingConstraints{
+			RouterRoles:         msg.RouterRoles,
+			RouterRolesSemantic: msg.RouterRolesSemantic,
+			ExcludedLinks:       msg.ExcludedLinks,
+			MaxHops:             msg.MaxHops,
+		},
 	}, nil
 }
@@ -78,8 +78,8 @@ func (network *Network) getRerouteCandidates() []*newCircuitPath {
 	}
 	log.Tracef("smart reroute ceiling [%d]", ceiling)
 	for _, sId := range orderedCircuits {
-		if circuit, found := network.GetCircuit(sId); found {
-			if updatedPath, err := network.UpdatePath(circuit.Path); err == nil {
+		if circuit, found := network.GetCircuit(sId); found && !circuit.Path.pinned {
+			if updatedPath, err := network.smartReroutePath(circuit.Path); err == nil {
 				pathChanged := !updatedPath.EqualPath(circuit.Path)
 				oldCost := circuit.Path.cost(minRouterCost)
 				newCost := updatedPath.cost(minRouterCost)
@@ -100,6 +100,32 @@ func (network *Network) getRerouteCandidates() []*newCircuitPath {
 	return candidates
 }
 
+// smartReroutePath returns the path a circuit should be moved to. When multiple paths are computed, the cheapest
+// candidate which satisfies the configured diversity relative to the circuit's current path is preferred, so that
+// circuits move off degraded links rather than onto a variation of the same path.
+func (network *Network) smartReroutePath(path *Path) (*Path, error) {
+	if network.options.Paths.Count < 2 {
+		return network.UpdatePath(path)
+	}
+
+	srcR := path.Nodes[0]
+	dstR := path.Nodes[len(path.Nodes)-1]
+	paths, err := network.kShortestPaths(srcR, dstR, int(network.options.Paths.Count), PathDiversityNone, path.constraints)
+	if err != nil {
+		return nil, err
+	}
+
+	selected := paths[0].path
+	for _, candidate := range paths {
+		if network.options.Paths.Diversity.isDiverse(path.Nodes, candidate.path) {
+			selected = candidate.path
+			break
+		}
+	}
+
+	return network.updatePathNodes(path, selected)
+}
+
 type newCircuitPath struct {
 	circuit *Circuit
 	path    *Path
@@ -66,7 +66,7 @@ func TestSmartRerouteMinCostDelta(t *testing.T) {
 		},
 	}
 
-	_, terminator, pathNodes, cerr := network.selectPath(r0, svc, "", lc)
+	_, terminator, pathNodes, cerr := network.selectPath(r0, svc, "", nil, lc, nil)
 	assert.NoError(t, cerr)
 
 	path, pathErr := network.CreatePathWithNodes(pathNodes)
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/openziti/edge-api/rest_model"
+	"github.com/openziti/foundation/v2/errorz"
 	"github.com/openziti/ziti/controller/apierror"
 	"github.com/openziti/ziti/controller/model"
 	"github.com/pkg/errors"
@@ -36,6 +37,9 @@ const (
 	TotpRequiredHeader  = "totp-required"
 	ContentTypeHeader   = "content-type"
 
+	// WebAuthnAvailableHeader is set alongside TotpRequiredHeader when the identity can satisfy MFA with WebAuthn
+	WebAuthnAvailableHeader = "webauthn-available"
+
 	FormContentType = "application/x-www-form-urlencoded"
 	JsonContentType = "application/json"
 	HtmlContentType = "text/html"
@@ -122,6 +126,9 @@ func (l *login) createRouter(issuerInterceptor *op.IssuerInterceptor) {
 	l.router.Path("/totp").Methods("POST").HandlerFunc(l.checkTotp)
 	l.router.Path("/totp/enroll").Methods("POST").HandlerFunc(l.startEnrollTotp)
 	l.router.Path("/totp/enroll/verify").Methods("POST").HandlerFunc(l.completeTotpEnrollment)
+
+	l.router.Path("/webauthn/options").Methods("POST").HandlerFunc(l.startWebAuthn)
+	l.router.Path("/webauthn").Methods("POST").HandlerFunc(l.checkWebAuthn)
 }
 
 func (l *login) genericHandler(w http.ResponseWriter, r *http.Request) {
@@ -284,8 +291,11 @@ func (l *login) authenticate(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if authRequest.SecondaryTotpRequired && !authRequest.HasAmr(AuthMethodSecondaryTotp) {
+	if authRequest.SecondaryTotpRequired && !authRequest.HasMfaAuth() {
 		w.Header().Set(TotpRequiredHeader, "true")
+		if authRequest.WebAuthnAvailable {
+			w.Header().Set(WebAuthnAvailableHeader, "true")
+		}
 		if responseType == HtmlContentType {
 			renderTotp(w, credentials.AuthRequestId, err)
 		} else if responseType == JsonContentType {
@@ -356,3 +366,80 @@ func (l *login) completeTotpEnrollment(w http.ResponseWriter, r *http.Request) {
 
 	renderJson(w, http.StatusOK, &rest_model.Empty{})
 }
+
+func (l *login) startWebAuthn(w http.ResponseWriter, r *http.Request) {
+	_, err := negotiateResponseContentType(r)
+
+	if err != nil {
+		renderJsonError(w, err)
+		return
+	}
+
+	payload := &AuthRequestBody{}
+	apiErr := parsePayload(r, payload)
+
+	if apiErr != nil {
+		renderJsonError(w, apiErr)
+		return
+	}
+
+	options, startErr := l.store.StartWebAuthn(payload.AuthRequestId)
+
+	if startErr != nil {
+		renderJsonError(w, startErr)
+		return
+	}
+
+	renderJson(w, http.StatusOK, jsonValue{value: options})
+}
+
+func (l *login) checkWebAuthn(w http.ResponseWriter, r *http.Request) {
+	responseType, err := negotiateResponseContentType(r)
+
+	if err != nil {
+		renderJsonError(w, err)
+		return
+	}
+
+	payload := &WebAuthn{}
+	apiErr := parsePayload(r, payload)
+
+	if apiErr != nil {
+		renderJsonError(w, apiErr)
+		return
+	}
+
+	assertion := &model.WebAuthnAssertion{
+		CredentialId: payload.CredentialId,
+	}
+
+	for _, field := range []struct {
+		name  string
+		value string
+		dst   *[]byte
+	}{
+		{"clientDataJSON", payload.ClientDataJSON, &assertion.ClientDataJSON},
+		{"authenticatorData", payload.AuthenticatorData, &assertion.AuthenticatorData},
+		{"signature", payload.Signature, &assertion.Signature},
+	} {
+		var decodeErr error
+		if *field.dst, decodeErr = model.DecodeWebAuthnValue(field.value); decodeErr != nil || field.value == "" {
+			renderJsonError(w, apierror.NewBadRequestFieldError(*errorz.NewFieldError("base64url encoded value expected", field.name, field.value)))
+			return
+		}
+	}
+
+	_, verifyErr := l.store.VerifyWebAuthn(NewHttpChangeCtx(r), payload.AuthRequestId, assertion)
+
+	if verifyErr != nil {
+		renderJsonError(w, verifyErr)
+		return
+	}
+
+	if responseType == HtmlContentType {
+		http.Redirect(w, r, l.callback(r.Context(), payload.AuthRequestId), http.StatusFound)
+		return
+	}
+
+	renderJson(w, http.StatusOK, &rest_model.Empty{})
+}
@@ -17,6 +17,14 @@ type Totp struct {
 	Code string `json:"code"`
 }
 
+type WebAuthn struct {
+	AuthRequestBody
+	CredentialId      string `json:"credentialId"`
+	ClientDataJSON    string `json:"clientDataJSON"`
+	AuthenticatorData string `json:"authenticatorData"`
+	Signature         string `json:"signature"`
+}
+
 type updbCreds struct {
 	rest_model.Authenticate
 	AuthRequestBody
@@ -23,8 +23,9 @@ const (
 	AuthMethodExtJwt   = model.AuthMethodExtJwt
 	AuthMethodCert     = db.MethodAuthenticatorCert
 
-	AuthMethodSecondaryTotp   = "totp"
-	AuthMethodSecondaryExtJwt = "ejs"
+	AuthMethodSecondaryTotp     = "totp"
+	AuthMethodSecondaryWebAuthn = "webauthn"
+	AuthMethodSecondaryExtJwt   = "ejs"
 
 	DefaultNativeClientId = "native"
 )
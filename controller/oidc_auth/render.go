@@ -2,6 +2,7 @@ package oidc_auth
 
 import (
 	"encoding"
+	"encoding/json"
 	"errors"
 	"github.com/michaelquigley/pfxlog"
 	"github.com/openziti/edge-api/rest_model"
@@ -64,6 +65,15 @@ func renderJson(w http.ResponseWriter, status int, data encoding.BinaryMarshaler
 	render(w, JsonContentType, status, data)
 }
 
+// jsonValue adapts values which don't implement encoding.BinaryMarshaler, so they can be rendered as JSON
+type jsonValue struct {
+	value any
+}
+
+func (self jsonValue) MarshalBinary() ([]byte, error) {
+	return json.Marshal(self.value)
+}
+
 func renderJsonError(w http.ResponseWriter, err error) {
 	restErr, status := errorToRestApiError(err)
 	renderJson(w, status, restErr)
@@ -20,6 +20,7 @@ type AuthRequest struct {
 	AuthTime                time.Time
 	ApiSessionId            string
 	SecondaryTotpRequired   bool
+	WebAuthnAvailable       bool
 	SecondaryExtJwtRequired bool
 	SecondaryExtJwtId       string
 	ConfigTypes             []string
@@ -67,10 +68,15 @@ func (a *AuthRequest) HasPrimaryAuth() bool {
 
 // HasSecondaryAuth returns true if all applicable secondary authentications have been passed
 func (a *AuthRequest) HasSecondaryAuth() bool {
-	return (!a.SecondaryTotpRequired || a.HasAmr(AuthMethodSecondaryTotp)) &&
+	return (!a.SecondaryTotpRequired || a.HasMfaAuth()) &&
 		(!a.SecondaryExtJwtRequired || a.HasAmr(AuthMethodSecondaryExtJwt))
 }
 
+// HasMfaAuth returns true if an MFA second factor, TOTP or WebAuthn, has been passed
+func (a *AuthRequest) HasMfaAuth() bool {
+	return a.HasAmr(AuthMethodSecondaryTotp) || a.HasAmr(AuthMethodSecondaryWebAuthn)
+}
+
 // HasAmr returns true if the supplied amr is present
 func (a *AuthRequest) HasAmr(amr string) bool {
 	_, found := a.Amr[amr]
@@ -13,6 +13,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/go-webauthn/webauthn/protocol"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/michaelquigley/pfxlog"
 	"github.com/openziti/foundation/v2/stringz"
@@ -52,6 +53,12 @@ type Storage interface {
 
 	CompleteTotpEnrollment(ctx *change.Context, authRequestId, code string) error
 
+	// StartWebAuthn issues WebAuthn assertion options for the current authentication request's subject
+	StartWebAuthn(authRequestId string) (*protocol.PublicKeyCredentialRequestOptions, error)
+
+	// VerifyWebAuthn will verify the supplied WebAuthn assertion for the current authentication request's subject
+	VerifyWebAuthn(ctx *change.Context, authRequestId string, assertion *model.WebAuthnAssertion) (*AuthRequest, error)
+
 	// IsTokenRevoked will return true if a token has been removed.
 	// TokenId may be a JWT token id or an identity id
 	IsTokenRevoked(tokenId string) bool
@@ -224,6 +231,7 @@ func (s *HybridStorage) Authenticate(authCtx model.AuthContext, id string, confi
 	}
 
 	authRequest.SecondaryTotpRequired = mfa != nil && mfa.IsVerified
+	authRequest.WebAuthnAvailable = mfa.HasWebAuthn()
 
 	if authCtx.GetMethod() == AuthMethodCert {
 		if len(authRequest.PeerCerts) == 0 {
@@ -290,6 +298,75 @@ func (s *HybridStorage) VerifyTotp(ctx *change.Context, code string, id string)
 
 }
 
+// getAuthenticatedRequest returns the AuthRequest associated with `id` if it has passed primary authentication
+func (s *HybridStorage) getAuthenticatedRequest(id string) (*AuthRequest, error) {
+	id = strings.TrimSpace(id)
+
+	if len(id) == 0 || len(id) > 40 {
+		return nil, errors.New("invalid request")
+	}
+
+	authRequest, ok := s.authRequests.Get(id)
+
+	if !ok {
+		return nil, errors.New("request not found")
+	}
+
+	if len(authRequest.Amr) == 0 {
+		return nil, errors.New("request not authorized")
+	}
+
+	return authRequest, nil
+}
+
+// StartWebAuthn will issue WebAuthn assertion options for the AuthRequest associated with `authRequestId`
+func (s *HybridStorage) StartWebAuthn(authRequestId string) (*protocol.PublicKeyCredentialRequestOptions, error) {
+	authRequest, err := s.getAuthenticatedRequest(authRequestId)
+
+	if err != nil {
+		return nil, errorz.NewUnauthorized()
+	}
+
+	mfa, err := s.env.GetManagers().Mfa.ReadOneByIdentityId(authRequest.IdentityId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !mfa.HasWebAuthn() {
+		return nil, apierror.NewMfaNotEnrolledError()
+	}
+
+	return s.env.GetManagers().Mfa.StartWebAuthnAssertion(mfa, authRequest.Id)
+}
+
+// VerifyWebAuthn will update and return the AuthRequest associated with `authRequestId`
+func (s *HybridStorage) VerifyWebAuthn(ctx *change.Context, authRequestId string, assertion *model.WebAuthnAssertion) (*AuthRequest, error) {
+	authRequest, err := s.getAuthenticatedRequest(authRequestId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	mfa, err := s.env.GetManagers().Mfa.ReadOneByIdentityId(authRequest.IdentityId)
+
+	if err != nil {
+		return nil, errors.New("could not read mfa status")
+	}
+
+	if !mfa.HasWebAuthn() {
+		return nil, apierror.NewMfaNotEnrolledError()
+	}
+
+	if err = s.env.GetManagers().Mfa.VerifyWebAuthnAssertion(mfa, authRequest.Id, assertion, ctx); err != nil {
+		return nil, err
+	}
+
+	authRequest.AddAmr(AuthMethodSecondaryWebAuthn)
+
+	return authRequest, nil
+}
+
 // CreateAuthRequest creates a new AuthRequest based on an incoming request, implements the op.Storage interface
 func (s *HybridStorage) CreateAuthRequest(ctx context.Context, authReq *oidc.AuthRequest, identityId string) (op.AuthRequest, error) {
 	httpRequest, err := HttpRequestFromContext(ctx)
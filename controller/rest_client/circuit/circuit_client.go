@@ -60,6 +60,8 @@ type ClientService interface {
 
 	ListCircuits(params *ListCircuitsParams, opts ...ClientOption) (*ListCircuitsOK, error)
 
+	RerouteCircuits(params *RerouteCircuitsParams, opts ...ClientOption) (*RerouteCircuitsOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
@@ -184,6 +186,46 @@ func (a *Client) ListCircuits(params *ListCircuitsParams, opts ...ClientOption)
 	panic(msg)
 }
 
+/*
+  RerouteCircuits reroutes circuits
+
+  Reroutes a circuit, or all circuits using a link or passing through a router, onto newly computed paths. A single circuit may instead be moved to an explicitly specified path. Requires admin access.
+*/
+func (a *Client) RerouteCircuits(params *RerouteCircuitsParams, opts ...ClientOption) (*RerouteCircuitsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRerouteCircuitsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "rerouteCircuits",
+		Method:             "POST",
+		PathPattern:        "/circuits/reroute",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &RerouteCircuitsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RerouteCircuitsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for rerouteCircuits: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
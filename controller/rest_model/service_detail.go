@@ -44,10 +44,25 @@ import (
 type ServiceDetail struct {
 	BaseEntity
 
+	// Links which circuits for the service may not use, given as link ids or as router id pairs, in the form routerId:routerId
+	ExcludedLinks []string `json:"excludedLinks"`
+
+	// The maximum number of router to router hops in paths for the service. 0 means no limit
+	MaxHops int64 `json:"maxHops,omitempty"`
+
 	// name
 	// Required: true
 	Name *string `json:"name"`
 
+	// The qos priority class used to schedule payloads for circuits of the service on links, one of realtime, interactive, standard or bulk. Defaults to standard
+	PriorityClass string `json:"priorityClass,omitempty"`
+
+	// Router roles (#attribute) or router ids (@id) which paths for the service are restricted to
+	RouterRoles []string `json:"routerRoles"`
+
+	// How router roles are matched, either AllOf or AnyOf. Defaults to AllOf
+	RouterRolesSemantic string `json:"routerRolesSemantic,omitempty"`
+
 	// terminator strategy
 	// Required: true
 	TerminatorStrategy *string `json:"terminatorStrategy"`
@@ -64,16 +79,36 @@ func (m *ServiceDetail) UnmarshalJSON(raw []byte) error {
 
 	// AO1
 	var dataAO1 struct {
+		ExcludedLinks []string `json:"excludedLinks"`
+
+		MaxHops int64 `json:"maxHops,omitempty"`
+
 		Name *string `json:"name"`
 
+		PriorityClass string `json:"priorityClass,omitempty"`
+
+		RouterRoles []string `json:"routerRoles"`
+
+		RouterRolesSemantic string `json:"routerRolesSemantic,omitempty"`
+
 		TerminatorStrategy *string `json:"terminatorStrategy"`
 	}
 	if err := swag.ReadJSON(raw, &dataAO1); err != nil {
 		return err
 	}
 
+	m.ExcludedLinks = dataAO1.ExcludedLinks
+
+	m.MaxHops = dataAO1.MaxHops
+
 	m.Name = dataAO1.Name
 
+	m.PriorityClass = dataAO1.PriorityClass
+
+	m.RouterRoles = dataAO1.RouterRoles
+
+	m.RouterRolesSemantic = dataAO1.RouterRolesSemantic
+
 	m.TerminatorStrategy = dataAO1.TerminatorStrategy
 
 	return nil
@@ -89,13 +124,33 @@ func (m ServiceDetail) MarshalJSON() ([]byte, error) {
 	}
 	_parts = append(_parts, aO0)
 	var dataAO1 struct {
+		ExcludedLinks []string `json:"excludedLinks"`
+
+		MaxHops int64 `json:"maxHops,omitempty"`
+
 		Name *string `json:"name"`
 
+		PriorityClass string `json:"priorityClass,omitempty"`
+
+		RouterRoles []string `json:"routerRoles"`
+
+		RouterRolesSemantic string `json:"routerRolesSemantic,omitempty"`
+
 		TerminatorStrategy *string `json:"terminatorStrategy"`
 	}
 
+	dataAO1.ExcludedLinks = m.ExcludedLinks
+
+	dataAO1.MaxHops = m.MaxHops
+
 	dataAO1.Name = m.Name
 
+	dataAO1.PriorityClass = m.PriorityClass
+
+	dataAO1.RouterRoles = m.RouterRoles
+
+	dataAO1.RouterRolesSemantic = m.RouterRolesSemantic
+
 	dataAO1.TerminatorStrategy = m.TerminatorStrategy
 
 	jsonDataAO1, errAO1 := swag.WriteJSON(dataAO1)
@@ -42,9 +42,24 @@ import (
 // swagger:model servicePatch
 type ServicePatch struct {
 
+	// Links which circuits for the service may not use, given as link ids or as router id pairs, in the form routerId:routerId
+	ExcludedLinks []string `json:"excludedLinks"`
+
+	// The maximum number of router to router hops in paths for the service. 0 means no limit
+	MaxHops int64 `json:"maxHops,omitempty"`
+
 	// name
 	Name string `json:"name,omitempty"`
 
+	// The qos priority class used to schedule payloads for circuits of the service on links, one of realtime, interactive, standard or bulk. Defaults to standard
+	PriorityClass string `json:"priorityClass,omitempty"`
+
+	// Router roles (#attribute) or router ids (@id) which paths for the service are restricted to
+	RouterRoles []string `json:"routerRoles"`
+
+	// How router roles are matched, either AllOf or AnyOf. Defaults to AllOf
+	RouterRolesSemantic string `json:"routerRolesSemantic,omitempty"`
+
 	// tags
 	Tags *Tags `json:"tags,omitempty"`
 
@@ -239,6 +239,11 @@ func configureAPI(api *operations.ZitiFabricAPI) http.Handler {
 			return middleware.NotImplemented("operation raft.RaftTranferLeadership has not yet been implemented")
 		})
 	}
+	if api.CircuitRerouteCircuitsHandler == nil {
+		api.CircuitRerouteCircuitsHandler = circuit.RerouteCircuitsHandlerFunc(func(params circuit.RerouteCircuitsParams) middleware.Responder {
+			return middleware.NotImplemented("operation circuit.RerouteCircuits has not yet been implemented")
+		})
+	}
 	if api.RouterUpdateRouterHandler == nil {
 		api.RouterUpdateRouterHandler = router.UpdateRouterHandlerFunc(func(params router.UpdateRouterParams) middleware.Responder {
 			return middleware.NotImplemented("operation router.UpdateRouter has not yet been implemented")
@@ -101,6 +101,44 @@ func init() {
         }
       }
     },
+    "/circuits/reroute": {
+      "post": {
+        "description": "Reroutes a circuit, or all circuits using a link or passing through a router, onto newly computed paths. A single circuit may instead be moved to an explicitly specified path. Requires admin access.",
+        "tags": [
+          "Circuit"
+        ],
+        "summary": "Reroute circuits",
+        "operationId": "rerouteCircuits",
+        "parameters": [
+          {
+            "description": "circuit reroute parameters",
+            "name": "reroute",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/circuitReroute"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/rerouteCircuitsResponse"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          }
+        }
+      }
+    },
     "/circuits/{id}": {
       "get": {
         "description": "Retrieves a single circuit by id. Requires admin access.",
@@ -1360,6 +1398,49 @@ func init() {
         }
       }
     },
+    "circuitReroute": {
+      "type": "object",
+      "properties": {
+        "circuitId": {
+          "description": "The id of a circuit to reroute",
+          "type": "string"
+        },
+        "linkId": {
+          "description": "Reroute all circuits using this link",
+          "type": "string"
+        },
+        "path": {
+          "description": "Router ids of an explicit path for the circuit, starting at the circuit's initiating router and ending at its terminating router. Only valid with circuitId",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "routerId": {
+          "description": "Reroute all circuits passing through this router. Circuits which start or end at the router are not rerouted",
+          "type": "string"
+        }
+      }
+    },
+    "circuitRerouteResult": {
+      "type": "object",
+      "properties": {
+        "failed": {
+          "description": "Circuits which could not be rerouted, mapped to the reason",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "rerouted": {
+          "description": "Ids of the circuits which were rerouted",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
     "circuitDetail": {
       "allOf": [
         {
@@ -2177,9 +2258,35 @@ func init() {
         "name"
       ],
       "properties": {
+        "excludedLinks": {
+          "description": "Links which circuits for the service may not use, given as link ids or as router id pairs, in the form routerId:routerId",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "maxHops": {
+          "description": "The maximum number of router to router hops in paths for the service. 0 means no limit",
+          "type": "integer"
+        },
         "name": {
           "type": "string"
         },
+        "priorityClass": {
+          "description": "The qos priority class used to schedule payloads for circuits of the service on links, one of realtime, interactive, standard or bulk. Defaults to standard",
+          "type": "string"
+        },
+        "routerRoles": {
+          "description": "Router roles (#attribute) or router ids (@id) which paths for the service are restricted to",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "routerRolesSemantic": {
+          "description": "How router roles are matched, either AllOf or AnyOf. Defaults to AllOf",
+          "type": "string"
+        },
         "tags": {
           "$ref": "#/definitions/tags"
         },
@@ -2201,9 +2308,35 @@ func init() {
             "terminatorStrategy"
           ],
           "properties": {
+            "excludedLinks": {
+              "description": "Links which circuits for the service may not use, given as link ids or as router id pairs, in the form routerId:routerId",
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            },
+            "maxHops": {
+              "description": "The maximum number of router to router hops in paths for the service. 0 means no limit",
+              "type": "integer"
+            },
             "name": {
               "type": "string"
             },
+            "priorityClass": {
+              "description": "The qos priority class used to schedule payloads for circuits of the service on links, one of realtime, interactive, standard or bulk. Defaults to standard",
+              "type": "string"
+            },
+            "routerRoles": {
+              "description": "Router roles (#attribute) or router ids (@id) which paths for the service are restricted to",
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            },
+            "routerRolesSemantic": {
+              "description": "How router roles are matched, either AllOf or AnyOf. Defaults to AllOf",
+              "type": "string"
+            },
             "terminatorStrategy": {
               "type": "string"
             }
@@ -2220,9 +2353,35 @@ func init() {
     "servicePatch": {
       "type": "object",
       "properties": {
+        "excludedLinks": {
+          "description": "Links which circuits for the service may not use, given as link ids or as router id pairs, in the form routerId:routerId",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "maxHops": {
+          "description": "The maximum number of router to router hops in paths for the service. 0 means no limit",
+          "type": "integer"
+        },
         "name": {
           "type": "string"
         },
+        "priorityClass": {
+          "description": "The qos priority class used to schedule payloads for circuits of the service on links, one of realtime, interactive, standard or bulk. Defaults to standard",
+          "type": "string"
+        },
+        "routerRoles": {
+          "description": "Router roles (#attribute) or router ids (@id) which paths for the service are restricted to",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "routerRolesSemantic": {
+          "description": "How router roles are matched, either AllOf or AnyOf. Defaults to AllOf",
+          "type": "string"
+        },
         "tags": {
           "$ref": "#/definitions/tags"
         },
@@ -2237,9 +2396,35 @@ func init() {
         "name"
       ],
       "properties": {
+        "excludedLinks": {
+          "description": "Links which circuits for the service may not use, given as link ids or as router id pairs, in the form routerId:routerId",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "maxHops": {
+          "description": "The maximum number of router to router hops in paths for the service. 0 means no limit",
+          "type": "integer"
+        },
         "name": {
           "type": "string"
         },
+        "priorityClass": {
+          "description": "The qos priority class used to schedule payloads for circuits of the service on links, one of realtime, interactive, standard or bulk. Defaults to standard",
+          "type": "string"
+        },
+        "routerRoles": {
+          "description": "Router roles (#attribute) or router ids (@id) which paths for the service are restricted to",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "routerRolesSemantic": {
+          "description": "How router roles are matched, either AllOf or AnyOf. Defaults to AllOf",
+          "type": "string"
+        },
         "tags": {
           "$ref": "#/definitions/tags"
         },
@@ -2748,6 +2933,12 @@ func init() {
         }
       }
     },
+    "rerouteCircuitsResponse": {
+      "description": "A response to a circuit reroute request",
+      "schema": {
+        "$ref": "#/definitions/circuitRerouteResult"
+      }
+    },
     "unauthorizedResponse": {
       "description": "The currently supplied session does not have the correct access rights to request this resource",
       "schema": {
@@ -2890,6 +3081,145 @@ func init() {
         }
       }
     },
+    "/circuits/reroute": {
+      "post": {
+        "description": "Reroutes a circuit, or all circuits using a link or passing through a router, onto newly computed paths. A single circuit may instead be moved to an explicitly specified path. Requires admin access.",
+        "tags": [
+          "Circuit"
+        ],
+        "summary": "Reroute circuits",
+        "operationId": "rerouteCircuits",
+        "parameters": [
+          {
+            "description": "circuit reroute parameters",
+            "name": "reroute",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/circuitReroute"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A response to a circuit reroute request",
+            "schema": {
+              "$ref": "#/definitions/circuitRerouteResult"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      }
+    },
     "/circuits/{id}": {
       "get": {
         "description": "Retrieves a single circuit by id. Requires admin access.",
@@ -6955,6 +7285,49 @@ func init() {
         }
       }
     },
+    "circuitReroute": {
+      "type": "object",
+      "properties": {
+        "circuitId": {
+          "description": "The id of a circuit to reroute",
+          "type": "string"
+        },
+        "linkId": {
+          "description": "Reroute all circuits using this link",
+          "type": "string"
+        },
+        "path": {
+          "description": "Router ids of an explicit path for the circuit, starting at the circuit's initiating router and ending at its terminating router. Only valid with circuitId",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "routerId": {
+          "description": "Reroute all circuits passing through this router. Circuits which start or end at the router are not rerouted",
+          "type": "string"
+        }
+      }
+    },
+    "circuitRerouteResult": {
+      "type": "object",
+      "properties": {
+        "failed": {
+          "description": "Circuits which could not be rerouted, mapped to the reason",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "rerouted": {
+          "description": "Ids of the circuits which were rerouted",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
     "circuitDetail": {
       "allOf": [
         {
@@ -7776,9 +8149,35 @@ func init() {
         "name"
       ],
       "properties": {
+        "excludedLinks": {
+          "description": "Links which circuits for the service may not use, given as link ids or as router id pairs, in the form routerId:routerId",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "maxHops": {
+          "description": "The maximum number of router to router hops in paths for the service. 0 means no limit",
+          "type": "integer"
+        },
         "name": {
           "type": "string"
         },
+        "priorityClass": {
+          "description": "The qos priority class used to schedule payloads for circuits of the service on links, one of realtime, interactive, standard or bulk. Defaults to standard",
+          "type": "string"
+        },
+        "routerRoles": {
+          "description": "Router roles (#attribute) or router ids (@id) which paths for the service are restricted to",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "routerRolesSemantic": {
+          "description": "How router roles are matched, either AllOf or AnyOf. Defaults to AllOf",
+          "type": "string"
+        },
         "tags": {
           "$ref": "#/definitions/tags"
         },
@@ -7800,9 +8199,35 @@ func init() {
             "terminatorStrategy"
           ],
           "properties": {
+            "excludedLinks": {
+              "description": "Links which circuits for the service may not use, given as link ids or as router id pairs, in the form routerId:routerId",
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            },
+            "maxHops": {
+              "description": "The maximum number of router to router hops in paths for the service. 0 means no limit",
+              "type": "integer"
+            },
             "name": {
               "type": "string"
             },
+            "priorityClass": {
+              "description": "The qos priority class used to schedule payloads for circuits of the service on links, one of realtime, interactive, standard or bulk. Defaults to standard",
+              "type": "string"
+            },
+            "routerRoles": {
+              "description": "Router roles (#attribute) or router ids (@id) which paths for the service are restricted to",
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            },
+            "routerRolesSemantic": {
+              "description": "How router roles are matched, either AllOf or AnyOf. Defaults to AllOf",
+              "type": "string"
+            },
             "terminatorStrategy": {
               "type": "string"
             }
@@ -7819,9 +8244,35 @@ func init() {
     "servicePatch": {
       "type": "object",
       "properties": {
+        "excludedLinks": {
+          "description": "Links which circuits for the service may not use, given as link ids or as router id pairs, in the form routerId:routerId",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "maxHops": {
+          "description": "The maximum number of router to router hops in paths for the service. 0 means no limit",
+          "type": "integer"
+        },
         "name": {
           "type": "string"
         },
+        "priorityClass": {
+          "description": "The qos priority class used to schedule payloads for circuits of the service on links, one of realtime, interactive, standard or bulk. Defaults to standard",
+          "type": "string"
+        },
+        "routerRoles": {
+          "description": "Router roles (#attribute) or router ids (@id) which paths for the service are restricted to",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "routerRolesSemantic": {
+          "description": "How router roles are matched, either AllOf or AnyOf. Defaults to AllOf",
+          "type": "string"
+        },
         "tags": {
           "$ref": "#/definitions/tags"
         },
@@ -7836,9 +8287,35 @@ func init() {
         "name"
       ],
       "properties": {
+        "excludedLinks": {
+          "description": "Links which circuits for the service may not use, given as link ids or as router id pairs, in the form routerId:routerId",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "maxHops": {
+          "description": "The maximum number of router to router hops in paths for the service. 0 means no limit",
+          "type": "integer"
+        },
         "name": {
           "type": "string"
         },
+        "priorityClass": {
+          "description": "The qos priority class used to schedule payloads for circuits of the service on links, one of realtime, interactive, standard or bulk. Defaults to standard",
+          "type": "string"
+        },
+        "routerRoles": {
+          "description": "Router roles (#attribute) or router ids (@id) which paths for the service are restricted to",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "routerRolesSemantic": {
+          "description": "How router roles are matched, either AllOf or AnyOf. Defaults to AllOf",
+          "type": "string"
+        },
         "tags": {
           "$ref": "#/definitions/tags"
         },
@@ -8348,6 +8825,12 @@ func init() {
         }
       }
     },
+    "rerouteCircuitsResponse": {
+      "description": "A response to a circuit reroute request",
+      "schema": {
+        "$ref": "#/definitions/circuitRerouteResult"
+      }
+    },
     "unauthorizedResponse": {
       "description": "The currently supplied session does not have the correct access rights to request this resource",
       "schema": {
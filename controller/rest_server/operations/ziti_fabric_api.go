@@ -177,6 +177,9 @@ func NewZitiFabricAPI(spec *loads.Document) *ZitiFabricAPI {
 		RaftRaftTranferLeadershipHandler: raft.RaftTranferLeadershipHandlerFunc(func(params raft.RaftTranferLeadershipParams) middleware.Responder {
 			return middleware.NotImplemented("operation raft.RaftTranferLeadership has not yet been implemented")
 		}),
+		CircuitRerouteCircuitsHandler: circuit.RerouteCircuitsHandlerFunc(func(params circuit.RerouteCircuitsParams) middleware.Responder {
+			return middleware.NotImplemented("operation circuit.RerouteCircuits has not yet been implemented")
+		}),
 		RouterUpdateRouterHandler: router.UpdateRouterHandlerFunc(func(params router.UpdateRouterParams) middleware.Responder {
 			return middleware.NotImplemented("operation router.UpdateRouter has not yet been implemented")
 		}),
@@ -290,6 +293,8 @@ type ZitiFabricAPI struct {
 	RaftRaftMemberRemoveHandler raft.RaftMemberRemoveHandler
 	// RaftRaftTranferLeadershipHandler sets the operation handler for the raft tranfer leadership operation
 	RaftRaftTranferLeadershipHandler raft.RaftTranferLeadershipHandler
+	// CircuitRerouteCircuitsHandler sets the operation handler for the reroute circuits operation
+	CircuitRerouteCircuitsHandler circuit.RerouteCircuitsHandler
 	// RouterUpdateRouterHandler sets the operation handler for the update router operation
 	RouterUpdateRouterHandler router.UpdateRouterHandler
 	// ServiceUpdateServiceHandler sets the operation handler for the update service operation
@@ -475,6 +480,9 @@ func (o *ZitiFabricAPI) Validate() error {
 	if o.RaftRaftTranferLeadershipHandler == nil {
 		unregistered = append(unregistered, "raft.RaftTranferLeadershipHandler")
 	}
+	if o.CircuitRerouteCircuitsHandler == nil {
+		unregistered = append(unregistered, "circuit.RerouteCircuitsHandler")
+	}
 	if o.RouterUpdateRouterHandler == nil {
 		unregistered = append(unregistered, "router.UpdateRouterHandler")
 	}
@@ -708,6 +716,10 @@ func (o *ZitiFabricAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/raft/transfer-leadership"] = raft.NewRaftTranferLeadership(o.context, o.RaftRaftTranferLeadershipHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/circuits/reroute"] = circuit.NewRerouteCircuits(o.context, o.CircuitRerouteCircuitsHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -0,0 +1,185 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package scim
+
+import (
+	"net/http"
+)
+
+// discoveryResources are the read only resources describing the service provider, see RFC 7644 section 4
+type discoveryResources struct {
+	// single is set for ServiceProviderConfig, which is a single resource rather than a list
+	single    bool
+	resources []map[string]interface{}
+}
+
+func (self *Handler) serveDiscovery(rw http.ResponseWriter, r *http.Request, id string, discovery *discoveryResources) {
+	if r.Method != http.MethodGet {
+		WriteError(rw, NewError(http.StatusMethodNotAllowed, "", "method %s not supported for %s", r.Method, r.URL.Path))
+		return
+	}
+
+	if discovery.single {
+		if id != "" {
+			WriteError(rw, NewError(http.StatusNotFound, "", "unknown resource %s", r.URL.Path))
+			return
+		}
+		writeJson(rw, http.StatusOK, discovery.resources[0])
+		return
+	}
+
+	if id == "" {
+		var resources []interface{}
+		for _, resource := range discovery.resources {
+			resources = append(resources, resource)
+		}
+
+		writeJson(rw, http.StatusOK, &ListResponse{
+			Schemas:      []string{SchemaListResponse},
+			TotalResults: len(resources),
+			StartIndex:   1,
+			ItemsPerPage: len(resources),
+			Resources:    resources,
+		})
+		return
+	}
+
+	for _, resource := range discovery.resources {
+		if resource["id"] == id {
+			writeJson(rw, http.StatusOK, resource)
+			return
+		}
+	}
+
+	WriteError(rw, NewError(http.StatusNotFound, "", "unknown resource %s", r.URL.Path))
+}
+
+func supported(value bool) map[string]interface{} {
+	return map[string]interface{}{"supported": value}
+}
+
+var serviceProviderConfig = &discoveryResources{
+	single: true,
+	resources: []map[string]interface{}{{
+		"schemas": []string{SchemaServiceProviderConfig},
+		"patch":   supported(true),
+		"bulk": map[string]interface{}{
+			"supported":      false,
+			"maxOperations":  0,
+			"maxPayloadSize": 0,
+		},
+		"filter": map[string]interface{}{
+			"supported":  true,
+			"maxResults": MaxPageSize,
+		},
+		"changePassword": supported(false),
+		"sort":           supported(false),
+		"etag":           supported(false),
+		"authenticationSchemes": []map[string]interface{}{{
+			"type":        "oauthbearertoken",
+			"name":        "Bearer Token",
+			"description": "The configured SCIM token or an admin API session token",
+			"primary":     true,
+		}},
+		"meta": map[string]interface{}{
+			"resourceType": "ServiceProviderConfig",
+		},
+	}},
+}
+
+var resourceTypes = &discoveryResources{
+	resources: []map[string]interface{}{
+		{
+			"schemas":     []string{SchemaResourceType},
+			"id":          ResourceTypeUser,
+			"name":        ResourceTypeUser,
+			"endpoint":    "/Users",
+			"description": "Identities with an external id",
+			"schema":      SchemaUser,
+			"meta":        map[string]interface{}{"resourceType": "ResourceType"},
+		},
+		{
+			"schemas":     []string{SchemaResourceType},
+			"id":          ResourceTypeGroup,
+			"name":        ResourceTypeGroup,
+			"endpoint":    "/Groups",
+			"description": "Identity role attributes",
+			"schema":      SchemaGroup,
+			"meta":        map[string]interface{}{"resourceType": "ResourceType"},
+		},
+	},
+}
+
+func schemaAttribute(name, attributeType string, multiValued, required bool, mutability string, subAttributes ...map[string]interface{}) map[string]interface{} {
+	attribute := map[string]interface{}{
+		"name":        name,
+		"type":        attributeType,
+		"multiValued": multiValued,
+		"required":    required,
+		"caseExact":   false,
+		"mutability":  mutability,
+		"returned":    "default",
+		"uniqueness":  "none",
+	}
+
+	if name == "userName" {
+		attribute["uniqueness"] = "server"
+	}
+
+	if len(subAttributes) > 0 {
+		attribute["subAttributes"] = subAttributes
+	}
+
+	return attribute
+}
+
+func referenceAttribute(name, mutability string) map[string]interface{} {
+	return schemaAttribute(name, "complex", true, false, mutability,
+		schemaAttribute("value", "string", false, false, mutability),
+		schemaAttribute("$ref", "reference", false, false, mutability),
+		schemaAttribute("display", "string", false, false, "readOnly"),
+	)
+}
+
+var schemas = &discoveryResources{
+	resources: []map[string]interface{}{
+		{
+			"schemas":     []string{SchemaSchema},
+			"id":          SchemaUser,
+			"name":        ResourceTypeUser,
+			"description": "User Account",
+			"attributes": []map[string]interface{}{
+				schemaAttribute("userName", "string", false, true, "readWrite"),
+				schemaAttribute("externalId", "string", false, false, "readWrite"),
+				schemaAttribute("active", "boolean", false, false, "readWrite"),
+				referenceAttribute("groups", "readOnly"),
+			},
+			"meta": map[string]interface{}{"resourceType": "Schema"},
+		},
+		{
+			"schemas":     []string{SchemaSchema},
+			"id":          SchemaGroup,
+			"name":        ResourceTypeGroup,
+			"description": "Group",
+			"attributes": []map[string]interface{}{
+				schemaAttribute("displayName", "string", false, true, "immutable"),
+				referenceAttribute("members", "readWrite"),
+			},
+			"meta": map[string]interface{}{"resourceType": "Schema"},
+		},
+	},
+}
@@ -0,0 +1,116 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package scim
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/storage/boltz"
+)
+
+// SCIM error types, see RFC 7644 section 3.12
+const (
+	ScimTypeInvalidFilter = "invalidFilter"
+	ScimTypeUniqueness    = "uniqueness"
+	ScimTypeMutability    = "mutability"
+	ScimTypeInvalidSyntax = "invalidSyntax"
+	ScimTypeInvalidPath   = "invalidPath"
+	ScimTypeNoTarget      = "noTarget"
+	ScimTypeInvalidValue  = "invalidValue"
+)
+
+// Error is a SCIM error response
+type Error struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+}
+
+func (self *Error) Error() string {
+	if self.ScimType == "" {
+		return fmt.Sprintf("%s: %s", self.Status, self.Detail)
+	}
+	return fmt.Sprintf("%s %s: %s", self.Status, self.ScimType, self.Detail)
+}
+
+func (self *Error) statusCode() int {
+	status, err := strconv.Atoi(self.Status)
+	if err != nil {
+		return http.StatusInternalServerError
+	}
+	return status
+}
+
+func NewError(status int, scimType string, detail string, args ...interface{}) *Error {
+	if len(args) > 0 {
+		detail = fmt.Sprintf(detail, args...)
+	}
+	return &Error{
+		Schemas:  []string{SchemaError},
+		Status:   strconv.Itoa(status),
+		ScimType: scimType,
+		Detail:   detail,
+	}
+}
+
+func newBadRequestError(scimType string, detail string, args ...interface{}) *Error {
+	return NewError(http.StatusBadRequest, scimType, detail, args...)
+}
+
+func newNotFoundError(resourceType, id string) *Error {
+	return NewError(http.StatusNotFound, "", "%s %s not found", resourceType, id)
+}
+
+// toError converts errors returned by the model into SCIM errors
+func toError(err error) *Error {
+	var scimErr *Error
+	if errors.As(err, &scimErr) {
+		return scimErr
+	}
+
+	if boltz.IsUniqueIndexDuplicateError(err) {
+		return NewError(http.StatusConflict, ScimTypeUniqueness, err.Error())
+	}
+
+	if boltz.IsErrNotFoundErr(err) {
+		return NewError(http.StatusNotFound, "", err.Error())
+	}
+
+	// names are checked by the model rather than by a unique index
+	var fieldErr *errorz.FieldError
+	if errors.As(err, &fieldErr) {
+		if strings.Contains(fieldErr.Reason, "unique") {
+			return NewError(http.StatusConflict, ScimTypeUniqueness, fieldErr.Error())
+		}
+		return NewError(http.StatusBadRequest, ScimTypeInvalidValue, fieldErr.Error())
+	}
+
+	var apiErr *errorz.ApiError
+	if errors.As(err, &apiErr) && apiErr.Status >= 400 && apiErr.Status < 500 {
+		return NewError(http.StatusBadRequest, ScimTypeInvalidValue, apiErr.Error())
+	}
+
+	pfxlog.Logger().WithError(err).Error("unexpected error handling scim request")
+	return NewError(http.StatusInternalServerError, "", "internal error")
+}
@@ -0,0 +1,578 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Filter is a parsed SCIM filter expression, see RFC 7644 section 3.4.2.2. Filters are evaluated against the JSON
+// representation of a resource.
+type Filter interface {
+	Matches(resource map[string]interface{}) bool
+}
+
+type logicalFilter struct {
+	and   bool
+	left  Filter
+	right Filter
+}
+
+func (self *logicalFilter) Matches(resource map[string]interface{}) bool {
+	if self.and {
+		return self.left.Matches(resource) && self.right.Matches(resource)
+	}
+	return self.left.Matches(resource) || self.right.Matches(resource)
+}
+
+type notFilter struct {
+	filter Filter
+}
+
+func (self *notFilter) Matches(resource map[string]interface{}) bool {
+	return !self.filter.Matches(resource)
+}
+
+// valuePathFilter matches if any value of a multi-valued attribute matches the nested filter, ex: members[value eq "x"]
+type valuePathFilter struct {
+	path   attributePath
+	filter Filter
+}
+
+func (self *valuePathFilter) Matches(resource map[string]interface{}) bool {
+	for _, value := range self.path.values(resource) {
+		if self.filter.Matches(asComplexValue(value)) {
+			return true
+		}
+	}
+	return false
+}
+
+type attributeFilter struct {
+	path  attributePath
+	op    string
+	value interface{}
+}
+
+func (self *attributeFilter) Matches(resource map[string]interface{}) bool {
+	values := self.path.values(resource)
+
+	switch self.op {
+	case "pr":
+		for _, value := range values {
+			if !isEmptyValue(value) {
+				return true
+			}
+		}
+		return false
+	case "ne":
+		return !(&attributeFilter{path: self.path, op: "eq", value: self.value}).Matches(resource)
+	}
+
+	if self.value == nil {
+		return self.op == "eq" && len(values) == 0
+	}
+
+	for _, value := range values {
+		if compareValue(self.op, value, self.value) {
+			return true
+		}
+	}
+	return false
+}
+
+// attributePath is an attribute name with an optional sub-attribute, ex: name.givenName. Schema URN prefixes are
+// stripped, as the resources served here only have core schema attributes.
+type attributePath struct {
+	attribute    string
+	subAttribute string
+}
+
+func parseAttributePath(path string) (attributePath, error) {
+	if strings.HasPrefix(strings.ToLower(path), "urn:") {
+		idx := strings.LastIndex(path, ":")
+		path = path[idx+1:]
+	}
+
+	attribute, subAttribute, _ := strings.Cut(path, ".")
+	if attribute == "" || strings.Contains(subAttribute, ".") {
+		return attributePath{}, fmt.Errorf("invalid attribute path '%s'", path)
+	}
+
+	return attributePath{attribute: attribute, subAttribute: subAttribute}, nil
+}
+
+func (self attributePath) String() string {
+	if self.subAttribute == "" {
+		return self.attribute
+	}
+	return self.attribute + "." + self.subAttribute
+}
+
+// is reports whether the path refers to the given top level attribute. Attribute names are case-insensitive.
+func (self attributePath) is(attribute string) bool {
+	return self.subAttribute == "" && strings.EqualFold(self.attribute, attribute)
+}
+
+// values returns the values of the attribute, flattening multi-valued attributes
+func (self attributePath) values(resource map[string]interface{}) []interface{} {
+	value, found := getAttribute(resource, self.attribute)
+	if !found {
+		return nil
+	}
+
+	var values []interface{}
+	if list, ok := value.([]interface{}); ok {
+		values = list
+	} else {
+		values = []interface{}{value}
+	}
+
+	if self.subAttribute == "" {
+		return values
+	}
+
+	var result []interface{}
+	for _, value := range values {
+		if complexValue, ok := value.(map[string]interface{}); ok {
+			if subValue, found := getAttribute(complexValue, self.subAttribute); found {
+				result = append(result, subValue)
+			}
+		}
+	}
+	return result
+}
+
+func getAttribute(resource map[string]interface{}, name string) (interface{}, bool) {
+	if value, found := resource[name]; found {
+		return value, true
+	}
+	for key, value := range resource {
+		if strings.EqualFold(key, name) {
+			return value, true
+		}
+	}
+	return nil, false
+}
+
+// asComplexValue allows filtering multi-valued attributes of simple values, whose values are matched as 'value'
+func asComplexValue(value interface{}) map[string]interface{} {
+	if complexValue, ok := value.(map[string]interface{}); ok {
+		return complexValue
+	}
+	return map[string]interface{}{"value": value}
+}
+
+func isEmptyValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+// compareValue compares an attribute value to a filter value. Strings are compared case-insensitively.
+func compareValue(op string, actual, expected interface{}) bool {
+	switch expectedValue := expected.(type) {
+	case string:
+		actualValue, ok := actual.(string)
+		if !ok {
+			return false
+		}
+		actualValue = strings.ToLower(actualValue)
+		expectedValue = strings.ToLower(expectedValue)
+
+		switch op {
+		case "eq":
+			return actualValue == expectedValue
+		case "co":
+			return strings.Contains(actualValue, expectedValue)
+		case "sw":
+			return strings.HasPrefix(actualValue, expectedValue)
+		case "ew":
+			return strings.HasSuffix(actualValue, expectedValue)
+		case "gt":
+			return actualValue > expectedValue
+		case "ge":
+			return actualValue >= expectedValue
+		case "lt":
+			return actualValue < expectedValue
+		case "le":
+			return actualValue <= expectedValue
+		}
+	case float64:
+		actualValue, ok := actual.(float64)
+		if !ok {
+			return false
+		}
+
+		switch op {
+		case "eq":
+			return actualValue == expectedValue
+		case "gt":
+			return actualValue > expectedValue
+		case "ge":
+			return actualValue >= expectedValue
+		case "lt":
+			return actualValue < expectedValue
+		case "le":
+			return actualValue <= expectedValue
+		}
+	case bool:
+		actualValue, ok := actual.(bool)
+		return ok && op == "eq" && actualValue == expectedValue
+	}
+	return false
+}
+
+var filterOperators = map[string]struct{}{
+	"eq": {}, "ne": {}, "co": {}, "sw": {}, "ew": {}, "gt": {}, "ge": {}, "lt": {}, "le": {}, "pr": {},
+}
+
+// ParseFilter parses a SCIM filter expression. Logical operators, grouping, not and value path filters are supported.
+func ParseFilter(filter string) (Filter, error) {
+	parser, err := newFilterParser(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := parser.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if !parser.done() {
+		return nil, fmt.Errorf("unexpected '%s' in filter", parser.peek().text)
+	}
+
+	return result, nil
+}
+
+type filterTokenType int
+
+const (
+	filterTokenWord filterTokenType = iota
+	filterTokenString
+	filterTokenOpenParen
+	filterTokenCloseParen
+	filterTokenOpenBracket
+	filterTokenCloseBracket
+)
+
+type filterToken struct {
+	tokenType filterTokenType
+	text      string
+}
+
+func (self filterToken) isWord(word string) bool {
+	return self.tokenType == filterTokenWord && strings.EqualFold(self.text, word)
+}
+
+type filterParser struct {
+	tokens []filterToken
+	pos    int
+}
+
+func newFilterParser(filter string) (*filterParser, error) {
+	tokens, err := tokenizeFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+	return &filterParser{tokens: tokens}, nil
+}
+
+func tokenizeFilter(filter string) ([]filterToken, error) {
+	var tokens []filterToken
+
+	for i := 0; i < len(filter); {
+		c := filter[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(':
+			tokens = append(tokens, filterToken{tokenType: filterTokenOpenParen, text: "("})
+			i++
+		case c == ')':
+			tokens = append(tokens, filterToken{tokenType: filterTokenCloseParen, text: ")"})
+			i++
+		case c == '[':
+			tokens = append(tokens, filterToken{tokenType: filterTokenOpenBracket, text: "["})
+			i++
+		case c == ']':
+			tokens = append(tokens, filterToken{tokenType: filterTokenCloseBracket, text: "]"})
+			i++
+		case c == '"':
+			end := i + 1
+			for end < len(filter) && filter[end] != '"' {
+				if filter[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(filter) {
+				return nil, fmt.Errorf("unterminated string in filter")
+			}
+
+			var value string
+			if err := json.Unmarshal([]byte(filter[i:end+1]), &value); err != nil {
+				return nil, fmt.Errorf("invalid string %s in filter (%w)", filter[i:end+1], err)
+			}
+			tokens = append(tokens, filterToken{tokenType: filterTokenString, text: value})
+			i = end + 1
+		default:
+			end := i
+			for end < len(filter) && !strings.ContainsRune(" \t\n\r()[]\"", rune(filter[end])) {
+				end++
+			}
+			tokens = append(tokens, filterToken{tokenType: filterTokenWord, text: filter[i:end]})
+			i = end
+		}
+	}
+
+	return tokens, nil
+}
+
+func (self *filterParser) done() bool {
+	return self.pos >= len(self.tokens)
+}
+
+func (self *filterParser) peek() filterToken {
+	if self.done() {
+		return filterToken{tokenType: -1}
+	}
+	return self.tokens[self.pos]
+}
+
+func (self *filterParser) next() (filterToken, error) {
+	if self.done() {
+		return filterToken{}, fmt.Errorf("unexpected end of filter")
+	}
+	token := self.tokens[self.pos]
+	self.pos++
+	return token, nil
+}
+
+func (self *filterParser) expect(tokenType filterTokenType, text string) error {
+	token, err := self.next()
+	if err != nil {
+		return err
+	}
+	if token.tokenType != tokenType {
+		return fmt.Errorf("expected '%s' in filter, found '%s'", text, token.text)
+	}
+	return nil
+}
+
+func (self *filterParser) parseOr() (Filter, error) {
+	left, err := self.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for self.peek().isWord("or") {
+		self.pos++
+		right, err := self.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &logicalFilter{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (self *filterParser) parseAnd() (Filter, error) {
+	left, err := self.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for self.peek().isWord("and") {
+		self.pos++
+		right, err := self.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &logicalFilter{and: true, left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (self *filterParser) parseUnary() (Filter, error) {
+	token, err := self.next()
+	if err != nil {
+		return nil, err
+	}
+
+	if token.isWord("not") {
+		if err = self.expect(filterTokenOpenParen, "("); err != nil {
+			return nil, err
+		}
+		return self.parseGroup(func(filter Filter) Filter {
+			return &notFilter{filter: filter}
+		})
+	}
+
+	if token.tokenType == filterTokenOpenParen {
+		return self.parseGroup(func(filter Filter) Filter {
+			return filter
+		})
+	}
+
+	if token.tokenType != filterTokenWord {
+		return nil, fmt.Errorf("expected attribute in filter, found '%s'", token.text)
+	}
+
+	path, err := parseAttributePath(token.text)
+	if err != nil {
+		return nil, err
+	}
+
+	if self.peek().tokenType == filterTokenOpenBracket {
+		self.pos++
+		nested, err := self.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err = self.expect(filterTokenCloseBracket, "]"); err != nil {
+			return nil, err
+		}
+		return &valuePathFilter{path: path, filter: nested}, nil
+	}
+
+	opToken, err := self.next()
+	if err != nil {
+		return nil, err
+	}
+
+	op := strings.ToLower(opToken.text)
+	if _, ok := filterOperators[op]; !ok || opToken.tokenType != filterTokenWord {
+		return nil, fmt.Errorf("unsupported filter operator '%s'", opToken.text)
+	}
+
+	if op == "pr" {
+		return &attributeFilter{path: path, op: op}, nil
+	}
+
+	value, err := self.parseValue()
+	if err != nil {
+		return nil, err
+	}
+
+	return &attributeFilter{path: path, op: op, value: value}, nil
+}
+
+func (self *filterParser) parseGroup(wrap func(Filter) Filter) (Filter, error) {
+	filter, err := self.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if err = self.expect(filterTokenCloseParen, ")"); err != nil {
+		return nil, err
+	}
+	return wrap(filter), nil
+}
+
+func (self *filterParser) parseValue() (interface{}, error) {
+	token, err := self.next()
+	if err != nil {
+		return nil, err
+	}
+
+	if token.tokenType == filterTokenString {
+		return token.text, nil
+	}
+
+	if token.tokenType == filterTokenWord {
+		switch strings.ToLower(token.text) {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		case "null":
+			return nil, nil
+		}
+
+		if value, err := strconv.ParseFloat(token.text, 64); err == nil {
+			return value, nil
+		}
+	}
+
+	return nil, fmt.Errorf("invalid filter value '%s'", token.text)
+}
+
+// patchPath is the target of a PATCH operation: an attribute path, optionally followed by a value filter and a
+// sub-attribute, ex: members[value eq "x"] or emails[type eq "work"].value
+type patchPath struct {
+	attributePath
+	valueFilter       Filter
+	valueSubAttribute string
+}
+
+func parsePatchPath(path string) (*patchPath, error) {
+	parser, err := newFilterParser(path)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := parser.next()
+	if err != nil || token.tokenType != filterTokenWord {
+		return nil, fmt.Errorf("invalid path '%s'", path)
+	}
+
+	attrPath, err := parseAttributePath(token.text)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &patchPath{attributePath: attrPath}
+
+	if parser.peek().tokenType == filterTokenOpenBracket {
+		if attrPath.subAttribute != "" {
+			return nil, fmt.Errorf("invalid path '%s'", path)
+		}
+
+		parser.pos++
+		if result.valueFilter, err = parser.parseOr(); err != nil {
+			return nil, err
+		}
+		if err = parser.expect(filterTokenCloseBracket, "]"); err != nil {
+			return nil, err
+		}
+
+		if next := parser.peek(); next.tokenType == filterTokenWord && strings.HasPrefix(next.text, ".") {
+			result.valueSubAttribute = next.text[1:]
+			parser.pos++
+		}
+	}
+
+	if !parser.done() {
+		return nil, fmt.Errorf("invalid path '%s'", path)
+	}
+
+	return result, nil
+}
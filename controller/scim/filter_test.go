@@ -0,0 +1,120 @@
+package scim
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Filter(t *testing.T) {
+	var user map[string]interface{}
+	err := json.Unmarshal([]byte(`{
+		"id": "1234",
+		"userName": "Alice@Example.com",
+		"externalId": "alice",
+		"active": true,
+		"groups": [{"value": "engineering"}, {"value": "sales"}],
+		"meta": {"resourceType": "User", "created": "2024-01-02T03:04:05Z"}
+	}`), &user)
+	require.NoError(t, err)
+
+	matches := func(filter string) bool {
+		parsed, err := ParseFilter(filter)
+		require.NoError(t, err, filter)
+		return parsed.Matches(user)
+	}
+
+	t.Run("comparisons are case-insensitive for attribute names and string values", func(t *testing.T) {
+		req := require.New(t)
+		req.True(matches(`userName eq "alice@example.com"`))
+		req.True(matches(`USERNAME Eq "ALICE@EXAMPLE.COM"`))
+		req.True(matches(`urn:ietf:params:scim:schemas:core:2.0:User:userName eq "alice@example.com"`))
+		req.False(matches(`userName eq "bob@example.com"`))
+		req.True(matches(`userName ne "bob@example.com"`))
+	})
+
+	t.Run("string operators", func(t *testing.T) {
+		req := require.New(t)
+		req.True(matches(`userName co "example"`))
+		req.True(matches(`userName sw "alice"`))
+		req.True(matches(`userName ew ".com"`))
+		req.False(matches(`userName sw "example"`))
+		req.True(matches(`meta.created gt "2024-01-01T00:00:00Z"`))
+		req.False(matches(`meta.created lt "2024-01-01T00:00:00Z"`))
+	})
+
+	t.Run("presence, booleans and null", func(t *testing.T) {
+		req := require.New(t)
+		req.True(matches(`externalId pr`))
+		req.False(matches(`title pr`))
+		req.True(matches(`active eq true`))
+		req.False(matches(`active eq false`))
+		req.True(matches(`title eq null`))
+		req.False(matches(`externalId eq null`))
+	})
+
+	t.Run("multi-valued attributes", func(t *testing.T) {
+		req := require.New(t)
+		req.True(matches(`groups.value eq "sales"`))
+		req.True(matches(`groups[value eq "engineering"]`))
+		req.False(matches(`groups[value eq "marketing"]`))
+	})
+
+	t.Run("logical operators and grouping", func(t *testing.T) {
+		req := require.New(t)
+		req.True(matches(`userName sw "alice" and active eq true`))
+		req.False(matches(`userName sw "alice" and active eq false`))
+		req.True(matches(`userName sw "bob" or externalId eq "alice"`))
+		req.True(matches(`not (userName sw "bob")`))
+		req.True(matches(`active eq false or (externalId eq "alice" and groups[value eq "sales"])`))
+		// and binds tighter than or
+		req.True(matches(`externalId eq "alice" or active eq false and userName eq "bob"`))
+	})
+
+	t.Run("invalid filters are rejected", func(t *testing.T) {
+		req := require.New(t)
+		for _, filter := range []string{
+			``,
+			`userName`,
+			`userName eq`,
+			`userName is "alice"`,
+			`userName eq alice`,
+			`userName eq "alice`,
+			`(userName eq "alice"`,
+			`groups[value eq "sales"`,
+			`userName eq "alice" and`,
+			`userName eq "alice" extra`,
+		} {
+			_, err := ParseFilter(filter)
+			req.Error(err, filter)
+		}
+	})
+}
+
+func Test_PatchPath(t *testing.T) {
+	req := require.New(t)
+
+	path, err := parsePatchPath(`members[value eq "1234"]`)
+	req.NoError(err)
+	req.True(path.is("members"))
+	req.True(path.valueFilter.Matches(map[string]interface{}{"value": "1234"}))
+	req.False(path.valueFilter.Matches(map[string]interface{}{"value": "5678"}))
+
+	path, err = parsePatchPath(`emails[type eq "work"].value`)
+	req.NoError(err)
+	req.Equal("emails", path.attribute)
+	req.Equal("value", path.valueSubAttribute)
+
+	path, err = parsePatchPath(`name.givenName`)
+	req.NoError(err)
+	req.Equal("name", path.attribute)
+	req.Equal("givenName", path.subAttribute)
+	req.Nil(path.valueFilter)
+
+	_, err = parsePatchPath(`members[value eq "1234"`)
+	req.Error(err)
+
+	_, err = parsePatchPath(`members extra`)
+	req.Error(err)
+}
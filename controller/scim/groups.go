@@ -0,0 +1,380 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package scim
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/openziti/ziti/controller/change"
+	"github.com/openziti/ziti/controller/db"
+	"github.com/openziti/ziti/controller/fields"
+	"github.com/openziti/ziti/controller/model"
+	"github.com/openziti/ziti/controller/models"
+)
+
+// Group is the SCIM representation of an identity role attribute, see RFC 7643 section 4.2. A group has no state of
+// its own: its members are the SCIM users with the role attribute, and its id is its name. Groups therefore can't be
+// renamed, and a group without members only exists as far as it can be read by id.
+type Group struct {
+	Schemas     []string    `json:"schemas"`
+	Id          string      `json:"id"`
+	DisplayName string      `json:"displayName"`
+	Members     []Reference `json:"members"`
+	Meta        Meta        `json:"meta"`
+}
+
+type groupRequest struct {
+	DisplayName string      `json:"displayName"`
+	Members     []Reference `json:"members"`
+}
+
+type groupHandler struct {
+	*Handler
+}
+
+func (self *Handler) groupAttribute(groupName string) string {
+	return self.config.GroupAttributePrefix + groupName
+}
+
+// groupName returns the name of the group represented by the role attribute, if the attribute represents a group
+func (self *Handler) groupName(attribute string) (string, bool) {
+	if !strings.HasPrefix(attribute, self.config.GroupAttributePrefix) || len(attribute) == len(self.config.GroupAttributePrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(attribute, self.config.GroupAttributePrefix), true
+}
+
+func (self *groupHandler) toGroup(r *http.Request, groupName string, members []*model.Identity) *Group {
+	group := &Group{
+		Schemas:     []string{SchemaGroup},
+		Id:          groupName,
+		DisplayName: groupName,
+		Members:     []Reference{},
+		Meta: Meta{
+			ResourceType: ResourceTypeGroup,
+			Location:     resourceLocation(r, "Groups", groupName),
+		},
+	}
+
+	for _, member := range members {
+		group.Members = append(group.Members, Reference{
+			Value:   member.Id,
+			Ref:     resourceLocation(r, "Users", member.Id),
+			Display: member.Name,
+		})
+	}
+
+	return group
+}
+
+// listMembers returns the members of all groups, by group name
+func (self *groupHandler) listMembers() (map[string][]*model.Identity, error) {
+	identities, err := self.listUserIdentities()
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string][]*model.Identity{}
+	for _, identity := range identities {
+		for _, attribute := range identity.RoleAttributes {
+			if groupName, ok := self.groupName(attribute); ok {
+				result[groupName] = append(result[groupName], identity)
+			}
+		}
+	}
+	return result, nil
+}
+
+func (self *groupHandler) readMembers(groupName string) ([]*model.Identity, error) {
+	members, err := self.listMembers()
+	if err != nil {
+		return nil, err
+	}
+	return members[groupName], nil
+}
+
+func (self *groupHandler) list(r *http.Request, filter Filter) ([]interface{}, error) {
+	members, err := self.listMembers()
+	if err != nil {
+		return nil, err
+	}
+
+	var groupNames []string
+	for groupName := range members {
+		groupNames = append(groupNames, groupName)
+	}
+	sort.Strings(groupNames)
+
+	var groups []*Group
+	for _, groupName := range groupNames {
+		groups = append(groups, self.toGroup(r, groupName, members[groupName]))
+	}
+
+	return filterResources(groups, filter)
+}
+
+func (self *groupHandler) get(r *http.Request, id string) (interface{}, error) {
+	members, err := self.readMembers(id)
+	if err != nil {
+		return nil, err
+	}
+	return self.toGroup(r, id, members), nil
+}
+
+func parseGroupRequest(body []byte) (*groupRequest, error) {
+	request := &groupRequest{}
+	if err := json.Unmarshal(body, request); err != nil {
+		return nil, newBadRequestError(ScimTypeInvalidSyntax, "invalid group (%v)", err)
+	}
+
+	if request.DisplayName == "" {
+		return nil, newBadRequestError(ScimTypeInvalidValue, "displayName is required")
+	}
+
+	return request, nil
+}
+
+func (self *groupHandler) create(r *http.Request, body []byte, ctx *change.Context) (interface{}, error) {
+	request, err := parseGroupRequest(body)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := self.readMembers(request.DisplayName)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(members) > 0 {
+		return nil, NewError(http.StatusConflict, ScimTypeUniqueness, "group %s already exists", request.DisplayName)
+	}
+
+	if err = self.setMembers(request.DisplayName, nil, referenceIds(request.Members), ctx); err != nil {
+		return nil, err
+	}
+
+	return self.get(r, request.DisplayName)
+}
+
+func (self *groupHandler) replace(r *http.Request, id string, body []byte, ctx *change.Context) (interface{}, error) {
+	request, err := parseGroupRequest(body)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = checkGroupName(id, request.DisplayName); err != nil {
+		return nil, err
+	}
+
+	members, err := self.readMembers(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = self.setMembers(id, members, referenceIds(request.Members), ctx); err != nil {
+		return nil, err
+	}
+
+	return self.get(r, id)
+}
+
+func (self *groupHandler) patch(r *http.Request, id string, operations []PatchOperation, ctx *change.Context) (interface{}, error) {
+	members, err := self.readMembers(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var memberIds []string
+	for _, member := range members {
+		memberIds = append(memberIds, member.Id)
+	}
+
+	for _, operation := range operations {
+		if operation.Path == nil {
+			attributes, err := operation.valueAttributes()
+			if err != nil {
+				return nil, err
+			}
+
+			for name, value := range attributes {
+				path, err := parseAttributePath(name)
+				if err != nil {
+					return nil, newBadRequestError(ScimTypeInvalidPath, err.Error())
+				}
+				if memberIds, err = patchGroupAttribute(id, memberIds, operation.Op, path, value); err != nil {
+					return nil, err
+				}
+			}
+			continue
+		}
+
+		if operation.Path.valueFilter != nil {
+			if !operation.Path.is("members") {
+				continue
+			}
+
+			if operation.Op != PatchOpRemove || operation.Path.valueSubAttribute != "" {
+				return nil, newBadRequestError(ScimTypeInvalidPath, "only remove is supported for filtered member paths")
+			}
+
+			memberIds = slices.DeleteFunc(memberIds, func(memberId string) bool {
+				return operation.Path.valueFilter.Matches(map[string]interface{}{"value": memberId})
+			})
+			continue
+		}
+
+		if memberIds, err = patchGroupAttribute(id, memberIds, operation.Op, operation.Path.attributePath, operation.Value); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = self.setMembers(id, members, memberIds, ctx); err != nil {
+		return nil, err
+	}
+
+	return self.get(r, id)
+}
+
+// patchGroupAttribute applies a patch operation to the group with the given member ids and returns the new member ids.
+// Attributes other than members and displayName are ignored.
+func patchGroupAttribute(id string, memberIds []string, op string, path attributePath, value json.RawMessage) ([]string, error) {
+	switch {
+	case path.is("displayName"):
+		if op == PatchOpRemove {
+			return nil, newBadRequestError(ScimTypeMutability, "displayName can't be removed")
+		}
+		displayName, err := decodeString("displayName", value)
+		if err != nil {
+			return nil, err
+		}
+		return memberIds, checkGroupName(id, displayName)
+	case path.is("members"):
+		var ids []string
+		if len(value) > 0 {
+			references, err := decodeReferences("members", value)
+			if err != nil {
+				return nil, err
+			}
+			ids = referenceIds(references)
+		}
+
+		switch op {
+		case PatchOpAdd:
+			for _, memberId := range ids {
+				if !slices.Contains(memberIds, memberId) {
+					memberIds = append(memberIds, memberId)
+				}
+			}
+			return memberIds, nil
+		case PatchOpReplace:
+			return ids, nil
+		default:
+			// removing members without a value removes all members
+			if len(value) == 0 {
+				return nil, nil
+			}
+			return slices.DeleteFunc(memberIds, func(memberId string) bool {
+				return slices.Contains(ids, memberId)
+			}), nil
+		}
+	case strings.EqualFold(path.attribute, "id"):
+		return nil, newBadRequestError(ScimTypeMutability, "id is read only")
+	}
+
+	return memberIds, nil
+}
+
+func (self *groupHandler) delete(id string, ctx *change.Context) error {
+	members, err := self.readMembers(id)
+	if err != nil {
+		return err
+	}
+	return self.setMembers(id, members, nil, ctx)
+}
+
+// setMembers adds or removes the group's role attribute, so that exactly the given identities are members. All
+// members are validated before any identity is updated.
+func (self *groupHandler) setMembers(groupName string, current []*model.Identity, memberIds []string, ctx *change.Context) error {
+	attribute := self.groupAttribute(groupName)
+
+	var added []*model.Identity
+	for _, memberId := range memberIds {
+		if slices.ContainsFunc(current, func(identity *model.Identity) bool { return identity.Id == memberId }) {
+			continue
+		}
+
+		identity, err := self.readUserIdentity(memberId)
+		if err != nil {
+			if scimErr := toError(err); scimErr.statusCode() == http.StatusNotFound {
+				return newBadRequestError(ScimTypeInvalidValue, "member %s is not a user", memberId)
+			}
+			return err
+		}
+		added = append(added, identity)
+	}
+
+	for _, identity := range added {
+		if err := self.updateRoleAttributes(identity, append(identity.RoleAttributes, attribute), ctx); err != nil {
+			return err
+		}
+	}
+
+	for _, identity := range current {
+		if slices.Contains(memberIds, identity.Id) {
+			continue
+		}
+
+		roleAttributes := slices.DeleteFunc(slices.Clone(identity.RoleAttributes), func(roleAttribute string) bool {
+			return roleAttribute == attribute
+		})
+
+		if err := self.updateRoleAttributes(identity, roleAttributes, ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (self *groupHandler) updateRoleAttributes(identity *model.Identity, roleAttributes []string, ctx *change.Context) error {
+	update := &model.Identity{
+		BaseEntity:     models.BaseEntity{Id: identity.Id},
+		RoleAttributes: roleAttributes,
+	}
+	return self.env.GetManagers().Identity.Update(update, fields.UpdatedFieldsMap{db.FieldRoleAttributes: struct{}{}}, ctx)
+}
+
+func checkGroupName(id, displayName string) error {
+	if displayName != id {
+		return newBadRequestError(ScimTypeMutability, "groups can't be renamed, group %s can't be renamed to %s", id, displayName)
+	}
+	return nil
+}
+
+func referenceIds(references []Reference) []string {
+	var result []string
+	for _, reference := range references {
+		if reference.Value != "" && !slices.Contains(result, reference.Value) {
+			result = append(result, reference.Value)
+		}
+	}
+	return result
+}
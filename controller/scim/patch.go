@@ -0,0 +1,137 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package scim
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+const (
+	PatchOpAdd     = "add"
+	PatchOpRemove  = "remove"
+	PatchOpReplace = "replace"
+)
+
+// PatchOperation is a single operation of a PATCH request, see RFC 7644 section 3.5.2
+type PatchOperation struct {
+	Op    string
+	Path  *patchPath
+	Value json.RawMessage
+}
+
+type patchRequest struct {
+	Schemas    []string `json:"schemas"`
+	Operations []struct {
+		Op    string          `json:"op"`
+		Path  string          `json:"path"`
+		Value json.RawMessage `json:"value"`
+	} `json:"Operations"`
+}
+
+func parsePatchRequest(body []byte) ([]PatchOperation, error) {
+	request := &patchRequest{}
+	if err := json.Unmarshal(body, request); err != nil {
+		return nil, newBadRequestError(ScimTypeInvalidSyntax, "invalid patch request (%v)", err)
+	}
+
+	if len(request.Operations) == 0 {
+		return nil, newBadRequestError(ScimTypeInvalidSyntax, "patch request has no operations")
+	}
+
+	var result []PatchOperation
+	for _, operation := range request.Operations {
+		// some identity providers capitalize operations
+		op := strings.ToLower(operation.Op)
+		if op != PatchOpAdd && op != PatchOpRemove && op != PatchOpReplace {
+			return nil, newBadRequestError(ScimTypeInvalidSyntax, "unsupported patch operation '%s'", operation.Op)
+		}
+
+		patchOperation := PatchOperation{
+			Op:    op,
+			Value: operation.Value,
+		}
+
+		if operation.Path != "" {
+			path, err := parsePatchPath(operation.Path)
+			if err != nil {
+				return nil, newBadRequestError(ScimTypeInvalidPath, err.Error())
+			}
+			patchOperation.Path = path
+		} else if op == PatchOpRemove {
+			return nil, newBadRequestError(ScimTypeNoTarget, "remove operations require a path")
+		}
+
+		if op != PatchOpRemove && len(operation.Value) == 0 {
+			return nil, newBadRequestError(ScimTypeInvalidValue, "%s operation requires a value", op)
+		}
+
+		result = append(result, patchOperation)
+	}
+
+	return result, nil
+}
+
+// valueAttributes returns the value of an operation without a path, which must be an object of attributes
+func (self *PatchOperation) valueAttributes() (map[string]json.RawMessage, error) {
+	attributes := map[string]json.RawMessage{}
+	if err := json.Unmarshal(self.Value, &attributes); err != nil {
+		return nil, newBadRequestError(ScimTypeInvalidValue, "%s operation without a path requires an object value", self.Op)
+	}
+	return attributes, nil
+}
+
+func decodeString(attribute string, value json.RawMessage) (string, error) {
+	var result string
+	if err := json.Unmarshal(value, &result); err != nil {
+		return "", newBadRequestError(ScimTypeInvalidValue, "%s must be a string", attribute)
+	}
+	return result, nil
+}
+
+// decodeBool decodes a boolean attribute. Some identity providers send booleans as strings, ex: "False".
+func decodeBool(attribute string, value json.RawMessage) (bool, error) {
+	var result bool
+	if err := json.Unmarshal(value, &result); err == nil {
+		return result, nil
+	}
+
+	var stringValue string
+	if err := json.Unmarshal(value, &stringValue); err == nil {
+		if result, err = strconv.ParseBool(stringValue); err == nil {
+			return result, nil
+		}
+	}
+
+	return false, newBadRequestError(ScimTypeInvalidValue, "%s must be a boolean", attribute)
+}
+
+// decodeReferences decodes the value of a multi-valued reference attribute. A single reference is accepted as well.
+func decodeReferences(attribute string, value json.RawMessage) ([]Reference, error) {
+	var result []Reference
+	if err := json.Unmarshal(value, &result); err == nil {
+		return result, nil
+	}
+
+	var single Reference
+	if err := json.Unmarshal(value, &single); err == nil {
+		return []Reference{single}, nil
+	}
+
+	return nil, newBadRequestError(ScimTypeInvalidValue, "%s must be a list of objects with a value", attribute)
+}
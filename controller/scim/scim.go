@@ -0,0 +1,399 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+// Package scim implements a SCIM 2.0 (RFC 7643, RFC 7644) service provider, allowing identity providers to provision
+// identities. SCIM Users map to identities with an external id, which ext-jwt signers use to match tokens to identities.
+// SCIM Groups map to identity role attributes, so group membership can be used in policies.
+package scim
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openziti/ziti/controller/change"
+	"github.com/openziti/ziti/controller/model"
+)
+
+const (
+	RootPath    = "/scim/v2"
+	ContentType = "application/scim+json"
+
+	SchemaUser                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	SchemaGroup                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	SchemaListResponse          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	SchemaPatchOp               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	SchemaError                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+	SchemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	SchemaResourceType          = "urn:ietf:params:scim:schemas:core:2.0:ResourceType"
+	SchemaSchema                = "urn:ietf:params:scim:schemas:core:2.0:Schema"
+
+	ResourceTypeUser  = "User"
+	ResourceTypeGroup = "Group"
+
+	// DefaultPageSize is the number of resources returned by list requests which don't specify a count
+	DefaultPageSize = 100
+	MaxPageSize     = 1000
+
+	maxRequestSize = 1024 * 1024
+)
+
+// Config configures how SCIM resources are mapped to identities
+type Config struct {
+	// AuthPolicyId is the auth policy assigned to identities created through SCIM
+	AuthPolicyId string
+
+	// IdentityTypeId is the identity type assigned to identities created through SCIM
+	IdentityTypeId string
+
+	// GroupAttributePrefix is prepended to group names to get the role attribute representing the group. A prefix
+	// keeps role attributes managed by the identity provider apart from role attributes managed by administrators.
+	GroupAttributePrefix string
+}
+
+// Handler serves the SCIM Users, Groups and discovery endpoints below RootPath. Callers are responsible for
+// authenticating requests and are expected to attach a change.Context to the request context.
+type Handler struct {
+	env    model.Env
+	config Config
+}
+
+func NewHandler(env model.Env, config Config) *Handler {
+	return &Handler{
+		env:    env,
+		config: config,
+	}
+}
+
+func (self *Handler) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.EscapedPath(), RootPath)
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	if len(segments) > 2 {
+		WriteError(rw, NewError(http.StatusNotFound, "", "unknown resource %s", r.URL.Path))
+		return
+	}
+
+	var id string
+	if len(segments) == 2 {
+		var err error
+		if id, err = url.PathUnescape(segments[1]); err != nil || id == "" {
+			WriteError(rw, NewError(http.StatusNotFound, "", "unknown resource %s", r.URL.Path))
+			return
+		}
+	}
+
+	switch segments[0] {
+	case "Users":
+		self.serveResource(rw, r, id, &userHandler{Handler: self})
+	case "Groups":
+		self.serveResource(rw, r, id, &groupHandler{Handler: self})
+	case "ServiceProviderConfig":
+		self.serveDiscovery(rw, r, id, serviceProviderConfig)
+	case "ResourceTypes":
+		self.serveDiscovery(rw, r, id, resourceTypes)
+	case "Schemas":
+		self.serveDiscovery(rw, r, id, schemas)
+	default:
+		WriteError(rw, NewError(http.StatusNotFound, "", "unknown resource %s", r.URL.Path))
+	}
+}
+
+// resourceHandler implements the operations of a SCIM resource type
+type resourceHandler interface {
+	list(r *http.Request, filter Filter) ([]interface{}, error)
+	get(r *http.Request, id string) (interface{}, error)
+	create(r *http.Request, body []byte, ctx *change.Context) (interface{}, error)
+	replace(r *http.Request, id string, body []byte, ctx *change.Context) (interface{}, error)
+	patch(r *http.Request, id string, operations []PatchOperation, ctx *change.Context) (interface{}, error)
+	delete(id string, ctx *change.Context) error
+}
+
+func (self *Handler) serveResource(rw http.ResponseWriter, r *http.Request, id string, handler resourceHandler) {
+	ctx := change.FromContext(r.Context())
+	if ctx == nil {
+		ctx = change.New().SetSourceType(change.SourceTypeRest).SetChangeAuthorType(change.AuthorTypeUnattributed)
+	}
+
+	var body []byte
+	if r.Method == http.MethodPost || r.Method == http.MethodPut || r.Method == http.MethodPatch {
+		var err error
+		if body, err = io.ReadAll(http.MaxBytesReader(rw, r.Body, maxRequestSize)); err != nil {
+			WriteError(rw, newBadRequestError(ScimTypeInvalidSyntax, "unable to read request body (%v)", err))
+			return
+		}
+	}
+
+	var result interface{}
+	var err error
+	status := http.StatusOK
+
+	switch {
+	case id == "" && r.Method == http.MethodGet:
+		self.serveList(rw, r, handler)
+		return
+	case id == "" && r.Method == http.MethodPost:
+		result, err = handler.create(r, body, ctx)
+		status = http.StatusCreated
+	case id != "" && r.Method == http.MethodGet:
+		result, err = handler.get(r, id)
+	case id != "" && r.Method == http.MethodPut:
+		result, err = handler.replace(r, id, body, ctx)
+	case id != "" && r.Method == http.MethodPatch:
+		var operations []PatchOperation
+		if operations, err = parsePatchRequest(body); err == nil {
+			result, err = handler.patch(r, id, operations, ctx)
+		}
+	case id != "" && r.Method == http.MethodDelete:
+		if err = handler.delete(id, ctx); err == nil {
+			rw.WriteHeader(http.StatusNoContent)
+			return
+		}
+	default:
+		WriteError(rw, NewError(http.StatusMethodNotAllowed, "", "method %s not supported for %s", r.Method, r.URL.Path))
+		return
+	}
+
+	if err != nil {
+		WriteError(rw, toError(err))
+		return
+	}
+
+	resource, err := selectAttributes(r, result)
+	if err != nil {
+		WriteError(rw, toError(err))
+		return
+	}
+
+	if status == http.StatusCreated {
+		if meta, ok := resource["meta"].(map[string]interface{}); ok {
+			if location, ok := meta["location"].(string); ok {
+				rw.Header().Set("Location", location)
+			}
+		}
+	}
+
+	writeJson(rw, status, resource)
+}
+
+// ListResponse is the result of querying resources, see RFC 7644 section 3.4.2
+type ListResponse struct {
+	Schemas      []string      `json:"schemas"`
+	TotalResults int           `json:"totalResults"`
+	StartIndex   int           `json:"startIndex"`
+	ItemsPerPage int           `json:"itemsPerPage"`
+	Resources    []interface{} `json:"Resources"`
+}
+
+func (self *Handler) serveList(rw http.ResponseWriter, r *http.Request, handler resourceHandler) {
+	query := r.URL.Query()
+
+	var filter Filter
+	if filterString := query.Get("filter"); filterString != "" {
+		var err error
+		if filter, err = ParseFilter(filterString); err != nil {
+			WriteError(rw, newBadRequestError(ScimTypeInvalidFilter, err.Error()))
+			return
+		}
+	}
+
+	startIndex, paramErr := parsePositiveQueryParam(query, "startIndex", 1)
+	if paramErr != nil {
+		WriteError(rw, paramErr)
+		return
+	}
+
+	count, paramErr := parsePositiveQueryParam(query, "count", DefaultPageSize)
+	if paramErr != nil {
+		WriteError(rw, paramErr)
+		return
+	}
+	if count > MaxPageSize {
+		count = MaxPageSize
+	}
+
+	resources, err := handler.list(r, filter)
+	if err != nil {
+		WriteError(rw, toError(err))
+		return
+	}
+
+	result := &ListResponse{
+		Schemas:      []string{SchemaListResponse},
+		TotalResults: len(resources),
+		StartIndex:   startIndex,
+		Resources:    []interface{}{},
+	}
+
+	for i := startIndex - 1; i < len(resources) && len(result.Resources) < count; i++ {
+		resource, err := selectAttributes(r, resources[i])
+		if err != nil {
+			WriteError(rw, toError(err))
+			return
+		}
+		result.Resources = append(result.Resources, resource)
+	}
+	result.ItemsPerPage = len(result.Resources)
+
+	writeJson(rw, http.StatusOK, result)
+}
+
+// parsePositiveQueryParam parses a paging parameter. Per RFC 7644 section 3.4.2.4, values less than one are treated
+// as one for startIndex and as zero for count.
+func parsePositiveQueryParam(query url.Values, name string, defaultValue int) (int, *Error) {
+	value := query.Get(name)
+	if value == "" {
+		return defaultValue, nil
+	}
+
+	result, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, newBadRequestError(ScimTypeInvalidValue, "invalid %s '%s'", name, value)
+	}
+
+	if result < 1 && name == "startIndex" {
+		return 1, nil
+	}
+
+	if result < 0 {
+		return 0, nil
+	}
+
+	return result, nil
+}
+
+// filterResources returns the resources matching the filter, in their JSON representation
+func filterResources[T any](resources []T, filter Filter) ([]interface{}, error) {
+	result := []interface{}{}
+	for _, resource := range resources {
+		if filter != nil {
+			attributes, err := toAttributes(resource)
+			if err != nil {
+				return nil, err
+			}
+			if !filter.Matches(attributes) {
+				continue
+			}
+		}
+		result = append(result, resource)
+	}
+	return result, nil
+}
+
+// selectAttributes returns the JSON representation of a resource, applying the attributes and excludedAttributes
+// request parameters, see RFC 7644 section 3.9. Only top level attributes can be selected.
+func selectAttributes(r *http.Request, resource interface{}) (map[string]interface{}, error) {
+	attributes, err := toAttributes(resource)
+	if err != nil {
+		return nil, err
+	}
+
+	query := r.URL.Query()
+	alwaysReturned := []string{"schemas", "id"}
+
+	if included := query.Get("attributes"); included != "" {
+		result := map[string]interface{}{}
+		for _, name := range append(splitAttributeList(included), alwaysReturned...) {
+			for key, value := range attributes {
+				if strings.EqualFold(key, name) {
+					result[key] = value
+				}
+			}
+		}
+		return result, nil
+	}
+
+	for _, name := range splitAttributeList(query.Get("excludedAttributes")) {
+		for key := range attributes {
+			if strings.EqualFold(key, name) && !strings.EqualFold(key, "id") && !strings.EqualFold(key, "schemas") {
+				delete(attributes, key)
+			}
+		}
+	}
+
+	return attributes, nil
+}
+
+func splitAttributeList(list string) []string {
+	var result []string
+	for _, name := range strings.Split(list, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			if path, err := parseAttributePath(name); err == nil {
+				result = append(result, path.attribute)
+			}
+		}
+	}
+	return result
+}
+
+func toAttributes(resource interface{}) (map[string]interface{}, error) {
+	if attributes, ok := resource.(map[string]interface{}); ok {
+		return attributes, nil
+	}
+
+	bytes, err := json.Marshal(resource)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := map[string]interface{}{}
+	if err = json.Unmarshal(bytes, &attributes); err != nil {
+		return nil, err
+	}
+	return attributes, nil
+}
+
+// Meta is the resource metadata common to all resources, see RFC 7643 section 3.1
+type Meta struct {
+	ResourceType string     `json:"resourceType"`
+	Created      *time.Time `json:"created,omitempty"`
+	LastModified *time.Time `json:"lastModified,omitempty"`
+	Location     string     `json:"location,omitempty"`
+}
+
+// Reference is a value of a multi-valued attribute referring to another resource, such as a group member
+type Reference struct {
+	Value   string `json:"value"`
+	Ref     string `json:"$ref,omitempty"`
+	Display string `json:"display,omitempty"`
+}
+
+// resourceLocation returns the URL of the resource, based on the address the request was received on
+func resourceLocation(r *http.Request, resourcePath string, id string) string {
+	scheme := "https"
+	if r.TLS == nil {
+		scheme = "http"
+	}
+	return scheme + "://" + r.Host + RootPath + "/" + resourcePath + "/" + url.PathEscape(id)
+}
+
+func writeJson(rw http.ResponseWriter, status int, value interface{}) {
+	rw.Header().Set("content-type", ContentType)
+	rw.WriteHeader(status)
+	_ = json.NewEncoder(rw).Encode(value)
+}
+
+// WriteError writes a SCIM error response
+func WriteError(rw http.ResponseWriter, err *Error) {
+	if err.statusCode() == http.StatusUnauthorized {
+		rw.Header().Set("WWW-Authenticate", "Bearer")
+	}
+	writeJson(rw, err.statusCode(), err)
+}
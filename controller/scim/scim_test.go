@@ -0,0 +1,218 @@
+package scim
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/openziti/ziti/common/eid"
+	"github.com/openziti/ziti/controller/change"
+	"github.com/openziti/ziti/controller/db"
+	"github.com/openziti/ziti/controller/model"
+)
+
+type testContext struct {
+	*model.TestContext
+	handler *Handler
+}
+
+func (ctx *testContext) request(method, path string, body string) (int, map[string]interface{}) {
+	request := httptest.NewRequest(method, "https://ctrl.example.com"+RootPath+path, bytes.NewReader([]byte(body)))
+	request = request.WithContext(change.New().AddToContext(request.Context()))
+	recorder := httptest.NewRecorder()
+	ctx.handler.ServeHTTP(recorder, request)
+
+	var result map[string]interface{}
+	if recorder.Body.Len() > 0 {
+		ctx.NoError(json.Unmarshal(recorder.Body.Bytes(), &result), recorder.Body.String())
+	}
+	return recorder.Code, result
+}
+
+func (ctx *testContext) requireStatus(expected int, method, path, body string) map[string]interface{} {
+	status, result := ctx.request(method, path, body)
+	ctx.Equal(expected, status, "%s %s: %v", method, path, result)
+	return result
+}
+
+func (ctx *testContext) list(path string) []interface{} {
+	result := ctx.requireStatus(http.StatusOK, http.MethodGet, path, "")
+	resources, _ := result["Resources"].([]interface{})
+	return resources
+}
+
+func (ctx *testContext) readIdentity(id string) *model.Identity {
+	identity, err := ctx.GetManagers().Identity.Read(id)
+	ctx.NoError(err)
+	return identity
+}
+
+func Test_Scim(t *testing.T) {
+	modelCtx := model.NewTestContext(t)
+	defer modelCtx.Cleanup()
+	modelCtx.Init()
+
+	ctx := &testContext{
+		TestContext: modelCtx,
+		handler: NewHandler(modelCtx, Config{
+			AuthPolicyId:         db.DefaultAuthPolicyId,
+			IdentityTypeId:       db.DefaultIdentityType,
+			GroupAttributePrefix: "idp.",
+		}),
+	}
+
+	// identities without an external id aren't managed by the identity provider
+	unmanaged := &model.Identity{
+		Name:           eid.New(),
+		IdentityTypeId: db.DefaultIdentityType,
+		AuthPolicyId:   db.DefaultAuthPolicyId,
+		RoleAttributes: []string{"idp.engineering"},
+	}
+	ctx.NoError(ctx.GetManagers().Identity.Create(unmanaged, change.New()))
+
+	// admins can't be managed through SCIM, even if they have an external id
+	adminExternalId := eid.New()
+	admin := &model.Identity{
+		Name:           eid.New(),
+		IdentityTypeId: db.DefaultIdentityType,
+		AuthPolicyId:   db.DefaultAuthPolicyId,
+		IsAdmin:        true,
+		ExternalId:     &adminExternalId,
+	}
+	ctx.NoError(ctx.GetManagers().Identity.Create(admin, change.New()))
+
+	var aliceId, bobId string
+
+	t.Run("users are created as identities with external ids", func(t *testing.T) {
+		ctx.NextTest(t)
+
+		alice := ctx.requireStatus(http.StatusCreated, http.MethodPost, "/Users",
+			`{"schemas": ["`+SchemaUser+`"], "userName": "alice@example.com", "externalId": "00u1", "active": true, "name": {"givenName": "Alice"}}`)
+		aliceId = alice["id"].(string)
+		ctx.Equal("alice@example.com", alice["userName"])
+		ctx.Equal("00u1", alice["externalId"])
+		ctx.Equal(true, alice["active"])
+
+		identity := ctx.readIdentity(aliceId)
+		ctx.Equal("alice@example.com", identity.Name)
+		ctx.Equal("00u1", *identity.ExternalId)
+		ctx.Equal(db.DefaultAuthPolicyId, identity.AuthPolicyId)
+
+		// the user name is used as external id if the identity provider doesn't send one
+		bob := ctx.requireStatus(http.StatusCreated, http.MethodPost, "/Users", `{"userName": "bob@example.com", "active": "False"}`)
+		bobId = bob["id"].(string)
+		ctx.Equal("bob@example.com", bob["externalId"])
+		ctx.Equal(false, bob["active"])
+		ctx.True(ctx.readIdentity(bobId).Disabled)
+
+		ctx.requireStatus(http.StatusConflict, http.MethodPost, "/Users", `{"userName": "alice@example.com", "externalId": "00u3"}`)
+		ctx.requireStatus(http.StatusBadRequest, http.MethodPost, "/Users", `{"externalId": "00u4"}`)
+	})
+
+	t.Run("users can be listed and filtered", func(t *testing.T) {
+		ctx.NextTest(t)
+
+		users := ctx.list("/Users")
+		ctx.Len(users, 2)
+
+		users = ctx.list("/Users?filter=" + url.QueryEscape(`userName eq "ALICE@example.com"`))
+		ctx.Len(users, 1)
+		ctx.Equal(aliceId, users[0].(map[string]interface{})["id"])
+
+		users = ctx.list("/Users?startIndex=2&count=5")
+		ctx.Len(users, 1)
+		ctx.Equal(bobId, users[0].(map[string]interface{})["id"])
+
+		ctx.requireStatus(http.StatusBadRequest, http.MethodGet, "/Users?filter="+url.QueryEscape(`userName eq`), "")
+		ctx.requireStatus(http.StatusNotFound, http.MethodGet, "/Users/"+unmanaged.Id, "")
+		ctx.requireStatus(http.StatusNotFound, http.MethodGet, "/Users/"+admin.Id, "")
+		ctx.requireStatus(http.StatusNotFound, http.MethodDelete, "/Users/"+admin.Id, "")
+	})
+
+	t.Run("users can be deactivated and updated", func(t *testing.T) {
+		ctx.NextTest(t)
+
+		alice := ctx.requireStatus(http.StatusOK, http.MethodPatch, "/Users/"+aliceId,
+			`{"schemas": ["`+SchemaPatchOp+`"], "Operations": [{"op": "Replace", "path": "active", "value": "False"}]}`)
+		ctx.Equal(false, alice["active"])
+		ctx.True(ctx.readIdentity(aliceId).Disabled)
+
+		alice = ctx.requireStatus(http.StatusOK, http.MethodPatch, "/Users/"+aliceId,
+			`{"Operations": [{"op": "replace", "value": {"active": true, "userName": "alice@example.org"}}]}`)
+		ctx.Equal(true, alice["active"])
+		ctx.Equal("alice@example.org", alice["userName"])
+		ctx.False(ctx.readIdentity(aliceId).Disabled)
+
+		alice = ctx.requireStatus(http.StatusOK, http.MethodPut, "/Users/"+aliceId, `{"userName": "alice@example.com", "externalId": "00u5"}`)
+		ctx.Equal("alice@example.com", alice["userName"])
+		ctx.Equal("00u5", *ctx.readIdentity(aliceId).ExternalId)
+
+		ctx.requireStatus(http.StatusBadRequest, http.MethodPatch, "/Users/"+aliceId, `{"Operations": [{"op": "remove", "path": "externalId"}]}`)
+	})
+
+	t.Run("groups map to role attributes", func(t *testing.T) {
+		ctx.NextTest(t)
+
+		group := ctx.requireStatus(http.StatusCreated, http.MethodPost, "/Groups",
+			`{"displayName": "engineering", "members": [{"value": "`+aliceId+`"}]}`)
+		ctx.Equal("engineering", group["id"])
+		ctx.Len(group["members"], 1)
+		ctx.Equal([]string{"idp.engineering"}, ctx.readIdentity(aliceId).RoleAttributes)
+
+		// the unmanaged identity also has the role attribute, but isn't a member
+		ctx.requireStatus(http.StatusConflict, http.MethodPost, "/Groups", `{"displayName": "engineering"}`)
+
+		group = ctx.requireStatus(http.StatusOK, http.MethodPatch, "/Groups/engineering",
+			`{"Operations": [{"op": "add", "path": "members", "value": [{"value": "`+bobId+`"}]}]}`)
+		ctx.Len(group["members"], 2)
+
+		groups := ctx.list("/Groups?filter=" + url.QueryEscape(`members[value eq "`+bobId+`"]`))
+		ctx.Len(groups, 1)
+
+		ctx.requireStatus(http.StatusOK, http.MethodPatch, "/Groups/engineering",
+			`{"Operations": [{"op": "remove", "path": "members[value eq \"`+aliceId+`\"]"}]}`)
+		ctx.Empty(ctx.readIdentity(aliceId).RoleAttributes)
+		ctx.Equal([]string{"idp.engineering"}, ctx.readIdentity(bobId).RoleAttributes)
+
+		user := ctx.requireStatus(http.StatusOK, http.MethodGet, "/Users/"+bobId, "")
+		ctx.Len(user["groups"], 1)
+
+		ctx.requireStatus(http.StatusBadRequest, http.MethodPatch, "/Groups/engineering",
+			`{"Operations": [{"op": "replace", "path": "displayName", "value": "eng"}]}`)
+		ctx.requireStatus(http.StatusBadRequest, http.MethodPatch, "/Groups/engineering",
+			`{"Operations": [{"op": "add", "path": "members", "value": [{"value": "`+unmanaged.Id+`"}]}]}`)
+
+		ctx.requireStatus(http.StatusNoContent, http.MethodDelete, "/Groups/engineering", "")
+		ctx.Empty(ctx.readIdentity(bobId).RoleAttributes)
+		ctx.Equal([]string{"idp.engineering"}, ctx.readIdentity(unmanaged.Id).RoleAttributes)
+		ctx.Empty(ctx.list("/Groups"))
+
+		// groups without members can still be read
+		group = ctx.requireStatus(http.StatusOK, http.MethodGet, "/Groups/engineering", "")
+		ctx.Empty(group["members"])
+	})
+
+	t.Run("deleting users deletes their identities", func(t *testing.T) {
+		ctx.NextTest(t)
+
+		ctx.requireStatus(http.StatusNoContent, http.MethodDelete, "/Users/"+bobId, "")
+		ctx.requireStatus(http.StatusNotFound, http.MethodGet, "/Users/"+bobId, "")
+		ctx.requireStatus(http.StatusNotFound, http.MethodDelete, "/Users/"+unmanaged.Id, "")
+		ctx.NotNil(ctx.readIdentity(unmanaged.Id))
+	})
+
+	t.Run("discovery endpoints", func(t *testing.T) {
+		ctx.NextTest(t)
+
+		config := ctx.requireStatus(http.StatusOK, http.MethodGet, "/ServiceProviderConfig", "")
+		ctx.Equal(true, config["patch"].(map[string]interface{})["supported"])
+
+		ctx.Len(ctx.list("/ResourceTypes"), 2)
+		ctx.Len(ctx.list("/Schemas"), 2)
+		ctx.requireStatus(http.StatusOK, http.MethodGet, "/Schemas/"+SchemaUser, "")
+		ctx.requireStatus(http.StatusNotFound, http.MethodGet, "/Unknown", "")
+	})
+}
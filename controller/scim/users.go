@@ -0,0 +1,370 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/controller/change"
+	"github.com/openziti/ziti/controller/db"
+	"github.com/openziti/ziti/controller/fields"
+	"github.com/openziti/ziti/controller/model"
+	"github.com/openziti/ziti/controller/models"
+)
+
+// userQuery selects the identities exposed as SCIM users. Only identities with an external id are managed by the
+// identity provider. Admin and router identities never are, so that holders of the SCIM token can't disable, rename
+// or delete them.
+var userQuery = fmt.Sprintf(`%s != null and %s = false and %s = false and %s != "%s"`,
+	db.FieldIdentityExternalId, db.FieldIdentityIsDefaultAdmin, db.FieldIdentityIsAdmin, db.FieldIdentityType, db.RouterIdentityType)
+
+// User is the SCIM representation of an identity, see RFC 7643 section 4.1
+type User struct {
+	Schemas    []string    `json:"schemas"`
+	Id         string      `json:"id"`
+	ExternalId string      `json:"externalId,omitempty"`
+	UserName   string      `json:"userName"`
+	Active     bool        `json:"active"`
+	Groups     []Reference `json:"groups,omitempty"`
+	Meta       Meta        `json:"meta"`
+}
+
+// userRequest holds the user attributes which can be set by clients, other attributes are ignored
+type userRequest struct {
+	UserName   string          `json:"userName"`
+	ExternalId string          `json:"externalId"`
+	Active     json.RawMessage `json:"active"`
+}
+
+// userState is the state of an identity which is managed through SCIM
+type userState struct {
+	userName   string
+	externalId string
+	active     bool
+}
+
+type userHandler struct {
+	*Handler
+}
+
+func (self *Handler) listUserIdentities() ([]*model.Identity, error) {
+	result, err := self.env.GetManagers().Identity.BaseList(userQuery + " sort by name limit none")
+	if err != nil {
+		return nil, err
+	}
+	return result.Entities, nil
+}
+
+// readUserIdentity returns the identity with the given id if it's exposed as a SCIM user
+func (self *Handler) readUserIdentity(id string) (*model.Identity, error) {
+	identity, err := self.env.GetManagers().Identity.Read(id)
+	if err != nil {
+		if boltz.IsErrNotFoundErr(err) {
+			return nil, newNotFoundError(ResourceTypeUser, id)
+		}
+		return nil, err
+	}
+
+	if identity.ExternalId == nil || identity.IsDefaultAdmin || identity.IsAdmin || identity.IdentityTypeId == db.RouterIdentityType {
+		return nil, newNotFoundError(ResourceTypeUser, id)
+	}
+
+	return identity, nil
+}
+
+func (self *userHandler) toUser(r *http.Request, identity *model.Identity) *User {
+	user := &User{
+		Schemas:  []string{SchemaUser},
+		Id:       identity.Id,
+		UserName: identity.Name,
+		Active:   !identity.Disabled,
+		Meta: Meta{
+			ResourceType: ResourceTypeUser,
+			Created:      &identity.CreatedAt,
+			LastModified: &identity.UpdatedAt,
+			Location:     resourceLocation(r, "Users", identity.Id),
+		},
+	}
+
+	if identity.ExternalId != nil {
+		user.ExternalId = *identity.ExternalId
+	}
+
+	for _, attribute := range identity.RoleAttributes {
+		if groupName, ok := self.groupName(attribute); ok {
+			user.Groups = append(user.Groups, Reference{
+				Value:   groupName,
+				Ref:     resourceLocation(r, "Groups", groupName),
+				Display: groupName,
+			})
+		}
+	}
+
+	return user
+}
+
+func (self *userHandler) list(r *http.Request, filter Filter) ([]interface{}, error) {
+	identities, err := self.listUserIdentities()
+	if err != nil {
+		return nil, err
+	}
+
+	var users []*User
+	for _, identity := range identities {
+		users = append(users, self.toUser(r, identity))
+	}
+
+	return filterResources(users, filter)
+}
+
+func (self *userHandler) get(r *http.Request, id string) (interface{}, error) {
+	identity, err := self.readUserIdentity(id)
+	if err != nil {
+		return nil, err
+	}
+	return self.toUser(r, identity), nil
+}
+
+func parseUserRequest(body []byte) (*userRequest, error) {
+	request := &userRequest{}
+	if err := json.Unmarshal(body, request); err != nil {
+		return nil, newBadRequestError(ScimTypeInvalidSyntax, "invalid user (%v)", err)
+	}
+
+	if request.UserName = strings.TrimSpace(request.UserName); request.UserName == "" {
+		return nil, newBadRequestError(ScimTypeInvalidValue, "userName is required")
+	}
+
+	return request, nil
+}
+
+// create creates an identity for the user. Identities need an external id to be matched with ext-jwt tokens, so the
+// userName is used if the identity provider doesn't send one.
+func (self *userHandler) create(r *http.Request, body []byte, ctx *change.Context) (interface{}, error) {
+	request, err := parseUserRequest(body)
+	if err != nil {
+		return nil, err
+	}
+
+	externalId := request.ExternalId
+	if externalId == "" {
+		externalId = request.UserName
+	}
+
+	active := true
+	if len(request.Active) > 0 {
+		if active, err = decodeBool("active", request.Active); err != nil {
+			return nil, err
+		}
+	}
+
+	identity := &model.Identity{
+		Name:           request.UserName,
+		IdentityTypeId: self.config.IdentityTypeId,
+		AuthPolicyId:   self.config.AuthPolicyId,
+		ExternalId:     &externalId,
+	}
+
+	if err = self.env.GetManagers().Identity.Create(identity, ctx); err != nil {
+		return nil, err
+	}
+
+	if !active {
+		if err = self.env.GetManagers().Identity.Disable(identity.Id, 0, ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return self.get(r, identity.Id)
+}
+
+// replace updates the user from a full representation. Attributes which aren't given are left unchanged, as the
+// external id and active state can't be cleared.
+func (self *userHandler) replace(r *http.Request, id string, body []byte, ctx *change.Context) (interface{}, error) {
+	identity, err := self.readUserIdentity(id)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := parseUserRequest(body)
+	if err != nil {
+		return nil, err
+	}
+
+	state := newUserState(identity)
+	state.userName = request.UserName
+
+	if request.ExternalId != "" {
+		state.externalId = request.ExternalId
+	}
+
+	if len(request.Active) > 0 {
+		if state.active, err = decodeBool("active", request.Active); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = self.apply(identity, state, ctx); err != nil {
+		return nil, err
+	}
+
+	return self.get(r, id)
+}
+
+func (self *userHandler) patch(r *http.Request, id string, operations []PatchOperation, ctx *change.Context) (interface{}, error) {
+	identity, err := self.readUserIdentity(id)
+	if err != nil {
+		return nil, err
+	}
+
+	state := newUserState(identity)
+
+	for _, operation := range operations {
+		if operation.Path == nil {
+			attributes, err := operation.valueAttributes()
+			if err != nil {
+				return nil, err
+			}
+
+			for name, value := range attributes {
+				path, err := parseAttributePath(name)
+				if err != nil {
+					return nil, newBadRequestError(ScimTypeInvalidPath, err.Error())
+				}
+				if err = state.set(path, value); err != nil {
+					return nil, err
+				}
+			}
+			continue
+		}
+
+		if operation.Path.valueFilter != nil {
+			if operation.Path.is("groups") {
+				return nil, newBadRequestError(ScimTypeMutability, "groups are read only, update group members instead")
+			}
+			continue
+		}
+
+		if operation.Op == PatchOpRemove {
+			if err = state.remove(operation.Path.attributePath); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if err = state.set(operation.Path.attributePath, operation.Value); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = self.apply(identity, state, ctx); err != nil {
+		return nil, err
+	}
+
+	return self.get(r, id)
+}
+
+func (self *userHandler) delete(id string, ctx *change.Context) error {
+	if _, err := self.readUserIdentity(id); err != nil {
+		return err
+	}
+	return self.env.GetManagers().Identity.Delete(id, ctx)
+}
+
+// apply updates the identity to the given state. Deactivated users are disabled indefinitely, which also removes
+// their API sessions.
+func (self *userHandler) apply(identity *model.Identity, state *userState, ctx *change.Context) error {
+	identityManager := self.env.GetManagers().Identity
+
+	update := &model.Identity{
+		BaseEntity: models.BaseEntity{Id: identity.Id},
+		Name:       state.userName,
+		ExternalId: &state.externalId,
+	}
+
+	updatedFields := fields.UpdatedFieldsMap{}
+	if state.userName != identity.Name {
+		updatedFields[db.FieldName] = struct{}{}
+	}
+	if state.externalId != *identity.ExternalId {
+		updatedFields[db.FieldIdentityExternalId] = struct{}{}
+	}
+
+	if len(updatedFields) > 0 {
+		if err := identityManager.Update(update, updatedFields, ctx); err != nil {
+			return err
+		}
+	}
+
+	if state.active && identity.Disabled {
+		return identityManager.Enable(identity.Id, ctx)
+	}
+
+	if !state.active && !identity.Disabled {
+		return identityManager.Disable(identity.Id, 0, ctx)
+	}
+
+	return nil
+}
+
+func newUserState(identity *model.Identity) *userState {
+	return &userState{
+		userName:   identity.Name,
+		externalId: *identity.ExternalId,
+		active:     !identity.Disabled,
+	}
+}
+
+// set sets a user attribute. Attributes which don't map to identities are ignored, as allowed by RFC 7644.
+func (self *userState) set(path attributePath, value json.RawMessage) error {
+	var err error
+
+	switch {
+	case path.is("userName"):
+		if self.userName, err = decodeString("userName", value); err == nil && strings.TrimSpace(self.userName) == "" {
+			err = newBadRequestError(ScimTypeInvalidValue, "userName is required")
+		}
+	case path.is("externalId"):
+		if self.externalId, err = decodeString("externalId", value); err == nil && self.externalId == "" {
+			err = newBadRequestError(ScimTypeMutability, "externalId is required")
+		}
+	case path.is("active"):
+		self.active, err = decodeBool("active", value)
+	case strings.EqualFold(path.attribute, "groups"):
+		err = newBadRequestError(ScimTypeMutability, "groups are read only, update group members instead")
+	case strings.EqualFold(path.attribute, "id"):
+		err = newBadRequestError(ScimTypeMutability, "id is read only")
+	}
+
+	return err
+}
+
+func (self *userState) remove(path attributePath) error {
+	switch {
+	case path.is("userName"), path.is("externalId"), strings.EqualFold(path.attribute, "id"):
+		return newBadRequestError(ScimTypeMutability, "%s can't be removed", path)
+	case path.is("active"):
+		self.active = false
+	case strings.EqualFold(path.attribute, "groups"):
+		return newBadRequestError(ScimTypeMutability, "groups are read only, update group members instead")
+	}
+	return nil
+}
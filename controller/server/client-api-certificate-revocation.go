@@ -0,0 +1,120 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package server
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/ziti/controller"
+	"github.com/openziti/ziti/controller/env"
+	"golang.org/x/crypto/ocsp"
+)
+
+const (
+	ContentTypePkixCrl      = "application/pkix-crl"
+	ContentTypeOcspResponse = "application/ocsp-response"
+
+	maxOcspRequestSize = 16 * 1024
+)
+
+// handleCertificateRevocationRequest serves the CRL and OCSP responder for certificates issued by the edge signer.
+// Both are unauthenticated so that any TLS server trusting the Ziti CA can check revocation. Returns false if the
+// request isn't a revocation request.
+func handleCertificateRevocationRequest(ae *env.AppEnv, rw http.ResponseWriter, r *http.Request) bool {
+	switch {
+	case r.URL.Path == controller.WellKnownCrl:
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return true
+		}
+		serveCrl(ae, rw, r)
+	case r.URL.Path == controller.WellKnownOcsp:
+		if r.Method != http.MethodPost {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return true
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(rw, r.Body, maxOcspRequestSize))
+		if err != nil {
+			writeOcspResponse(rw, ocsp.MalformedRequestErrorResponse)
+			return true
+		}
+		serveOcsp(ae, rw, body)
+	case strings.HasPrefix(r.URL.Path, controller.WellKnownOcsp+"/"):
+		// RFC 6960 appendix A.1, the request is base64 encoded into the path of GET requests
+		if r.Method != http.MethodGet {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return true
+		}
+
+		encoded := strings.TrimPrefix(r.URL.Path, controller.WellKnownOcsp+"/")
+		body, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil || len(body) > maxOcspRequestSize {
+			writeOcspResponse(rw, ocsp.MalformedRequestErrorResponse)
+			return true
+		}
+		serveOcsp(ae, rw, body)
+	default:
+		return false
+	}
+
+	return true
+}
+
+func serveCrl(ae *env.AppEnv, rw http.ResponseWriter, r *http.Request) {
+	signer := ae.GetApiClientCsrSigner()
+	validity := ae.GetConfig().Revocation.CrlValidity
+
+	crl, err := ae.Managers.CertificateRevocation.GetCrl(signer.Cert(), signer.Signer(), validity)
+	if err != nil {
+		pfxlog.Logger().WithError(err).Error("unable to generate certificate revocation list")
+		rw.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	rw.Header().Set("content-type", ContentTypePkixCrl)
+	rw.Header().Set("content-length", fmt.Sprint(len(crl)))
+	rw.WriteHeader(http.StatusOK)
+
+	if r.Method != http.MethodHead {
+		_, _ = rw.Write(crl)
+	}
+}
+
+func serveOcsp(ae *env.AppEnv, rw http.ResponseWriter, request []byte) {
+	signer := ae.GetApiClientCsrSigner()
+	validity := ae.GetConfig().Revocation.OcspValidity
+
+	response, err := ae.Managers.CertificateRevocation.RespondOcsp(signer.Cert(), signer.Signer(), validity, request)
+	if err != nil {
+		pfxlog.Logger().WithError(err).Error("unable to answer ocsp request")
+	}
+
+	writeOcspResponse(rw, response)
+}
+
+func writeOcspResponse(rw http.ResponseWriter, response []byte) {
+	rw.Header().Set("content-type", ContentTypeOcspResponse)
+	rw.Header().Set("content-length", fmt.Sprint(len(response)))
+	rw.WriteHeader(http.StatusOK)
+	_, _ = rw.Write(response)
+}
@@ -160,6 +160,10 @@ func (clientApi ClientApiHandler) newHandler(ae *env.AppEnv) http.Handler {
 		//translate /edge/v1 to /edge/client/v1
 		r.URL.Path = strings.Replace(r.URL.Path, controller.LegacyClientRestApiBaseUrlV1, controller.ClientRestApiBaseUrlLatest, 1)
 
+		if handleCertificateRevocationRequest(ae, rw, r) {
+			return
+		}
+
 		// .well-known/est/cacerts can be handled by the client API but the generated server requires
 		// the prefixed path for route resolution.
 		if r.URL.Path == WellKnownEstCaCerts {
@@ -197,6 +201,10 @@ func (clientApi ClientApiHandler) newHandler(ae *env.AppEnv) http.Handler {
 		//after request context is filled so that api session is present for session expiration headers
 		response.AddHeaders(rc)
 
+		if ae.ClientApiRoutes.Handle(ae, rc) {
+			return
+		}
+
 		innerClientHandler.ServeHTTP(rw, r)
 	})
 
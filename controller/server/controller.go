@@ -213,6 +213,7 @@ func (c *Controller) initializeAuthModules() {
 		c.AppEnv.EnrollRegistry.Add(model.NewEnrollModuleEdgeRouterOtt(c.AppEnv))
 		c.AppEnv.EnrollRegistry.Add(model.NewEnrollModuleTransitRouterOtt(c.AppEnv))
 		c.AppEnv.EnrollRegistry.Add(model.NewEnrollModuleUpdb(c.AppEnv))
+		c.AppEnv.EnrollRegistry.Add(model.NewEnrollModuleDeviceKey(c.AppEnv))
 	})
 }
 
@@ -289,6 +290,7 @@ func (c *Controller) Run() {
 	managementApiFactory := NewManagementApiFactory(c.AppEnv)
 	clientApiFactory := NewClientApiFactory(c.AppEnv)
 	oidcApiFactory := NewOidcApiFactory(c.AppEnv)
+	scimApiFactory := NewScimApiFactory(c.AppEnv)
 
 	if err := c.AppEnv.HostController.GetXWebInstance().GetRegistry().Add(managementApiFactory); err != nil {
 		pfxlog.Logger().Fatalf("failed to create Edge Management API factory: %v", err)
@@ -302,6 +304,10 @@ func (c *Controller) Run() {
 		pfxlog.Logger().Fatalf("failed to create OIDC API factory: %v", err)
 	}
 
+	if err := c.AppEnv.HostController.GetXWebInstance().GetRegistry().Add(scimApiFactory); err != nil {
+		pfxlog.Logger().Fatalf("failed to create SCIM API factory: %v", err)
+	}
+
 	if err := c.policyEngine.Start(c.AppEnv.HostController.GetCloseNotifyChannel()); err != nil {
 		log.WithError(err).Fatalf("error starting policy engine")
 	}
@@ -138,6 +138,10 @@ func (managementApi ManagementApiHandler) newHandler(ae *env.AppEnv) http.Handle
 		//after request context is filled so that api session is present for session expiration headers
 		response.AddHeaders(rc)
 
+		if ae.ManagementApiRoutes.Handle(ae, rc) {
+			return
+		}
+
 		innerManagementHandler.ServeHTTP(rw, r)
 	})
 
@@ -0,0 +1,185 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package server
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/openziti/xweb/v2"
+	"github.com/openziti/ziti/controller"
+	"github.com/openziti/ziti/controller/change"
+	"github.com/openziti/ziti/controller/db"
+	"github.com/openziti/ziti/controller/env"
+	"github.com/openziti/ziti/controller/internal/permissions"
+	"github.com/openziti/ziti/controller/scim"
+)
+
+var _ xweb.ApiHandlerFactory = &ScimApiFactory{}
+
+type ScimApiFactory struct {
+	InitFunc func(*ScimApiHandler) error
+	appEnv   *env.AppEnv
+}
+
+func (factory ScimApiFactory) Validate(*xweb.InstanceConfig) error {
+	return nil
+}
+
+func NewScimApiFactory(appEnv *env.AppEnv) *ScimApiFactory {
+	return &ScimApiFactory{
+		appEnv: appEnv,
+	}
+}
+
+func (factory ScimApiFactory) Binding() string {
+	return controller.ScimApiBinding
+}
+
+func (factory ScimApiFactory) New(_ *xweb.ServerConfig, options map[interface{}]interface{}) (xweb.ApiHandler, error) {
+	scimApi, err := NewScimApiHandler(factory.appEnv, options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if factory.InitFunc != nil {
+		if err := factory.InitFunc(scimApi); err != nil {
+			return nil, fmt.Errorf("error running on init func: %v", err)
+		}
+	}
+
+	return scimApi, nil
+}
+
+// ScimApiHandler serves the SCIM 2.0 API used by identity providers to provision identities. Requests are authorized
+// either by the bearer token configured with the token option or by an admin API session.
+type ScimApiHandler struct {
+	handler http.Handler
+	appEnv  *env.AppEnv
+	options map[interface{}]interface{}
+	token   string
+}
+
+func (h ScimApiHandler) Binding() string {
+	return controller.ScimApiBinding
+}
+
+func (h ScimApiHandler) Options() map[interface{}]interface{} {
+	return h.options
+}
+
+func (h ScimApiHandler) RootPath() string {
+	return scim.RootPath
+}
+
+func (h ScimApiHandler) IsHandler(r *http.Request) bool {
+	return strings.HasPrefix(r.URL.Path, h.RootPath())
+}
+
+func (h ScimApiHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	writer.Header().Set(ZitiInstanceId, h.appEnv.InstanceId)
+
+	changeCtx := h.authorize(writer, request)
+	if changeCtx == nil {
+		scim.WriteError(writer, scim.NewError(http.StatusUnauthorized, "", "a valid bearer token or admin session is required"))
+		return
+	}
+
+	h.handler.ServeHTTP(writer, request.WithContext(changeCtx.AddToContext(request.Context())))
+}
+
+// authorize returns the change context to attribute changes to, or nil if the request isn't authorized
+func (h ScimApiHandler) authorize(writer http.ResponseWriter, request *http.Request) *change.Context {
+	if h.token != "" {
+		for _, header := range request.Header.Values("authorization") {
+			token, found := strings.CutPrefix(header, "Bearer ")
+			if found && subtle.ConstantTimeCompare([]byte(strings.TrimSpace(token)), []byte(h.token)) == 1 {
+				return change.New().SetSourceType(change.SourceTypeRest).
+					SetSourceAuth("scim").
+					SetSourceMethod(request.Method).
+					SetSourceLocal(request.Host).
+					SetSourceRemote(request.RemoteAddr).
+					SetChangeAuthorType(change.AuthorTypeUnattributed).
+					SetChangeAuthorName("scim")
+			}
+		}
+	}
+
+	rc := h.appEnv.CreateRequestContext(writer, request)
+	if err := h.appEnv.FillRequestContext(rc); err != nil {
+		return nil
+	}
+
+	if !permissions.NewRequireAll(permissions.AuthenticatedPermission, permissions.AdminPermission).IsAllowed(rc.ActivePermissions...) {
+		return nil
+	}
+
+	return rc.NewChangeContext().SetSourceAuth("scim")
+}
+
+func NewScimApiHandler(ae *env.AppEnv, options map[interface{}]interface{}) (*ScimApiHandler, error) {
+	scimApi := &ScimApiHandler{
+		options: options,
+		appEnv:  ae,
+	}
+
+	config := scim.Config{
+		AuthPolicyId:   db.DefaultAuthPolicyId,
+		IdentityTypeId: db.DefaultIdentityType,
+	}
+
+	stringOption := func(name string) (string, error) {
+		if val, ok := options[name]; ok {
+			if str, ok := val.(string); ok {
+				return strings.TrimSpace(str), nil
+			}
+			return "", fmt.Errorf("invalid %s option for %s, must be a string", name, controller.ScimApiBinding)
+		}
+		return "", nil
+	}
+
+	var err error
+	if scimApi.token, err = stringOption("token"); err != nil {
+		return nil, err
+	}
+
+	if authPolicyId, err := stringOption("authPolicyId"); err != nil {
+		return nil, err
+	} else if authPolicyId != "" {
+		if _, err = ae.Managers.AuthPolicy.Read(authPolicyId); err != nil {
+			return nil, fmt.Errorf("invalid authPolicyId option for %s: %w", controller.ScimApiBinding, err)
+		}
+		config.AuthPolicyId = authPolicyId
+	}
+
+	if identityType, err := stringOption("identityType"); err != nil {
+		return nil, err
+	} else if identityType != "" {
+		config.IdentityTypeId = identityType
+	}
+
+	if config.GroupAttributePrefix, err = stringOption("groupAttributePrefix"); err != nil {
+		return nil, err
+	}
+
+	scimApi.handler = scim.NewHandler(ae, config)
+
+	return scimApi, nil
+}
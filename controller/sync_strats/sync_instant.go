@@ -107,13 +107,14 @@ type InstantStrategy struct {
 	stopNotify chan struct{}
 	stopped    atomic.Bool
 	*common.RouterDataModel
-	servicePolicyHandler *constraintToIndexedEvents[*db.ServicePolicy]
-	identityHandler      *constraintToIndexedEvents[*db.Identity]
-	postureCheckHandler  *constraintToIndexedEvents[*db.PostureCheck]
-	serviceHandler       *constraintToIndexedEvents[*db.EdgeService]
-	caHandler            *constraintToIndexedEvents[*db.Ca]
-	revocationHandler    *constraintToIndexedEvents[*db.Revocation]
-	controllerHandler    *constraintToIndexedEvents[*db.Controller]
+	servicePolicyHandler  *constraintToIndexedEvents[*db.ServicePolicy]
+	identityHandler       *constraintToIndexedEvents[*db.Identity]
+	postureCheckHandler   *constraintToIndexedEvents[*db.PostureCheck]
+	serviceHandler        *constraintToIndexedEvents[*db.EdgeService]
+	caHandler             *constraintToIndexedEvents[*db.Ca]
+	revocationHandler     *constraintToIndexedEvents[*db.Revocation]
+	bandwidthLimitHandler *constraintToIndexedEvents[*db.BandwidthLimit]
+	controllerHandler     *constraintToIndexedEvents[*db.Controller]
 
 	changeSetLock sync.Mutex
 	changeSets    map[uint64]*edge_ctrl_pb.DataState_ChangeSet
@@ -206,6 +207,15 @@ func (strategy *InstantStrategy) Initialize(logSize uint64, bufferSize uint) err
 	}
 	strategy.ae.GetStores().Revocation.AddEntityConstraint(strategy.revocationHandler)
 
+	//bandwidth limit create/delete/update
+	strategy.bandwidthLimitHandler = &constraintToIndexedEvents[*db.BandwidthLimit]{
+		indexProvider: strategy.indexProvider,
+		createHandler: strategy.BandwidthLimitCreate,
+		updateHandler: strategy.BandwidthLimitUpdate,
+		deleteHandler: strategy.BandwidthLimitDelete,
+	}
+	strategy.ae.GetStores().BandwidthLimit.AddEntityConstraint(strategy.bandwidthLimitHandler)
+
 	strategy.controllerHandler = &constraintToIndexedEvents[*db.Controller]{
 		indexProvider: strategy.indexProvider,
 		createHandler: strategy.ControllerCreate,
@@ -962,6 +972,10 @@ func (strategy *InstantStrategy) BuildAll() error {
 			return err
 		}
 
+		if err := strategy.BuildBandwidthLimits(tx); err != nil {
+			return err
+		}
+
 		strategy.SetCurrentIndex(strategy.indexProvider.CurrentIndex())
 
 		return nil
@@ -1036,6 +1050,23 @@ func (strategy *InstantStrategy) BuildPostureChecks(tx *bbolt.Tx) error {
 	return nil
 }
 
+func (strategy *InstantStrategy) BuildBandwidthLimits(tx *bbolt.Tx) error {
+	for cursor := strategy.ae.GetStores().BandwidthLimit.IterateIds(tx, ast.BoolNodeTrue); cursor.IsValid(); cursor.Next() {
+		bandwidthLimit, err := strategy.ae.GetStores().BandwidthLimit.LoadById(tx, string(cursor.Current()))
+		if err != nil {
+			return err
+		}
+
+		newModel := &edge_ctrl_pb.DataState_Event_BandwidthLimit{BandwidthLimit: newBandwidthLimit(bandwidthLimit)}
+		newEvent := &edge_ctrl_pb.DataState_Event{
+			Action: edge_ctrl_pb.DataState_Create,
+			Model:  newModel,
+		}
+		strategy.HandleBandwidthLimitEvent(newEvent, newModel)
+	}
+	return nil
+}
+
 func newIdentityById(tx *bbolt.Tx, ae *env.AppEnv, id string) (*edge_ctrl_pb.DataState_Identity, error) {
 	identityModel, err := ae.GetStores().Identity.LoadById(tx, id)
 
@@ -1080,6 +1111,7 @@ func newIdentity(identityModel *db.Identity) *edge_ctrl_pb.DataState_Identity {
 		ServiceHostingPrecedences: hostingPrecedences,
 		ServiceHostingCosts:       hostingCosts,
 		AppDataJson:               appDataJson,
+		RoleAttributes:            identityModel.RoleAttributes,
 	}
 }
 
@@ -1088,6 +1120,8 @@ func newServicePolicy(storeModel *db.ServicePolicy) *edge_ctrl_pb.DataState_Serv
 		Id:         storeModel.Id,
 		Name:       storeModel.Name,
 		PolicyType: edge_ctrl_pb.PolicyType(storeModel.PolicyType.Id()),
+		Schedule:   edge_ctrl_pb.NewPolicySchedule(storeModel.Schedule),
+		Deny:       storeModel.Effect.IsDeny(),
 	}
 
 	return servicePolicy
@@ -1105,9 +1139,32 @@ func newServiceById(tx *bbolt.Tx, ae *env.AppEnv, id string) (*edge_ctrl_pb.Data
 
 func newService(storeModel *db.EdgeService) *edge_ctrl_pb.DataState_Service {
 	return &edge_ctrl_pb.DataState_Service{
-		Id:   storeModel.Id,
-		Name: storeModel.Name,
+		Id:             storeModel.Id,
+		Name:           storeModel.Name,
+		RoleAttributes: storeModel.RoleAttributes,
+	}
+}
+
+func newBandwidthLimit(storeModel *db.BandwidthLimit) *edge_ctrl_pb.DataState_BandwidthLimit {
+	return &edge_ctrl_pb.DataState_BandwidthLimit{
+		Id:                 storeModel.Id,
+		Name:               storeModel.Name,
+		IdentityRoles:      storeModel.IdentityRoles,
+		ServiceRoles:       storeModel.ServiceRoles,
+		RateBytesPerSecond: uint64(storeModel.RateBytesPerSecond),
+		BurstBytes:         uint64(storeModel.BurstBytes),
+		Scope:              getBandwidthLimitScope(storeModel.Scope),
+	}
+}
+
+func getBandwidthLimitScope(scope string) edge_ctrl_pb.BandwidthLimitScope {
+	switch scope {
+	case db.BandwidthLimitScopeIdentity:
+		return edge_ctrl_pb.BandwidthLimitScope_PerIdentity
+	case db.BandwidthLimitScopeService:
+		return edge_ctrl_pb.BandwidthLimitScope_PerService
 	}
+	return edge_ctrl_pb.BandwidthLimitScope_PerCircuit
 }
 
 func newPublicKey(data []byte, format edge_ctrl_pb.DataState_PublicKey_Format, usages []edge_ctrl_pb.DataState_PublicKey_Usage) *edge_ctrl_pb.DataState_PublicKey {
@@ -1174,6 +1231,38 @@ func newPostureCheck(postureModel *db.PostureCheck) *edge_ctrl_pb.DataState_Post
 			},
 		}
 
+	case *db.PostureCheckDeviceKey:
+		newVal.Subtype = &edge_ctrl_pb.DataState_PostureCheck_DeviceKey_{
+			DeviceKey: &edge_ctrl_pb.DataState_PostureCheck_DeviceKey{
+				TimeoutSeconds: subType.TimeoutSeconds,
+			},
+		}
+
+	case *db.PostureCheckSdkVersion:
+		sdkVersion := &edge_ctrl_pb.DataState_PostureCheck_SdkVersion{
+			AppIds: subType.AppIds,
+		}
+
+		for _, constraint := range subType.Constraints {
+			sdkVersion.Constraints = append(sdkVersion.Constraints, &edge_ctrl_pb.DataState_PostureCheck_SdkVersionConstraint{
+				SdkType:  constraint.SdkType,
+				OsType:   constraint.OsType,
+				Versions: constraint.Versions,
+			})
+		}
+
+		newVal.Subtype = &edge_ctrl_pb.DataState_PostureCheck_SdkVersion_{
+			SdkVersion: sdkVersion,
+		}
+
+	case *db.PostureCheckSourceIp:
+		newVal.Subtype = &edge_ctrl_pb.DataState_PostureCheck_SourceIp_{
+			SourceIp: &edge_ctrl_pb.DataState_PostureCheck_SourceIp{
+				AllowedCidrs: subType.AllowedCidrs,
+				DeniedCidrs:  subType.DeniedCidrs,
+			},
+		}
+
 	case *db.PostureCheckWindowsDomains:
 		newVal.Subtype = &edge_ctrl_pb.DataState_PostureCheck_Domains_{
 			Domains: &edge_ctrl_pb.DataState_PostureCheck_Domains{
@@ -1393,6 +1482,27 @@ func (strategy *InstantStrategy) handleRevocation(index uint64, action edge_ctrl
 	})
 }
 
+func (strategy *InstantStrategy) BandwidthLimitCreate(index uint64, bandwidthLimit *db.BandwidthLimit) {
+	strategy.handleBandwidthLimit(index, edge_ctrl_pb.DataState_Create, bandwidthLimit)
+}
+
+func (strategy *InstantStrategy) BandwidthLimitUpdate(index uint64, bandwidthLimit *db.BandwidthLimit) {
+	strategy.handleBandwidthLimit(index, edge_ctrl_pb.DataState_Update, bandwidthLimit)
+}
+
+func (strategy *InstantStrategy) BandwidthLimitDelete(index uint64, bandwidthLimit *db.BandwidthLimit) {
+	strategy.handleBandwidthLimit(index, edge_ctrl_pb.DataState_Delete, bandwidthLimit)
+}
+
+func (strategy *InstantStrategy) handleBandwidthLimit(index uint64, action edge_ctrl_pb.DataState_Action, bandwidthLimit *db.BandwidthLimit) {
+	strategy.addToChangeSet(index, &edge_ctrl_pb.DataState_Event{
+		Action: action,
+		Model: &edge_ctrl_pb.DataState_Event_BandwidthLimit{
+			BandwidthLimit: newBandwidthLimit(bandwidthLimit),
+		},
+	})
+}
+
 func (strategy *InstantStrategy) addToChangeSet(index uint64, event *edge_ctrl_pb.DataState_Event) {
 	strategy.changeSetLock.Lock()
 	defer strategy.changeSetLock.Unlock()
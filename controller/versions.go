@@ -35,10 +35,15 @@ const (
 	ClientRestApiSpecUrl     = ClientRestApiBaseUrlLatest + "/swagger.json"
 	ManagementRestApiSpecUrl = ManagementRestApiBaseUrlLatest + "/swagger.json"
 
+	// WellKnownCrl and WellKnownOcsp publish the revocation status of certificates issued by the edge signer
+	WellKnownCrl  = "/.well-known/ziti/crl"
+	WellKnownOcsp = "/.well-known/ziti/ocsp"
+
 	LegacyClientApiBinding = "edge"
 	ClientApiBinding       = "edge-client"
 	ManagementApiBinding   = "edge-management"
 	OidcApiBinding         = "edge-oidc"
+	ScimApiBinding         = "edge-scim"
 )
 
 // AllApiBindingVersions is a map of: API Binding -> Api Version -> API Path
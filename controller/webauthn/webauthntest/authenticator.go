@@ -0,0 +1,235 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+// Package webauthntest provides a software WebAuthn authenticator for use in tests
+package webauthntest
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
+	"github.com/go-webauthn/webauthn/protocol/webauthncose"
+	"github.com/pkg/errors"
+)
+
+// Authenticator is a software authenticator which creates credentials and assertions the way a FIDO2 security key
+// would. Attestations use "packed" self attestation.
+type Authenticator struct {
+	RpId         string
+	Origin       string
+	Algorithm    webauthncose.COSEAlgorithmIdentifier
+	AAGUID       []byte
+	UserVerified bool
+
+	// ClientDataType, when set, overrides the type in generated client data
+	ClientDataType protocol.CeremonyType
+
+	credentials map[string]*credential
+}
+
+type credential struct {
+	key       crypto.Signer
+	signCount uint32
+}
+
+// AttestationResponse is the result of a credential creation
+type AttestationResponse struct {
+	CredentialId      []byte
+	ClientDataJSON    []byte
+	AttestationObject []byte
+}
+
+// AssertionResponse is the result of a credential request
+type AssertionResponse struct {
+	CredentialId      []byte
+	ClientDataJSON    []byte
+	AuthenticatorData []byte
+	Signature         []byte
+}
+
+func NewAuthenticator(rpId, origin string) *Authenticator {
+	return &Authenticator{
+		RpId:         rpId,
+		Origin:       origin,
+		Algorithm:    webauthncose.AlgES256,
+		AAGUID:       make([]byte, 16),
+		UserVerified: true,
+		credentials:  map[string]*credential{},
+	}
+}
+
+// Create makes a new credential for the given challenge
+func (self *Authenticator) Create(challenge []byte) (*AttestationResponse, error) {
+	credentialId := make([]byte, 32)
+	if _, err := rand.Read(credentialId); err != nil {
+		return nil, err
+	}
+
+	var key crypto.Signer
+	var err error
+	if self.Algorithm == webauthncose.AlgEdDSA {
+		_, key, err = ed25519.GenerateKey(rand.Reader)
+	} else {
+		key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	cred := &credential{key: key}
+	self.credentials[string(credentialId)] = cred
+
+	coseKey, err := self.encodePublicKey(key.Public())
+	if err != nil {
+		return nil, err
+	}
+
+	attestedData := append([]byte{}, self.AAGUID...)
+	attestedData = binary.BigEndian.AppendUint16(attestedData, uint16(len(credentialId)))
+	attestedData = append(attestedData, credentialId...)
+	attestedData = append(attestedData, coseKey...)
+
+	authData := self.authData(0x40, cred.signCount, attestedData)
+	clientDataJSON, err := self.clientData(protocol.CreateCeremony, challenge)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := self.sign(cred.key, authData, clientDataJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	attestationObject, err := webauthncbor.Marshal(map[string]any{
+		"fmt":      "packed",
+		"authData": authData,
+		"attStmt": map[string]any{
+			"alg": int64(self.Algorithm),
+			"sig": sig,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &AttestationResponse{
+		CredentialId:      credentialId,
+		ClientDataJSON:    clientDataJSON,
+		AttestationObject: attestationObject,
+	}, nil
+}
+
+// Get makes an assertion for the given challenge with a previously created credential
+func (self *Authenticator) Get(challenge []byte, credentialId []byte) (*AssertionResponse, error) {
+	cred, ok := self.credentials[string(credentialId)]
+	if !ok {
+		return nil, errors.New("unknown credential")
+	}
+
+	cred.signCount++
+
+	authData := self.authData(0, cred.signCount, nil)
+	clientDataJSON, err := self.clientData(protocol.AssertCeremony, challenge)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := self.sign(cred.key, authData, clientDataJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AssertionResponse{
+		CredentialId:      credentialId,
+		ClientDataJSON:    clientDataJSON,
+		AuthenticatorData: authData,
+		Signature:         sig,
+	}, nil
+}
+
+// SetSignCount overrides the signature counter of a credential, e.g. to simulate a cloned authenticator
+func (self *Authenticator) SetSignCount(credentialId []byte, signCount uint32) {
+	if cred, ok := self.credentials[string(credentialId)]; ok {
+		cred.signCount = signCount
+	}
+}
+
+func (self *Authenticator) authData(flags byte, signCount uint32, attestedData []byte) []byte {
+	rpIdHash := sha256.Sum256([]byte(self.RpId))
+
+	flags |= 0x01
+	if self.UserVerified {
+		flags |= 0x04
+	}
+
+	result := append([]byte{}, rpIdHash[:]...)
+	result = append(result, flags)
+	result = binary.BigEndian.AppendUint32(result, signCount)
+	return append(result, attestedData...)
+}
+
+func (self *Authenticator) clientData(clientDataType protocol.CeremonyType, challenge []byte) ([]byte, error) {
+	if self.ClientDataType != "" {
+		clientDataType = self.ClientDataType
+	}
+
+	return json.Marshal(&protocol.CollectedClientData{
+		Type:      clientDataType,
+		Challenge: base64.RawURLEncoding.EncodeToString(challenge),
+		Origin:    self.Origin,
+	})
+}
+
+func (self *Authenticator) sign(key crypto.Signer, authData, clientDataJSON []byte) ([]byte, error) {
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	signedData := append(append([]byte{}, authData...), clientDataHash[:]...)
+
+	if edKey, ok := key.(ed25519.PrivateKey); ok {
+		return ed25519.Sign(edKey, signedData), nil
+	}
+
+	digest := sha256.Sum256(signedData)
+	return key.Sign(rand.Reader, digest[:], crypto.SHA256)
+}
+
+func (self *Authenticator) encodePublicKey(key crypto.PublicKey) ([]byte, error) {
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		return webauthncbor.Marshal(map[int64]any{
+			1:  int64(webauthncose.EllipticKey),
+			3:  int64(webauthncose.AlgES256),
+			-1: int64(webauthncose.P256),
+			-2: k.X.FillBytes(make([]byte, 32)),
+			-3: k.Y.FillBytes(make([]byte, 32)),
+		})
+	case ed25519.PublicKey:
+		return webauthncbor.Marshal(map[int64]any{
+			1:  int64(webauthncose.OctetKey),
+			3:  int64(webauthncose.AlgEdDSA),
+			-1: int64(webauthncose.Ed25519),
+			-2: []byte(k),
+		})
+	}
+	return nil, errors.Errorf("unsupported key type %T", key)
+}
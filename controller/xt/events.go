@@ -16,6 +16,8 @@
 
 package xt
 
+import "time"
+
 func NewStrategyChangeEvent(serviceId string, current, added, changed, removed []Terminator) StrategyChangeEvent {
 	return &strategyChangeEvent{
 		serviceId: serviceId,
@@ -58,24 +60,47 @@ func (event *strategyChangeEvent) GetRemoved() []Terminator {
 	return event.removed
 }
 
-func NewDialFailedEvent(terminator Terminator) TerminatorEvent {
+func NewDialContext(dialerId, affinityKey string) DialContext {
+	return &dialContext{
+		dialerId:    dialerId,
+		affinityKey: affinityKey,
+	}
+}
+
+type dialContext struct {
+	dialerId    string
+	affinityKey string
+}
+
+func (self *dialContext) GetDialerId() string {
+	return self.dialerId
+}
+
+func (self *dialContext) GetAffinityKey() string {
+	return self.affinityKey
+}
+
+func NewDialFailedEvent(terminator Terminator, dialDuration time.Duration) TerminatorEvent {
 	return &defaultEvent{
 		terminator: terminator,
 		eventType:  eventTypeFailed,
+		duration:   dialDuration,
 	}
 }
 
-func NewDialSucceeded(terminator Terminator) TerminatorEvent {
+func NewDialSucceeded(terminator Terminator, dialDuration time.Duration) TerminatorEvent {
 	return &defaultEvent{
 		terminator: terminator,
 		eventType:  eventTypeSucceeded,
+		duration:   dialDuration,
 	}
 }
 
-func NewCircuitRemoved(terminator Terminator) TerminatorEvent {
+func NewCircuitRemoved(terminator Terminator, circuitDuration time.Duration) TerminatorEvent {
 	return &defaultEvent{
 		terminator: terminator,
 		eventType:  eventTypeCircuitRemoved,
+		duration:   circuitDuration,
 	}
 }
 
@@ -90,12 +115,17 @@ const (
 type defaultEvent struct {
 	terminator Terminator
 	eventType  eventType
+	duration   time.Duration
 }
 
 func (event *defaultEvent) GetTerminator() Terminator {
 	return event.terminator
 }
 
+func (event *defaultEvent) GetDuration() time.Duration {
+	return event.duration
+}
+
 func (event *defaultEvent) Accept(visitor EventVisitor) {
 	if event.eventType == eventTypeFailed {
 		visitor.VisitDialFailed(event)
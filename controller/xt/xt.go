@@ -66,6 +66,21 @@ type Strategy interface {
 	NotifyEvent(event TerminatorEvent)
 }
 
+// DialContext describes the dial which a terminator is being selected for
+type DialContext interface {
+	// GetDialerId returns the id of the dialing identity, or of the initiating router for fabric dials
+	GetDialerId() string
+	// GetAffinityKey returns a caller supplied key which should map dials to the same terminator, if one was provided
+	GetAffinityKey() string
+}
+
+// DialContextStrategy may be implemented by strategies which need information about the dialer in order to
+// select a terminator. If implemented, SelectForDial will be used in place of Select when creating circuits
+type DialContextStrategy interface {
+	Strategy
+	SelectForDial(ctx DialContext, terminators []CostedTerminator) (CostedTerminator, error)
+}
+
 type Precedence interface {
 	fmt.Stringer
 	getMinCost() uint32
@@ -79,6 +94,9 @@ type Precedence interface {
 
 type TerminatorEvent interface {
 	GetTerminator() Terminator
+	// GetDuration returns the time taken by the dial for dial events, or the lifetime of the circuit for circuit
+	// removed events
+	GetDuration() time.Duration
 	Accept(visitor EventVisitor)
 }
 
@@ -88,6 +106,13 @@ type EventVisitor interface {
 	VisitCircuitRemoved(event TerminatorEvent)
 }
 
+// InspectableStrategy may be implemented by strategies which track per-terminator state, so that state can be
+// reported by the controller's terminator-strategy inspection
+type InspectableStrategy interface {
+	Strategy
+	Inspect() any
+}
+
 type Stats interface {
 	GetCost() uint32
 	GetPrecedence() Precedence
@@ -0,0 +1,225 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xt_latency
+
+import (
+	"github.com/openziti/ziti/common/inspect"
+	"github.com/openziti/ziti/controller/xt"
+	"github.com/openziti/ziti/controller/xt_common"
+	cmap "github.com/orcaman/concurrent-map/v2"
+	"math"
+	"sort"
+	"time"
+)
+
+const (
+	Name = "latency"
+
+	// minSamples is the number of dial latency samples required before a terminator's p95 is trusted
+	minSamples = 5
+
+	// outlierFactor is how many times slower than the fastest peer a terminator's p95 must be to be ejected
+	outlierFactor = 3
+
+	// minOutlierDelta keeps terminators with small absolute latencies from being ejected over noise
+	minOutlierDelta = 50 * time.Millisecond
+
+	// maxConsecutiveFailures is the number of dial failures in a row which will cause a terminator to be ejected
+	maxConsecutiveFailures = 5
+
+	baseEjectionTime = 30 * time.Second
+	maxEjectionTime  = 5 * time.Minute
+
+	// failedDialLatency is the dial latency recorded for a failed dial, so that failures push up a terminator's p95
+	failedDialLatency = 10 * time.Second
+
+	// routeCostUnit is the latency each unit of route cost adds to a terminator's score. Route costs include the
+	// terminator's static cost, the dynamic costs added for dial failures and active circuits, and the path cost
+	routeCostUnit = time.Millisecond
+)
+
+/**
+The latency strategy keeps a rolling window of dial latencies and circuit durations for each terminator and selects
+the terminator with the lowest score from the terminators sharing the highest available precedence. A terminator's
+score is its p95 dial latency plus its route cost, with each unit of cost counting as a millisecond. Failed dials are
+recorded as very slow dials. Terminators without enough samples are scored using the median p95 of the terminators
+which have enough, so that new or recovered terminators get probed without being favored over known fast terminators.
+
+Dial failures and active circuits adjust dynamic costs in the same way as the smartrouting strategy, and so also count
+towards the score. Terminators which fail several dials in a row, or whose p95 is an outlier compared to the fastest
+peer, are ejected from selection for a period which doubles with each consecutive ejection. If every candidate is
+ejected, ejection is ignored so that the service remains reachable.
+*/
+
+func NewFactory() xt.Factory {
+	return &factory{}
+}
+
+type factory struct{}
+
+func (self *factory) GetStrategyName() string {
+	return Name
+}
+
+func (self *factory) NewStrategy() xt.Strategy {
+	strategy := &strategy{
+		CostVisitor: xt_common.CostVisitor{
+			FailureCosts: xt.NewFailureCosts(math.MaxUint16/4, 20, 2),
+			CircuitCost:  2,
+		},
+		stats: cmap.New[*terminatorStats](),
+	}
+	strategy.CostVisitor.FailureCosts.CreditOverTime(5, time.Minute)
+	return strategy
+}
+
+type strategy struct {
+	xt_common.CostVisitor
+	stats cmap.ConcurrentMap[string, *terminatorStats]
+}
+
+func (self *strategy) Select(terminators []xt.CostedTerminator) (xt.CostedTerminator, error) {
+	terminators = xt.GetRelatedTerminators(terminators)
+	if len(terminators) == 1 {
+		return terminators[0], nil
+	}
+
+	now := time.Now()
+	candidates := make([]*candidate, 0, len(terminators))
+	fastest := time.Duration(-1)
+	for _, t := range terminators {
+		c := &candidate{
+			terminator: t,
+			costDelay:  time.Duration(t.GetPrecedence().Unbias(t.GetRouteCost())) * routeCostUnit,
+		}
+		if stats, found := self.stats.Get(t.GetId()); found {
+			c.stats = stats
+			c.p95, c.known, c.ejected = stats.selectionState(now)
+			if c.known && !c.ejected && (fastest < 0 || c.p95 < fastest) {
+				fastest = c.p95
+			}
+		}
+		candidates = append(candidates, c)
+	}
+
+	if fastest >= 0 {
+		for _, c := range candidates {
+			if c.known && !c.ejected && c.p95 > fastest*outlierFactor && c.p95-fastest > minOutlierDelta {
+				c.stats.eject(now, true)
+				c.ejected = true
+			}
+		}
+	}
+
+	var known []time.Duration
+	for _, c := range candidates {
+		if c.known && !c.ejected {
+			known = append(known, c.p95)
+		}
+	}
+
+	var unsampledP95 time.Duration
+	if len(known) > 0 {
+		sort.Slice(known, func(i, j int) bool {
+			return known[i] < known[j]
+		})
+		unsampledP95 = percentile(known, 50)
+	}
+
+	for _, c := range candidates {
+		if !c.known {
+			c.p95 = unsampledP95
+		}
+	}
+
+	var selected *candidate
+	for _, c := range candidates {
+		if !c.ejected && (selected == nil || c.score() < selected.score()) {
+			selected = c
+		}
+	}
+
+	// if everything has been ejected, fall back to the best of the ejected terminators
+	if selected == nil {
+		for _, c := range candidates {
+			if selected == nil || c.score() < selected.score() {
+				selected = c
+			}
+		}
+	}
+
+	return selected.terminator, nil
+}
+
+func (self *strategy) NotifyEvent(event xt.TerminatorEvent) {
+	event.Accept(&self.CostVisitor)
+	event.Accept(self)
+}
+
+func (self *strategy) HandleTerminatorChange(event xt.StrategyChangeEvent) error {
+	for _, t := range event.GetRemoved() {
+		self.FailureCosts.Clear(t.GetId())
+		self.stats.Remove(t.GetId())
+	}
+	return nil
+}
+
+func (self *strategy) VisitDialFailed(event xt.TerminatorEvent) {
+	self.getStats(event.GetTerminator()).dialFailed(time.Now())
+}
+
+func (self *strategy) VisitDialSucceeded(event xt.TerminatorEvent) {
+	self.getStats(event.GetTerminator()).dialSucceeded(time.Now(), event.GetDuration())
+}
+
+func (self *strategy) VisitCircuitRemoved(event xt.TerminatorEvent) {
+	self.getStats(event.GetTerminator()).circuitRemoved(time.Now(), event.GetDuration())
+}
+
+func (self *strategy) getStats(terminator xt.Terminator) *terminatorStats {
+	return self.stats.Upsert(terminator.GetId(), nil, func(exist bool, valueInMap *terminatorStats, _ *terminatorStats) *terminatorStats {
+		if exist {
+			return valueInMap
+		}
+		return newTerminatorStats(terminator.GetId(), terminator.GetServiceId())
+	})
+}
+
+func (self *strategy) Inspect() any {
+	result := &inspect.TerminatorLatencyInspectResult{}
+	now := time.Now()
+	self.stats.IterCb(func(_ string, stats *terminatorStats) {
+		result.Terminators = append(result.Terminators, stats.inspect(now))
+	})
+	sort.Slice(result.Terminators, func(i, j int) bool {
+		return result.Terminators[i].TerminatorId < result.Terminators[j].TerminatorId
+	})
+	return result
+}
+
+type candidate struct {
+	terminator xt.CostedTerminator
+	stats      *terminatorStats
+	p95        time.Duration
+	costDelay  time.Duration
+	known      bool
+	ejected    bool
+}
+
+func (self *candidate) score() time.Duration {
+	return self.p95 + self.costDelay
+}
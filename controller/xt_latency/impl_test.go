@@ -0,0 +1,203 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xt_latency
+
+import (
+	"github.com/openziti/ziti/common/inspect"
+	"github.com/openziti/ziti/controller/xt"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+type testTerminator struct {
+	id   string
+	cost uint32
+}
+
+func (self *testTerminator) GetId() string                { return self.id }
+func (self *testTerminator) GetPrecedence() xt.Precedence { return xt.Precedences.Default }
+func (self *testTerminator) GetCost() uint16              { return 0 }
+func (self *testTerminator) GetServiceId() string         { return "svc" }
+func (self *testTerminator) GetInstanceId() string        { return "" }
+func (self *testTerminator) GetRouterId() string          { return "router" }
+func (self *testTerminator) GetBinding() string           { return "transport" }
+func (self *testTerminator) GetAddress() string           { return "" }
+func (self *testTerminator) GetPeerData() xt.PeerData     { return nil }
+func (self *testTerminator) GetCreatedAt() time.Time      { return time.Time{} }
+func (self *testTerminator) GetHostId() string            { return "" }
+func (self *testTerminator) GetRouteCost() uint32 {
+	return xt.Precedences.Default.GetBiasedCost(self.cost)
+}
+
+func TestSelectsLowestP95(t *testing.T) {
+	req := require.New(t)
+
+	s := NewFactory().NewStrategy()
+	fast := &testTerminator{id: "fast"}
+	slow := &testTerminator{id: "slow"}
+
+	for i := 0; i < minSamples; i++ {
+		s.NotifyEvent(xt.NewDialSucceeded(fast, 10*time.Millisecond))
+		s.NotifyEvent(xt.NewDialSucceeded(slow, 20*time.Millisecond))
+	}
+
+	selected, err := s.Select([]xt.CostedTerminator{slow, fast})
+	req.NoError(err)
+	req.Equal("fast", selected.GetId())
+}
+
+func TestUnsampledTerminatorsScoreAsMedian(t *testing.T) {
+	req := require.New(t)
+
+	s := NewFactory().NewStrategy()
+	fast := &testTerminator{id: "fast"}
+	medium := &testTerminator{id: "medium"}
+	slow := &testTerminator{id: "slow"}
+	unknown := &testTerminator{id: "unknown"}
+
+	for i := 0; i < minSamples; i++ {
+		s.NotifyEvent(xt.NewDialSucceeded(fast, 10*time.Millisecond))
+		s.NotifyEvent(xt.NewDialSucceeded(medium, 20*time.Millisecond))
+		s.NotifyEvent(xt.NewDialSucceeded(slow, 30*time.Millisecond))
+	}
+
+	selected, err := s.Select([]xt.CostedTerminator{slow, medium, unknown, fast})
+	req.NoError(err)
+	req.Equal("fast", selected.GetId())
+
+	// without the fast terminator, the median is the medium terminator's p95, which beats the slow terminator
+	selected, err = s.Select([]xt.CostedTerminator{slow, unknown, medium})
+	req.NoError(err)
+	req.Equal("unknown", selected.GetId())
+}
+
+func TestRouteCostCountsTowardsScore(t *testing.T) {
+	req := require.New(t)
+
+	s := NewFactory().NewStrategy()
+	fast := &testTerminator{id: "fast", cost: 50}
+	slow := &testTerminator{id: "slow"}
+
+	for i := 0; i < minSamples; i++ {
+		s.NotifyEvent(xt.NewDialSucceeded(fast, 10*time.Millisecond))
+		s.NotifyEvent(xt.NewDialSucceeded(slow, 20*time.Millisecond))
+	}
+
+	selected, err := s.Select([]xt.CostedTerminator{fast, slow})
+	req.NoError(err)
+	req.Equal("slow", selected.GetId())
+}
+
+func TestDialFailuresAreSampled(t *testing.T) {
+	req := require.New(t)
+
+	s := NewFactory().NewStrategy()
+	good := &testTerminator{id: "good"}
+	flaky := &testTerminator{id: "flaky"}
+
+	for i := 0; i < minSamples; i++ {
+		s.NotifyEvent(xt.NewDialSucceeded(good, 20*time.Millisecond))
+		s.NotifyEvent(xt.NewDialSucceeded(flaky, 10*time.Millisecond))
+		// failures which aren't consecutive don't eject, but still count against the terminator
+		s.NotifyEvent(xt.NewDialFailedEvent(flaky, time.Millisecond))
+	}
+
+	selected, err := s.Select([]xt.CostedTerminator{flaky, good})
+	req.NoError(err)
+	req.Equal("good", selected.GetId())
+}
+
+func TestOutlierEjection(t *testing.T) {
+	req := require.New(t)
+
+	s := NewFactory().NewStrategy()
+	fast := &testTerminator{id: "fast"}
+	slow := &testTerminator{id: "slow"}
+
+	for i := 0; i < minSamples; i++ {
+		s.NotifyEvent(xt.NewDialSucceeded(fast, 10*time.Millisecond))
+		s.NotifyEvent(xt.NewDialSucceeded(slow, time.Second))
+	}
+
+	selected, err := s.Select([]xt.CostedTerminator{slow, fast})
+	req.NoError(err)
+	req.Equal("fast", selected.GetId())
+
+	result := s.(xt.InspectableStrategy).Inspect().(*inspect.TerminatorLatencyInspectResult)
+	req.Len(result.Terminators, 2)
+	req.Equal("slow", result.Terminators[1].TerminatorId)
+	req.True(result.Terminators[1].Ejected)
+	req.Equal(0, result.Terminators[1].DialSamples)
+	req.False(result.Terminators[0].Ejected)
+}
+
+func TestFailureEjection(t *testing.T) {
+	req := require.New(t)
+
+	s := NewFactory().NewStrategy()
+	good := &testTerminator{id: "good"}
+	bad := &testTerminator{id: "bad"}
+
+	for i := 0; i < maxConsecutiveFailures; i++ {
+		s.NotifyEvent(xt.NewDialFailedEvent(bad, time.Second))
+	}
+
+	for i := 0; i < 10; i++ {
+		selected, err := s.Select([]xt.CostedTerminator{bad, good})
+		req.NoError(err)
+		req.Equal("good", selected.GetId())
+	}
+
+	// if everything is ejected, we should still get a terminator back
+	for i := 0; i < maxConsecutiveFailures; i++ {
+		s.NotifyEvent(xt.NewDialFailedEvent(good, time.Second))
+	}
+
+	selected, err := s.Select([]xt.CostedTerminator{bad, good})
+	req.NoError(err)
+	req.NotNil(selected)
+}
+
+func TestRemovedTerminatorsAreForgotten(t *testing.T) {
+	req := require.New(t)
+
+	s := NewFactory().NewStrategy()
+	term := &testTerminator{id: "term"}
+	s.NotifyEvent(xt.NewDialSucceeded(term, 10*time.Millisecond))
+	s.NotifyEvent(xt.NewCircuitRemoved(term, time.Minute))
+
+	result := s.(xt.InspectableStrategy).Inspect().(*inspect.TerminatorLatencyInspectResult)
+	req.Len(result.Terminators, 1)
+	req.Equal(1, result.Terminators[0].CircuitDurationSamples)
+
+	req.NoError(s.HandleTerminatorChange(xt.NewStrategyChangeEvent("svc", nil, nil, nil, xt.TList(term))))
+	result = s.(xt.InspectableStrategy).Inspect().(*inspect.TerminatorLatencyInspectResult)
+	req.Len(result.Terminators, 0)
+}
+
+func TestPercentile(t *testing.T) {
+	req := require.New(t)
+
+	var values []time.Duration
+	for i := 1; i <= 100; i++ {
+		values = append(values, time.Duration(i))
+	}
+	req.Equal(time.Duration(50), percentile(values, 50))
+	req.Equal(time.Duration(95), percentile(values, 95))
+	req.Equal(time.Duration(7), percentile([]time.Duration{7}, 95))
+}
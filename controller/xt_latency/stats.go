@@ -0,0 +1,210 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xt_latency
+
+import (
+	"github.com/openziti/ziti/common/inspect"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	windowSize   = 100
+	windowMaxAge = 5 * time.Minute
+)
+
+type sample struct {
+	at    time.Time
+	value time.Duration
+}
+
+// window is a bounded, time limited set of samples. Percentiles are recalculated whenever the window changes so that
+// selection doesn't need to sort on every dial.
+type window struct {
+	samples []sample
+	p50     time.Duration
+	p95     time.Duration
+}
+
+func (self *window) add(now time.Time, value time.Duration) {
+	if len(self.samples) == windowSize {
+		self.samples = self.samples[1:]
+	}
+	self.samples = append(self.samples, sample{at: now, value: value})
+	self.prune(now)
+	self.recalculate()
+}
+
+func (self *window) expire(now time.Time) {
+	if len(self.samples) > 0 && now.Sub(self.samples[0].at) > windowMaxAge {
+		self.prune(now)
+		self.recalculate()
+	}
+}
+
+func (self *window) prune(now time.Time) {
+	idx := 0
+	for idx < len(self.samples) && now.Sub(self.samples[idx].at) > windowMaxAge {
+		idx++
+	}
+	self.samples = self.samples[idx:]
+}
+
+func (self *window) clear() {
+	self.samples = nil
+	self.recalculate()
+}
+
+func (self *window) recalculate() {
+	if len(self.samples) == 0 {
+		self.p50 = 0
+		self.p95 = 0
+		return
+	}
+
+	values := make([]time.Duration, len(self.samples))
+	for idx, s := range self.samples {
+		values[idx] = s.value
+	}
+	sort.Slice(values, func(i, j int) bool {
+		return values[i] < values[j]
+	})
+	self.p50 = percentile(values, 50)
+	self.p95 = percentile(values, 95)
+}
+
+// percentile uses the nearest-rank method on an already sorted list of values
+func percentile(sorted []time.Duration, p int) time.Duration {
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+func newTerminatorStats(terminatorId, serviceId string) *terminatorStats {
+	return &terminatorStats{
+		terminatorId: terminatorId,
+		serviceId:    serviceId,
+	}
+}
+
+type terminatorStats struct {
+	sync.Mutex
+	terminatorId        string
+	serviceId           string
+	dialLatency         window
+	circuitDuration     window
+	successes           uint64
+	failures            uint64
+	consecutiveFailures uint32
+	ejectedUntil        time.Time
+	ejectionCount       uint32
+}
+
+func (self *terminatorStats) dialSucceeded(now time.Time, latency time.Duration) {
+	self.Lock()
+	defer self.Unlock()
+	self.successes++
+	self.consecutiveFailures = 0
+	self.dialLatency.add(now, latency)
+}
+
+func (self *terminatorStats) dialFailed(now time.Time) {
+	self.Lock()
+	defer self.Unlock()
+	self.failures++
+	self.consecutiveFailures++
+	self.dialLatency.add(now, failedDialLatency)
+	if self.consecutiveFailures >= maxConsecutiveFailures && !now.Before(self.ejectedUntil) {
+		self.ejectLocked(now, false)
+	}
+}
+
+func (self *terminatorStats) circuitRemoved(now time.Time, duration time.Duration) {
+	self.Lock()
+	defer self.Unlock()
+	self.circuitDuration.add(now, duration)
+}
+
+// selectionState returns the current p95 dial latency, whether enough samples exist for it to be meaningful and
+// whether the terminator is currently ejected
+func (self *terminatorStats) selectionState(now time.Time) (time.Duration, bool, bool) {
+	self.Lock()
+	defer self.Unlock()
+	self.dialLatency.expire(now)
+	return self.dialLatency.p95, len(self.dialLatency.samples) >= minSamples, now.Before(self.ejectedUntil)
+}
+
+func (self *terminatorStats) eject(now time.Time, clearLatency bool) {
+	self.Lock()
+	defer self.Unlock()
+	self.ejectLocked(now, clearLatency)
+}
+
+func (self *terminatorStats) ejectLocked(now time.Time, clearLatency bool) {
+	// if the terminator has behaved since its last ejection, start backing off from scratch
+	if now.Sub(self.ejectedUntil) > maxEjectionTime {
+		self.ejectionCount = 0
+	}
+
+	ejectionTime := baseEjectionTime << min(self.ejectionCount, 4)
+	if ejectionTime > maxEjectionTime {
+		ejectionTime = maxEjectionTime
+	}
+
+	self.ejectionCount++
+	self.ejectedUntil = now.Add(ejectionTime)
+	self.consecutiveFailures = 0
+
+	// latency samples are cleared so the terminator is re-probed once the ejection expires, rather than being
+	// passed over indefinitely on the basis of stale data
+	if clearLatency {
+		self.dialLatency.clear()
+	}
+}
+
+func (self *terminatorStats) inspect(now time.Time) *inspect.TerminatorLatencyInspectDetail {
+	self.Lock()
+	defer self.Unlock()
+
+	self.dialLatency.expire(now)
+	self.circuitDuration.expire(now)
+
+	result := &inspect.TerminatorLatencyInspectDetail{
+		TerminatorId:           self.terminatorId,
+		ServiceId:              self.serviceId,
+		DialSamples:            len(self.dialLatency.samples),
+		DialLatencyP50:         self.dialLatency.p50.String(),
+		DialLatencyP95:         self.dialLatency.p95.String(),
+		DialSuccesses:          self.successes,
+		DialFailures:           self.failures,
+		ConsecutiveFailures:    self.consecutiveFailures,
+		CircuitDurationSamples: len(self.circuitDuration.samples),
+		CircuitDurationP50:     self.circuitDuration.p50.String(),
+		CircuitDurationP95:     self.circuitDuration.p95.String(),
+		Ejected:                now.Before(self.ejectedUntil),
+		EjectionCount:          self.ejectionCount,
+	}
+
+	if result.Ejected {
+		result.EjectedUntil = self.ejectedUntil.Format(time.RFC3339)
+	}
+
+	return result
+}
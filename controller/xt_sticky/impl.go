@@ -0,0 +1,186 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xt_sticky
+
+import (
+	"github.com/openziti/ziti/controller/xt"
+	"github.com/openziti/ziti/controller/xt_common"
+	cmap "github.com/orcaman/concurrent-map/v2"
+	"math"
+	"time"
+)
+
+const (
+	Name = "sticky"
+
+	// maxConsecutiveFailures is the number of dial failures in a row after which a terminator is passed over, so
+	// that its keys move to the next terminator on the ring until it recovers
+	maxConsecutiveFailures = 3
+)
+
+/**
+The sticky strategy uses consistent hashing to map dials to terminators, so that dials from the same client keep
+landing on the same terminator. The key is the affinity key supplied by the caller in the dial app data if present,
+otherwise the id of the dialing identity.
+
+Each service has a hash ring with a set of virtual nodes per terminator. The ring is maintained from the added and
+removed terminators in strategy change events, so only keys belonging to an added or removed terminator move. When
+selecting, the ring is walked from the key's position to the first terminator which is available at the highest
+precedence and isn't repeatedly failing dials. Keys return to their original terminator once it's healthy again.
+
+Dials without any dial context fall back to the lowest cost terminator.
+*/
+
+func NewFactory() xt.Factory {
+	return &factory{}
+}
+
+type factory struct{}
+
+func (self *factory) GetStrategyName() string {
+	return Name
+}
+
+func (self *factory) NewStrategy() xt.Strategy {
+	strategy := &strategy{
+		CostVisitor: xt_common.CostVisitor{
+			FailureCosts: xt.NewFailureCosts(math.MaxUint16/4, 20, 2),
+			CircuitCost:  2,
+		},
+		rings:    cmap.New[*ring](),
+		failures: cmap.New[uint32](),
+	}
+	strategy.CostVisitor.FailureCosts.CreditOverTime(5, time.Minute)
+	return strategy
+}
+
+type strategy struct {
+	xt_common.CostVisitor
+	rings    cmap.ConcurrentMap[string, *ring]
+	failures cmap.ConcurrentMap[string, uint32]
+}
+
+func (self *strategy) Select(terminators []xt.CostedTerminator) (xt.CostedTerminator, error) {
+	return terminators[0], nil
+}
+
+func (self *strategy) SelectForDial(ctx xt.DialContext, terminators []xt.CostedTerminator) (xt.CostedTerminator, error) {
+	terminators = xt.GetRelatedTerminators(terminators)
+	if len(terminators) == 1 {
+		return terminators[0], nil
+	}
+
+	key := ctx.GetAffinityKey()
+	if key == "" {
+		key = ctx.GetDialerId()
+	}
+
+	if key == "" {
+		return terminators[0], nil
+	}
+
+	healthy := map[string]xt.CostedTerminator{}
+	available := map[string]xt.CostedTerminator{}
+	var missing []string
+	r := self.getRing(terminators[0].GetServiceId())
+
+	for _, t := range terminators {
+		available[t.GetId()] = t
+		if failures, _ := self.failures.Get(t.GetId()); failures < maxConsecutiveFailures {
+			healthy[t.GetId()] = t
+		}
+		if !r.contains(t.GetId()) {
+			missing = append(missing, t.GetId())
+		}
+	}
+
+	// the ring is populated from change events, but after a restart it will only fill in as terminators are seen
+	if len(missing) > 0 {
+		r.update(missing, nil)
+	}
+
+	if len(healthy) > 0 {
+		if id := r.lookup(key, healthy); id != "" {
+			return healthy[id], nil
+		}
+	}
+
+	if id := r.lookup(key, available); id != "" {
+		return available[id], nil
+	}
+
+	return terminators[0], nil
+}
+
+func (self *strategy) NotifyEvent(event xt.TerminatorEvent) {
+	event.Accept(&self.CostVisitor)
+	event.Accept(self)
+}
+
+func (self *strategy) HandleTerminatorChange(event xt.StrategyChangeEvent) error {
+	added := map[string][]string{}
+	removed := map[string][]string{}
+
+	for _, t := range event.GetAdded() {
+		added[t.GetServiceId()] = append(added[t.GetServiceId()], t.GetId())
+	}
+
+	for _, t := range event.GetRemoved() {
+		removed[t.GetServiceId()] = append(removed[t.GetServiceId()], t.GetId())
+		self.FailureCosts.Clear(t.GetId())
+		self.failures.Remove(t.GetId())
+	}
+
+	for serviceId, ids := range added {
+		self.getRing(serviceId).update(ids, removed[serviceId])
+		delete(removed, serviceId)
+	}
+
+	for serviceId, ids := range removed {
+		if r, found := self.rings.Get(serviceId); found {
+			r.update(nil, ids)
+			if r.isEmpty() {
+				self.rings.RemoveCb(serviceId, func(key string, v *ring, exists bool) bool {
+					return exists && v.isEmpty()
+				})
+			}
+		}
+	}
+
+	return nil
+}
+
+func (self *strategy) VisitDialFailed(event xt.TerminatorEvent) {
+	self.failures.Upsert(event.GetTerminator().GetId(), 0, func(exist bool, valueInMap uint32, _ uint32) uint32 {
+		return valueInMap + 1
+	})
+}
+
+func (self *strategy) VisitDialSucceeded(event xt.TerminatorEvent) {
+	self.failures.Remove(event.GetTerminator().GetId())
+}
+
+func (self *strategy) VisitCircuitRemoved(xt.TerminatorEvent) {}
+
+func (self *strategy) getRing(serviceId string) *ring {
+	return self.rings.Upsert(serviceId, nil, func(exist bool, valueInMap *ring, _ *ring) *ring {
+		if exist {
+			return valueInMap
+		}
+		return newRing()
+	})
+}
@@ -0,0 +1,150 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xt_sticky
+
+import (
+	"fmt"
+	"github.com/openziti/ziti/controller/xt"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+type testTerminator struct {
+	id string
+}
+
+func (self *testTerminator) GetId() string                { return self.id }
+func (self *testTerminator) GetPrecedence() xt.Precedence { return xt.Precedences.Default }
+func (self *testTerminator) GetCost() uint16              { return 0 }
+func (self *testTerminator) GetServiceId() string         { return "svc" }
+func (self *testTerminator) GetInstanceId() string        { return "" }
+func (self *testTerminator) GetRouterId() string          { return "router" }
+func (self *testTerminator) GetBinding() string           { return "transport" }
+func (self *testTerminator) GetAddress() string           { return "" }
+func (self *testTerminator) GetPeerData() xt.PeerData     { return nil }
+func (self *testTerminator) GetCreatedAt() time.Time      { return time.Time{} }
+func (self *testTerminator) GetHostId() string            { return "" }
+func (self *testTerminator) GetRouteCost() uint32         { return 0 }
+
+func newTerminators(count int) []xt.CostedTerminator {
+	var result []xt.CostedTerminator
+	for i := 0; i < count; i++ {
+		result = append(result, &testTerminator{id: fmt.Sprintf("t%d", i)})
+	}
+	return result
+}
+
+func toTerminators(list []xt.CostedTerminator) []xt.Terminator {
+	var result []xt.Terminator
+	for _, t := range list {
+		result = append(result, t)
+	}
+	return result
+}
+
+func selectAll(t *testing.T, s xt.DialContextStrategy, keys int, terminators []xt.CostedTerminator) map[string]string {
+	result := map[string]string{}
+	for i := 0; i < keys; i++ {
+		id := fmt.Sprintf("identity-%d", i)
+		selected, err := s.SelectForDial(xt.NewDialContext(id, ""), terminators)
+		require.NoError(t, err)
+		result[id] = selected.GetId()
+	}
+	return result
+}
+
+func TestSameDialerGetsSameTerminator(t *testing.T) {
+	req := require.New(t)
+
+	s := NewFactory().NewStrategy().(xt.DialContextStrategy)
+	terminators := newTerminators(5)
+	req.NoError(s.HandleTerminatorChange(xt.NewStrategyChangeEvent("svc", nil, toTerminators(terminators), nil, nil)))
+
+	first := selectAll(t, s, 100, terminators)
+	second := selectAll(t, s, 100, terminators)
+	req.Equal(first, second)
+
+	used := map[string]struct{}{}
+	for _, id := range first {
+		used[id] = struct{}{}
+	}
+	req.Len(used, 5)
+}
+
+func TestAffinityKeyOverridesDialer(t *testing.T) {
+	req := require.New(t)
+
+	s := NewFactory().NewStrategy().(xt.DialContextStrategy)
+	terminators := newTerminators(5)
+
+	selected, err := s.SelectForDial(xt.NewDialContext("a", "session-1"), terminators)
+	req.NoError(err)
+
+	for i := 0; i < 20; i++ {
+		next, err := s.SelectForDial(xt.NewDialContext(fmt.Sprintf("dialer-%d", i), "session-1"), terminators)
+		req.NoError(err)
+		req.Equal(selected.GetId(), next.GetId())
+	}
+}
+
+func TestMinimalMovementOnRemoval(t *testing.T) {
+	req := require.New(t)
+
+	s := NewFactory().NewStrategy().(xt.DialContextStrategy)
+	terminators := newTerminators(5)
+	req.NoError(s.HandleTerminatorChange(xt.NewStrategyChangeEvent("svc", nil, toTerminators(terminators), nil, nil)))
+
+	before := selectAll(t, s, 500, terminators)
+
+	removed := terminators[2]
+	remaining := append(append([]xt.CostedTerminator{}, terminators[:2]...), terminators[3:]...)
+	req.NoError(s.HandleTerminatorChange(xt.NewStrategyChangeEvent("svc", nil, nil, nil, xt.TList(removed))))
+
+	after := selectAll(t, s, 500, remaining)
+	for key, terminatorId := range before {
+		if terminatorId != removed.GetId() {
+			req.Equal(terminatorId, after[key], "key %s moved unnecessarily", key)
+		} else {
+			req.NotEqual(removed.GetId(), after[key])
+		}
+	}
+}
+
+func TestFailingTerminatorIsSkippedUntilRecovered(t *testing.T) {
+	req := require.New(t)
+
+	s := NewFactory().NewStrategy().(xt.DialContextStrategy)
+	terminators := newTerminators(3)
+
+	ctx := xt.NewDialContext("identity", "")
+	selected, err := s.SelectForDial(ctx, terminators)
+	req.NoError(err)
+
+	for i := 0; i < maxConsecutiveFailures; i++ {
+		s.NotifyEvent(xt.NewDialFailedEvent(selected, time.Second))
+	}
+
+	failover, err := s.SelectForDial(ctx, terminators)
+	req.NoError(err)
+	req.NotEqual(selected.GetId(), failover.GetId())
+
+	s.NotifyEvent(xt.NewDialSucceeded(selected, time.Millisecond))
+	recovered, err := s.SelectForDial(ctx, terminators)
+	req.NoError(err)
+	req.Equal(selected.GetId(), recovered.GetId())
+}
@@ -0,0 +1,147 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xt_sticky
+
+import (
+	"github.com/openziti/ziti/controller/xt"
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+)
+
+// virtualNodes is the number of points each terminator gets on the ring. More points give a more even spread of keys
+const virtualNodes = 64
+
+type ringPoint struct {
+	hash         uint64
+	terminatorId string
+}
+
+type ringState struct {
+	points  []ringPoint
+	members map[string]struct{}
+}
+
+// ring is a consistent hash ring. Lookups read an immutable snapshot, while updates are serialized and replace it.
+type ring struct {
+	state atomic.Pointer[ringState]
+	lock  sync.Mutex
+}
+
+func newRing() *ring {
+	result := &ring{}
+	result.state.Store(&ringState{
+		members: map[string]struct{}{},
+	})
+	return result
+}
+
+func (self *ring) contains(terminatorId string) bool {
+	_, found := self.state.Load().members[terminatorId]
+	return found
+}
+
+func (self *ring) isEmpty() bool {
+	return len(self.state.Load().members) == 0
+}
+
+func (self *ring) update(added []string, removed []string) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	current := self.state.Load()
+	next := &ringState{
+		members: map[string]struct{}{},
+	}
+
+	for id := range current.members {
+		next.members[id] = struct{}{}
+	}
+
+	for _, id := range removed {
+		delete(next.members, id)
+	}
+
+	for _, id := range added {
+		next.members[id] = struct{}{}
+	}
+
+	for _, point := range current.points {
+		if _, found := next.members[point.terminatorId]; found {
+			next.points = append(next.points, point)
+		}
+	}
+
+	for _, id := range added {
+		if _, found := current.members[id]; found {
+			continue
+		}
+		for i := 0; i < virtualNodes; i++ {
+			next.points = append(next.points, ringPoint{
+				hash:         hashKey(id + "#" + strconv.Itoa(i)),
+				terminatorId: id,
+			})
+		}
+	}
+
+	sort.Slice(next.points, func(i, j int) bool {
+		if next.points[i].hash == next.points[j].hash {
+			return next.points[i].terminatorId < next.points[j].terminatorId
+		}
+		return next.points[i].hash < next.points[j].hash
+	})
+
+	self.state.Store(next)
+}
+
+// lookup walks the ring clockwise from the key's position and returns the first terminator in the candidate set,
+// or the empty string if none of the candidates are on the ring
+func (self *ring) lookup(key string, candidates map[string]xt.CostedTerminator) string {
+	points := self.state.Load().points
+	if len(points) == 0 {
+		return ""
+	}
+
+	h := hashKey(key)
+	start := sort.Search(len(points), func(i int) bool {
+		return points[i].hash >= h
+	})
+
+	for i := 0; i < len(points); i++ {
+		point := points[(start+i)%len(points)]
+		if _, found := candidates[point.terminatorId]; found {
+			return point.terminatorId
+		}
+	}
+
+	return ""
+}
+
+// hashKey uses fnv-1a, followed by a 64-bit finalizer to spread out similar inputs such as virtual node names
+func hashKey(key string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(key))
+	result := h.Sum64()
+	result ^= result >> 33
+	result *= 0xff51afd7ed558ccd
+	result ^= result >> 33
+	result *= 0xc4ceb9fe1a85ec53
+	result ^= result >> 33
+	return result
+}
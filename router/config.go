@@ -35,6 +35,7 @@ import (
 	"github.com/openziti/ziti/common/config"
 	"github.com/openziti/ziti/common/pb/ctrl_pb"
 	"github.com/openziti/ziti/router/forwarder"
+	"github.com/openziti/ziti/router/link"
 	"github.com/openziti/ziti/router/xgress"
 	"github.com/pkg/errors"
 	"github.com/spf13/pflag"
@@ -141,6 +142,7 @@ type Config struct {
 		Listeners  []map[interface{}]interface{}
 		Dialers    []map[interface{}]interface{}
 		Heartbeats channel.HeartbeatOptions
+		Bonding    *link.BondingOptions
 	}
 	Dialers   map[string]xgress.OptionsData
 	Listeners []listenerBinding
@@ -564,6 +566,7 @@ func LoadConfig(path string) (*Config, error) {
 	cfg.Link.Heartbeats = *channel.DefaultHeartbeatOptions()
 	cfg.Link.Heartbeats.SendInterval = DefaultLinkHeartbeatSendInterval
 	cfg.Link.Heartbeats.CloseUnresponsiveTimeout = DefaultLinkUnresponsiveTimeout
+	cfg.Link.Bonding = link.DefaultBondingOptions()
 
 	if value, found := cfgmap["link"]; found {
 		if submap, ok := value.(map[interface{}]interface{}); ok {
@@ -619,6 +622,18 @@ func LoadConfig(path string) (*Config, error) {
 					cfg.Link.Heartbeats = *options
 				}
 			}
+
+			if value, found := submap["bonding"]; found {
+				if submap, ok := value.(map[interface{}]interface{}); ok {
+					options, err := link.LoadBondingOptions(submap)
+					if err != nil {
+						return nil, errors.Wrap(err, "invalid [link/bonding] config")
+					}
+					cfg.Link.Bonding = options
+				} else {
+					return nil, fmt.Errorf("[link/bonding] must express a map (%v)", value)
+				}
+			}
 		}
 	}
 
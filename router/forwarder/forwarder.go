@@ -23,6 +23,7 @@ import (
 	"github.com/openziti/metrics"
 	"github.com/openziti/ziti/common/inspect"
 	"github.com/openziti/ziti/common/pb/ctrl_pb"
+	"github.com/openziti/ziti/common/qos"
 	"github.com/openziti/ziti/common/trace"
 	"github.com/openziti/ziti/router/env"
 	"github.com/openziti/ziti/router/xgress"
@@ -148,6 +149,7 @@ func (forwarder *Forwarder) Route(ctrlId string, route *ctrl_pb.Route) error {
 		}
 		circuitFt.setForwardAddress(xgress.Address(forward.SrcAddress), xgress.Address(forward.DstAddress))
 	}
+	circuitFt.setPriorityClass(qos.PriorityClass(route.PriorityClass))
 	forwarder.circuits.setForwardTable(circuitId, circuitFt)
 	return nil
 }
@@ -180,7 +182,7 @@ func (forwarder *Forwarder) forwardPayload(srcAddr xgress.Address, payload *xgre
 	if forwardTable, found := forwarder.circuits.getForwardTable(circuitId, markActive); found {
 		if dstAddr, found := forwardTable.getForwardAddress(srcAddr); found {
 			if dst, found := forwarder.destinations.getDestination(dstAddr); found {
-				if err := dst.SendPayload(payload); err != nil {
+				if err := sendPayload(dst, payload, forwardTable.getPriorityClass()); err != nil {
 					return err
 				}
 				log.WithFields(payload.GetLoggerFields()).Debugf("=> %s", string(dstAddr))
@@ -196,6 +198,21 @@ func (forwarder *Forwarder) forwardPayload(srcAddr xgress.Address, payload *xgre
 	}
 }
 
+// sendPayload passes the circuit's priority class along to links, so they can schedule the payload
+func sendPayload(dst Destination, payload *xgress.Payload, class qos.PriorityClass) error {
+	if link, ok := dst.(xlink.LinkDestination); ok {
+		return link.SendPrioritizedPayload(payload, class)
+	}
+	return dst.SendPayload(payload)
+}
+
+func sendAcknowledgement(dst Destination, acknowledgement *xgress.Acknowledgement, class qos.PriorityClass) error {
+	if link, ok := dst.(xlink.LinkDestination); ok {
+		return link.SendPrioritizedAcknowledgement(acknowledgement, class)
+	}
+	return dst.SendAcknowledgement(acknowledgement)
+}
+
 func (forwarder *Forwarder) ForwardAcknowledgement(srcAddr xgress.Address, acknowledgement *xgress.Acknowledgement) error {
 	log := pfxlog.ContextLogger(string(srcAddr))
 
@@ -203,7 +220,7 @@ func (forwarder *Forwarder) ForwardAcknowledgement(srcAddr xgress.Address, ackno
 	if forwardTable, found := forwarder.circuits.getForwardTable(circuitId, true); found {
 		if dstAddr, found := forwardTable.getForwardAddress(srcAddr); found {
 			if dst, found := forwarder.destinations.getDestination(dstAddr); found {
-				if err := dst.SendAcknowledgement(acknowledgement); err != nil {
+				if err := sendAcknowledgement(dst, acknowledgement, forwardTable.getPriorityClass()); err != nil {
 					return err
 				}
 				log.Debugf("=> %s", string(dstAddr))
@@ -18,6 +18,7 @@ package forwarder
 
 import (
 	"fmt"
+	"github.com/openziti/ziti/common/qos"
 	"github.com/openziti/ziti/router/xgress"
 	"github.com/orcaman/concurrent-map/v2"
 	"reflect"
@@ -67,9 +68,10 @@ func (st *circuitTable) debug() string {
 
 // forwardTable implements a directory of destinations, keyed by source address.
 type forwardTable struct {
-	ctrlId       string
-	last         int64
-	destinations cmap.ConcurrentMap[string, string]
+	ctrlId        string
+	last          int64
+	priorityClass atomic.Uint32
+	destinations  cmap.ConcurrentMap[string, string]
 }
 
 func newForwardTable(ctrlId string) *forwardTable {
@@ -83,6 +85,14 @@ func (ft *forwardTable) setForwardAddress(src, dst xgress.Address) {
 	ft.destinations.Set(string(src), string(dst))
 }
 
+func (ft *forwardTable) setPriorityClass(class qos.PriorityClass) {
+	ft.priorityClass.Store(uint32(class))
+}
+
+func (ft *forwardTable) getPriorityClass() qos.PriorityClass {
+	return qos.PriorityClass(ft.priorityClass.Load())
+}
+
 func (ft *forwardTable) getForwardAddress(src xgress.Address) (xgress.Address, bool) {
 	if dst, found := ft.destinations.Get(string(src)); found {
 		return xgress.Address(dst), true
@@ -27,12 +27,14 @@ import (
 type ackHandler struct {
 	link      xlink.Xlink
 	forwarder *forwarder.Forwarder
+	registry  xlink.Registry
 }
 
-func newAckHandler(link xlink.Xlink, forwarder *forwarder.Forwarder) *ackHandler {
+func newAckHandler(link xlink.Xlink, forwarder *forwarder.Forwarder, registry xlink.Registry) *ackHandler {
 	return &ackHandler{
 		link:      link,
 		forwarder: forwarder,
+		registry:  registry,
 	}
 }
 
@@ -50,7 +52,7 @@ func (self *ackHandler) HandleReceive(msg *channel.Message, ch channel.Channel)
 		return
 	}
 
-	if err = self.forwarder.ForwardAcknowledgement(xgress.Address(self.link.Id()), ack); err != nil {
+	if err = self.forwarder.ForwardAcknowledgement(sourceAddress(self.link, self.registry, msg), ack); err != nil {
 		pfxlog.ContextLogger(ch.Label()).
 			WithField("linkId", self.link.Id()).
 			WithField("routerId", self.link.DestinationId()).
@@ -73,10 +73,12 @@ func (self *bindHandler) BindChannel(binding channel.Binding) error {
 	binding.SetUserData(self.xlink.Id())
 	binding.AddCloseHandler(newCloseHandler(self.xlink, self.forwarder, self.xlinkRegistry))
 	binding.AddErrorHandler(newErrorHandler(self.xlink, self.ctrl))
-	binding.AddTypedReceiveHandler(newPayloadHandler(self.xlink, self.forwarder))
-	binding.AddTypedReceiveHandler(newAckHandler(self.xlink, self.forwarder))
+	binding.AddTypedReceiveHandler(newPayloadHandler(self.xlink, self.forwarder, self.xlinkRegistry))
+	binding.AddTypedReceiveHandler(newAckHandler(self.xlink, self.forwarder, self.xlinkRegistry))
 	binding.AddTypedReceiveHandler(&latency.LatencyHandler{})
-	binding.AddTypedReceiveHandler(newControlHandler(self.xlink, self.forwarder))
+	binding.AddTypedReceiveHandler(newControlHandler(self.xlink, self.forwarder, self.xlinkRegistry))
+	binding.AddTypedReceiveHandler(newBondRequestHandler(self.xlink, self.xlinkRegistry))
+	binding.AddTypedReceiveHandler(newBondCloseHandler(self.xlink, self.xlinkRegistry))
 	binding.AddPeekHandler(metrics2.NewChannelPeekHandler(self.xlink.Id(), self.forwarder.MetricsRegistry()))
 	binding.AddPeekHandler(trace.NewChannelPeekHandler(self.xlink.Id(), ch, self.forwarder.TraceController()))
 	if err := self.xlink.Init(self.forwarder.MetricsRegistry()); err != nil {
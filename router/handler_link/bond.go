@@ -0,0 +1,90 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package handler_link
+
+import (
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/channel/v2"
+	"github.com/openziti/ziti/router/xlink"
+	"time"
+)
+
+type bondRequestHandler struct {
+	link     xlink.Xlink
+	registry xlink.Registry
+}
+
+func newBondRequestHandler(link xlink.Xlink, registry xlink.Registry) *bondRequestHandler {
+	return &bondRequestHandler{
+		link:     link,
+		registry: registry,
+	}
+}
+
+func (self *bondRequestHandler) ContentType() int32 {
+	return xlink.ContentTypeBondRequest
+}
+
+func (self *bondRequestHandler) HandleReceive(msg *channel.Message, ch channel.Channel) {
+	log := pfxlog.ContextLogger(ch.Label()).
+		WithField("linkId", self.link.Id()).
+		WithField("routerId", self.link.DestinationId())
+
+	var response *channel.Message
+
+	iteration, _ := msg.GetUint32Header(xlink.HeaderKeyBondIteration)
+	memberIds, _, err := msg.GetStringSliceHeader(xlink.HeaderKeyBondMembers)
+	if err != nil {
+		log.WithError(err).Error("unable to decode bond request members")
+		response = channel.NewResult(false, err.Error())
+	} else if acceptedIds, bondIteration, err := self.registry.AcceptBond(self.link, iteration, memberIds); err != nil {
+		log.WithField("iteration", iteration).WithError(err).Info("rejected bond request")
+		response = channel.NewResult(false, err.Error())
+		response.PutUint32Header(xlink.HeaderKeyBondIteration, bondIteration)
+	} else {
+		response = channel.NewResult(true, "")
+		response.PutUint32Header(xlink.HeaderKeyBondIteration, bondIteration)
+		response.PutStringSliceHeader(xlink.HeaderKeyBondMembers, acceptedIds)
+	}
+
+	response.ReplyTo(msg)
+	if err = response.WithTimeout(5 * time.Second).Send(ch); err != nil {
+		log.WithError(err).Error("unable to reply to bond request")
+	}
+}
+
+type bondCloseHandler struct {
+	link     xlink.Xlink
+	registry xlink.Registry
+}
+
+func newBondCloseHandler(link xlink.Xlink, registry xlink.Registry) *bondCloseHandler {
+	return &bondCloseHandler{
+		link:     link,
+		registry: registry,
+	}
+}
+
+func (self *bondCloseHandler) ContentType() int32 {
+	return xlink.ContentTypeBondClose
+}
+
+func (self *bondCloseHandler) HandleReceive(msg *channel.Message, _ channel.Channel) {
+	if iteration, found := msg.GetUint32Header(xlink.HeaderKeyBondIteration); found {
+		self.registry.CloseBond(self.link, iteration)
+	}
+}
@@ -27,12 +27,14 @@ import (
 type controlHandler struct {
 	link      xlink.Xlink
 	forwarder *forwarder.Forwarder
+	registry  xlink.Registry
 }
 
-func newControlHandler(link xlink.Xlink, forwarder *forwarder.Forwarder) *controlHandler {
+func newControlHandler(link xlink.Xlink, forwarder *forwarder.Forwarder, registry xlink.Registry) *controlHandler {
 	result := &controlHandler{
 		link:      link,
 		forwarder: forwarder,
+		registry:  registry,
 	}
 	return result
 }
@@ -45,7 +47,7 @@ func (self *controlHandler) HandleReceive(msg *channel.Message, ch channel.Chann
 	log := pfxlog.ContextLogger(ch.Label())
 
 	if control, err := xgress.UnmarshallControl(msg); err == nil {
-		if err = self.forwarder.ForwardControl(xgress.Address(self.link.Id()), control); err != nil {
+		if err = self.forwarder.ForwardControl(sourceAddress(self.link, self.registry, msg), control); err != nil {
 			log.WithError(err).Debug("unable to forward")
 		}
 	} else {
@@ -27,12 +27,14 @@ import (
 type payloadHandler struct {
 	link      xlink.Xlink
 	forwarder *forwarder.Forwarder
+	registry  xlink.Registry
 }
 
-func newPayloadHandler(link xlink.Xlink, forwarder *forwarder.Forwarder) *payloadHandler {
+func newPayloadHandler(link xlink.Xlink, forwarder *forwarder.Forwarder, registry xlink.Registry) *payloadHandler {
 	return &payloadHandler{
 		link:      link,
 		forwarder: forwarder,
+		registry:  registry,
 	}
 }
 
@@ -47,6 +49,10 @@ func (self *payloadHandler) HandleReceive(msg *channel.Message, ch channel.Chann
 
 	payload, err := xgress.UnmarshallPayload(msg)
 	if err == nil {
+		if bond := bondForMessage(self.link, self.registry, msg); bond != nil {
+			bond.ReceivePayload(msg, payload)
+			return
+		}
 		if err = self.forwarder.ForwardPayload(xgress.Address(self.link.Id()), payload); err != nil {
 			log.WithError(err).Debug("unable to forward")
 			self.forwarder.ReportForwardingFault(payload.CircuitId, "")
@@ -58,3 +64,23 @@ func (self *payloadHandler) HandleReceive(msg *channel.Message, ch channel.Chann
 		log.WithError(err).Errorf("error unmarshalling payload")
 	}
 }
+
+// bondForMessage returns the bond the message was sent over, if it was sent over a bond which includes the link.
+// Messages sent over a bond are forwarded from the bond, since that's the link their circuits were routed over.
+func bondForMessage(link xlink.Xlink, registry xlink.Registry, msg *channel.Message) xlink.Bond {
+	if _, bonded := msg.Headers[xlink.HeaderKeyBondSequence]; !bonded {
+		return nil
+	}
+	if bond, found := registry.GetBond(link.DestinationId()); found {
+		return bond
+	}
+	return nil
+}
+
+// sourceAddress returns the address which messages received on the link should be forwarded from
+func sourceAddress(link xlink.Xlink, registry xlink.Registry, msg *channel.Message) xgress.Address {
+	if bond := bondForMessage(link, registry, msg); bond != nil {
+		return xgress.Address(bond.Id())
+	}
+	return xgress.Address(link.Id())
+}
@@ -0,0 +1,552 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package link
+
+import (
+	"fmt"
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/channel/v2"
+	"github.com/openziti/metrics"
+	"github.com/openziti/ziti/common/inspect"
+	"github.com/openziti/ziti/common/qos"
+	"github.com/openziti/ziti/router/xgress"
+	"github.com/openziti/ziti/router/xlink"
+	cmap "github.com/orcaman/concurrent-map/v2"
+	"github.com/pkg/errors"
+	metrics2 "github.com/rcrowley/go-metrics"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// bondIdleTimeout is how long per-circuit send sequences and reorder buffers are kept without any traffic
+	bondIdleTimeout = time.Minute
+
+	// bondMinLossSamples is the number of messages a member must have sent during an evaluation interval before its
+	// loss rate is considered
+	bondMinLossSamples = 100
+)
+
+// BondForwarder is the part of the forwarder used by bonded links. Bonds are registered as link destinations, so
+// circuits can be routed over them, and forward the payloads they receive once they've been reordered
+type BondForwarder interface {
+	RegisterLink(link xlink.LinkDestination) error
+	UnregisterLink(link xlink.LinkDestination)
+	ForwardPayload(srcAddr xgress.Address, payload *xgress.Payload) error
+	ReportForwardingFault(circuitId string, ctrlId string)
+	EndCircuit(circuitId string)
+}
+
+// BondId returns the id of the bonded link between the two routers. Both routers derive the same id, so that either
+// end can forward circuits routed over the bond by the controller
+func BondId(routerId, otherRouterId string) string {
+	if otherRouterId < routerId {
+		routerId, otherRouterId = otherRouterId, routerId
+	}
+	return "bond:" + routerId + ":" + otherRouterId
+}
+
+type bondMember struct {
+	link   xlink.Xlink
+	sender xlink.MessageSender
+	sent   atomic.Uint64
+
+	// the following are updated during evaluation and are guarded by the bond lock
+	healthy     bool
+	reason      string
+	latency     time.Duration
+	lossRate    float64
+	lastSent    uint64
+	lastDropped uint64
+}
+
+type bondSequence struct {
+	next     atomic.Uint64
+	lastUsed atomic.Int64
+}
+
+// bondedLink stripes payloads round-robin across the healthy links to a router. Each payload carries a per-circuit
+// bond sequence, which the receiving router uses to put the payloads back in order before forwarding them. Acks and
+// control messages don't need to be ordered, and are sent unsequenced.
+//
+// Member latency and loss are checked periodically. Members which are much slower than the best member, or which are
+// dropping messages, are removed from striping until they recover. The member links stay open, and may still be
+// used directly by circuits which were routed over them.
+type bondedLink struct {
+	id        string
+	routerId  string
+	destId    string
+	iteration uint32
+	options   *BondingOptions
+	forwarder BondForwarder
+	registry  metrics.UsageRegistry
+
+	latencyMetric metrics.Histogram
+
+	lock    sync.Mutex
+	members []*bondMember
+	healthy atomic.Pointer[[]*bondMember]
+	counter atomic.Uint32
+
+	sequences cmap.ConcurrentMap[string, *bondSequence]
+	buffers   cmap.ConcurrentMap[string, *reorderBuffer]
+
+	closed      atomic.Bool
+	closeNotify chan struct{}
+}
+
+func newBondedLink(routerId, destId string, iteration uint32, options *BondingOptions, forwarder BondForwarder, registry metrics.UsageRegistry) *bondedLink {
+	id := BondId(routerId, destId)
+	return &bondedLink{
+		id:            id,
+		routerId:      routerId,
+		destId:        destId,
+		iteration:     iteration,
+		options:       options,
+		forwarder:     forwarder,
+		registry:      registry,
+		latencyMetric: registry.Histogram("link." + id + ".latency"),
+		sequences:     cmap.New[*bondSequence](),
+		buffers:       cmap.New[*reorderBuffer](),
+		closeNotify:   make(chan struct{}),
+	}
+}
+
+func (self *bondedLink) Id() string {
+	return self.id
+}
+
+func (self *bondedLink) DestinationId() string {
+	return self.destId
+}
+
+func (self *bondedLink) Iteration() uint32 {
+	return self.iteration
+}
+
+// isOwner returns true if this end of the bond is responsible for negotiating it and reporting it to controllers
+func (self *bondedLink) isOwner() bool {
+	return isBondOwner(self.routerId, self.destId)
+}
+
+// isBondOwner returns true if the router owns the bond to the other router. The owner asks the other router to form
+// the bond, and is the end which reports it to controllers
+func isBondOwner(routerId, otherRouterId string) bool {
+	return routerId < otherRouterId
+}
+
+// addMember adds the link to the bond. New members are used for striping right away, and are evaluated with the
+// other members at the next interval. Returns false if the link can't be used as a bond member.
+func (self *bondedLink) addMember(link xlink.Xlink) bool {
+	sender, ok := link.(xlink.MessageSender)
+	if !ok {
+		return false
+	}
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	for _, member := range self.members {
+		if member.link == link {
+			return true
+		}
+	}
+
+	// only drops from after the link joined count towards its loss rate
+	self.members = append(self.members, &bondMember{
+		link:        link,
+		sender:      sender,
+		healthy:     true,
+		lastDropped: self.droppedCount(link),
+	})
+	self.updateHealthy()
+	return true
+}
+
+// removeMember removes the link from the bond and returns the number of remaining members
+func (self *bondedLink) removeMember(link xlink.Xlink) int {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	for idx, member := range self.members {
+		if member.link == link {
+			self.members = append(self.members[:idx], self.members[idx+1:]...)
+			break
+		}
+	}
+	self.updateHealthy()
+	return len(self.members)
+}
+
+func (self *bondedLink) memberCount() int {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	return len(self.members)
+}
+
+func (self *bondedLink) memberIds() []string {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	var result []string
+	for _, member := range self.members {
+		result = append(result, member.link.Id())
+	}
+	return result
+}
+
+// updateHealthy publishes the set of members which payloads are striped across. Must be called with the lock held.
+func (self *bondedLink) updateHealthy() {
+	var healthy []*bondMember
+	for _, member := range self.members {
+		if member.healthy && !member.link.IsClosed() {
+			healthy = append(healthy, member)
+		}
+	}
+	self.healthy.Store(&healthy)
+}
+
+// send hands the message to the next healthy member, moving on to the following member if a send fails
+func (self *bondedLink) send(f func(member *bondMember) error) error {
+	healthy := self.healthy.Load()
+	if healthy == nil || len(*healthy) == 0 {
+		return errors.Errorf("bonded link %s has no healthy members", self.id)
+	}
+
+	members := *healthy
+	start := self.counter.Add(1)
+
+	var err error
+	for i := 0; i < len(members); i++ {
+		member := members[(start+uint32(i))%uint32(len(members))]
+		if member.link.IsClosed() {
+			continue
+		}
+		if err = f(member); err == nil {
+			member.sent.Add(1)
+			return nil
+		}
+	}
+
+	if err == nil {
+		err = errors.Errorf("bonded link %s has no open members", self.id)
+	}
+	return err
+}
+
+func (self *bondedLink) nextSequence(circuitId string) uint64 {
+	sequence := self.sequences.Upsert(circuitId, nil, func(exist bool, valueInMap *bondSequence, _ *bondSequence) *bondSequence {
+		if exist {
+			return valueInMap
+		}
+		return &bondSequence{}
+	})
+	sequence.lastUsed.Store(time.Now().UnixMilli())
+	return sequence.next.Add(1)
+}
+
+func (self *bondedLink) SendPayload(payload *xgress.Payload) error {
+	return self.SendPrioritizedPayload(payload, qos.Standard)
+}
+
+func (self *bondedLink) SendPrioritizedPayload(payload *xgress.Payload, class qos.PriorityClass) error {
+	msg := payload.Marshall()
+	msg.PutUint64Header(xlink.HeaderKeyBondSequence, self.nextSequence(payload.CircuitId))
+
+	if payload.IsCircuitEndFlagSet() {
+		self.sequences.Remove(payload.CircuitId)
+	}
+
+	return self.send(func(member *bondMember) error {
+		return member.sender.SendPayloadMessage(msg, class)
+	})
+}
+
+func (self *bondedLink) SendAcknowledgement(ack *xgress.Acknowledgement) error {
+	return self.SendPrioritizedAcknowledgement(ack, qos.Standard)
+}
+
+func (self *bondedLink) SendPrioritizedAcknowledgement(ack *xgress.Acknowledgement, class qos.PriorityClass) error {
+	msg := ack.Marshall()
+	msg.PutUint64Header(xlink.HeaderKeyBondSequence, 0)
+	return self.send(func(member *bondMember) error {
+		return member.sender.SendAcknowledgementMessage(msg, class)
+	})
+}
+
+func (self *bondedLink) SendControl(control *xgress.Control) error {
+	msg := control.Marshall()
+	msg.PutUint64Header(xlink.HeaderKeyBondSequence, 0)
+	return self.send(func(member *bondMember) error {
+		return member.sender.SendControlMessage(msg)
+	})
+}
+
+func (self *bondedLink) ReceivePayload(msg *channel.Message, payload *xgress.Payload) {
+	seq, _ := msg.GetUint64Header(xlink.HeaderKeyBondSequence)
+	if seq == 0 {
+		self.deliver(payload)
+		return
+	}
+
+	now := time.Now()
+	buffer := self.buffers.Upsert(payload.CircuitId, nil, func(exist bool, valueInMap *reorderBuffer, _ *reorderBuffer) *reorderBuffer {
+		if exist {
+			return valueInMap
+		}
+		return newReorderBuffer(now)
+	})
+
+	buffer.lock.Lock()
+	defer buffer.lock.Unlock()
+
+	for _, ready := range buffer.receive(seq, payload, self.options.ReorderWindow, now) {
+		self.deliver(ready)
+	}
+}
+
+func (self *bondedLink) deliver(payload *xgress.Payload) {
+	if err := self.forwarder.ForwardPayload(xgress.Address(self.id), payload); err != nil {
+		pfxlog.Logger().WithField("linkId", self.id).
+			WithField("routerId", self.destId).
+			WithError(err).Debug("unable to forward")
+		self.forwarder.ReportForwardingFault(payload.CircuitId, "")
+	}
+	if payload.IsCircuitEndFlagSet() {
+		self.buffers.Remove(payload.CircuitId)
+		self.forwarder.EndCircuit(payload.GetCircuitId())
+	}
+}
+
+func (self *bondedLink) run() {
+	reorderTicker := time.NewTicker(max(self.options.ReorderTimeout/2, time.Millisecond))
+	defer reorderTicker.Stop()
+
+	evaluationTicker := time.NewTicker(self.options.EvaluationInterval)
+	defer evaluationTicker.Stop()
+
+	for {
+		select {
+		case now := <-reorderTicker.C:
+			self.expireReorderBuffers(now)
+		case <-evaluationTicker.C:
+			self.evaluate()
+			self.removeIdleSequences()
+		case <-self.closeNotify:
+			return
+		}
+	}
+}
+
+func (self *bondedLink) expireReorderBuffers(now time.Time) {
+	for circuitId, buffer := range self.buffers.Items() {
+		buffer.lock.Lock()
+		for _, ready := range buffer.expire(self.options.ReorderTimeout, now) {
+			self.deliver(ready)
+		}
+		if buffer.isIdle(bondIdleTimeout, now) {
+			self.buffers.RemoveCb(circuitId, func(key string, v *reorderBuffer, exists bool) bool {
+				return v == buffer
+			})
+		}
+		buffer.lock.Unlock()
+	}
+}
+
+func (self *bondedLink) removeIdleSequences() {
+	idleSince := time.Now().Add(-bondIdleTimeout).UnixMilli()
+	for circuitId, sequence := range self.sequences.Items() {
+		if sequence.lastUsed.Load() < idleSince {
+			self.sequences.RemoveCb(circuitId, func(key string, v *bondSequence, exists bool) bool {
+				return v == sequence
+			})
+		}
+	}
+}
+
+// evaluate measures member latency and loss, updates which members payloads are striped across and publishes the
+// bond latency, which controllers use to cost paths over the bond
+func (self *bondedLink) evaluate() {
+	log := pfxlog.Logger().WithField("linkId", self.id).WithField("routerId", self.destId)
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	wasHealthy := map[*bondMember]bool{}
+	for _, member := range self.members {
+		wasHealthy[member] = member.healthy
+		self.measure(member)
+	}
+
+	evaluateBondMembers(self.options, self.members)
+
+	var latency time.Duration
+	for _, member := range self.members {
+		if member.healthy && !wasHealthy[member] {
+			log.WithField("memberLinkId", member.link.Id()).Info("bond member recovered, resuming striping")
+		} else if !member.healthy && wasHealthy[member] {
+			log.WithField("memberLinkId", member.link.Id()).
+				WithField("reason", member.reason).
+				Warn("bond member diverged, removing from striping")
+		}
+		if member.healthy && member.latency > latency {
+			latency = member.latency
+		}
+	}
+
+	self.updateHealthy()
+
+	// payloads are reordered on receive, so they're delivered at the pace of the slowest striped member
+	if latency > 0 {
+		self.latencyMetric.Update(latency.Nanoseconds())
+	}
+}
+
+// measure reads the member's heartbeat latency and the number of messages dropped by the member since the last
+// evaluation. Must be called with the lock held.
+func (self *bondedLink) measure(member *bondMember) {
+	if histogram, ok := self.registry.GetHistogram("link." + member.link.Id() + ".latency").(metrics2.Histogram); ok {
+		member.latency = time.Duration(histogram.Mean())
+	}
+
+	sent := member.sent.Load()
+	dropped := self.droppedCount(member.link)
+
+	var sentDelta, droppedDelta uint64
+	if sent > member.lastSent {
+		sentDelta = sent - member.lastSent
+	}
+	if dropped > member.lastDropped {
+		droppedDelta = dropped - member.lastDropped
+	}
+
+	member.lossRate = 0
+	if total := max(sentDelta, droppedDelta); total >= bondMinLossSamples {
+		member.lossRate = float64(droppedDelta) / float64(total)
+	}
+
+	member.lastSent = sent
+	member.lastDropped = dropped
+}
+
+// droppedCount returns the number of messages the link has dropped because its send queues were full
+func (self *bondedLink) droppedCount(link xlink.Xlink) uint64 {
+	if meter, ok := self.registry.GetMeter("link.dropped_msgs:" + link.Id()).(metrics2.Meter); ok {
+		return uint64(meter.Count())
+	}
+	return 0
+}
+
+// evaluateBondMembers marks members as unhealthy if they're dropping more messages than allowed, or if their latency
+// has diverged from the best member latency. Members without a latency measurement yet are left in the bond. At least
+// one member is always left healthy.
+func evaluateBondMembers(options *BondingOptions, members []*bondMember) {
+	var best time.Duration
+	for _, member := range members {
+		if member.lossRate <= options.MaxLossRate && member.latency > 0 && (best == 0 || member.latency < best) {
+			best = member.latency
+		}
+	}
+
+	var fallback *bondMember
+	anyHealthy := false
+
+	for _, member := range members {
+		member.healthy = true
+		member.reason = ""
+
+		if member.lossRate > options.MaxLossRate {
+			member.healthy = false
+			member.reason = fmt.Sprintf("loss rate %.1f%% exceeds %.1f%%", member.lossRate*100, options.MaxLossRate*100)
+		} else if best > 0 && float64(member.latency) > float64(best)*options.MaxLatencyRatio && member.latency-best > options.MinLatencyDelta {
+			member.healthy = false
+			member.reason = fmt.Sprintf("latency %v diverged from best member latency %v", member.latency, best)
+		}
+
+		anyHealthy = anyHealthy || member.healthy
+
+		if fallback == nil || member.lossRate < fallback.lossRate ||
+			(member.lossRate == fallback.lossRate && member.latency < fallback.latency) {
+			fallback = member
+		}
+	}
+
+	if !anyHealthy && fallback != nil {
+		fallback.healthy = true
+		fallback.reason = ""
+	}
+}
+
+// notifyClosed tells the other router that the bond has been dissolved, using one of the remaining members
+func (self *bondedLink) notifyClosed() {
+	msg := newBondCloseMessage(self.iteration)
+	err := self.send(func(member *bondMember) error {
+		return member.sender.SendControlMessage(msg)
+	})
+	if err != nil {
+		pfxlog.Logger().WithField("linkId", self.id).
+			WithField("routerId", self.destId).
+			WithError(err).Debug("unable to notify router of dissolved bond")
+	}
+}
+
+func newBondCloseMessage(iteration uint32) *channel.Message {
+	msg := channel.NewMessage(xlink.ContentTypeBondClose, nil)
+	msg.PutUint32Header(xlink.HeaderKeyBondIteration, iteration)
+	return msg
+}
+
+func (self *bondedLink) close() {
+	if self.closed.CompareAndSwap(false, true) {
+		close(self.closeNotify)
+		self.latencyMetric.Dispose()
+	}
+}
+
+func (self *bondedLink) InspectCircuit(detail *inspect.CircuitInspectDetail) {
+	detail.LinkDetails[self.id] = self.InspectLink()
+}
+
+func (self *bondedLink) InspectLink() *inspect.LinkInspectDetail {
+	bondDetail := &inspect.LinkBondDetail{
+		ReorderBuffers: self.buffers.Count(),
+	}
+
+	self.lock.Lock()
+	for _, member := range self.members {
+		bondDetail.Members = append(bondDetail.Members, &inspect.LinkBondMemberDetail{
+			LinkId:   member.link.Id(),
+			Healthy:  member.healthy,
+			Reason:   member.reason,
+			Latency:  member.latency.String(),
+			LossRate: member.lossRate,
+			Sent:     member.sent.Load(),
+		})
+	}
+	self.lock.Unlock()
+
+	return &inspect.LinkInspectDetail{
+		Id:        self.id,
+		Iteration: self.iteration,
+		Key:       self.id,
+		Protocol:  xlink.BondLinkProtocol,
+		Dest:      self.destId,
+		Dialed:    self.isOwner(),
+		Bond:      bondDetail,
+	}
+}
@@ -0,0 +1,153 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package link
+
+import (
+	"github.com/pkg/errors"
+	"reflect"
+	"time"
+)
+
+const (
+	DefaultBondReorderTimeout     = 50 * time.Millisecond
+	DefaultBondReorderWindow      = 256
+	DefaultBondEvaluationInterval = 5 * time.Second
+	DefaultBondMaxLatencyRatio    = 2.0
+	DefaultBondMinLatencyDelta    = 20 * time.Millisecond
+	DefaultBondMaxLossRate        = 0.05
+	DefaultBondMinMembers         = 2
+)
+
+// BondingOptions configures bonded links. When enabled, the links to a router are grouped into a single logical link
+// once there are at least MinMembers of them, and payloads are striped across the healthy members. Bonding must be
+// enabled on both routers. The router with the lower id asks the other router to form the bond, and reports it to
+// controllers once the other router has accepted.
+type BondingOptions struct {
+	Enabled bool
+
+	// ReorderTimeout is how long a receiver will hold payloads waiting for an earlier payload sent over another member
+	ReorderTimeout time.Duration
+	// ReorderWindow is the maximum number of payloads held per circuit while waiting for an earlier payload
+	ReorderWindow int
+
+	// EvaluationInterval is how often member latency and loss are checked
+	EvaluationInterval time.Duration
+	// MaxLatencyRatio and MinLatencyDelta control when a member is considered too slow. A member is removed from
+	// striping if its latency is more than MaxLatencyRatio times the best member latency and also more than
+	// MinLatencyDelta over it
+	MaxLatencyRatio float64
+	MinLatencyDelta time.Duration
+	// MaxLossRate is the fraction of messages a member may drop during an evaluation interval before it's removed
+	// from striping
+	MaxLossRate float64
+	// MinMembers is the number of links to a router required to form a bond
+	MinMembers int
+}
+
+func DefaultBondingOptions() *BondingOptions {
+	return &BondingOptions{
+		ReorderTimeout:     DefaultBondReorderTimeout,
+		ReorderWindow:      DefaultBondReorderWindow,
+		EvaluationInterval: DefaultBondEvaluationInterval,
+		MaxLatencyRatio:    DefaultBondMaxLatencyRatio,
+		MinLatencyDelta:    DefaultBondMinLatencyDelta,
+		MaxLossRate:        DefaultBondMaxLossRate,
+		MinMembers:         DefaultBondMinMembers,
+	}
+}
+
+func LoadBondingOptions(data map[interface{}]interface{}) (*BondingOptions, error) {
+	options := DefaultBondingOptions()
+
+	if value, found := data["enabled"]; found {
+		if enabled, ok := value.(bool); ok {
+			options.Enabled = enabled
+		} else {
+			return nil, errors.Errorf("invalid 'enabled' in bonding config (%s), must be a boolean", reflect.TypeOf(value))
+		}
+	}
+
+	var err error
+	if options.ReorderTimeout, err = loadBondDuration(data, "reorderTimeout", options.ReorderTimeout); err != nil {
+		return nil, err
+	}
+
+	if value, found := data["reorderWindow"]; found {
+		if window, ok := value.(int); ok && window > 0 {
+			options.ReorderWindow = window
+		} else {
+			return nil, errors.Errorf("invalid 'reorderWindow' in bonding config (%v), must be a positive integer", value)
+		}
+	}
+
+	if options.EvaluationInterval, err = loadBondDuration(data, "evaluationInterval", options.EvaluationInterval); err != nil {
+		return nil, err
+	}
+
+	if value, found := data["maxLatencyRatio"]; found {
+		if ratio, ok := toFloat(value); ok && ratio >= 1 {
+			options.MaxLatencyRatio = ratio
+		} else {
+			return nil, errors.Errorf("invalid 'maxLatencyRatio' in bonding config (%v), must be a number >= 1", value)
+		}
+	}
+
+	if options.MinLatencyDelta, err = loadBondDuration(data, "minLatencyDelta", options.MinLatencyDelta); err != nil {
+		return nil, err
+	}
+
+	if value, found := data["maxLossRate"]; found {
+		if rate, ok := toFloat(value); ok && rate > 0 && rate <= 1 {
+			options.MaxLossRate = rate
+		} else {
+			return nil, errors.Errorf("invalid 'maxLossRate' in bonding config (%v), must be a number between 0 and 1", value)
+		}
+	}
+
+	if value, found := data["minMembers"]; found {
+		if minMembers, ok := value.(int); ok && minMembers >= 2 {
+			options.MinMembers = minMembers
+		} else {
+			return nil, errors.Errorf("invalid 'minMembers' in bonding config (%v), must be an integer >= 2", value)
+		}
+	}
+
+	return options, nil
+}
+
+func loadBondDuration(data map[interface{}]interface{}, key string, defaultValue time.Duration) (time.Duration, error) {
+	value, found := data[key]
+	if !found {
+		return defaultValue, nil
+	}
+	if strVal, ok := value.(string); ok {
+		if d, err := time.ParseDuration(strVal); err == nil && d > 0 {
+			return d, nil
+		}
+	}
+	return 0, errors.Errorf("invalid '%s' in bonding config (%v), must be a positive duration", key, value)
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
@@ -0,0 +1,137 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package link
+
+import (
+	"github.com/openziti/ziti/router/xgress"
+	"sync"
+	"time"
+)
+
+// reorderBuffer restores the order in which a circuit's payloads were sent over a bond. Payloads which arrive ahead
+// of an earlier payload are held until the gap is filled, the gap has been open for the reorder timeout, or the
+// window is full. Once a gap is skipped, payloads which arrive late are passed through immediately, and it's left to
+// xgress to handle them.
+//
+// Callers hold the lock while receiving and delivering, so that payloads are delivered in the order released.
+type reorderBuffer struct {
+	lock         sync.Mutex
+	next         uint64
+	pending      map[uint64]*xgress.Payload
+	gapSince     time.Time
+	lastActivity time.Time
+}
+
+func newReorderBuffer(now time.Time) *reorderBuffer {
+	return &reorderBuffer{
+		pending:      map[uint64]*xgress.Payload{},
+		lastActivity: now,
+	}
+}
+
+// receive accepts the payload with the given bond sequence and returns the payloads which can now be delivered, in
+// order
+func (self *reorderBuffer) receive(seq uint64, payload *xgress.Payload, window int, now time.Time) []*xgress.Payload {
+	self.lastActivity = now
+
+	var result []*xgress.Payload
+
+	// sequences start at 1, so seeing 1 again means the sender has started a new sequence for the circuit
+	if seq == 1 && self.next > 1 {
+		result = self.flush()
+		self.next = 1
+	}
+
+	if self.next == 0 {
+		self.next = seq
+	}
+
+	if seq < self.next {
+		return append(result, payload)
+	}
+
+	if seq > self.next {
+		self.pending[seq] = payload
+		if self.gapSince.IsZero() {
+			self.gapSince = now
+		}
+		if len(self.pending) > window {
+			result = append(result, self.skipGap(now)...)
+		}
+		return result
+	}
+
+	result = append(result, payload)
+	self.next++
+	return append(result, self.release(now)...)
+}
+
+// expire skips the current gap if it's been open for longer than the timeout, returning the payloads which can now be
+// delivered
+func (self *reorderBuffer) expire(timeout time.Duration, now time.Time) []*xgress.Payload {
+	if len(self.pending) == 0 || now.Sub(self.gapSince) < timeout {
+		return nil
+	}
+	return self.skipGap(now)
+}
+
+// skipGap gives up on the missing payloads before the lowest pending sequence
+func (self *reorderBuffer) skipGap(now time.Time) []*xgress.Payload {
+	first := true
+	for seq := range self.pending {
+		if first || seq < self.next {
+			self.next = seq
+			first = false
+		}
+	}
+	return self.release(now)
+}
+
+// release removes the payloads which follow on from next without a gap
+func (self *reorderBuffer) release(now time.Time) []*xgress.Payload {
+	var result []*xgress.Payload
+	for {
+		payload, found := self.pending[self.next]
+		if !found {
+			break
+		}
+		delete(self.pending, self.next)
+		result = append(result, payload)
+		self.next++
+	}
+
+	if len(self.pending) == 0 {
+		self.gapSince = time.Time{}
+	} else {
+		self.gapSince = now
+	}
+
+	return result
+}
+
+// flush returns all pending payloads in sequence order
+func (self *reorderBuffer) flush() []*xgress.Payload {
+	var result []*xgress.Payload
+	for len(self.pending) > 0 {
+		result = append(result, self.skipGap(time.Time{})...)
+	}
+	return result
+}
+
+func (self *reorderBuffer) isIdle(timeout time.Duration, now time.Time) bool {
+	return len(self.pending) == 0 && now.Sub(self.lastActivity) > timeout
+}
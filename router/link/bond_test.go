@@ -0,0 +1,188 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package link
+
+import (
+	"github.com/openziti/ziti/router/xgress"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func testPayload(seq uint64) *xgress.Payload {
+	return &xgress.Payload{Sequence: int32(seq)}
+}
+
+func payloadSequences(payloads []*xgress.Payload) []int32 {
+	var result []int32
+	for _, payload := range payloads {
+		result = append(result, payload.Sequence)
+	}
+	return result
+}
+
+func TestReorderBuffer(t *testing.T) {
+	req := require.New(t)
+	now := time.Now()
+	buffer := newReorderBuffer(now)
+
+	receive := func(seq uint64) []int32 {
+		return payloadSequences(buffer.receive(seq, testPayload(seq), 8, now))
+	}
+
+	req.Equal([]int32{1}, receive(1))
+	req.Equal([]int32{2}, receive(2))
+
+	// 4 and 5 arrive over a faster member and wait for 3
+	req.Empty(receive(4))
+	req.Empty(receive(5))
+	req.Equal([]int32{3, 4, 5}, receive(3))
+	req.Empty(buffer.pending)
+
+	// 6 is lost, so 7 is released once the reorder timeout passes
+	req.Empty(receive(7))
+	req.Empty(buffer.expire(50*time.Millisecond, now.Add(10*time.Millisecond)))
+	req.Equal([]int32{7}, payloadSequences(buffer.expire(50*time.Millisecond, now.Add(50*time.Millisecond))))
+
+	// once skipped, late payloads are passed through
+	req.Equal([]int32{6}, receive(6))
+	req.Equal([]int32{8}, receive(8))
+}
+
+func TestReorderBufferWindow(t *testing.T) {
+	req := require.New(t)
+	now := time.Now()
+	buffer := newReorderBuffer(now)
+
+	req.Len(buffer.receive(1, testPayload(1), 2, now), 1)
+	req.Empty(buffer.receive(3, testPayload(3), 2, now))
+	req.Empty(buffer.receive(4, testPayload(4), 2, now))
+
+	// a third held payload overflows the window, so the gap at 2 is skipped
+	req.Equal([]int32{3, 4}, payloadSequences(buffer.receive(6, testPayload(6), 2, now)))
+	req.Equal(uint64(5), buffer.next)
+	req.Len(buffer.pending, 1)
+}
+
+func TestReorderBufferRestart(t *testing.T) {
+	req := require.New(t)
+	now := time.Now()
+	buffer := newReorderBuffer(now)
+
+	// a buffer created mid-stream starts from the first sequence it sees
+	req.Equal([]int32{10}, payloadSequences(buffer.receive(10, testPayload(10), 8, now)))
+	req.Empty(buffer.receive(12, testPayload(12), 8, now))
+
+	// a sender which has started a new sequence flushes what was held
+	req.Equal([]int32{12, 1}, payloadSequences(buffer.receive(1, testPayload(1), 8, now)))
+	req.Equal([]int32{2}, payloadSequences(buffer.receive(2, testPayload(2), 8, now)))
+
+	req.False(buffer.isIdle(time.Minute, now.Add(time.Second)))
+	req.True(buffer.isIdle(time.Minute, now.Add(2*time.Minute)))
+}
+
+func TestEvaluateBondMembers(t *testing.T) {
+	req := require.New(t)
+	options := DefaultBondingOptions()
+
+	fast := &bondMember{latency: 20 * time.Millisecond}
+	similar := &bondMember{latency: 35 * time.Millisecond}
+	slow := &bondMember{latency: 200 * time.Millisecond}
+	lossy := &bondMember{latency: 10 * time.Millisecond, lossRate: 0.2}
+	unmeasured := &bondMember{}
+
+	evaluateBondMembers(options, []*bondMember{fast, similar, slow, lossy, unmeasured})
+
+	req.True(fast.healthy)
+	req.True(similar.healthy)
+	req.True(unmeasured.healthy)
+	req.False(slow.healthy)
+	req.Contains(slow.reason, "latency")
+	req.False(lossy.healthy)
+	req.Contains(lossy.reason, "loss")
+
+	// small differences in latency don't remove members, even if the ratio is large
+	close1 := &bondMember{latency: 2 * time.Millisecond}
+	close2 := &bondMember{latency: 15 * time.Millisecond}
+	evaluateBondMembers(options, []*bondMember{close1, close2})
+	req.True(close1.healthy)
+	req.True(close2.healthy)
+
+	// members recover once they're back in line
+	slow.latency = 25 * time.Millisecond
+	evaluateBondMembers(options, []*bondMember{fast, slow})
+	req.True(slow.healthy)
+	req.Empty(slow.reason)
+
+	// the least bad member is kept if all members are unhealthy
+	lossy1 := &bondMember{latency: 10 * time.Millisecond, lossRate: 0.5}
+	lossy2 := &bondMember{latency: 10 * time.Millisecond, lossRate: 0.1}
+	evaluateBondMembers(options, []*bondMember{lossy1, lossy2})
+	req.False(lossy1.healthy)
+	req.True(lossy2.healthy)
+}
+
+func TestLoadBondingOptions(t *testing.T) {
+	req := require.New(t)
+
+	options, err := LoadBondingOptions(map[interface{}]interface{}{})
+	req.NoError(err)
+	req.False(options.Enabled)
+	req.Equal(DefaultBondReorderTimeout, options.ReorderTimeout)
+	req.Equal(DefaultBondMinMembers, options.MinMembers)
+
+	options, err = LoadBondingOptions(map[interface{}]interface{}{
+		"enabled":            true,
+		"reorderTimeout":     "100ms",
+		"reorderWindow":      64,
+		"evaluationInterval": "10s",
+		"maxLatencyRatio":    3,
+		"minLatencyDelta":    "5ms",
+		"maxLossRate":        0.01,
+		"minMembers":         3,
+	})
+	req.NoError(err)
+	req.True(options.Enabled)
+	req.Equal(100*time.Millisecond, options.ReorderTimeout)
+	req.Equal(64, options.ReorderWindow)
+	req.Equal(10*time.Second, options.EvaluationInterval)
+	req.Equal(3.0, options.MaxLatencyRatio)
+	req.Equal(5*time.Millisecond, options.MinLatencyDelta)
+	req.Equal(0.01, options.MaxLossRate)
+	req.Equal(3, options.MinMembers)
+
+	_, err = LoadBondingOptions(map[interface{}]interface{}{"minMembers": 1})
+	req.Error(err)
+
+	_, err = LoadBondingOptions(map[interface{}]interface{}{"reorderTimeout": "soon"})
+	req.Error(err)
+
+	_, err = LoadBondingOptions(map[interface{}]interface{}{"maxLossRate": 2})
+	req.Error(err)
+}
+
+func TestBondId(t *testing.T) {
+	req := require.New(t)
+	req.Equal("bond:a:b", BondId("a", "b"))
+	req.Equal(BondId("a", "b"), BondId("b", "a"))
+}
+
+func TestIsBondOwner(t *testing.T) {
+	req := require.New(t)
+	req.True(isBondOwner("a", "b"))
+	req.False(isBondOwner("b", "a"))
+}
@@ -0,0 +1,410 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package link
+
+import (
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/channel/v2"
+	"github.com/openziti/channel/v2/protobufs"
+	"github.com/openziti/ziti/common/capabilities"
+	"github.com/openziti/ziti/common/pb/ctrl_pb"
+	"github.com/openziti/ziti/router/xlink"
+	"github.com/pkg/errors"
+	"sort"
+	"time"
+)
+
+const (
+	// bondNegotiationTimeout is how long the bond owner waits for the other router to accept or reject a bond. Routers
+	// which don't support bonding won't reply at all
+	bondNegotiationTimeout = 10 * time.Second
+
+	// bondNegotiationAttempts is how many times a rejected bond request is retried. The other router may not have
+	// registered all the member links yet, or may know of a later bond iteration
+	bondNegotiationAttempts = 3
+
+	// bondNegotiationRetryInterval is how long the bond owner waits before retrying a rejected bond request
+	bondNegotiationRetryInterval = time.Second
+)
+
+func (self *linkRegistryImpl) GetBond(routerId string) (xlink.Bond, bool) {
+	bond, found := self.bonds.Get(routerId)
+	if !found {
+		return nil, false
+	}
+	return bond, true
+}
+
+// addBondMember tracks the link as a candidate member of a bond to its destination. Once there are enough candidates
+// the router which owns the bond negotiates it with the other router.
+func (self *linkRegistryImpl) addBondMember(link xlink.Xlink) {
+	options := self.env.GetLinkBondingOptions()
+	if options == nil || !options.Enabled {
+		return
+	}
+
+	if _, ok := link.(xlink.MessageSender); !ok {
+		return
+	}
+
+	self.bondLock.Lock()
+	defer self.bondLock.Unlock()
+
+	destId := link.DestinationId()
+	candidates := self.bondCandidates[destId]
+	if candidates == nil {
+		candidates = map[string]xlink.Xlink{}
+		self.bondCandidates[destId] = candidates
+	}
+	candidates[link.Id()] = link
+
+	if bond, found := self.bonds.Get(destId); found {
+		bond.addMember(link)
+		pfxlog.Logger().WithField("linkId", bond.Id()).
+			WithField("memberLinkId", link.Id()).
+			Info("link added to bond")
+		return
+	}
+
+	// the other router owns the bond, and will ask this router to form it
+	if len(candidates) < options.MinMembers || !isBondOwner(self.env.GetRouterId().Token, destId) {
+		return
+	}
+
+	if !self.bondNegotiations[destId] {
+		self.bondNegotiations[destId] = true
+		go self.negotiateBond(destId, options)
+	}
+}
+
+// negotiateBond asks the other router to form the bond. The bond is only registered and reported to controllers once
+// the other router has registered its end of the bond, so that payloads sent over the bond are always reordered on
+// arrival.
+func (self *linkRegistryImpl) negotiateBond(destId string, options *BondingOptions) {
+	defer func() {
+		self.bondLock.Lock()
+		delete(self.bondNegotiations, destId)
+		self.bondLock.Unlock()
+	}()
+
+	log := pfxlog.Logger().WithField("dest", destId)
+
+	for attempt := 1; attempt <= bondNegotiationAttempts; attempt++ {
+		if attempt > 1 {
+			time.Sleep(bondNegotiationRetryInterval)
+		}
+
+		self.bondLock.Lock()
+		_, bonded := self.bonds.Get(destId)
+		candidates := self.bondCandidates[destId]
+		if bonded || len(candidates) < options.MinMembers {
+			self.bondLock.Unlock()
+			return
+		}
+
+		var memberIds []string
+		var sender xlink.MessageSender
+		for _, candidate := range candidates {
+			memberIds = append(memberIds, candidate.Id())
+			if sender == nil && !candidate.IsClosed() {
+				sender = candidate.(xlink.MessageSender)
+			}
+		}
+		sort.Strings(memberIds)
+
+		self.bondIterations[destId]++
+		iteration := self.bondIterations[destId]
+		self.bondLock.Unlock()
+
+		if sender == nil {
+			return
+		}
+
+		log := log.WithField("iteration", iteration).WithField("members", memberIds)
+
+		request := channel.NewMessage(xlink.ContentTypeBondRequest, nil)
+		request.PutUint32Header(xlink.HeaderKeyBondIteration, iteration)
+		request.PutStringSliceHeader(xlink.HeaderKeyBondMembers, memberIds)
+
+		reply, err := sender.SendForReply(request, bondNegotiationTimeout)
+		if err != nil {
+			log.WithError(err).Warn("no reply to bond request, router may not support bonding")
+			return
+		}
+
+		if reply.ContentType != channel.ContentTypeResultType {
+			log.Errorf("unexpected response type to bond request: %v", reply.ContentType)
+			return
+		}
+
+		result := channel.UnmarshalResult(reply)
+		if !result.Success {
+			log.WithField("reason", result.Message).Info("bond request rejected")
+			if peerIteration, found := reply.GetUint32Header(xlink.HeaderKeyBondIteration); found {
+				self.bondLock.Lock()
+				self.bondIterations[destId] = max(self.bondIterations[destId], peerIteration)
+				self.bondLock.Unlock()
+			}
+			continue
+		}
+
+		acceptedIds, _, err := reply.GetStringSliceHeader(xlink.HeaderKeyBondMembers)
+		if err != nil {
+			log.WithError(err).Error("unable to decode accepted bond members")
+			return
+		}
+
+		self.bondLock.Lock()
+		bond, err := self.formBond(destId, iteration, acceptedIds, options)
+		self.bondLock.Unlock()
+
+		if err != nil {
+			log.WithError(err).Error("unable to form accepted bond")
+			self.sendBondClose(sender, iteration)
+			return
+		}
+
+		self.sendBondMessage(bond, self.ctrls.AllResponsiveCtrlChannels()...)
+		return
+	}
+}
+
+// AcceptBond forms the bond requested by the link's destination router, which owns the bond. If a bond with an older
+// iteration exists, the owner no longer has it, so it's replaced. Requests for iterations which aren't newer than the
+// last known iteration are rejected. Returns the members the bond was formed with and the bond iteration, or on
+// rejection the last known iteration, so that the owner can move past it.
+func (self *linkRegistryImpl) AcceptBond(link xlink.Xlink, iteration uint32, memberIds []string) ([]string, uint32, error) {
+	options := self.env.GetLinkBondingOptions()
+	if options == nil || !options.Enabled {
+		return nil, 0, errors.New("bonding is not enabled")
+	}
+
+	destId := link.DestinationId()
+	if isBondOwner(self.env.GetRouterId().Token, destId) {
+		return nil, 0, errors.Errorf("bonds to router %s must be requested by this router", destId)
+	}
+
+	self.bondLock.Lock()
+	defer self.bondLock.Unlock()
+
+	if bond, found := self.bonds.Get(destId); found {
+		if bond.Iteration() == iteration {
+			return bond.memberIds(), iteration, nil
+		}
+		if bond.Iteration() < iteration {
+			self.dissolveBond(bond)
+			pfxlog.Logger().WithField("linkId", bond.Id()).
+				WithField("dest", destId).
+				WithField("iteration", bond.Iteration()).
+				WithField("newIteration", iteration).
+				Info("bond replaced by newer iteration")
+		}
+	}
+
+	lastIteration := self.bondIterations[destId]
+	if iteration <= lastIteration {
+		return nil, lastIteration, errors.Errorf("bond iteration %d is not newer than iteration %d", iteration, lastIteration)
+	}
+
+	bond, err := self.formBond(destId, iteration, memberIds, options)
+	if err != nil {
+		return nil, lastIteration, err
+	}
+
+	go self.sendBondMessage(bond, self.ctrls.AllResponsiveCtrlChannels()...)
+
+	return bond.memberIds(), iteration, nil
+}
+
+// CloseBond dissolves the bond to the link's destination when the other router reports that it dissolved the bond
+func (self *linkRegistryImpl) CloseBond(link xlink.Xlink, iteration uint32) {
+	self.bondLock.Lock()
+	defer self.bondLock.Unlock()
+
+	bond, found := self.bonds.Get(link.DestinationId())
+	if !found || bond.Iteration() != iteration {
+		return
+	}
+
+	self.dissolveBond(bond)
+
+	pfxlog.Logger().WithField("linkId", bond.Id()).
+		WithField("dest", bond.DestinationId()).
+		WithField("iteration", bond.Iteration()).
+		Info("bond dissolved by other router")
+
+	go self.sendBondFault(bond)
+}
+
+// formBond creates the bond to the destination with all the candidate members, and registers it with the forwarder.
+// The bond is only formed if at least MinMembers of the members known to the other router are also known here. Must
+// be called with the bond lock held.
+func (self *linkRegistryImpl) formBond(destId string, iteration uint32, memberIds []string, options *BondingOptions) (*bondedLink, error) {
+	candidates := self.bondCandidates[destId]
+
+	shared := 0
+	for _, memberId := range memberIds {
+		if candidate, found := candidates[memberId]; found && !candidate.IsClosed() {
+			shared++
+		}
+	}
+
+	if shared < options.MinMembers {
+		return nil, errors.Errorf("only %d of %d bond members are available, %d required", shared, len(memberIds), options.MinMembers)
+	}
+
+	bond := newBondedLink(self.env.GetRouterId().Token, destId, iteration, options,
+		self.env.GetBondForwarder(), self.env.GetMetricsRegistry())
+
+	for _, candidate := range candidates {
+		if !candidate.IsClosed() {
+			bond.addMember(candidate)
+		}
+	}
+
+	log := pfxlog.Logger().WithField("linkId", bond.Id()).
+		WithField("dest", destId).
+		WithField("iteration", bond.Iteration()).
+		WithField("members", bond.memberIds())
+
+	if err := bond.forwarder.RegisterLink(bond); err != nil {
+		bond.close()
+		return nil, errors.Wrap(err, "unable to register bond with forwarder")
+	}
+
+	self.bondIterations[destId] = iteration
+	self.bonds.Set(destId, bond)
+	go bond.run()
+
+	log.Info("bond formed")
+
+	return bond, nil
+}
+
+// removeBondMember removes the link from the bond to its destination. If there are no longer enough members, the bond
+// is dissolved and the other router and controllers are notified, so that circuits using the bond can be rerouted.
+func (self *linkRegistryImpl) removeBondMember(link xlink.Xlink) {
+	self.bondLock.Lock()
+	defer self.bondLock.Unlock()
+
+	destId := link.DestinationId()
+	if candidates := self.bondCandidates[destId]; candidates != nil {
+		if candidates[link.Id()] != link {
+			return
+		}
+		delete(candidates, link.Id())
+		if len(candidates) == 0 {
+			delete(self.bondCandidates, destId)
+		}
+	}
+
+	bond, found := self.bonds.Get(destId)
+	if !found {
+		return
+	}
+
+	remaining := bond.removeMember(link)
+
+	log := pfxlog.Logger().WithField("linkId", bond.Id()).
+		WithField("dest", destId).
+		WithField("iteration", bond.Iteration()).
+		WithField("memberLinkId", link.Id())
+
+	if remaining >= bond.options.MinMembers {
+		log.Info("link removed from bond")
+		return
+	}
+
+	bond.notifyClosed()
+	self.dissolveBond(bond)
+
+	log.Info("bond dissolved, too few members remaining")
+
+	go self.sendBondFault(bond)
+}
+
+// dissolveBond removes the bond and unregisters it from the forwarder. Must be called with the bond lock held.
+func (self *linkRegistryImpl) dissolveBond(bond *bondedLink) {
+	self.bonds.Remove(bond.DestinationId())
+	bond.forwarder.UnregisterLink(bond)
+	bond.close()
+}
+
+func (self *linkRegistryImpl) closeBonds() {
+	self.bondLock.Lock()
+	defer self.bondLock.Unlock()
+
+	for _, bond := range self.bonds.Items() {
+		self.dissolveBond(bond)
+	}
+}
+
+func (self *linkRegistryImpl) sendBondClose(sender xlink.MessageSender, iteration uint32) {
+	if err := sender.SendControlMessage(newBondCloseMessage(iteration)); err != nil {
+		pfxlog.Logger().WithError(err).Debug("unable to notify router of dissolved bond")
+	}
+}
+
+func (self *linkRegistryImpl) newBondRouterLink(bond *bondedLink) *ctrl_pb.RouterLinks_RouterLink {
+	return &ctrl_pb.RouterLinks_RouterLink{
+		Id:           bond.Id(),
+		DestRouterId: bond.DestinationId(),
+		LinkProtocol: xlink.BondLinkProtocol,
+		Iteration:    bond.Iteration(),
+		BondMembers:  bond.memberIds(),
+	}
+}
+
+// sendBondMessage notifies the given controllers about the bond. As with dialed links, only one end of the bond
+// reports it, unless the controller expects links to be reported from both ends.
+func (self *linkRegistryImpl) sendBondMessage(bond *bondedLink, channels ...channel.Channel) {
+	linkMsg := &ctrl_pb.RouterLinks{
+		Links: []*ctrl_pb.RouterLinks_RouterLink{self.newBondRouterLink(bond)},
+	}
+
+	log := pfxlog.Logger().
+		WithField("linkId", bond.Id()).
+		WithField("dest", bond.DestinationId()).
+		WithField("iteration", bond.Iteration())
+
+	for _, ch := range channels {
+		if !capabilities.IsCapable(ch, capabilities.ControllerSingleRouterLinkSource) || bond.isOwner() {
+			if err := protobufs.MarshalTyped(linkMsg).WithTimeout(10 * time.Second).SendAndWaitForWire(ch); err != nil {
+				log.WithField("ctrlId", ch.Id()).WithError(err).Error("error sending bond link message")
+			} else {
+				log.WithField("ctrlId", ch.Id()).Info("notified controller of bond")
+			}
+		}
+	}
+}
+
+func (self *linkRegistryImpl) sendBondFault(bond *bondedLink) {
+	self.ctrls.ForEach(func(ctrlId string, ch channel.Channel) {
+		fault := &ctrl_pb.Fault{
+			Subject:   ctrl_pb.FaultSubject_LinkFault,
+			Id:        bond.Id(),
+			Iteration: bond.Iteration(),
+		}
+
+		if err := protobufs.MarshalTyped(fault).WithTimeout(10 * time.Second).SendAndWaitForWire(ch); err != nil {
+			pfxlog.Logger().WithField("ctrlId", ctrlId).
+				WithField("linkId", bond.Id()).
+				WithError(err).
+				Error("failed to notify controller of dissolved bond")
+		}
+	})
+}
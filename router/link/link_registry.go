@@ -25,11 +25,13 @@ import (
 	"github.com/openziti/foundation/v2/debugz"
 	"github.com/openziti/foundation/v2/goroutines"
 	"github.com/openziti/identity"
+	"github.com/openziti/metrics"
 	"github.com/openziti/ziti/common/capabilities"
 	"github.com/openziti/ziti/common/inspect"
 	"github.com/openziti/ziti/common/pb/ctrl_pb"
 	"github.com/openziti/ziti/router/env"
 	"github.com/openziti/ziti/router/xlink"
+	cmap "github.com/orcaman/concurrent-map/v2"
 	"github.com/sirupsen/logrus"
 	"sync"
 	"sync/atomic"
@@ -43,18 +45,25 @@ type Env interface {
 	GetCloseNotify() <-chan struct{}
 	GetLinkDialerPool() goroutines.Pool
 	GetRateLimiterPool() goroutines.Pool
+	GetMetricsRegistry() metrics.UsageRegistry
+	GetLinkBondingOptions() *BondingOptions
+	GetBondForwarder() BondForwarder
 }
 
 func NewLinkRegistry(routerEnv Env) xlink.Registry {
 	result := &linkRegistryImpl{
-		linkMap:        map[string]xlink.Xlink{},
-		linkByIdMap:    map[string]xlink.Xlink{},
-		ctrls:          routerEnv.GetNetworkControllers(),
-		events:         make(chan event, 16),
-		env:            routerEnv,
-		destinations:   map[string]*linkDest{},
-		linkStateQueue: &linkStateHeap{},
-		triggerNotifyC: make(chan struct{}, 1),
+		linkMap:          map[string]xlink.Xlink{},
+		linkByIdMap:      map[string]xlink.Xlink{},
+		ctrls:            routerEnv.GetNetworkControllers(),
+		events:           make(chan event, 16),
+		env:              routerEnv,
+		destinations:     map[string]*linkDest{},
+		linkStateQueue:   &linkStateHeap{},
+		triggerNotifyC:   make(chan struct{}, 1),
+		bonds:            cmap.New[*bondedLink](),
+		bondCandidates:   map[string]map[string]xlink.Xlink{},
+		bondIterations:   map[string]uint32{},
+		bondNegotiations: map[string]bool{},
 	}
 
 	go result.run()
@@ -75,6 +84,12 @@ type linkRegistryImpl struct {
 	events           chan event
 	triggerNotifyC   chan struct{}
 	notifyInProgress atomic.Bool
+
+	bondLock         sync.Mutex
+	bonds            cmap.ConcurrentMap[string, *bondedLink]
+	bondCandidates   map[string]map[string]xlink.Xlink
+	bondIterations   map[string]uint32
+	bondNegotiations map[string]bool
 }
 
 func (self *linkRegistryImpl) GetLink(linkKey string) (xlink.Xlink, bool) {
@@ -193,6 +208,8 @@ func (self *linkRegistryImpl) applyLink(link xlink.Xlink) (xlink.Xlink, bool) {
 
 	log.Info("link registered")
 
+	self.addBondMember(link)
+
 	return nil, true
 }
 
@@ -209,6 +226,8 @@ func (self *linkRegistryImpl) LinkClosed(link xlink.Xlink) {
 	}
 	self.linkMapLocks.Unlock()
 
+	self.removeBondMember(link)
+
 	if markLinkStateClosed {
 		self.updateLinkStateClosed(link)
 	} else {
@@ -217,6 +236,8 @@ func (self *linkRegistryImpl) LinkClosed(link xlink.Xlink) {
 }
 
 func (self *linkRegistryImpl) Shutdown() {
+	self.closeBonds()
+
 	log := pfxlog.Logger()
 	linkCount := 0
 	for link := range self.Iter() {
@@ -319,6 +340,12 @@ func (self *linkRegistryImpl) NotifyOfReconnect(ch channel.Channel) {
 		}
 	}
 
+	for _, bond := range self.bonds.Items() {
+		if alwaysSend || bond.isOwner() {
+			routerLinks.Links = append(routerLinks.Links, self.newBondRouterLink(bond))
+		}
+	}
+
 	if err := protobufs.MarshalTyped(routerLinks).Send(ch); err != nil {
 		logrus.WithError(err).Error("failed to send router links on reconnect")
 	}
@@ -525,6 +552,9 @@ func (self *linkRegistryImpl) Inspect(timeout time.Duration) *inspect.LinksInspe
 	for link := range self.Iter() {
 		result.Links = append(result.Links, link.InspectLink())
 	}
+	for _, bond := range self.bonds.Items() {
+		result.Links = append(result.Links, bond.InspectLink())
+	}
 
 	var err error
 	result.Destinations, err = evt.GetResults(timeout)
@@ -223,5 +223,23 @@ func (handler *xgressPeekHandler) Tx(x *xgress.Xgress, payload *xgress.Payload)
 	}
 }
 
+// RxShaped records payloads read from the peer which were delayed by a bandwidth limit
+func (handler *xgressPeekHandler) RxShaped(x *xgress.Xgress, size int, _ time.Duration) {
+	if x.Originator() == xgress.Initiator {
+		handler.usageCounter.Update(x, "ingress.rx.shaped", time.Now(), uint64(size))
+	} else {
+		handler.usageCounter.Update(x, "egress.rx.shaped", time.Now(), uint64(size))
+	}
+}
+
+// TxShaped records payloads written to the peer which were delayed by a bandwidth limit
+func (handler *xgressPeekHandler) TxShaped(x *xgress.Xgress, size int, _ time.Duration) {
+	if x.Originator() == xgress.Initiator {
+		handler.usageCounter.Update(x, "ingress.tx.shaped", time.Now(), uint64(size))
+	} else {
+		handler.usageCounter.Update(x, "egress.tx.shaped", time.Now(), uint64(size))
+	}
+}
+
 func (handler *xgressPeekHandler) Close(*xgress.Xgress) {
 }
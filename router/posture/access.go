@@ -18,6 +18,13 @@ func HasAccess(rdm *common.RouterDataModel, identityId string, serviceId string,
 		return nil, err
 	}
 
+	if accessPolicies != nil && accessPolicies.DeniedBy != nil {
+		return nil, &DeniedByPolicyError{
+			Id:   accessPolicies.DeniedBy.Id,
+			Name: accessPolicies.DeniedBy.Name,
+		}
+	}
+
 	if accessPolicies == nil || len(accessPolicies.Policies) == 0 {
 		return nil, &NoPoliciesError{}
 	}
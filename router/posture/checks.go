@@ -3,6 +3,7 @@ package posture
 import (
 	"github.com/openziti/sdk-golang/pb/edge_client_pb"
 	"github.com/openziti/ziti/common/pb/edge_ctrl_pb"
+	"net"
 	"time"
 )
 
@@ -14,6 +15,18 @@ type Cache struct {
 	Woken       *edge_client_pb.PostureResponse_Woken
 	ProcessList *edge_client_pb.PostureResponse_ProcessList
 	PassedMfaAt *time.Time
+
+	DeviceKeyProvedAt *time.Time
+	SdkInfo           *SdkInfo
+	SourceIp          net.IP
+}
+
+// SdkInfo is the SDK and environment information reported by the endpoint at authentication
+type SdkInfo struct {
+	Type    string
+	Version string
+	AppId   string
+	OsType  string
 }
 
 type Check interface {
@@ -62,6 +75,21 @@ func CtrlCheckToLogic(postureCheck *edge_ctrl_pb.DataState_PostureCheck) Check {
 			DataState_PostureCheck:     postureCheck,
 			DataState_PostureCheck_Mfa: subCheck.Mfa,
 		}
+	case *edge_ctrl_pb.DataState_PostureCheck_DeviceKey_:
+		return &DeviceKeyCheck{
+			DataState_PostureCheck:           postureCheck,
+			DataState_PostureCheck_DeviceKey: subCheck.DeviceKey,
+		}
+	case *edge_ctrl_pb.DataState_PostureCheck_SdkVersion_:
+		return &SdkVersionCheck{
+			DataState_PostureCheck:            postureCheck,
+			DataState_PostureCheck_SdkVersion: subCheck.SdkVersion,
+		}
+	case *edge_ctrl_pb.DataState_PostureCheck_SourceIp_:
+		return &SourceIpCheck{
+			DataState_PostureCheck:          postureCheck,
+			DataState_PostureCheck_SourceIp: subCheck.SourceIp,
+		}
 	}
 
 	return nil
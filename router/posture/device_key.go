@@ -0,0 +1,49 @@
+package posture
+
+import (
+	"fmt"
+	"github.com/openziti/ziti/common/pb/edge_ctrl_pb"
+	"github.com/pkg/errors"
+	"time"
+)
+
+// DeviceKeyCheck requires that the endpoint has proven possession of the device key enrolled for its identity by
+// signing a controller issued challenge, so the check fails for a copied identity used on a device which doesn't hold
+// the key.
+type DeviceKeyCheck struct {
+	*edge_ctrl_pb.DataState_PostureCheck
+	*edge_ctrl_pb.DataState_PostureCheck_DeviceKey
+}
+
+func (d *DeviceKeyCheck) Evaluate(state *Cache) *CheckError {
+	if state == nil {
+		return &CheckError{
+			Id:    d.Id,
+			Name:  d.Name,
+			Cause: NilStateError,
+		}
+	}
+
+	if state.DeviceKeyProvedAt == nil {
+		return &CheckError{
+			Id:    d.Id,
+			Name:  d.Name,
+			Cause: errors.New("device key has never been proven"),
+		}
+	}
+
+	if d.TimeoutSeconds != NoTimeout && d.TimeoutSeconds > 0 {
+		now := time.Now()
+		timeout := time.Duration(d.TimeoutSeconds) * time.Second
+
+		if state.DeviceKeyProvedAt.Add(timeout).Before(now) {
+			return &CheckError{
+				Id:    d.Id,
+				Name:  d.Name,
+				Cause: fmt.Errorf("last device key proof exceeded timeout of %s, proven at %s, checked at %s", timeout.String(), state.DeviceKeyProvedAt.String(), now.String()),
+			}
+		}
+	}
+
+	return nil
+}
@@ -23,6 +23,15 @@ func (e *NoPoliciesError) Error() string {
 	return "no policies provide access"
 }
 
+type DeniedByPolicyError struct {
+	Id   string
+	Name string
+}
+
+func (e *DeniedByPolicyError) Error() string {
+	return fmt.Sprintf("access denied by deny policy %s [%s]", e.Name, e.Id)
+}
+
 type PolicyAccessError struct {
 	Id     string
 	Name   string
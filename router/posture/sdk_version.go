@@ -0,0 +1,95 @@
+package posture
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/blang/semver"
+	"github.com/openziti/foundation/v2/stringz"
+	"github.com/openziti/ziti/common/pb/edge_ctrl_pb"
+	"github.com/pkg/errors"
+)
+
+// SdkVersionCheck requires that the SDK reported by the endpoint satisfies the version constraints for its SDK type
+// and OS and, if app ids are given, reports one of the allowed application ids. When there are constraints, an SDK
+// which matches none of them fails.
+type SdkVersionCheck struct {
+	*edge_ctrl_pb.DataState_PostureCheck
+	*edge_ctrl_pb.DataState_PostureCheck_SdkVersion
+}
+
+func (s *SdkVersionCheck) Evaluate(state *Cache) *CheckError {
+	if state == nil {
+		return &CheckError{
+			Id:    s.Id,
+			Name:  s.Name,
+			Cause: NilStateError,
+		}
+	}
+
+	if err := s.evaluate(state.SdkInfo); err != nil {
+		return &CheckError{
+			Id:    s.Id,
+			Name:  s.Name,
+			Cause: err,
+		}
+	}
+
+	return nil
+}
+
+func (s *SdkVersionCheck) evaluate(sdkInfo *SdkInfo) error {
+	if sdkInfo == nil {
+		return errors.New("no sdk information was reported")
+	}
+
+	osType := normalizeOsType(sdkInfo.OsType)
+
+	if len(s.Constraints) > 0 {
+		version, err := semver.ParseTolerant(sdkInfo.Version)
+		if err != nil {
+			return fmt.Errorf("sdk version %q of sdk type %q is not a valid semantic version", sdkInfo.Version, sdkInfo.Type)
+		}
+
+		matched := false
+		for _, constraint := range s.Constraints {
+			if constraint.SdkType != "" && !strings.EqualFold(constraint.SdkType, sdkInfo.Type) {
+				continue
+			}
+
+			if constraint.OsType != "" && normalizeOsType(constraint.OsType) != osType {
+				continue
+			}
+
+			matched = true
+
+			versionRange, err := semver.ParseRange(constraint.Versions)
+			if err != nil {
+				return fmt.Errorf("version constraint %q is not a valid semantic version range", constraint.Versions)
+			}
+
+			if !versionRange(version) {
+				return fmt.Errorf("sdk version %s of sdk type %q on os %q does not satisfy %q", version, sdkInfo.Type, osType, constraint.Versions)
+			}
+		}
+
+		if !matched {
+			return fmt.Errorf("sdk type %q on os %q is not allowed", sdkInfo.Type, osType)
+		}
+	}
+
+	if len(s.AppIds) > 0 && !stringz.Contains(s.AppIds, sdkInfo.AppId) {
+		return fmt.Errorf("application id %q is not allowed", sdkInfo.AppId)
+	}
+
+	return nil
+}
+
+// normalizeOsType maps the Go runtime OS names reported by SDKs to the lower cased OS types used by posture checks
+func normalizeOsType(osType string) string {
+	osType = strings.ToLower(osType)
+	if osType == "darwin" {
+		return "macos"
+	}
+	return osType
+}
@@ -0,0 +1,98 @@
+package posture
+
+import (
+	"net"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/ziti/common"
+	"github.com/openziti/ziti/common/pb/edge_ctrl_pb"
+)
+
+// SourceIpCheck requires that the observed source IP of the endpoint is not in any of the denied CIDRs and, if
+// allowed CIDRs are given, is in one of them.
+type SourceIpCheck struct {
+	*edge_ctrl_pb.DataState_PostureCheck
+	*edge_ctrl_pb.DataState_PostureCheck_SourceIp
+}
+
+func (s *SourceIpCheck) Evaluate(state *Cache) *CheckError {
+	if state == nil {
+		return &CheckError{
+			Id:    s.Id,
+			Name:  s.Name,
+			Cause: NilStateError,
+		}
+	}
+
+	if err := common.CheckSourceIp(state.SourceIp, s.AllowedCidrs, s.DeniedCidrs); err != nil {
+		return &CheckError{
+			Id:    s.Id,
+			Name:  s.Name,
+			Cause: err,
+		}
+	}
+
+	return nil
+}
+
+// HasSourceIpAccess evaluates only the SOURCE_IP posture checks of the policies granting the identity access to the
+// service, using the source IP the dial was observed from. Other posture checks are evaluated by the controller, which
+// has the posture data for them. Access is allowed if any granting policy's source ip checks pass. If the router data
+// model doesn't know about the identity or service, the controller's evaluation is relied on.
+func HasSourceIpAccess(rdm *common.RouterDataModel, identityId string, serviceId string, sourceIp net.IP) error {
+	log := pfxlog.Logger().WithField("identityId", identityId).WithField("serviceId", serviceId)
+
+	if rdm == nil || identityId == "" || serviceId == "" {
+		return nil
+	}
+
+	accessPolicies, err := rdm.GetServiceAccessPolicies(identityId, serviceId, edge_ctrl_pb.PolicyType_DialPolicy)
+	if err != nil || accessPolicies == nil || len(accessPolicies.Policies) == 0 {
+		log.WithError(err).Debug("no access policies found in router data model, skipping source ip checks")
+		return nil
+	}
+
+	cache := &Cache{
+		SourceIp: sourceIp,
+	}
+
+	errs := &PolicyAccessErrors{}
+
+	for _, policy := range accessPolicies.Policies {
+		policyErr := &PolicyAccessError{
+			Id:     policy.Id,
+			Name:   policy.Name,
+			Errors: []error{},
+		}
+
+		for postureCheckId := range policy.PostureChecks {
+			// checks missing from the model are left to the controller's evaluation
+			postureCheck, ok := accessPolicies.PostureChecks[postureCheckId]
+			if !ok || postureCheck == nil {
+				continue
+			}
+
+			sourceIpCheck, ok := postureCheck.Subtype.(*edge_ctrl_pb.DataState_PostureCheck_SourceIp_)
+			if !ok {
+				continue
+			}
+
+			check := &SourceIpCheck{
+				DataState_PostureCheck:          postureCheck,
+				DataState_PostureCheck_SourceIp: sourceIpCheck.SourceIp,
+			}
+
+			if checkErr := check.Evaluate(cache); checkErr != nil {
+				policyErr.Errors = append(policyErr.Errors, checkErr)
+			}
+		}
+
+		if len(policyErr.Errors) == 0 {
+			return nil
+		}
+
+		*errs = append(*errs, policyErr)
+	}
+
+	return errs
+}
@@ -129,6 +129,14 @@ func (self *Router) GetXlinkRegistry() xlink.Registry {
 	return self.xlinkRegistry
 }
 
+func (self *Router) GetLinkBondingOptions() *link.BondingOptions {
+	return self.config.Link.Bonding
+}
+
+func (self *Router) GetBondForwarder() link.BondForwarder {
+	return self.forwarder
+}
+
 func (self *Router) GetCloseNotify() <-chan struct{} {
 	return self.shutdownC
 }
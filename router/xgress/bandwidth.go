@@ -0,0 +1,210 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/openziti/ziti/common/inspect"
+)
+
+// ShapedPeekHandler may be implemented by a PeekHandler which wants to be notified when a payload is delayed by a
+// bandwidth limit. size is the payload size and delay is how long the payload was held back.
+type ShapedPeekHandler interface {
+	RxShaped(x *Xgress, size int, delay time.Duration)
+	TxShaped(x *Xgress, size int, delay time.Duration)
+}
+
+// TokenBucket is a byte based token bucket. Tokens accrue at the configured rate up to the burst size. A reservation
+// always succeeds, but may leave the bucket in debt, in which case the caller must wait before sending.
+type TokenBucket struct {
+	lock   sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// NewTokenBucket creates a bucket which allows rateBytesPerSecond on average. If burstBytes is zero, the burst
+// defaults to one second worth of traffic.
+func NewTokenBucket(rateBytesPerSecond, burstBytes uint64) *TokenBucket {
+	if burstBytes == 0 {
+		burstBytes = rateBytesPerSecond
+	}
+	return &TokenBucket{
+		rate:   float64(rateBytesPerSecond),
+		burst:  float64(burstBytes),
+		tokens: float64(burstBytes),
+		last:   time.Now(),
+	}
+}
+
+func (self *TokenBucket) Rate() uint64 {
+	return uint64(self.rate)
+}
+
+func (self *TokenBucket) Burst() uint64 {
+	return uint64(self.burst)
+}
+
+// Reserve takes size tokens from the bucket and returns how long the caller must wait before the reservation is
+// covered. A zero duration means the bytes may be sent immediately.
+func (self *TokenBucket) Reserve(size int, now time.Time) time.Duration {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if elapsed := now.Sub(self.last); elapsed > 0 {
+		self.tokens += elapsed.Seconds() * self.rate
+		if self.tokens > self.burst {
+			self.tokens = self.burst
+		}
+		self.last = now
+	}
+
+	self.tokens -= float64(size)
+	if self.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-self.tokens / self.rate * float64(time.Second))
+}
+
+// BandwidthLimit is a bandwidth limit as applied to a single xgress. The token buckets may be shared with other
+// xgress instances, depending on the scope of the limit.
+type BandwidthLimit struct {
+	Id    string
+	Name  string
+	Scope string
+	Rx    *TokenBucket
+	Tx    *TokenBucket
+
+	rxShapedPayloads atomic.Uint64
+	txShapedPayloads atomic.Uint64
+	rxShapedBytes    atomic.Uint64
+	txShapedBytes    atomic.Uint64
+	rxDelay          atomic.Int64
+	txDelay          atomic.Int64
+}
+
+func (self *BandwidthLimit) inspect() *inspect.XgressBandwidthLimitDetail {
+	return &inspect.XgressBandwidthLimitDetail{
+		Id:                 self.Id,
+		Name:               self.Name,
+		Scope:              self.Scope,
+		RateBytesPerSecond: self.Rx.Rate(),
+		BurstBytes:         self.Rx.Burst(),
+		RxShapedPayloads:   self.rxShapedPayloads.Load(),
+		TxShapedPayloads:   self.txShapedPayloads.Load(),
+		RxShapedBytes:      self.rxShapedBytes.Load(),
+		TxShapedBytes:      self.txShapedBytes.Load(),
+		RxDelay:            time.Duration(self.rxDelay.Load()).String(),
+		TxDelay:            time.Duration(self.txDelay.Load()).String(),
+	}
+}
+
+// AddBandwidthLimit applies a bandwidth limit to data read from and written to the peer. Limits must be added before
+// the xgress is started.
+func (self *Xgress) AddBandwidthLimit(limit *BandwidthLimit) {
+	self.bandwidthLimits = append(self.bandwidthLimits, limit)
+}
+
+// shapeRx holds back data read from the peer until every applicable limit has capacity for it. Returns false if the
+// xgress was closed while waiting.
+func (self *Xgress) shapeRx(size int) bool {
+	if len(self.bandwidthLimits) == 0 {
+		return true
+	}
+
+	var delay time.Duration
+	now := time.Now()
+	for _, limit := range self.bandwidthLimits {
+		if d := limit.Rx.Reserve(size, now); d > 0 {
+			limit.rxShapedPayloads.Add(1)
+			limit.rxShapedBytes.Add(uint64(size))
+			limit.rxDelay.Add(int64(d))
+			delay = max(delay, d)
+		}
+	}
+
+	if delay == 0 {
+		return true
+	}
+
+	for _, peekHandler := range self.peekHandlers {
+		if shapedHandler, ok := peekHandler.(ShapedPeekHandler); ok {
+			shapedHandler.RxShaped(self, size, delay)
+		}
+	}
+
+	return self.waitForShaping(delay)
+}
+
+// shapeTx holds back data to be written to the peer until every applicable limit has capacity for it. Returns false
+// if the xgress was closed while waiting.
+func (self *Xgress) shapeTx(size int) bool {
+	if len(self.bandwidthLimits) == 0 {
+		return true
+	}
+
+	var delay time.Duration
+	now := time.Now()
+	for _, limit := range self.bandwidthLimits {
+		if d := limit.Tx.Reserve(size, now); d > 0 {
+			limit.txShapedPayloads.Add(1)
+			limit.txShapedBytes.Add(uint64(size))
+			limit.txDelay.Add(int64(d))
+			delay = max(delay, d)
+		}
+	}
+
+	if delay == 0 {
+		return true
+	}
+
+	for _, peekHandler := range self.peekHandlers {
+		if shapedHandler, ok := peekHandler.(ShapedPeekHandler); ok {
+			shapedHandler.TxShaped(self, size, delay)
+		}
+	}
+
+	return self.waitForShaping(delay)
+}
+
+func (self *Xgress) waitForShaping(delay time.Duration) bool {
+	shapedPayloadsMeter.Mark(1)
+	shapingDelayTimer.Update(delay)
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-self.closeNotify:
+		return false
+	}
+}
+
+func (self *Xgress) inspectBandwidthLimits() []*inspect.XgressBandwidthLimitDetail {
+	var result []*inspect.XgressBandwidthLimitDetail
+	for _, limit := range self.bandwidthLimits {
+		result = append(result, limit.inspect())
+	}
+	return result
+}
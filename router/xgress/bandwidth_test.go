@@ -0,0 +1,50 @@
+package xgress
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_TokenBucket(t *testing.T) {
+	req := require.New(t)
+
+	bucket := NewTokenBucket(1000, 0)
+	req.Equal(uint64(1000), bucket.Rate())
+	req.Equal(uint64(1000), bucket.Burst())
+
+	now := bucket.last
+
+	// the full burst may be sent immediately
+	req.Equal(time.Duration(0), bucket.Reserve(1000, now))
+
+	// once the burst is used, the caller waits for the deficit to refill
+	req.Equal(500*time.Millisecond, bucket.Reserve(500, now))
+
+	// after the debt is repaid, tokens accrue at the configured rate
+	now = now.Add(time.Second)
+	req.Equal(time.Duration(0), bucket.Reserve(500, now))
+
+	// refill never goes past the burst size
+	now = now.Add(time.Hour)
+	req.Equal(time.Duration(0), bucket.Reserve(1000, now))
+	req.Equal(time.Second, bucket.Reserve(1000, now))
+}
+
+func Test_TokenBucketBurst(t *testing.T) {
+	req := require.New(t)
+
+	bucket := NewTokenBucket(1000, 4000)
+	req.Equal(uint64(4000), bucket.Burst())
+
+	now := bucket.last
+	req.Equal(time.Duration(0), bucket.Reserve(4000, now))
+	req.Equal(2*time.Second, bucket.Reserve(2000, now))
+}
+
+func Test_ShapeRxWithoutLimits(t *testing.T) {
+	x := &Xgress{}
+	require.True(t, x.shapeRx(1024))
+	require.True(t, x.shapeTx(1024))
+}
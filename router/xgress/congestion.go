@@ -0,0 +1,196 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress
+
+import (
+	"sort"
+
+	"github.com/openziti/ziti/common/inspect"
+	"github.com/pkg/errors"
+)
+
+const (
+	CongestionControlTxPortal = "txportal"
+	CongestionControlCubic    = "cubic"
+	CongestionControlBbr      = "bbr"
+
+	// CircuitTagCongestionControl is the circuit tag used by the controller to override the congestion control
+	// algorithm configured on the router for a given service
+	CircuitTagCongestionControl = "congestionControl"
+)
+
+// CongestionControl decides how many unacknowledged bytes a LinkSendBuffer may have outstanding. Implementations are
+// only ever called from the LinkSendBuffer's run loop, so don't need to be thread-safe. Times are in milliseconds.
+type CongestionControl interface {
+	// Algorithm returns the name the algorithm is configured by
+	Algorithm() string
+
+	// WindowSize returns the number of bytes which may be outstanding
+	WindowSize() uint32
+
+	// RetxScale returns the factor applied to the round trip time to get the retransmission threshold
+	RetxScale() float64
+
+	// OnAck is called when a buffered payload of the given size is acknowledged
+	OnAck(size uint32, now int64)
+
+	// OnDuplicateAck is called when an acknowledgement arrives for a payload which is no longer buffered, meaning
+	// it was retransmitted unnecessarily
+	OnDuplicateAck(now int64)
+
+	// OnRtt is called with each round trip time sample
+	OnRtt(rtt uint16, now int64)
+
+	// OnRetransmit is called when a payload is queued for retransmission, which is taken as a sign of loss
+	OnRetransmit(now int64)
+
+	// Inspect adds the algorithm's state to the send buffer inspection
+	Inspect(detail *inspect.XgressSendBufferDetail)
+}
+
+type CongestionControlFactory func(options *Options) CongestionControl
+
+var congestionControlFactories = map[string]CongestionControlFactory{
+	CongestionControlTxPortal: newTxPortalCongestionControl,
+	CongestionControlCubic:    newCubicCongestionControl,
+	CongestionControlBbr:      newBbrCongestionControl,
+}
+
+// CongestionControlAlgorithms returns the names of the supported congestion control algorithms
+func CongestionControlAlgorithms() []string {
+	var result []string
+	for algorithm := range congestionControlFactories {
+		result = append(result, algorithm)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func NewCongestionControl(algorithm string, options *Options) (CongestionControl, error) {
+	factory, found := congestionControlFactories[algorithm]
+	if !found {
+		return nil, errors.Errorf("unsupported congestion control algorithm '%s', must be one of %v", algorithm, CongestionControlAlgorithms())
+	}
+	return factory(options), nil
+}
+
+func clampWindowSize(windowSize float64, options *Options) float64 {
+	if windowSize > float64(options.TxPortalMaxSize) {
+		return float64(options.TxPortalMaxSize)
+	}
+	if windowSize < float64(options.TxPortalMinSize) {
+		return float64(options.TxPortalMinSize)
+	}
+	return windowSize
+}
+
+// txPortalCongestionControl is the original xgress windowing algorithm. The window grows by the bytes acknowledged
+// since the last window reduction every TxPortalIncreaseThresh acks, and is scaled down by TxPortalRetxScale every
+// TxPortalRetxThresh retransmits. Duplicate acks make retransmission less aggressive.
+type txPortalCongestionControl struct {
+	options        *Options
+	windowSize     uint32
+	retxScale      float64
+	accumulator    uint32
+	successfulAcks uint32
+	duplicateAcks  uint32
+	retransmits    uint32
+}
+
+func newTxPortalCongestionControl(options *Options) CongestionControl {
+	return &txPortalCongestionControl{
+		options:    options,
+		windowSize: options.TxPortalStartSize,
+		retxScale:  options.RetxScale,
+	}
+}
+
+func (self *txPortalCongestionControl) Algorithm() string {
+	return CongestionControlTxPortal
+}
+
+func (self *txPortalCongestionControl) WindowSize() uint32 {
+	return self.windowSize
+}
+
+func (self *txPortalCongestionControl) RetxScale() float64 {
+	return self.retxScale
+}
+
+func (self *txPortalCongestionControl) OnAck(size uint32, _ int64) {
+	self.accumulator += size
+	self.successfulAcks++
+
+	if self.successfulAcks >= self.options.TxPortalIncreaseThresh {
+		self.successfulAcks = 0
+		delta := uint32(float64(self.accumulator) * self.options.TxPortalIncreaseScale)
+		self.windowSize += delta
+		if self.windowSize > self.options.TxPortalMaxSize {
+			self.windowSize = self.options.TxPortalMaxSize
+		}
+		self.retxScale -= 0.02
+		if self.retxScale < self.options.RetxScale {
+			self.retxScale = self.options.RetxScale
+		}
+	}
+}
+
+func (self *txPortalCongestionControl) OnDuplicateAck(int64) {
+	self.duplicateAcks++
+	if self.duplicateAcks >= self.options.TxPortalDupAckThresh {
+		self.duplicateAcks = 0
+		self.retxScale += 0.2
+	}
+}
+
+func (self *txPortalCongestionControl) OnRtt(uint16, int64) {}
+
+func (self *txPortalCongestionControl) OnRetransmit(int64) {
+	self.retransmits++
+	if self.retransmits >= self.options.TxPortalRetxThresh {
+		self.accumulator = 0
+		self.retransmits = 0
+		self.scale(self.options.TxPortalRetxScale)
+	}
+}
+
+func (self *txPortalCongestionControl) scale(factor float64) {
+	self.windowSize = uint32(float64(self.windowSize) * factor)
+	if factor > 1 {
+		if self.windowSize > self.options.TxPortalMaxSize {
+			self.windowSize = self.options.TxPortalMaxSize
+		}
+	} else if self.windowSize < self.options.TxPortalMinSize {
+		self.windowSize = self.options.TxPortalMinSize
+	}
+}
+
+func (self *txPortalCongestionControl) Inspect(detail *inspect.XgressSendBufferDetail) {
+	detail.Accumulator = self.accumulator
+	detail.SuccessfulAcks = self.successfulAcks
+	detail.DuplicateAcks = self.duplicateAcks
+	detail.Retransmits = self.retransmits
+	detail.CongestionControl = &inspect.XgressCongestionControlDetail{
+		Algorithm: self.Algorithm(),
+		State: map[string]interface{}{
+			"accumulator":    self.accumulator,
+			"successfulAcks": self.successfulAcks,
+			"duplicateAcks":  self.duplicateAcks,
+			"retransmits":    self.retransmits,
+		},
+	}
+}
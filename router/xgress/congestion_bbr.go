@@ -0,0 +1,230 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress
+
+import (
+	"github.com/openziti/ziti/common/inspect"
+)
+
+const (
+	bbrStateStartup  = "startup"
+	bbrStateDrain    = "drain"
+	bbrStateProbeBw  = "probeBw"
+	bbrStateProbeRtt = "probeRtt"
+
+	bbrStartupGain        = 2.89
+	bbrCwndGain           = 2.0
+	bbrFullBwGrowth       = 1.25
+	bbrFullBwRounds       = 3
+	bbrBwFilterRounds     = 10
+	bbrMinRttWindowMs     = 10_000
+	bbrProbeRttDurationMs = 200
+	bbrMinRoundMs         = 10
+)
+
+var bbrProbeBwGains = []float64{1.25, 0.75, 1, 1, 1, 1, 1, 1}
+
+// bbrCongestionControl is a BBR-style algorithm. Rather than reacting to loss, it models the path by tracking the
+// maximum delivery rate seen over recent round trips and the minimum round trip time, and sizes the window from the
+// resulting bandwidth-delay product. Since xgress doesn't pace sends, the probing gains are applied to the window.
+//
+//   - startup grows the window exponentially until the delivery rate stops increasing
+//   - drain shrinks the window to one bandwidth-delay product for a round, to empty the queue startup built up
+//   - probeBw cycles the window around the bandwidth-delay product to discover more bandwidth
+//   - probeRtt shrinks the window to the minimum briefly if the minimum rtt hasn't been refreshed in a while
+type bbrCongestionControl struct {
+	options       *Options
+	state         string
+	windowSize    float64
+	bwSamples     [bbrBwFilterRounds]float64
+	round         uint64
+	roundStart    int64
+	delivered     uint64
+	minRtt        int64
+	minRttStamp   int64
+	fullBw        float64
+	fullBwRounds  int
+	fullBwReached bool
+	cycleIndex    int
+	probeRttDone  int64
+	duplicateAcks uint32
+}
+
+func newBbrCongestionControl(options *Options) CongestionControl {
+	return &bbrCongestionControl{
+		options:    options,
+		state:      bbrStateStartup,
+		windowSize: clampWindowSize(float64(options.TxPortalStartSize), options),
+	}
+}
+
+func (self *bbrCongestionControl) Algorithm() string {
+	return CongestionControlBbr
+}
+
+func (self *bbrCongestionControl) WindowSize() uint32 {
+	return uint32(self.windowSize)
+}
+
+func (self *bbrCongestionControl) RetxScale() float64 {
+	return self.options.RetxScale
+}
+
+// bandwidth returns the estimated bottleneck bandwidth in bytes per millisecond
+func (self *bbrCongestionControl) bandwidth() float64 {
+	var result float64
+	for _, sample := range self.bwSamples {
+		if sample > result {
+			result = sample
+		}
+	}
+	return result
+}
+
+func (self *bbrCongestionControl) bdp() float64 {
+	return self.bandwidth() * float64(self.minRtt)
+}
+
+func (self *bbrCongestionControl) roundLength() int64 {
+	roundLength := self.minRtt
+	if roundLength == 0 {
+		roundLength = int64(self.options.RetxStartMs)
+	}
+	if roundLength < bbrMinRoundMs {
+		roundLength = bbrMinRoundMs
+	}
+	return roundLength
+}
+
+func (self *bbrCongestionControl) OnAck(size uint32, now int64) {
+	if self.roundStart == 0 {
+		self.roundStart = now
+	}
+	self.delivered += uint64(size)
+
+	if self.state == bbrStateStartup {
+		self.windowSize += float64(size)
+	}
+
+	if elapsed := now - self.roundStart; elapsed >= self.roundLength() {
+		// if the circuit was idle, the delivery rate says nothing about the path, so don't let it age out real samples
+		if elapsed < 2*self.roundLength() {
+			self.bwSamples[self.round%bbrBwFilterRounds] = float64(self.delivered) / float64(elapsed)
+		}
+		self.round++
+		self.roundStart = now
+		self.delivered = 0
+		self.endRound()
+	}
+
+	self.checkProbeRttDone(now)
+	self.updateWindowSize()
+}
+
+func (self *bbrCongestionControl) endRound() {
+	switch self.state {
+	case bbrStateStartup:
+		if bw := self.bandwidth(); bw >= self.fullBw*bbrFullBwGrowth {
+			self.fullBw = bw
+			self.fullBwRounds = 0
+		} else {
+			self.fullBwRounds++
+		}
+		if self.fullBwRounds >= bbrFullBwRounds {
+			self.fullBwReached = true
+			self.state = bbrStateDrain
+		}
+	case bbrStateDrain:
+		self.state = bbrStateProbeBw
+		self.cycleIndex = 0
+	case bbrStateProbeBw:
+		self.cycleIndex = (self.cycleIndex + 1) % len(bbrProbeBwGains)
+	}
+}
+
+func (self *bbrCongestionControl) checkProbeRttDone(now int64) {
+	if self.state == bbrStateProbeRtt && now >= self.probeRttDone {
+		self.minRttStamp = now
+		if self.fullBwReached {
+			self.state = bbrStateProbeBw
+		} else {
+			self.state = bbrStateStartup
+		}
+	}
+}
+
+func (self *bbrCongestionControl) updateWindowSize() {
+	bdp := self.bdp()
+
+	switch self.state {
+	case bbrStateStartup:
+		if target := bbrStartupGain * bdp; target > self.windowSize {
+			self.windowSize = target
+		}
+	case bbrStateDrain:
+		self.windowSize = bdp
+	case bbrStateProbeBw:
+		self.windowSize = bbrCwndGain * bbrProbeBwGains[self.cycleIndex] * bdp
+	case bbrStateProbeRtt:
+		self.windowSize = float64(self.options.TxPortalMinSize)
+	}
+
+	self.windowSize = clampWindowSize(self.windowSize, self.options)
+}
+
+func (self *bbrCongestionControl) OnDuplicateAck(int64) {
+	self.duplicateAcks++
+}
+
+func (self *bbrCongestionControl) OnRtt(rtt uint16, now int64) {
+	sample := int64(rtt)
+	if sample == 0 {
+		sample = 1
+	}
+
+	expired := self.minRttStamp != 0 && now-self.minRttStamp > bbrMinRttWindowMs
+	if self.minRtt == 0 || sample <= self.minRtt || expired {
+		self.minRtt = sample
+		self.minRttStamp = now
+	}
+
+	if expired && self.state != bbrStateProbeRtt {
+		self.state = bbrStateProbeRtt
+		self.probeRttDone = now + bbrProbeRttDurationMs
+		self.updateWindowSize()
+	}
+}
+
+// OnRetransmit doesn't change the window, as loss isn't taken as a congestion signal
+func (self *bbrCongestionControl) OnRetransmit(int64) {}
+
+func (self *bbrCongestionControl) Inspect(detail *inspect.XgressSendBufferDetail) {
+	detail.DuplicateAcks = self.duplicateAcks
+	detail.CongestionControl = &inspect.XgressCongestionControlDetail{
+		Algorithm: self.Algorithm(),
+		State: map[string]interface{}{
+			"state":              self.state,
+			"bandwidthBytesPerS": uint64(self.bandwidth() * 1000),
+			"minRttMs":           self.minRtt,
+			"bdp":                uint64(self.bdp()),
+			"round":              self.round,
+			"fullBwReached":      self.fullBwReached,
+			"probeBwGain":        bbrProbeBwGains[self.cycleIndex],
+			"duplicateAcks":      self.duplicateAcks,
+		},
+	}
+}
@@ -0,0 +1,170 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress
+
+import (
+	"math"
+
+	"github.com/openziti/ziti/common/inspect"
+)
+
+const (
+	cubicC    = 0.4
+	cubicBeta = 0.7
+
+	// window growth per round trip of the reno-friendly estimate, see RFC 8312 section 4.2
+	cubicRenoIncrease = 3 * (1 - cubicBeta) / (1 + cubicBeta)
+)
+
+// cubicCongestionControl adapts CUBIC (RFC 8312) to xgress. After a loss the window grows along a cubic curve
+// centered on the window size at the time of the loss, so it recovers quickly, plateaus near the previous limit and
+// then probes further. Because growth depends on time since the loss rather than on the number of acks, large windows
+// on high latency links are regained much faster than with txportal. The MTU is used as the segment size.
+type cubicCongestionControl struct {
+	options            *Options
+	segmentSize        float64
+	windowSize         float64
+	slowStartThreshold float64
+	maxWindowSize      float64
+	k                  float64
+	originWindow       float64
+	renoWindow         float64
+	epochStart         int64
+	srtt               float64
+	lastReduction      int64
+	reductions         uint32
+	duplicateAcks      uint32
+}
+
+func newCubicCongestionControl(options *Options) CongestionControl {
+	segmentSize := float64(options.Mtu)
+	if segmentSize < 1 {
+		segmentSize = 1
+	}
+
+	return &cubicCongestionControl{
+		options:            options,
+		segmentSize:        segmentSize,
+		windowSize:         clampWindowSize(float64(options.TxPortalStartSize), options),
+		slowStartThreshold: float64(options.TxPortalMaxSize),
+	}
+}
+
+func (self *cubicCongestionControl) Algorithm() string {
+	return CongestionControlCubic
+}
+
+func (self *cubicCongestionControl) WindowSize() uint32 {
+	return uint32(self.windowSize)
+}
+
+func (self *cubicCongestionControl) RetxScale() float64 {
+	return self.options.RetxScale
+}
+
+func (self *cubicCongestionControl) OnAck(size uint32, now int64) {
+	acked := float64(size)
+
+	if self.windowSize < self.slowStartThreshold {
+		self.windowSize = clampWindowSize(self.windowSize+acked, self.options)
+		return
+	}
+
+	if self.epochStart == 0 {
+		self.epochStart = now
+		if self.windowSize < self.maxWindowSize {
+			self.k = math.Cbrt((self.maxWindowSize - self.windowSize) / (cubicC * self.segmentSize))
+			self.originWindow = self.maxWindowSize
+		} else {
+			self.k = 0
+			self.originWindow = self.windowSize
+		}
+		self.renoWindow = self.windowSize
+	}
+
+	// aim for where the curve will be one round trip from now
+	t := float64(now-self.epochStart)/1000 + self.srtt/1000
+	target := self.originWindow + cubicC*self.segmentSize*math.Pow(t-self.k, 3)
+
+	self.renoWindow += cubicRenoIncrease * self.segmentSize * acked / self.renoWindow
+	if target < self.renoWindow {
+		target = self.renoWindow
+	}
+
+	if target > 1.5*self.windowSize {
+		target = 1.5 * self.windowSize
+	}
+
+	if target > self.windowSize {
+		self.windowSize = clampWindowSize(self.windowSize+(target-self.windowSize)*acked/self.windowSize, self.options)
+	}
+}
+
+func (self *cubicCongestionControl) OnDuplicateAck(int64) {
+	self.duplicateAcks++
+}
+
+func (self *cubicCongestionControl) OnRtt(rtt uint16, _ int64) {
+	if self.srtt == 0 {
+		self.srtt = float64(rtt)
+	} else {
+		self.srtt = 0.875*self.srtt + 0.125*float64(rtt)
+	}
+}
+
+func (self *cubicCongestionControl) OnRetransmit(now int64) {
+	// retransmits come in bursts, only reduce the window once per round trip
+	interval := int64(self.srtt)
+	if interval == 0 {
+		interval = int64(self.options.RetxStartMs)
+	}
+	if self.lastReduction != 0 && now-self.lastReduction < interval {
+		return
+	}
+
+	self.lastReduction = now
+	self.epochStart = 0
+	self.reductions++
+
+	// fast convergence: if we lost before reaching the last maximum, another flow is likely competing, so give up
+	// some more room
+	if self.windowSize < self.maxWindowSize {
+		self.maxWindowSize = self.windowSize * (1 + cubicBeta) / 2
+	} else {
+		self.maxWindowSize = self.windowSize
+	}
+
+	self.windowSize = clampWindowSize(self.windowSize*cubicBeta, self.options)
+	self.slowStartThreshold = self.windowSize
+}
+
+func (self *cubicCongestionControl) Inspect(detail *inspect.XgressSendBufferDetail) {
+	detail.DuplicateAcks = self.duplicateAcks
+	detail.CongestionControl = &inspect.XgressCongestionControlDetail{
+		Algorithm: self.Algorithm(),
+		State: map[string]interface{}{
+			"slowStart":          self.windowSize < self.slowStartThreshold,
+			"slowStartThreshold": uint32(self.slowStartThreshold),
+			"maxWindowSize":      uint32(self.maxWindowSize),
+			"k":                  self.k,
+			"renoWindow":         uint32(self.renoWindow),
+			"srttMs":             self.srtt,
+			"reductions":         self.reductions,
+			"duplicateAcks":      self.duplicateAcks,
+		},
+	}
+}
@@ -0,0 +1,147 @@
+package xgress
+
+import (
+	"testing"
+
+	"github.com/openziti/ziti/common/inspect"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_TxPortalCongestionControl(t *testing.T) {
+	req := require.New(t)
+	options := DefaultOptions()
+	options.TxPortalStartSize = 64 * 1024
+	options.TxPortalIncreaseThresh = 4
+	options.TxPortalRetxThresh = 2
+
+	cc, err := NewCongestionControl(CongestionControlTxPortal, options)
+	req.NoError(err)
+	req.Equal(options.TxPortalStartSize, cc.WindowSize())
+
+	for i := 0; i < 4; i++ {
+		cc.OnAck(1000, 0)
+	}
+	req.Equal(options.TxPortalStartSize+4000, cc.WindowSize())
+
+	cc.OnRetransmit(0)
+	cc.OnRetransmit(0)
+	req.Equal(uint32(float64(options.TxPortalStartSize+4000)*options.TxPortalRetxScale), cc.WindowSize())
+
+	for i := uint32(0); i < options.TxPortalDupAckThresh; i++ {
+		cc.OnDuplicateAck(0)
+	}
+	req.InDelta(options.RetxScale+0.2, cc.RetxScale(), 0.0001)
+
+	detail := &inspect.XgressSendBufferDetail{}
+	cc.Inspect(detail)
+	req.Equal(CongestionControlTxPortal, detail.CongestionControl.Algorithm)
+}
+
+func Test_CubicCongestionControl(t *testing.T) {
+	req := require.New(t)
+	options := DefaultOptions()
+
+	cc, err := NewCongestionControl(CongestionControlCubic, options)
+	req.NoError(err)
+	cc.OnRtt(100, 0)
+
+	// slow start doubles the window each round trip
+	for i := 0; i < 16; i++ {
+		cc.OnAck(1024, 10)
+	}
+	req.Equal(options.TxPortalStartSize*2, cc.WindowSize())
+
+	// retransmits only reduce the window once per round trip
+	beforeLoss := cc.WindowSize()
+	cc.OnRetransmit(1000)
+	cc.OnRetransmit(1050)
+	afterLoss := cc.WindowSize()
+	req.Equal(uint32(float64(beforeLoss)*cubicBeta), afterLoss)
+
+	cc.OnRetransmit(1200)
+	req.Less(cc.WindowSize(), afterLoss)
+
+	// the window grows back with time rather than with the number of acks
+	now := int64(1200)
+	for cc.WindowSize() < beforeLoss && now < 60_000 {
+		now += 10
+		cc.OnAck(1024, now)
+	}
+	req.GreaterOrEqual(cc.WindowSize(), beforeLoss)
+	req.Less(now, int64(60_000))
+
+	detail := &inspect.XgressSendBufferDetail{}
+	cc.Inspect(detail)
+	req.Equal(CongestionControlCubic, detail.CongestionControl.Algorithm)
+	req.Equal(uint32(2), detail.CongestionControl.State["reductions"])
+}
+
+func Test_BbrCongestionControl(t *testing.T) {
+	req := require.New(t)
+	options := DefaultOptions()
+	options.TxPortalMaxSize = 64 * 1024 * 1024
+
+	cc, err := NewCongestionControl(CongestionControlBbr, options)
+	req.NoError(err)
+	bbr := cc.(*bbrCongestionControl)
+
+	// simulate a 10MB/s path with a 50ms round trip time, so a bandwidth-delay product of 500KB
+	rtt := uint16(50)
+	run := func(from, to int64) {
+		for now := from; now < to; now++ {
+			if now%10 == 0 {
+				cc.OnRtt(rtt, now)
+			}
+			for i := 0; i < 10; i++ {
+				cc.OnAck(1000, now)
+			}
+		}
+	}
+
+	run(1, 1000)
+	req.Equal(bbrStateProbeBw, bbr.state)
+	req.InDelta(10_000, bbr.bandwidth(), 1)
+	req.Equal(int64(50), bbr.minRtt)
+	req.GreaterOrEqual(cc.WindowSize(), uint32(750_000))
+	req.LessOrEqual(cc.WindowSize(), uint32(1_250_000))
+
+	// loss doesn't shrink the window
+	windowSize := cc.WindowSize()
+	cc.OnRetransmit(1000)
+	req.Equal(windowSize, cc.WindowSize())
+
+	// if the minimum rtt isn't seen again for a while, the window is drained to measure it again
+	rtt = 60
+	now := int64(1000)
+	for bbr.state != bbrStateProbeRtt && now < 20_000 {
+		run(now, now+1)
+		now++
+	}
+	req.Equal(bbrStateProbeRtt, bbr.state)
+	req.Greater(now, int64(1000+bbrMinRttWindowMs))
+	req.Equal(options.TxPortalMinSize, cc.WindowSize())
+
+	run(now, now+bbrProbeRttDurationMs+1)
+	req.Equal(bbrStateProbeBw, bbr.state)
+	req.Equal(int64(60), bbr.minRtt)
+
+	detail := &inspect.XgressSendBufferDetail{}
+	cc.Inspect(detail)
+	req.Equal(CongestionControlBbr, detail.CongestionControl.Algorithm)
+	req.Equal(bbrStateProbeBw, detail.CongestionControl.State["state"])
+}
+
+func Test_CongestionControlOptions(t *testing.T) {
+	req := require.New(t)
+
+	options, err := LoadOptions(OptionsData{"options": map[interface{}]interface{}{"congestionControl": "bbr"}})
+	req.NoError(err)
+	req.Equal(CongestionControlBbr, options.CongestionControl)
+
+	options, err = LoadOptions(OptionsData{})
+	req.NoError(err)
+	req.Equal(CongestionControlTxPortal, options.CongestionControl)
+
+	_, err = LoadOptions(OptionsData{"options": map[interface{}]interface{}{"congestionControl": "reno"}})
+	req.Error(err)
+}
@@ -0,0 +1,366 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress
+
+import (
+	"encoding/binary"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/ziti/common/inspect"
+	"github.com/pkg/errors"
+)
+
+const (
+	// CircuitTagFec is the circuit tag used by the controller to enable or disable forward error correction for a
+	// given service, overriding the router's fec option
+	CircuitTagFec = "fec"
+
+	FecMaxGroupSize = 32
+
+	// partial groups are protected once no more payloads have been sent for this long
+	fecFlushDelay = 20 * time.Millisecond
+
+	// how many of the most recently received payloads are kept around to recover from
+	fecRetainedPayloads = 2 * FecMaxGroupSize
+
+	fecParityHeaderSize = 5
+)
+
+// Forward error correction sends an XOR parity payload after each group of consecutive payloads, which lets the
+// receiving xgress rebuild any single payload lost from the group without waiting for it to be retransmitted.
+// Parity payloads aren't acknowledged or retransmitted, and don't take up a sequence number; they carry the sequence
+// of the first payload in their group. Each xgress with FEC enabled sets PayloadFlagFec on its payloads, and only
+// sends parity once it has seen that flag from its peer, so parity is never sent to routers which can't handle it.
+
+// fecEncoder builds parity payloads for the payloads an xgress sends
+type fecEncoder struct {
+	sync.Mutex
+	x          *Xgress
+	groupSize  int
+	groupStart int32
+	count      int
+	lengths    uint32
+	parity     []byte
+	flushTimer *time.Timer
+	paritySent atomic.Uint64
+}
+
+func newFecEncoder(x *Xgress, groupSize uint32) *fecEncoder {
+	return &fecEncoder{
+		x:         x,
+		groupSize: int(groupSize),
+	}
+}
+
+// add includes the payload in the current parity group and returns the parity payloads for any groups which were
+// completed. A break in the sequence completes the current group before the payload is added, and the payload may
+// then complete its own group, so there may be two parity payloads.
+func (self *fecEncoder) add(payload *Payload) []*Payload {
+	self.Lock()
+	defer self.Unlock()
+
+	var result []*Payload
+
+	// groups must cover consecutive sequences
+	if self.count > 0 && payload.Sequence != self.groupStart+int32(self.count) {
+		result = append(result, self.buildParity())
+	}
+
+	if self.count == 0 {
+		self.groupStart = payload.Sequence
+		self.lengths = 0
+		self.parity = self.parity[:0]
+	}
+
+	block := encodeFecBlock(payload)
+	self.lengths ^= uint32(len(block))
+	self.parity = xorInto(self.parity, block)
+	self.count++
+
+	if self.count >= self.groupSize {
+		result = append(result, self.buildParity())
+	} else if self.count == 1 {
+		if self.flushTimer != nil {
+			self.flushTimer.Stop()
+		}
+		groupStart := self.groupStart
+		self.flushTimer = time.AfterFunc(fecFlushDelay, func() { self.flush(groupStart) })
+	}
+
+	return result
+}
+
+func (self *fecEncoder) flush(groupStart int32) {
+	self.Lock()
+	var parity *Payload
+	if self.count > 0 && self.groupStart == groupStart {
+		parity = self.buildParity()
+	}
+	self.Unlock()
+
+	if parity != nil {
+		self.x.sendParity(parity)
+	}
+}
+
+func (self *fecEncoder) buildParity() *Payload {
+	data := make([]byte, fecParityHeaderSize+len(self.parity))
+	data[0] = uint8(self.count)
+	binary.BigEndian.PutUint32(data[1:], self.lengths)
+	copy(data[fecParityHeaderSize:], self.parity)
+
+	self.count = 0
+	if self.flushTimer != nil {
+		self.flushTimer.Stop()
+	}
+	self.paritySent.Add(1)
+
+	return &Payload{
+		Header: Header{
+			CircuitId: self.x.circuitId,
+			Flags:     SetOriginatorFlag(uint32(PayloadFlagFec|PayloadFlagFecParity), self.x.originator),
+		},
+		Sequence: self.groupStart,
+		Data:     data,
+	}
+}
+
+func (self *fecEncoder) stop() {
+	self.Lock()
+	defer self.Unlock()
+	if self.flushTimer != nil {
+		self.flushTimer.Stop()
+	}
+}
+
+// fecDecoder recovers lost payloads from parity payloads. It's only used from the payload ingester goroutine.
+type fecDecoder struct {
+	received    map[int32]*Payload
+	parity      map[int32]*Payload
+	maxSequence int32
+	recovered   atomic.Uint64
+}
+
+func newFecDecoder() *fecDecoder {
+	return &fecDecoder{
+		received: map[int32]*Payload{},
+		parity:   map[int32]*Payload{},
+	}
+}
+
+// receive tracks the received payload, which may be a data or a parity payload, and returns any payloads which could
+// be recovered as a result
+func (self *fecDecoder) receive(payload *Payload) []*Payload {
+	var result []*Payload
+
+	if payload.IsFecParityFlagSet() {
+		if len(payload.Data) < fecParityHeaderSize || payload.Data[0] == 0 {
+			pfxlog.Logger().WithFields(payload.GetLoggerFields()).Error("invalid fec parity payload, dropping")
+			return nil
+		}
+		if payload.Sequence+int32(payload.Data[0]) <= self.maxSequence-fecRetainedPayloads {
+			return nil
+		}
+		self.parity[payload.Sequence] = payload
+		if recovered := self.recover(payload); recovered != nil {
+			result = append(result, recovered)
+		}
+	} else {
+		self.track(payload)
+		for _, parity := range self.parity {
+			count := int32(parity.Data[0])
+			if payload.Sequence >= parity.Sequence && payload.Sequence < parity.Sequence+count {
+				if recovered := self.recover(parity); recovered != nil {
+					result = append(result, recovered)
+				}
+			}
+		}
+	}
+
+	self.evict()
+	return result
+}
+
+func (self *fecDecoder) track(payload *Payload) {
+	self.received[payload.Sequence] = payload
+	if payload.Sequence > self.maxSequence {
+		self.maxSequence = payload.Sequence
+	}
+}
+
+func (self *fecDecoder) evict() {
+	if len(self.received) <= 2*fecRetainedPayloads && len(self.parity) <= fecRetainedPayloads {
+		return
+	}
+
+	minSequence := self.maxSequence - fecRetainedPayloads
+	for sequence := range self.received {
+		if sequence < minSequence {
+			delete(self.received, sequence)
+		}
+	}
+
+	for sequence, parity := range self.parity {
+		if sequence+int32(parity.Data[0]) <= minSequence {
+			delete(self.parity, sequence)
+		}
+	}
+}
+
+// recover rebuilds the payload missing from the parity payload's group, if exactly one is missing
+func (self *fecDecoder) recover(parity *Payload) *Payload {
+	count := int32(parity.Data[0])
+	missing := int32(-1)
+	for sequence := parity.Sequence; sequence < parity.Sequence+count; sequence++ {
+		if _, found := self.received[sequence]; !found {
+			if missing >= 0 {
+				return nil // more than one missing, maybe later
+			}
+			missing = sequence
+		}
+	}
+
+	delete(self.parity, parity.Sequence)
+
+	if missing < 0 {
+		return nil
+	}
+
+	length := binary.BigEndian.Uint32(parity.Data[1:])
+	block := append([]byte(nil), parity.Data[fecParityHeaderSize:]...)
+	for sequence := parity.Sequence; sequence < parity.Sequence+count; sequence++ {
+		if sequence != missing {
+			otherBlock := encodeFecBlock(self.received[sequence])
+			length ^= uint32(len(otherBlock))
+			block = xorInto(block, otherBlock)
+		}
+	}
+
+	if int(length) > len(block) {
+		pfxlog.Logger().WithFields(parity.GetLoggerFields()).Error("fec parity doesn't match received payloads, unable to recover")
+		return nil
+	}
+
+	result, err := decodeFecBlock(block[:length])
+	if err != nil {
+		pfxlog.Logger().WithFields(parity.GetLoggerFields()).WithError(err).Error("unable to recover payload from fec parity")
+		return nil
+	}
+
+	result.CircuitId = parity.CircuitId
+	result.RTT = parity.RTT
+	result.Sequence = missing
+	self.track(result)
+	self.recovered.Add(1)
+
+	return result
+}
+
+// encodeFecBlock serializes the parts of the payload which need to be recovered: flags, headers and data. Headers are
+// written in key order, so both ends produce the same block for the same payload.
+func encodeFecBlock(payload *Payload) []byte {
+	size := 6 + len(payload.Data)
+	keys := make([]int, 0, len(payload.Headers))
+	for k, v := range payload.Headers {
+		size += 5 + len(v)
+		keys = append(keys, int(k))
+	}
+	sort.Ints(keys)
+
+	block := make([]byte, 6, size)
+	binary.BigEndian.PutUint32(block, payload.Flags)
+	binary.BigEndian.PutUint16(block[4:], uint16(len(payload.Headers)))
+	for _, k := range keys {
+		v := payload.Headers[uint8(k)]
+		block = append(block, uint8(k))
+		block = binary.BigEndian.AppendUint32(block, uint32(len(v)))
+		block = append(block, v...)
+	}
+	return append(block, payload.Data...)
+}
+
+func decodeFecBlock(block []byte) (*Payload, error) {
+	if len(block) < 6 {
+		return nil, errors.Errorf("fec block too short: %d bytes", len(block))
+	}
+
+	result := &Payload{}
+	result.Flags = binary.BigEndian.Uint32(block)
+	headerCount := int(binary.BigEndian.Uint16(block[4:]))
+	block = block[6:]
+
+	for i := 0; i < headerCount; i++ {
+		if len(block) < 5 {
+			return nil, errors.New("fec block truncated in headers")
+		}
+		key := block[0]
+		length := binary.BigEndian.Uint32(block[1:])
+		block = block[5:]
+		if uint32(len(block)) < length {
+			return nil, errors.New("fec block truncated in header value")
+		}
+		if result.Headers == nil {
+			result.Headers = map[uint8][]byte{}
+		}
+		result.Headers[key] = block[:length]
+		block = block[length:]
+	}
+
+	if len(block) > 0 {
+		result.Data = block
+	}
+	return result, nil
+}
+
+// xorInto xors src into dst, growing dst as needed
+func xorInto(dst []byte, src []byte) []byte {
+	for len(dst) < len(src) {
+		dst = append(dst, 0)
+	}
+	for i, b := range src {
+		dst[i] ^= b
+	}
+	return dst
+}
+
+func (self *Xgress) isFecEnabled() bool {
+	return self.fecEncoder != nil
+}
+
+func (self *Xgress) sendParity(parity *Payload) {
+	if self.Closed() || !self.flags.IsSet(fecPeerSupportedFlag) {
+		return
+	}
+	fecParityMeter.Mark(1)
+	self.receiveHandler.HandleXgressReceive(parity, self)
+}
+
+func (self *Xgress) inspectFec() *inspect.XgressFecDetail {
+	if !self.isFecEnabled() {
+		return nil
+	}
+	return &inspect.XgressFecDetail{
+		GroupSize:          uint32(self.fecEncoder.groupSize),
+		PeerSupported:      self.flags.IsSet(fecPeerSupportedFlag),
+		ParityPayloadsSent: self.fecEncoder.paritySent.Load(),
+		RecoveredPayloads:  self.fecDecoder.recovered.Load(),
+	}
+}
@@ -0,0 +1,201 @@
+package xgress
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openziti/metrics"
+	"github.com/stretchr/testify/require"
+)
+
+type captureReceiveHandler struct {
+	payloads chan *Payload
+}
+
+func (self *captureReceiveHandler) HandleXgressReceive(payload *Payload, _ *Xgress) {
+	self.payloads <- payload
+}
+
+func (self *captureReceiveHandler) HandleControlReceive(*Control, *Xgress) {}
+
+func newFecTestPayloads(count int) []*Payload {
+	var result []*Payload
+	for i := 0; i < count; i++ {
+		payload := &Payload{
+			Header: Header{
+				CircuitId: "test",
+				Flags:     uint32(PayloadFlagFec),
+			},
+			Sequence: int32(i),
+			Data:     []byte(fmt.Sprintf("payload %d %s", i, make([]byte, i*7))),
+		}
+		if i%2 == 0 {
+			payload.Headers = map[uint8][]byte{
+				HeaderKeyUUID: []byte(fmt.Sprintf("uuid-%d", i)),
+				7:             {byte(i)},
+			}
+		}
+		result = append(result, payload)
+	}
+	result[0].Flags |= uint32(PayloadFlagCircuitStart)
+	return result
+}
+
+func Test_FecRecovery(t *testing.T) {
+	x := &Xgress{circuitId: "test", originator: Initiator}
+
+	encode := func(payloads []*Payload) *Payload {
+		encoder := newFecEncoder(x, uint32(len(payloads)))
+		var parities []*Payload
+		for _, payload := range payloads {
+			parities = append(parities, encoder.add(payload)...)
+		}
+		encoder.stop()
+		require.Len(t, parities, 1)
+		parity := parities[0]
+		require.True(t, parity.IsFecParityFlagSet())
+		require.False(t, parity.IsCircuitEndFlagSet())
+		return parity
+	}
+
+	t.Run("a single lost payload is recovered", func(t *testing.T) {
+		req := require.New(t)
+		payloads := newFecTestPayloads(6)
+		parity := encode(payloads)
+
+		for lost := range payloads {
+			decoder := newFecDecoder()
+			for i, payload := range payloads {
+				if i != lost {
+					req.Empty(decoder.receive(payload))
+				}
+			}
+			recovered := decoder.receive(parity)
+			req.Len(recovered, 1)
+			req.Equal(payloads[lost].Sequence, recovered[0].Sequence)
+			req.Equal(payloads[lost].Flags, recovered[0].Flags)
+			req.Equal(payloads[lost].Headers, recovered[0].Headers)
+			req.Equal(payloads[lost].Data, recovered[0].Data)
+			req.Equal("test", recovered[0].CircuitId)
+		}
+	})
+
+	t.Run("parity arriving before the group is complete is held", func(t *testing.T) {
+		req := require.New(t)
+		payloads := newFecTestPayloads(4)
+		parity := encode(payloads)
+
+		decoder := newFecDecoder()
+		req.Empty(decoder.receive(payloads[0]))
+		req.Empty(decoder.receive(parity))
+
+		// two payloads are still missing, so nothing can be recovered until one of them arrives
+		req.Empty(decoder.receive(payloads[3]))
+		recovered := decoder.receive(payloads[2])
+		req.Len(recovered, 1)
+		req.Equal(payloads[1].Data, recovered[0].Data)
+		req.Equal(uint64(1), decoder.recovered.Load())
+
+		// the group is done, a late retransmit doesn't recover anything else
+		req.Empty(decoder.receive(payloads[1]))
+	})
+
+	t.Run("parity for old groups is discarded", func(t *testing.T) {
+		req := require.New(t)
+		payloads := newFecTestPayloads(3)
+		parity := encode(payloads)
+
+		decoder := newFecDecoder()
+		decoder.receive(&Payload{Header: Header{CircuitId: "test"}, Sequence: 1000})
+		req.Empty(decoder.receive(parity))
+		req.Empty(decoder.parity)
+	})
+}
+
+func Test_FecEncoder(t *testing.T) {
+	req := require.New(t)
+	closeNotify := make(chan struct{})
+	defer close(closeNotify)
+	InitMetrics(metrics.NewUsageRegistry("test", map[string]string{}, closeNotify))
+
+	handler := &captureReceiveHandler{payloads: make(chan *Payload, 10)}
+	x := &Xgress{circuitId: "test", originator: Terminator, receiveHandler: handler}
+	x.fecEncoder = newFecEncoder(x, 4)
+	x.flags.Set(fecPeerSupportedFlag, true)
+	defer x.fecEncoder.stop()
+
+	payloads := newFecTestPayloads(7)
+
+	// a break in the sequence ends the group early
+	req.Empty(x.fecEncoder.add(payloads[0]))
+	parities := x.fecEncoder.add(payloads[2])
+	req.Len(parities, 1)
+	req.Equal(int32(0), parities[0].Sequence)
+	req.Equal(uint8(1), parities[0].Data[0])
+	req.Equal(Terminator, parities[0].GetOriginator())
+
+	req.Empty(x.fecEncoder.add(payloads[3]))
+	req.Empty(x.fecEncoder.add(payloads[4]))
+	parities = x.fecEncoder.add(payloads[5])
+	req.Len(parities, 1)
+	req.Equal(int32(2), parities[0].Sequence)
+	req.Equal(uint8(4), parities[0].Data[0])
+
+	// partial groups are flushed once sending pauses
+	req.Empty(x.fecEncoder.add(payloads[6]))
+	select {
+	case parity := <-handler.payloads:
+		req.Equal(int32(6), parity.Sequence)
+		req.Equal(uint8(1), parity.Data[0])
+	case <-time.After(time.Second):
+		req.Fail("partial group wasn't flushed")
+	}
+	req.Equal(uint64(3), x.fecEncoder.paritySent.Load())
+}
+
+func Test_FecIngest(t *testing.T) {
+	req := require.New(t)
+	closeNotify := make(chan struct{})
+	defer close(closeNotify)
+	metricsRegistry := metrics.NewUsageRegistry("test", map[string]string{}, closeNotify)
+	InitMetrics(metricsRegistry)
+	InitAcker(&noopForwarder{}, metricsRegistry, closeNotify)
+
+	options := DefaultOptions()
+	conn := &testConn{ch: make(chan uint64, 1), closeNotify: make(chan struct{})}
+	x := NewXgress("test", "ctrl", "test", conn, Terminator, options, map[string]string{CircuitTagFec: "true"})
+	x.receiveHandler = noopReceiveHandler{}
+	defer x.Close()
+	req.True(x.isFecEnabled())
+	req.Equal(uint32(PayloadFlagFec), x.fecFlags())
+
+	// the circuit start would start the receiver, so leave it out
+	payloads := newFecTestPayloads(5)[1:]
+	sender := &Xgress{circuitId: "test", originator: Initiator}
+	encoder := newFecEncoder(sender, 4)
+	defer encoder.stop()
+	var parities []*Payload
+	for _, payload := range payloads {
+		parities = append(parities, encoder.add(payload)...)
+	}
+	req.Len(parities, 1)
+	parity := parities[0]
+
+	x.payloadIngester(payloads[0])
+	req.True(x.flags.IsSet(fecPeerSupportedFlag))
+	x.payloadIngester(payloads[1])
+	x.payloadIngester(payloads[3])
+	x.payloadIngester(parity)
+
+	req.Equal(4, x.linkRxBuffer.tree.Size())
+	val, found := x.linkRxBuffer.tree.Get(payloads[2].Sequence)
+	req.True(found)
+	req.Equal(payloads[2].Data, val.(*Payload).Data)
+	req.Equal(uint64(1), x.inspectFec().RecoveredPayloads)
+
+	// fec can be turned off per service
+	x = NewXgress("test", "ctrl", "test", conn, Terminator, &Options{Fec: true}, map[string]string{CircuitTagFec: "false"})
+	req.False(x.isFecEnabled())
+	req.Nil(x.inspectFec())
+}
@@ -37,18 +37,13 @@ type PayloadBufferForwarder interface {
 // https://pkg.go.dev/sync/atomic#pkg-note-BUG
 // https://github.com/golang/go/issues/36606
 type LinkSendBuffer struct {
-	retxScale             float64
 	x                     *Xgress
 	buffer                map[int32]*txPayload
 	newlyBuffered         chan *txPayload
 	newlyReceivedAcks     chan *Acknowledgement
-	windowsSize           uint32
+	congestionControl     CongestionControl
 	linkSendBufferSize    uint32
 	linkRecvBufferSize    uint32
-	accumulator           uint32
-	successfulAcks        uint32
-	duplicateAcks         uint32
-	retransmits           uint32
 	closeNotify           chan struct{}
 	closed                atomic.Bool
 	blockedByLocalWindow  bool
@@ -103,6 +98,22 @@ func NewLinkSendBuffer(x *Xgress) *LinkSendBuffer {
 		x.Options.TxPortalStartSize,
 		x.Options.TxPortalMinSize)
 
+	// the controller sets the congestion control tag if the service overrides the router's configured algorithm
+	algorithm := x.Options.CongestionControl
+	if serviceAlgorithm := x.tags[CircuitTagCongestionControl]; serviceAlgorithm != "" {
+		algorithm = serviceAlgorithm
+	}
+
+	congestionControl, err := NewCongestionControl(algorithm, x.Options)
+	if err != nil && algorithm != x.Options.CongestionControl {
+		pfxlog.ContextLogger(x.Label()).WithError(err).Warn("invalid congestion control for service, using router default")
+		congestionControl, err = NewCongestionControl(x.Options.CongestionControl, x.Options)
+	}
+	if err != nil {
+		pfxlog.ContextLogger(x.Label()).WithError(err).Errorf("invalid congestion control, using %s", CongestionControlTxPortal)
+		congestionControl = newTxPortalCongestionControl(x.Options)
+	}
+
 	// newlyBuffered should be size 0, otherwise payloads can be sent and acks received before the payload is
 	// processed by the LinkSendBuffer
 	buffer := &LinkSendBuffer{
@@ -111,9 +122,8 @@ func NewLinkSendBuffer(x *Xgress) *LinkSendBuffer {
 		newlyBuffered:     make(chan *txPayload),
 		newlyReceivedAcks: make(chan *Acknowledgement, 2),
 		closeNotify:       make(chan struct{}),
-		windowsSize:       x.Options.TxPortalStartSize,
+		congestionControl: congestionControl,
 		retxThreshold:     x.Options.RetxStartMs,
-		retxScale:         x.Options.RetxScale,
 		inspectRequests:   make(chan *sendBufferInspectEvent, 1),
 	}
 
@@ -161,8 +171,9 @@ func (buffer *LinkSendBuffer) Close() {
 
 func (buffer *LinkSendBuffer) isBlocked() bool {
 	blocked := false
+	windowSize := buffer.congestionControl.WindowSize()
 
-	if buffer.windowsSize < buffer.linkRecvBufferSize {
+	if windowSize < buffer.linkRecvBufferSize {
 		blocked = true
 		if !buffer.blockedByRemoteWindow {
 			buffer.blockedByRemoteWindow = true
@@ -173,7 +184,7 @@ func (buffer *LinkSendBuffer) isBlocked() bool {
 		atomic.AddInt64(&buffersBlockedByRemoteWindow, -1)
 	}
 
-	if buffer.windowsSize < buffer.linkSendBufferSize {
+	if windowSize < buffer.linkSendBufferSize {
 		blocked = true
 		if !buffer.blockedByLocalWindow {
 			buffer.blockedByLocalWindow = true
@@ -185,7 +196,7 @@ func (buffer *LinkSendBuffer) isBlocked() bool {
 	}
 
 	if blocked {
-		pfxlog.ContextLogger(buffer.x.Label()).Debugf("blocked=%v win_size=%v tx_buffer_size=%v rx_buffer_size=%v", blocked, buffer.windowsSize, buffer.linkSendBufferSize, buffer.linkRecvBufferSize)
+		pfxlog.ContextLogger(buffer.x.Label()).Debugf("blocked=%v win_size=%v tx_buffer_size=%v rx_buffer_size=%v", blocked, windowSize, buffer.linkSendBufferSize, buffer.linkRecvBufferSize)
 	}
 
 	return blocked
@@ -265,6 +276,7 @@ func (buffer *LinkSendBuffer) close() {
 
 func (buffer *LinkSendBuffer) receiveAcknowledgement(ack *Acknowledgement) {
 	log := pfxlog.ContextLogger(buffer.x.Label()).WithFields(ack.GetLoggerFields())
+	now := info.NowInMilliseconds()
 
 	for _, sequence := range ack.Sequence {
 		if txPayload, found := buffer.buffer[sequence]; found {
@@ -273,45 +285,28 @@ func (buffer *LinkSendBuffer) receiveAcknowledgement(ack *Acknowledgement) {
 			}
 
 			payloadSize := uint32(len(txPayload.payload.Data))
-			buffer.accumulator += payloadSize
-			buffer.successfulAcks++
+			buffer.congestionControl.OnAck(payloadSize, now)
 			delete(buffer.buffer, sequence)
 			atomic.AddInt64(&outstandingPayloads, -1)
 			atomic.AddInt64(&outstandingPayloadBytes, -int64(payloadSize))
 			buffer.linkSendBufferSize -= payloadSize
 			log.Debugf("removing payload %v with size %v. payload buffer size: %v",
 				txPayload.payload.Sequence, len(txPayload.payload.Data), buffer.linkSendBufferSize)
-
-			if buffer.successfulAcks >= buffer.x.Options.TxPortalIncreaseThresh {
-				buffer.successfulAcks = 0
-				delta := uint32(float64(buffer.accumulator) * buffer.x.Options.TxPortalIncreaseScale)
-				buffer.windowsSize += delta
-				if buffer.windowsSize > buffer.x.Options.TxPortalMaxSize {
-					buffer.windowsSize = buffer.x.Options.TxPortalMaxSize
-				}
-				buffer.retxScale -= 0.02
-				if buffer.retxScale < buffer.x.Options.RetxScale {
-					buffer.retxScale = buffer.x.Options.RetxScale
-				}
-			}
 		} else { // duplicate ack
 			duplicateAcksMeter.Mark(1)
-			buffer.duplicateAcks++
-			if buffer.duplicateAcks >= buffer.x.Options.TxPortalDupAckThresh {
-				buffer.duplicateAcks = 0
-				buffer.retxScale += 0.2
-			}
+			buffer.congestionControl.OnDuplicateAck(now)
 		}
 	}
 
 	buffer.linkRecvBufferSize = ack.RecvBufferSize
 	if ack.RTT > 0 {
-		rtt := uint16(info.NowInMilliseconds()) - ack.RTT
+		rtt := uint16(now) - ack.RTT
+		buffer.congestionControl.OnRtt(rtt, now)
 		if buffer.lastRtt > 0 {
 			rtt = (rtt + buffer.lastRtt) >> 1
 		}
 		buffer.lastRtt = rtt
-		buffer.retxThreshold = uint32(float64(rtt)*buffer.retxScale) + buffer.x.Options.RetxAddMs
+		buffer.retxThreshold = uint32(float64(rtt)*buffer.congestionControl.RetxScale()) + buffer.x.Options.RetxAddMs
 	}
 }
 
@@ -326,12 +321,7 @@ func (buffer *LinkSendBuffer) retransmit() {
 				v.markQueued()
 				retransmitter.queue(v)
 				retransmitted++
-				buffer.retransmits++
-				if buffer.retransmits >= buffer.x.Options.TxPortalRetxThresh {
-					buffer.accumulator = 0
-					buffer.retransmits = 0
-					buffer.scale(buffer.x.Options.TxPortalRetxScale)
-				}
+				buffer.congestionControl.OnRetransmit(now)
 			}
 		}
 
@@ -342,35 +332,21 @@ func (buffer *LinkSendBuffer) retransmit() {
 	}
 }
 
-func (buffer *LinkSendBuffer) scale(factor float64) {
-	buffer.windowsSize = uint32(float64(buffer.windowsSize) * factor)
-	if factor > 1 {
-		if buffer.windowsSize > buffer.x.Options.TxPortalMaxSize {
-			buffer.windowsSize = buffer.x.Options.TxPortalMaxSize
-		}
-	} else if buffer.windowsSize < buffer.x.Options.TxPortalMinSize {
-		buffer.windowsSize = buffer.x.Options.TxPortalMinSize
-	}
-}
-
 func (buffer *LinkSendBuffer) inspect() *inspect.XgressSendBufferDetail {
 	timeSinceLastRetransmit := time.Duration(info.NowInMilliseconds()-buffer.lastRetransmitTime) * time.Millisecond
 	result := &inspect.XgressSendBufferDetail{
-		WindowSize:            buffer.windowsSize,
+		WindowSize:            buffer.congestionControl.WindowSize(),
 		LinkSendBufferSize:    buffer.linkSendBufferSize,
 		LinkRecvBufferSize:    buffer.linkRecvBufferSize,
-		Accumulator:           buffer.accumulator,
-		SuccessfulAcks:        buffer.successfulAcks,
-		DuplicateAcks:         buffer.duplicateAcks,
-		Retransmits:           buffer.retransmits,
 		Closed:                buffer.closed.Load(),
 		BlockedByLocalWindow:  buffer.blockedByLocalWindow,
 		BlockedByRemoteWindow: buffer.blockedByRemoteWindow,
-		RetxScale:             buffer.retxScale,
+		RetxScale:             buffer.congestionControl.RetxScale(),
 		RetxThreshold:         buffer.retxThreshold,
 		TimeSinceLastRetx:     timeSinceLastRetransmit.String(),
 		CloseWhenEmpty:        buffer.closeWhenEmpty.Load(),
 	}
+	buffer.congestionControl.Inspect(result)
 	return result
 }
 
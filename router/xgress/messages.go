@@ -68,6 +68,8 @@ const (
 	PayloadFlagCircuitEnd   PayloadFlag = 1
 	PayloadFlagOriginator   PayloadFlag = 2
 	PayloadFlagCircuitStart PayloadFlag = 4
+	PayloadFlagFec          PayloadFlag = 8
+	PayloadFlagFecParity    PayloadFlag = 16
 )
 
 type Header struct {
@@ -265,6 +267,14 @@ func (payload *Payload) IsCircuitStartFlagSet() bool {
 	return isPayloadFlagSet(payload.Flags, PayloadFlagCircuitStart)
 }
 
+func (payload *Payload) IsFecFlagSet() bool {
+	return isPayloadFlagSet(payload.Flags, PayloadFlagFec)
+}
+
+func (payload *Payload) IsFecParityFlagSet() bool {
+	return isPayloadFlagSet(payload.Flags, PayloadFlagFecParity)
+}
+
 func SetOriginatorFlag(flags uint32, originator Originator) uint32 {
 	if originator == Initiator {
 		return ^uint32(PayloadFlagOriginator) & flags
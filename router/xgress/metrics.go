@@ -14,6 +14,10 @@ var retransmissionFailures metrics.Meter
 var ackFailures metrics.Meter
 var payloadWriteTimer metrics.Timer
 var duplicateAcksMeter metrics.Meter
+var fecParityMeter metrics.Meter
+var fecRecoveredMeter metrics.Meter
+var shapedPayloadsMeter metrics.Meter
+var shapingDelayTimer metrics.Timer
 
 var buffersBlockedByLocalWindow int64
 var buffersBlockedByRemoteWindow int64
@@ -29,6 +33,10 @@ func InitMetrics(registry metrics.UsageRegistry) {
 	ackFailures = registry.Meter("xgress.ack_failures")
 	payloadWriteTimer = registry.Timer("xgress.tx_write_time")
 	duplicateAcksMeter = registry.Meter("xgress.ack_duplicates")
+	fecParityMeter = registry.Meter("xgress.fec.parity_payloads")
+	fecRecoveredMeter = registry.Meter("xgress.fec.recovered_payloads")
+	shapedPayloadsMeter = registry.Meter("xgress.shaped_payloads")
+	shapingDelayTimer = registry.Timer("xgress.shaping_delay")
 
 	registry.FuncGauge("xgress.blocked_by_local_window", func() int64 {
 		return atomic.LoadInt64(&buffersBlockedByLocalWindow)
@@ -29,6 +29,11 @@ type Options struct {
 	Drop1InN    int32
 	TxQueueSize int32
 
+	CongestionControl string
+
+	Fec          bool
+	FecGroupSize uint32
+
 	TxPortalStartSize      uint32
 	TxPortalMaxSize        uint32
 	TxPortalMinSize        uint32
@@ -69,6 +74,28 @@ func LoadOptions(data OptionsData) (*Options, error) {
 			options.TxQueueSize = int32(value.(int))
 		}
 
+		if value, found := data["congestionControl"]; found {
+			algorithm, ok := value.(string)
+			if !ok {
+				return nil, errors.Errorf("invalid 'congestionControl' value, must be a string")
+			}
+			if _, err := NewCongestionControl(algorithm, options); err != nil {
+				return nil, errors.Wrap(err, "invalid 'congestionControl' value")
+			}
+			options.CongestionControl = algorithm
+		}
+
+		if value, found := data["fec"]; found {
+			options.Fec = value.(bool)
+		}
+		if value, found := data["fecGroupSize"]; found {
+			groupSize := value.(int)
+			if groupSize < 2 || groupSize > FecMaxGroupSize {
+				return nil, errors.Errorf("invalid 'fecGroupSize' value %d, must be between 2 and %d", groupSize, FecMaxGroupSize)
+			}
+			options.FecGroupSize = uint32(groupSize)
+		}
+
 		if value, found := data["txPortalStartSize"]; found {
 			options.TxPortalStartSize = uint32(value.(int))
 		}
@@ -148,6 +175,9 @@ func DefaultOptions() *Options {
 		RandomDrops:            false,
 		Drop1InN:               100,
 		TxQueueSize:            1,
+		CongestionControl:      CongestionControlTxPortal,
+		Fec:                    false,
+		FecGroupSize:           8,
 		TxPortalStartSize:      16 * 1024,
 		TxPortalMinSize:        16 * 1024,
 		TxPortalMaxSize:        4 * 1024 * 1024,
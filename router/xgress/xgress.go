@@ -47,6 +47,7 @@ const (
 	rxerStartedFlag       = 1
 	endOfCircuitRecvdFlag = 2
 	endOfCircuitSentFlag  = 3
+	fecPeerSupportedFlag  = 4
 )
 
 type Address string
@@ -149,8 +150,11 @@ type Xgress struct {
 	receiveHandler       ReceiveHandler
 	payloadBuffer        *LinkSendBuffer
 	linkRxBuffer         *LinkReceiveBuffer
+	fecEncoder           *fecEncoder
+	fecDecoder           *fecDecoder
 	closeHandlers        []CloseHandler
 	peekHandlers         []PeekHandler
+	bandwidthLimits      []*BandwidthLimit
 	flags                concurrenz.AtomicBitSet
 	timeOfLastRxFromLink int64
 	tags                 map[string]string
@@ -180,6 +184,21 @@ func NewXgress(circuitId string, ctrlId string, address Address, peer Connection
 		tags:                 tags,
 	}
 	result.payloadBuffer = NewLinkSendBuffer(result)
+
+	// the controller sets the fec tag if the service overrides the router's fec option
+	fecEnabled := options.Fec
+	if val, found := tags[CircuitTagFec]; found {
+		if enabled, err := strconv.ParseBool(val); err == nil {
+			fecEnabled = enabled
+		} else {
+			pfxlog.ContextLogger(result.Label()).WithError(err).Warn("invalid fec circuit tag, using router default")
+		}
+	}
+	if fecEnabled {
+		result.fecEncoder = newFecEncoder(result, options.FecGroupSize)
+		result.fecDecoder = newFecDecoder()
+	}
+
 	return result
 }
 
@@ -265,7 +284,7 @@ func (self *Xgress) GetStartCircuit() *Payload {
 	startCircuit := &Payload{
 		Header: Header{
 			CircuitId: self.circuitId,
-			Flags:     SetOriginatorFlag(uint32(PayloadFlagCircuitStart), self.originator),
+			Flags:     SetOriginatorFlag(uint32(PayloadFlagCircuitStart)|self.fecFlags(), self.originator),
 		},
 		Sequence: self.nextReceiveSequence(),
 		Data:     nil,
@@ -277,7 +296,7 @@ func (self *Xgress) GetEndCircuit() *Payload {
 	endCircuit := &Payload{
 		Header: Header{
 			CircuitId: self.circuitId,
-			Flags:     SetOriginatorFlag(uint32(PayloadFlagCircuitEnd), self.originator),
+			Flags:     SetOriginatorFlag(uint32(PayloadFlagCircuitEnd)|self.fecFlags(), self.originator),
 		},
 		Sequence: self.nextReceiveSequence(),
 		Data:     nil,
@@ -334,6 +353,10 @@ func (self *Xgress) Close() {
 
 		self.payloadBuffer.Close()
 
+		if self.fecEncoder != nil {
+			self.fecEncoder.stop()
+		}
+
 		for _, peekHandler := range self.peekHandlers {
 			peekHandler.Close(self)
 		}
@@ -386,19 +409,41 @@ func (self *Xgress) HandleControlReceive(controlType ControlType, headers channe
 }
 
 func (self *Xgress) payloadIngester(payload *Payload) {
-	if payload.IsCircuitStartFlagSet() && self.firstCircuitStartReceived() {
-		pfxlog.ContextLogger(self.Label()).WithFields(payload.GetLoggerFields()).Debug("received circuit start, starting xgress receiver")
-		go self.rx()
-	}
+	self.checkCircuitStart(payload)
 
 	if !self.Options.RandomDrops || rand.Int31n(self.Options.Drop1InN) != 1 {
-		self.PayloadReceived(payload)
+		self.receivePayload(payload)
 	} else {
 		pfxlog.ContextLogger(self.Label()).WithFields(payload.GetLoggerFields()).Error("drop!")
 	}
 	self.queueSends()
 }
 
+func (self *Xgress) receivePayload(payload *Payload) {
+	if self.isFecEnabled() {
+		if payload.IsFecFlagSet() {
+			self.flags.Set(fecPeerSupportedFlag, true)
+		}
+		for _, recovered := range self.fecDecoder.receive(payload) {
+			pfxlog.ContextLogger(self.Label()).WithFields(recovered.GetLoggerFields()).Debug("recovered payload from fec parity")
+			fecRecoveredMeter.Mark(1)
+			self.checkCircuitStart(recovered)
+			self.PayloadReceived(recovered)
+		}
+	}
+
+	if !payload.IsFecParityFlagSet() {
+		self.PayloadReceived(payload)
+	}
+}
+
+func (self *Xgress) checkCircuitStart(payload *Payload) {
+	if payload.IsCircuitStartFlagSet() && self.firstCircuitStartReceived() {
+		pfxlog.ContextLogger(self.Label()).WithFields(payload.GetLoggerFields()).Debug("received circuit start, starting xgress receiver")
+		go self.rx()
+	}
+}
+
 func (self *Xgress) queueSends() {
 	payload := self.linkRxBuffer.PeekHead()
 	for payload != nil {
@@ -474,6 +519,11 @@ func (self *Xgress) tx() {
 		}
 
 		if !payload.IsCircuitStartFlagSet() {
+			if !self.shapeTx(len(payload.Data)) {
+				payloadLogger.Debug("closed while waiting for bandwidth limit, exiting")
+				return
+			}
+
 			start := time.Now()
 			n, err := self.peer.WritePayload(payload.Data, payload.Headers)
 			if err != nil {
@@ -540,14 +590,14 @@ func (self *Xgress) rx() {
 			return
 		}
 
-		if self.Closed() {
+		if self.Closed() || !self.shapeRx(n) {
 			return
 		}
 
 		payload := &Payload{
 			Header: Header{
 				CircuitId: self.circuitId,
-				Flags:     SetOriginatorFlag(0, self.originator),
+				Flags:     SetOriginatorFlag(self.fecFlags(), self.originator),
 			},
 			Sequence: self.nextReceiveSequence(),
 			Data:     buffer[0:n],
@@ -580,9 +630,22 @@ func (self *Xgress) forwardPayload(payload *Payload) bool {
 
 	self.receiveHandler.HandleXgressReceive(payload, self)
 	sendCallback()
+
+	if self.isFecEnabled() && self.flags.IsSet(fecPeerSupportedFlag) {
+		for _, parity := range self.fecEncoder.add(payload) {
+			self.sendParity(parity)
+		}
+	}
 	return true
 }
 
+func (self *Xgress) fecFlags() uint32 {
+	if self.isFecEnabled() {
+		return uint32(PayloadFlagFec)
+	}
+	return 0
+}
+
 func (self *Xgress) nextReceiveSequence() int32 {
 	self.rxSequenceLock.Lock()
 	defer self.rxSequenceLock.Unlock()
@@ -636,6 +699,8 @@ func (self *Xgress) InspectCircuit(detail *inspect.CircuitInspectDetail) {
 		TimeSinceLastLinkRx:   timeSinceLastRxFromLink.String(),
 		SendBufferDetail:      self.payloadBuffer.Inspect(),
 		RecvBufferDetail:      self.linkRxBuffer.Inspect(),
+		FecDetail:             self.inspectFec(),
+		BandwidthLimits:       self.inspectBandwidthLimits(),
 		XgressPointer:         fmt.Sprintf("%p", self),
 		LinkSendBufferPointer: fmt.Sprintf("%p", self.payloadBuffer),
 		Sequence:              self.GetSequence(),
@@ -0,0 +1,126 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress_common
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/ziti/common"
+	"github.com/openziti/ziti/common/pb/edge_ctrl_pb"
+	"github.com/openziti/ziti/router/xgress"
+)
+
+type sharedBandwidthBuckets struct {
+	rx       *xgress.TokenBucket
+	tx       *xgress.TokenBucket
+	refCount int
+}
+
+// bandwidthBuckets holds the token buckets for identity and service scoped limits, which are shared by all circuits
+// of the identity or service on this router. Buckets are released when the last xgress using them closes.
+var bandwidthBuckets = struct {
+	sync.Mutex
+	buckets map[string]*sharedBandwidthBuckets
+}{
+	buckets: map[string]*sharedBandwidthBuckets{},
+}
+
+// warnNoDataModel makes sure the missing router data model is only reported once, rather than for every circuit
+var warnNoDataModel sync.Once
+
+// ApplyBandwidthLimits looks up the bandwidth limits which apply to the circuit's identity and service and adds them to
+// the xgress. For ingress the identity is the dialing identity, for egress it is the hosting identity. Must be called
+// before the xgress is started.
+//
+// Bandwidth limits are distributed to routers as part of the router data model, so they are only enforced when the
+// router data model is enabled. Without it, no limits are applied.
+func ApplyBandwidthLimits(rdm *common.RouterDataModel, x *xgress.Xgress) {
+	if rdm == nil {
+		warnNoDataModel.Do(func() {
+			pfxlog.Logger().Warn("router data model not available, bandwidth limits will not be enforced")
+		})
+		return
+	}
+
+	tags := x.GetTags()
+	serviceId := tags["serviceId"]
+	if serviceId == "" {
+		return
+	}
+
+	identityId := tags["clientId"]
+	if x.Originator() == xgress.Terminator {
+		identityId = tags["hostId"]
+	}
+
+	for _, limit := range rdm.GetBandwidthLimits(identityId, serviceId) {
+		x.AddBandwidthLimit(newBandwidthLimit(limit, identityId, serviceId, x))
+	}
+}
+
+func newBandwidthLimit(limit *edge_ctrl_pb.DataState_BandwidthLimit, identityId, serviceId string, x *xgress.Xgress) *xgress.BandwidthLimit {
+	result := &xgress.BandwidthLimit{
+		Id:   limit.Id,
+		Name: limit.Name,
+	}
+
+	var scopeKey string
+	switch limit.Scope {
+	case edge_ctrl_pb.BandwidthLimitScope_PerIdentity:
+		result.Scope = "identity"
+		scopeKey = identityId
+	case edge_ctrl_pb.BandwidthLimitScope_PerService:
+		result.Scope = "service"
+		scopeKey = serviceId
+	default:
+		result.Scope = "circuit"
+		result.Rx = xgress.NewTokenBucket(limit.RateBytesPerSecond, limit.BurstBytes)
+		result.Tx = xgress.NewTokenBucket(limit.RateBytesPerSecond, limit.BurstBytes)
+		return result
+	}
+
+	// the rate and burst are part of the key, so circuits established after a limit update get fresh buckets
+	key := fmt.Sprintf("%s/%s/%s/%d/%d", limit.Id, result.Scope, scopeKey, limit.RateBytesPerSecond, limit.BurstBytes)
+
+	bandwidthBuckets.Lock()
+	shared, found := bandwidthBuckets.buckets[key]
+	if !found {
+		shared = &sharedBandwidthBuckets{
+			rx: xgress.NewTokenBucket(limit.RateBytesPerSecond, limit.BurstBytes),
+			tx: xgress.NewTokenBucket(limit.RateBytesPerSecond, limit.BurstBytes),
+		}
+		bandwidthBuckets.buckets[key] = shared
+	}
+	shared.refCount++
+	bandwidthBuckets.Unlock()
+
+	result.Rx = shared.rx
+	result.Tx = shared.tx
+
+	x.AddCloseHandler(xgress.CloseHandlerF(func(*xgress.Xgress) {
+		bandwidthBuckets.Lock()
+		defer bandwidthBuckets.Unlock()
+		shared.refCount--
+		if shared.refCount <= 0 && bandwidthBuckets.buckets[key] == shared {
+			delete(bandwidthBuckets.buckets, key)
+		}
+	}))
+
+	return result
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/openziti/ziti/common/logcontext"
 	"github.com/openziti/ziti/controller/xt"
 	"github.com/openziti/ziti/router/xgress"
+	"github.com/openziti/ziti/router/xgress_common"
 	"github.com/pkg/errors"
 )
 
@@ -117,6 +118,7 @@ func (dialer *dialer) Dial(params xgress.DialParams) (xt.PeerData, error) {
 		// On the terminator, which this is, this only starts the txer, which pulls data from the link
 		// Since the opposing xgress doesn't start until this call returns, nothing should be coming this way yet
 		x := xgress.NewXgress(circuitId.Token, params.GetCtrlId(), params.GetAddress(), conn, xgress.Terminator, &dialer.options.Options, params.GetCircuitTags())
+		xgress_common.ApplyBandwidthLimits(dialer.factory.stateManager.RouterDataModel(), x)
 		params.GetBindHandler().HandleXgressBind(x)
 		conn.ctrlRx = x
 		x.Start()
@@ -180,6 +182,7 @@ func (dialer *dialer) Dial(params xgress.DialParams) (xt.PeerData, error) {
 		}
 
 		x := xgress.NewXgress(circuitId.Token, params.GetCtrlId(), params.GetAddress(), conn, xgress.Terminator, &dialer.options.Options, params.GetCircuitTags())
+		xgress_common.ApplyBandwidthLimits(dialer.factory.stateManager.RouterDataModel(), x)
 		params.GetBindHandler().HandleXgressBind(x)
 		conn.ctrlRx = x
 		x.Start()
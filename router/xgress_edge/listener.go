@@ -19,6 +19,7 @@ package xgress_edge
 import (
 	"encoding/binary"
 	"fmt"
+	"github.com/openziti/ziti/common"
 	"github.com/openziti/ziti/common/ctrl_msg"
 	"github.com/openziti/ziti/controller/idgen"
 	"github.com/sirupsen/logrus"
@@ -37,6 +38,7 @@ import (
 	"github.com/openziti/identity"
 	"github.com/openziti/sdk-golang/ziti/edge"
 	"github.com/openziti/transport/v2"
+	"github.com/openziti/ziti/router/posture"
 	"github.com/openziti/ziti/router/state"
 	"github.com/openziti/ziti/router/xgress"
 	"github.com/openziti/ziti/router/xgress_common"
@@ -198,8 +200,20 @@ func (self *edgeClientConn) processConnect(manager state.Manager, req *channel.M
 	}
 
 	x := xgress.NewXgress(response.CircuitId, ctrlCh.Id(), xgress.Address(response.Address), conn, xgress.Initiator, &self.listener.options.Options, response.Tags)
+	xgress_common.ApplyBandwidthLimits(manager.RouterDataModel(), x)
 	self.listener.bindHandler.HandleXgressBind(x)
 	conn.ctrlRx = x
+
+	// source ip posture checks are enforced here, as only the router observes the address the client dials from
+	sourceIp := common.ParseSourceIp(ch.Underlay().GetRemoteAddr().String())
+	if err = posture.HasSourceIpAccess(manager.RouterDataModel(), response.Tags["clientId"], response.Tags["serviceId"], sourceIp); err != nil {
+		log.WithError(err).WithField("sourceIp", sourceIp).Info("dial rejected by source ip posture check")
+		self.sendStateClosedReply(err.Error(), req)
+		conn.close(false, "source ip posture check failed")
+		x.Close()
+		return
+	}
+
 	// send the state_connected before starting the xgress. That way we can't get a state_closed before we get state_connected
 	self.sendStateConnectedReply(req, response.PeerData, response.CircuitId)
 	x.Start()
@@ -8,6 +8,7 @@ import (
 	"github.com/openziti/sdk-golang/ziti/edge"
 	"github.com/openziti/ziti/common/inspect"
 	"github.com/openziti/ziti/common/pb/ctrl_pb"
+	"github.com/openziti/ziti/common/qos"
 	"github.com/openziti/ziti/router/forwarder"
 	"github.com/openziti/ziti/router/handler_xgress"
 	metrics2 "github.com/openziti/ziti/router/metrics"
@@ -102,6 +103,14 @@ func (link *mirrorLink) SendAcknowledgement(*xgress.Acknowledgement) error {
 	return nil
 }
 
+func (link *mirrorLink) SendPrioritizedPayload(payload *xgress.Payload, _ qos.PriorityClass) error {
+	return link.SendPayload(payload)
+}
+
+func (link *mirrorLink) SendPrioritizedAcknowledgement(ack *xgress.Acknowledgement, _ qos.PriorityClass) error {
+	return link.SendAcknowledgement(ack)
+}
+
 func (link *mirrorLink) SendControl(*xgress.Control) error {
 	return nil
 }
@@ -77,6 +77,7 @@ func (self *tunneler) Dial(params xgress.DialParams) (xt.PeerData, error) {
 	peerData[uint32(ctrl_msg.TerminatorRemoteAddressHeader)] = []byte(conn.RemoteAddr().String())
 
 	x := xgress.NewXgress(circuitId.Token, params.GetCtrlId(), params.GetAddress(), xgConn, xgress.Terminator, self.dialOptions.Options, params.GetCircuitTags())
+	xgress_common.ApplyBandwidthLimits(self.stateManager.RouterDataModel(), x)
 	params.GetBindHandler().HandleXgressBind(x)
 	x.Start()
 
@@ -301,6 +301,7 @@ func (self *fabricProvider) tunnelServiceV1(
 	})
 
 	x := xgress.NewXgress(response.CircuitId, ctrlCh.Id(), xgress.Address(response.Address), xgConn, xgress.Initiator, self.tunneler.listenOptions.Options, response.Tags)
+	xgress_common.ApplyBandwidthLimits(self.factory.stateManager.RouterDataModel(), x)
 	self.tunneler.bindHandler.HandleXgressBind(x)
 	x.AddCloseHandler(xgress.CloseHandlerF(func(x *xgress.Xgress) { cleanupCallback() }))
 	x.Start()
@@ -352,6 +353,7 @@ func (self *fabricProvider) tunnelServiceV2(
 	}
 
 	x := xgress.NewXgress(response.CircuitId, ctrlCh.Id(), xgress.Address(response.Address), xgConn, xgress.Initiator, self.tunneler.listenOptions.Options, response.Tags)
+	xgress_common.ApplyBandwidthLimits(rdm, x)
 	self.tunneler.bindHandler.HandleXgressBind(x)
 	x.Start()
 
@@ -245,6 +245,9 @@ func (options *Options) ToLoggableString() string {
 	buf.WriteString(fmt.Sprintf("randomDrops=%v\n", options.RandomDrops))
 	buf.WriteString(fmt.Sprintf("drop1InN=%v\n", options.Drop1InN))
 	buf.WriteString(fmt.Sprintf("txQueueSize=%v\n", options.TxQueueSize))
+	buf.WriteString(fmt.Sprintf("congestionControl=%v\n", options.CongestionControl))
+	buf.WriteString(fmt.Sprintf("fec=%v\n", options.Fec))
+	buf.WriteString(fmt.Sprintf("fecGroupSize=%v\n", options.FecGroupSize))
 	buf.WriteString(fmt.Sprintf("txPortalStartSize=%v\n", options.TxPortalStartSize))
 	buf.WriteString(fmt.Sprintf("txPortalMaxSize=%v\n", options.TxPortalMaxSize))
 	buf.WriteString(fmt.Sprintf("txPortalMinSize=%v\n", options.TxPortalMinSize))
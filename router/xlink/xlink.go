@@ -23,10 +23,36 @@ import (
 	"github.com/openziti/transport/v2"
 	"github.com/openziti/ziti/common/inspect"
 	"github.com/openziti/ziti/common/pb/ctrl_pb"
+	"github.com/openziti/ziti/common/qos"
 	"github.com/openziti/ziti/router/xgress"
 	"time"
 )
 
+const (
+	// BondLinkProtocol is the link protocol reported for bonded links
+	BondLinkProtocol = "bond"
+
+	// HeaderKeyBondSequence is set on messages sent over a bond member link. For payloads it holds the per-circuit
+	// bond sequence, which the receiver uses to restore the order payloads were sent in. Acks and control messages
+	// are sent with a bond sequence of 0.
+	HeaderKeyBondSequence = 2300
+
+	// HeaderKeyBondIteration holds the iteration of the bond being negotiated or closed
+	HeaderKeyBondIteration = 2301
+
+	// HeaderKeyBondMembers holds the ids of the links proposed for a bond, or accepted into it
+	HeaderKeyBondMembers = 2302
+
+	// ContentTypeBondRequest is sent over a member link by the router which owns the bond, asking the other router
+	// to form the bond. The other router registers the bond before replying, so payloads sent over the bond once the
+	// request is accepted can be reordered on arrival.
+	ContentTypeBondRequest = 1120
+
+	// ContentTypeBondClose is sent over a member link when a bond is dissolved, so that the other router dissolves
+	// its end of the bond as well
+	ContentTypeBondClose = 1121
+)
+
 // Registry contains known link instances and manages link de-duplication
 type Registry interface {
 	// UpdateLinkDest adds or updates the state of the given destination
@@ -64,6 +90,17 @@ type Registry interface {
 
 	// GetLinkKey returns the link key for the given link parameters
 	GetLinkKey(dialerBinding, protocol, dest, listenerBinding string) string
+
+	// GetBond returns the bonded link to the given router, if one exists
+	GetBond(routerId string) (Bond, bool)
+
+	// AcceptBond handles a request from the link's destination router to form a bond with the given iteration over
+	// the given member links. Returns the ids of the links the bond was formed with and the bond iteration. If the
+	// request is rejected, the last iteration known for the bond is returned along with the error
+	AcceptBond(link Xlink, iteration uint32, memberIds []string) ([]string, uint32, error)
+
+	// CloseBond dissolves the bond to the link's destination router, if it has the given iteration
+	CloseBond(link Xlink, iteration uint32)
 }
 
 // A Factory creates link listeners and link dialers
@@ -118,6 +155,33 @@ type LinkDestination interface {
 	SendAcknowledgement(acknowledgement *xgress.Acknowledgement) error
 	SendControl(control *xgress.Control) error
 	InspectCircuit(circuitDetail *inspect.CircuitInspectDetail)
+
+	// SendPrioritizedPayload sends the payload, scheduling it according to the priority class of its circuit, if the
+	// link has qos enabled
+	SendPrioritizedPayload(payload *xgress.Payload, class qos.PriorityClass) error
+	// SendPrioritizedAcknowledgement sends the ack, scheduling it according to the priority class of its circuit, if
+	// the link has qos enabled
+	SendPrioritizedAcknowledgement(acknowledgement *xgress.Acknowledgement, class qos.PriorityClass) error
+}
+
+// MessageSender is implemented by links which can send messages which have already been marshalled. Bonded links use
+// it to add bond headers to the messages they send over member links
+type MessageSender interface {
+	SendPayloadMessage(msg *channel.Message, class qos.PriorityClass) error
+	SendAcknowledgementMessage(msg *channel.Message, class qos.PriorityClass) error
+	SendControlMessage(msg *channel.Message) error
+	// SendForReply sends the message over the link and waits for the other router to reply. Used to negotiate bonds
+	SendForReply(msg *channel.Message, timeout time.Duration) (*channel.Message, error)
+}
+
+// A Bond is a logical link to another router, which stripes payloads across all the healthy links to that router
+type Bond interface {
+	LinkDestination
+	DestinationId() string
+	Iteration() uint32
+	// ReceivePayload handles a payload which was sent over the bond and arrived on one of its member links. Payloads
+	// are forwarded in the order they were sent in, waiting a short time for payloads sent over slower members
+	ReceivePayload(msg *channel.Message, payload *xgress.Payload)
 }
 
 type Xlink interface {
@@ -133,6 +133,12 @@ func loadListenerConfig(data map[interface{}]interface{}) (*listenerConfig, erro
 		config.options = channel.DefaultOptions()
 	}
 
+	qosConfig, err := loadQosConfigFrom(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse link listener qos config")
+	}
+	config.qos = qosConfig
+
 	return config, nil
 }
 
@@ -144,6 +150,7 @@ type listenerConfig struct {
 	linkCostTags  []string
 	groups        []string
 	options       *channel.Options
+	qos           *qosConfig
 }
 
 func loadDialerConfig(data map[interface{}]interface{}) (*dialerConfig, error) {
@@ -231,6 +238,12 @@ func loadDialerConfig(data map[interface{}]interface{}) (*dialerConfig, error) {
 		}
 	}
 
+	qosConfig, err := loadQosConfigFrom(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse link dialer qos config")
+	}
+	config.qos = qosConfig
+
 	return config, nil
 }
 
@@ -322,4 +335,5 @@ type dialerConfig struct {
 	options                *channel.Options
 	healthyBackoffConfig   *backoffConfig
 	unhealthyBackoffConfig *backoffConfig
+	qos                    *qosConfig
 }
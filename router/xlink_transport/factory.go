@@ -78,7 +78,11 @@ func (self *factory) CreateListener(id *identity.TokenId, _ xlink.Forwarder, con
 	}
 
 	if config.options.OutQueueSize == channel.DefaultOutQueueSize {
-		config.options.OutQueueSize = 64
+		if config.qos != nil {
+			config.options.OutQueueSize = DefaultQosOutQueueSize
+		} else {
+			config.options.OutQueueSize = 64
+		}
 	}
 
 	return &listener{
@@ -104,7 +108,11 @@ func (self *factory) CreateDialer(id *identity.TokenId, _ xlink.Forwarder, confi
 	}
 
 	if config.options.OutQueueSize == channel.DefaultOutQueueSize {
-		config.options.OutQueueSize = 64
+		if config.qos != nil {
+			config.options.OutQueueSize = DefaultQosOutQueueSize
+		} else {
+			config.options.OutQueueSize = 64
+		}
 	}
 
 	return &dialer{
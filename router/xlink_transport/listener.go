@@ -217,6 +217,7 @@ func (self *listener) getOrCreateSplitLink(connId string, linkMeta *linkMetadata
 				dialAddress:   self.GetAdvertisement(),
 				iteration:     linkMeta.iteration,
 				dialed:        false,
+				qos:           self.config.qos,
 			},
 			eventTime: time.Now(),
 		}
@@ -254,6 +255,7 @@ func (self *listener) bindNonSplitChannel(binding channel.Binding, linkMeta *lin
 		dialAddress:   self.GetAdvertisement(),
 		iteration:     linkMeta.iteration,
 		dialed:        false,
+		qos:           self.config.qos,
 	}
 
 	bindHandler := self.bindHandlerFactory.NewBindHandler(xli, true, true)
@@ -0,0 +1,300 @@
+/*
+	(c) Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xlink_transport
+
+import (
+	"fmt"
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/channel/v2"
+	"github.com/openziti/metrics"
+	"github.com/openziti/ziti/common/inspect"
+	"github.com/openziti/ziti/common/qos"
+	"github.com/pkg/errors"
+	"reflect"
+	"sync"
+	"sync/atomic"
+)
+
+const (
+	QosModeStrict       = "strict"
+	QosModeWeightedFair = "wfq"
+
+	DefaultQosQueueSize = 256
+
+	// DefaultQosOutQueueSize is used for the channel out queue when qos is enabled, so that most of the backlog is
+	// held in the scheduler, where it can be ordered by class
+	DefaultQosOutQueueSize = 4
+
+	// qosQuantum is the number of bytes a class may send per round, per unit of weight, when using weighted fair
+	// queueing
+	qosQuantum = 1500
+)
+
+var defaultQosWeights = map[qos.PriorityClass]uint32{
+	qos.Realtime:    8,
+	qos.Interactive: 4,
+	qos.Standard:    2,
+	qos.Bulk:        1,
+}
+
+type qosConfig struct {
+	mode      string
+	queueSize int
+	weights   [qos.ClassCount]uint32 // indexed by rank
+}
+
+func loadQosConfig(data map[interface{}]interface{}) (*qosConfig, error) {
+	config := &qosConfig{
+		mode:      QosModeStrict,
+		queueSize: DefaultQosQueueSize,
+	}
+
+	for class, weight := range defaultQosWeights {
+		config.weights[class.Rank()] = weight
+	}
+
+	if value, found := data["mode"]; found {
+		if mode, ok := value.(string); ok && (mode == QosModeStrict || mode == QosModeWeightedFair) {
+			config.mode = mode
+		} else {
+			return nil, errors.Errorf("invalid 'mode' in qos config (%v), must be %s or %s", value, QosModeStrict, QosModeWeightedFair)
+		}
+	}
+
+	if value, found := data["queueSize"]; found {
+		if queueSize, ok := value.(int); ok && queueSize > 0 {
+			config.queueSize = queueSize
+		} else {
+			return nil, errors.Errorf("invalid 'queueSize' in qos config (%v), must be a positive integer", value)
+		}
+	}
+
+	if value, found := data["weights"]; found {
+		submap, ok := value.(map[interface{}]interface{})
+		if !ok {
+			return nil, errors.Errorf("invalid 'weights' in qos config (%s)", reflect.TypeOf(value))
+		}
+		for k, v := range submap {
+			class, err := qos.ParsePriorityClass(fmt.Sprint(k))
+			if err != nil {
+				return nil, errors.Wrap(err, "invalid class in qos weights")
+			}
+			weight, ok := v.(int)
+			if !ok || weight < 1 {
+				return nil, errors.Errorf("invalid weight for class %v in qos config (%v), must be a positive integer", class, v)
+			}
+			config.weights[class.Rank()] = uint32(weight)
+		}
+	}
+
+	return config, nil
+}
+
+// loadQosConfigFrom loads the optional 'qos' section from a link listener or dialer config. qos is disabled if the
+// section isn't present.
+func loadQosConfigFrom(data map[interface{}]interface{}) (*qosConfig, error) {
+	value, found := data["qos"]
+	if !found {
+		return nil, nil
+	}
+	if submap, ok := value.(map[interface{}]interface{}); ok {
+		return loadQosConfig(submap)
+	}
+	return nil, errors.Errorf("invalid 'qos' config (%s)", reflect.TypeOf(value))
+}
+
+// scheduler holds messages for a link channel in a queue per priority class and sends them to the channel, either in
+// strict priority order or using deficit round-robin weighted by class.
+type scheduler struct {
+	name            string
+	ch              channel.Channel
+	config          *qosConfig
+	droppedMsgMeter metrics.Meter
+
+	lock        sync.Mutex
+	queues      [qos.ClassCount][]*channel.Message // indexed by rank
+	deficits    [qos.ClassCount]int
+	current     int
+	replenished bool
+	size        int
+
+	sent    [qos.ClassCount]atomic.Uint64
+	dropped [qos.ClassCount]atomic.Uint64
+
+	notify      chan struct{}
+	closeNotify chan struct{}
+	closed      atomic.Bool
+}
+
+func newScheduler(name string, ch channel.Channel, config *qosConfig, droppedMsgMeter metrics.Meter) *scheduler {
+	result := &scheduler{
+		name:            name,
+		ch:              ch,
+		config:          config,
+		droppedMsgMeter: droppedMsgMeter,
+		notify:          make(chan struct{}, 1),
+		closeNotify:     make(chan struct{}),
+	}
+	go result.run()
+	return result
+}
+
+// enqueue queues the message for the given class. If the class queue is full the message is dropped, which mirrors
+// a failed TrySend on an unscheduled link.
+func (self *scheduler) enqueue(msg *channel.Message, class qos.PriorityClass) error {
+	if self.closed.Load() || self.ch.IsClosed() {
+		return errors.Errorf("link channel %s closed", self.name)
+	}
+
+	rank := class.Rank()
+
+	self.lock.Lock()
+	if len(self.queues[rank]) >= self.config.queueSize {
+		self.lock.Unlock()
+		self.dropped[rank].Add(1)
+		self.droppedMsgMeter.Mark(1)
+		return nil
+	}
+	self.queues[rank] = append(self.queues[rank], msg)
+	self.size++
+	self.lock.Unlock()
+
+	select {
+	case self.notify <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+func (self *scheduler) run() {
+	log := pfxlog.Logger().WithField("linkChannel", self.name)
+	for {
+		msg, rank := self.dequeue()
+		if msg == nil {
+			return
+		}
+		if err := self.ch.Send(msg); err != nil {
+			if self.ch.IsClosed() {
+				return
+			}
+			log.WithError(err).Debug("failed to send scheduled message")
+			continue
+		}
+		self.sent[rank].Add(1)
+	}
+}
+
+func (self *scheduler) dequeue() (*channel.Message, int) {
+	for {
+		self.lock.Lock()
+		if self.size > 0 {
+			var rank int
+			if self.config.mode == QosModeWeightedFair {
+				rank = self.nextWeightedFair()
+			} else {
+				rank = self.nextStrict()
+			}
+			msg := self.queues[rank][0]
+			self.queues[rank][0] = nil
+			self.queues[rank] = self.queues[rank][1:]
+			self.size--
+			self.lock.Unlock()
+			return msg, rank
+		}
+		self.lock.Unlock()
+
+		select {
+		case <-self.notify:
+		case <-self.closeNotify:
+			return nil, 0
+		}
+	}
+}
+
+// nextStrict returns the highest ranked non-empty queue. Must be called with the lock held and at least one message
+// queued.
+func (self *scheduler) nextStrict() int {
+	for rank := range self.queues {
+		if len(self.queues[rank]) > 0 {
+			return rank
+		}
+	}
+	return 0
+}
+
+// nextWeightedFair implements deficit round-robin. Each time a queue gets its turn, it's credited with its weight
+// times the quantum and may send messages until the credit is used up. Must be called with the lock held and at
+// least one message queued.
+func (self *scheduler) nextWeightedFair() int {
+	for {
+		rank := self.current
+		if len(self.queues[rank]) == 0 {
+			self.deficits[rank] = 0
+			self.advance()
+			continue
+		}
+
+		if !self.replenished {
+			self.deficits[rank] += int(self.config.weights[rank]) * qosQuantum
+			self.replenished = true
+		}
+
+		if size := len(self.queues[rank][0].Body); size <= self.deficits[rank] {
+			self.deficits[rank] -= size
+			return rank
+		}
+
+		self.advance()
+	}
+}
+
+func (self *scheduler) advance() {
+	self.current = (self.current + 1) % qos.ClassCount
+	self.replenished = false
+}
+
+func (self *scheduler) close() {
+	if self.closed.CompareAndSwap(false, true) {
+		close(self.closeNotify)
+	}
+}
+
+func (self *scheduler) inspect() *inspect.LinkQosDetail {
+	result := &inspect.LinkQosDetail{
+		Channel: self.name,
+		Mode:    self.config.mode,
+		Classes: map[string]*inspect.LinkQosClassDetail{},
+	}
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	for rank := range self.queues {
+		classDetail := &inspect.LinkQosClassDetail{
+			QueueDepth: len(self.queues[rank]),
+			Sent:       self.sent[rank].Load(),
+			Dropped:    self.dropped[rank].Load(),
+		}
+		if self.config.mode == QosModeWeightedFair {
+			classDetail.Weight = self.config.weights[rank]
+		}
+		result.Classes[qos.ClassForRank(rank).String()] = classDetail
+	}
+
+	return result
+}
@@ -0,0 +1,115 @@
+/*
+	(c) Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xlink_transport
+
+import (
+	"github.com/openziti/channel/v2"
+	"github.com/openziti/ziti/common/qos"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func newTestScheduler(t *testing.T, mode string) *scheduler {
+	config, err := loadQosConfig(map[interface{}]interface{}{
+		"mode": mode,
+	})
+	require.NoError(t, err)
+	return &scheduler{config: config}
+}
+
+func (self *scheduler) push(class qos.PriorityClass, size int) {
+	rank := class.Rank()
+	self.queues[rank] = append(self.queues[rank], channel.NewMessage(0, make([]byte, size)))
+	self.size++
+}
+
+func (self *scheduler) pop() qos.PriorityClass {
+	var rank int
+	if self.config.mode == QosModeWeightedFair {
+		rank = self.nextWeightedFair()
+	} else {
+		rank = self.nextStrict()
+	}
+	self.queues[rank] = self.queues[rank][1:]
+	self.size--
+	return qos.ClassForRank(rank)
+}
+
+func Test_QosStrictPriority(t *testing.T) {
+	req := require.New(t)
+	s := newTestScheduler(t, QosModeStrict)
+
+	s.push(qos.Bulk, 100)
+	s.push(qos.Standard, 100)
+	s.push(qos.Realtime, 100)
+	s.push(qos.Interactive, 100)
+	s.push(qos.Realtime, 100)
+
+	req.Equal(qos.Realtime, s.pop())
+	req.Equal(qos.Realtime, s.pop())
+	req.Equal(qos.Interactive, s.pop())
+	req.Equal(qos.Standard, s.pop())
+	req.Equal(qos.Bulk, s.pop())
+}
+
+func Test_QosWeightedFair(t *testing.T) {
+	req := require.New(t)
+	s := newTestScheduler(t, QosModeWeightedFair)
+
+	for i := 0; i < 100; i++ {
+		s.push(qos.Interactive, qosQuantum)
+		s.push(qos.Bulk, qosQuantum)
+	}
+
+	counts := map[qos.PriorityClass]int{}
+	for i := 0; i < 50; i++ {
+		counts[s.pop()]++
+	}
+
+	// interactive has 4x the weight of bulk, but bulk isn't starved
+	req.Equal(40, counts[qos.Interactive])
+	req.Equal(10, counts[qos.Bulk])
+}
+
+func Test_QosConfig(t *testing.T) {
+	req := require.New(t)
+
+	config, err := loadQosConfigFrom(map[interface{}]interface{}{})
+	req.NoError(err)
+	req.Nil(config)
+
+	config, err = loadQosConfigFrom(map[interface{}]interface{}{
+		"qos": map[interface{}]interface{}{
+			"mode":      "wfq",
+			"queueSize": 16,
+			"weights": map[interface{}]interface{}{
+				"bulk": 3,
+			},
+		},
+	})
+	req.NoError(err)
+	req.Equal(QosModeWeightedFair, config.mode)
+	req.Equal(16, config.queueSize)
+	req.Equal(uint32(3), config.weights[qos.Bulk.Rank()])
+	req.Equal(uint32(8), config.weights[qos.Realtime.Rank()])
+
+	_, err = loadQosConfig(map[interface{}]interface{}{"mode": "fifo"})
+	req.Error(err)
+
+	_, err = loadQosConfig(map[interface{}]interface{}{"weights": map[interface{}]interface{}{"urgent": 1}})
+	req.Error(err)
+}
@@ -21,8 +21,10 @@ import (
 	"github.com/openziti/metrics"
 	"github.com/openziti/ziti/common/inspect"
 	"github.com/openziti/ziti/common/pb/ctrl_pb"
+	"github.com/openziti/ziti/common/qos"
 	"github.com/openziti/ziti/router/xgress"
 	"sync/atomic"
+	"time"
 )
 
 type impl struct {
@@ -39,6 +41,8 @@ type impl struct {
 	dialed          bool
 	iteration       uint32
 	dupsRejected    uint32
+	qos             *qosConfig
+	scheduler       *scheduler
 }
 
 func (self *impl) Id() string {
@@ -57,11 +61,25 @@ func (self *impl) Init(metricsRegistry metrics.Registry) error {
 	if self.droppedMsgMeter == nil {
 		self.droppedMsgMeter = metricsRegistry.Meter("link.dropped_msgs:" + self.id)
 	}
+	if self.qos != nil && self.scheduler == nil {
+		self.scheduler = newScheduler("single", self.ch, self.qos, self.droppedMsgMeter)
+	}
 	return nil
 }
 
 func (self *impl) SendPayload(msg *xgress.Payload) error {
-	sent, err := self.ch.TrySend(msg.Marshall())
+	return self.SendPrioritizedPayload(msg, qos.Standard)
+}
+
+func (self *impl) SendPrioritizedPayload(msg *xgress.Payload, class qos.PriorityClass) error {
+	return self.SendPayloadMessage(msg.Marshall(), class)
+}
+
+func (self *impl) SendPayloadMessage(msg *channel.Message, class qos.PriorityClass) error {
+	if self.scheduler != nil {
+		return self.scheduler.enqueue(msg, class)
+	}
+	sent, err := self.ch.TrySend(msg)
 	if err == nil && !sent {
 		self.droppedMsgMeter.Mark(1)
 	}
@@ -69,7 +87,18 @@ func (self *impl) SendPayload(msg *xgress.Payload) error {
 }
 
 func (self *impl) SendAcknowledgement(msg *xgress.Acknowledgement) error {
-	sent, err := self.ch.TrySend(msg.Marshall())
+	return self.SendPrioritizedAcknowledgement(msg, qos.Standard)
+}
+
+func (self *impl) SendPrioritizedAcknowledgement(msg *xgress.Acknowledgement, class qos.PriorityClass) error {
+	return self.SendAcknowledgementMessage(msg.Marshall(), class)
+}
+
+func (self *impl) SendAcknowledgementMessage(msg *channel.Message, class qos.PriorityClass) error {
+	if self.scheduler != nil {
+		return self.scheduler.enqueue(msg, class)
+	}
+	sent, err := self.ch.TrySend(msg)
 	if err == nil && !sent {
 		self.droppedMsgMeter.Mark(1)
 	}
@@ -77,14 +106,25 @@ func (self *impl) SendAcknowledgement(msg *xgress.Acknowledgement) error {
 }
 
 func (self *impl) SendControl(msg *xgress.Control) error {
-	sent, err := self.ch.TrySend(msg.Marshall())
+	return self.SendControlMessage(msg.Marshall())
+}
+
+func (self *impl) SendControlMessage(msg *channel.Message) error {
+	sent, err := self.ch.TrySend(msg)
 	if err == nil && !sent {
 		self.droppedMsgMeter.Mark(1)
 	}
 	return err
 }
 
+func (self *impl) SendForReply(msg *channel.Message, timeout time.Duration) (*channel.Message, error) {
+	return msg.WithTimeout(timeout).SendForReply(self.ch)
+}
+
 func (self *impl) Close() error {
+	if self.scheduler != nil {
+		self.scheduler.close()
+	}
 	self.droppedMsgMeter.Dispose()
 	return self.ch.Close()
 }
@@ -133,7 +173,7 @@ func (self *impl) InspectCircuit(detail *inspect.CircuitInspectDetail) {
 }
 
 func (self *impl) InspectLink() *inspect.LinkInspectDetail {
-	return &inspect.LinkInspectDetail{
+	result := &inspect.LinkInspectDetail{
 		Id:          self.Id(),
 		Iteration:   self.Iteration(),
 		Key:         self.key,
@@ -144,6 +184,10 @@ func (self *impl) InspectLink() *inspect.LinkInspectDetail {
 		DestVersion: self.DestVersion(),
 		Dialed:      self.dialed,
 	}
+	if self.scheduler != nil {
+		result.Qos = append(result.Qos, self.scheduler.inspect())
+	}
+	return result
 }
 
 func (self *impl) GetAddresses() []*ctrl_pb.LinkConn {
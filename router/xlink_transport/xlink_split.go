@@ -21,9 +21,12 @@ import (
 	"github.com/openziti/metrics"
 	"github.com/openziti/ziti/common/inspect"
 	"github.com/openziti/ziti/common/pb/ctrl_pb"
+	"github.com/openziti/ziti/common/qos"
 	"github.com/openziti/ziti/router/xgress"
 	"github.com/pkg/errors"
+	"sync"
 	"sync/atomic"
+	"time"
 )
 
 type splitImpl struct {
@@ -41,6 +44,10 @@ type splitImpl struct {
 	dialed          bool
 	iteration       uint32
 	dupsRejected    uint32
+	qos             *qosConfig
+	schedulerLock   sync.Mutex
+	payloadSched    atomic.Pointer[scheduler]
+	ackSched        atomic.Pointer[scheduler]
 }
 
 func (self *splitImpl) Id() string {
@@ -59,11 +66,34 @@ func (self *splitImpl) Init(metricsRegistry metrics.Registry) error {
 	if self.droppedMsgMeter == nil {
 		self.droppedMsgMeter = metricsRegistry.Meter("link.dropped_msgs:" + self.id)
 	}
+
+	// Init is called as each of the two channels is bound, which may happen concurrently on the listener side
+	if self.qos != nil {
+		self.schedulerLock.Lock()
+		defer self.schedulerLock.Unlock()
+		if self.payloadSched.Load() == nil && self.payloadCh != nil {
+			self.payloadSched.Store(newScheduler(PayloadChannel.String(), self.payloadCh, self.qos, self.droppedMsgMeter))
+		}
+		if self.ackSched.Load() == nil && self.ackCh != nil {
+			self.ackSched.Store(newScheduler(AckChannel.String(), self.ackCh, self.qos, self.droppedMsgMeter))
+		}
+	}
 	return nil
 }
 
 func (self *splitImpl) SendPayload(msg *xgress.Payload) error {
-	sent, err := self.payloadCh.TrySend(msg.Marshall())
+	return self.SendPrioritizedPayload(msg, qos.Standard)
+}
+
+func (self *splitImpl) SendPrioritizedPayload(msg *xgress.Payload, class qos.PriorityClass) error {
+	return self.SendPayloadMessage(msg.Marshall(), class)
+}
+
+func (self *splitImpl) SendPayloadMessage(msg *channel.Message, class qos.PriorityClass) error {
+	if payloadSched := self.payloadSched.Load(); payloadSched != nil {
+		return payloadSched.enqueue(msg, class)
+	}
+	sent, err := self.payloadCh.TrySend(msg)
 	if err == nil && !sent {
 		self.droppedMsgMeter.Mark(1)
 	}
@@ -71,7 +101,20 @@ func (self *splitImpl) SendPayload(msg *xgress.Payload) error {
 }
 
 func (self *splitImpl) SendAcknowledgement(msg *xgress.Acknowledgement) error {
-	sent, err := self.ackCh.TrySend(msg.Marshall())
+	return self.SendPrioritizedAcknowledgement(msg, qos.Standard)
+}
+
+// SendPrioritizedAcknowledgement schedules acks on the ack channel, so acks for interactive circuits aren't queued
+// behind acks for bulk circuits, independently of how payloads are scheduled
+func (self *splitImpl) SendPrioritizedAcknowledgement(msg *xgress.Acknowledgement, class qos.PriorityClass) error {
+	return self.SendAcknowledgementMessage(msg.Marshall(), class)
+}
+
+func (self *splitImpl) SendAcknowledgementMessage(msg *channel.Message, class qos.PriorityClass) error {
+	if ackSched := self.ackSched.Load(); ackSched != nil {
+		return ackSched.enqueue(msg, class)
+	}
+	sent, err := self.ackCh.TrySend(msg)
 	if err == nil && !sent {
 		self.droppedMsgMeter.Mark(1)
 	}
@@ -79,7 +122,11 @@ func (self *splitImpl) SendAcknowledgement(msg *xgress.Acknowledgement) error {
 }
 
 func (self *splitImpl) SendControl(msg *xgress.Control) error {
-	sent, err := self.payloadCh.TrySend(msg.Marshall())
+	return self.SendControlMessage(msg.Marshall())
+}
+
+func (self *splitImpl) SendControlMessage(msg *channel.Message) error {
+	sent, err := self.payloadCh.TrySend(msg)
 	if err == nil && !sent {
 		self.droppedMsgMeter.Mark(1)
 	}
@@ -95,7 +142,17 @@ func (self *splitImpl) AreFaultsSent() bool {
 	return self.faultsSent.Load()
 }
 
+func (self *splitImpl) SendForReply(msg *channel.Message, timeout time.Duration) (*channel.Message, error) {
+	return msg.WithTimeout(timeout).SendForReply(self.payloadCh)
+}
+
 func (self *splitImpl) Close() error {
+	if payloadSched := self.payloadSched.Load(); payloadSched != nil {
+		payloadSched.close()
+	}
+	if ackSched := self.ackSched.Load(); ackSched != nil {
+		ackSched.close()
+	}
 	if self.droppedMsgMeter != nil {
 		self.droppedMsgMeter.Dispose()
 	}
@@ -151,7 +208,7 @@ func (self *splitImpl) InspectCircuit(detail *inspect.CircuitInspectDetail) {
 }
 
 func (self *splitImpl) InspectLink() *inspect.LinkInspectDetail {
-	return &inspect.LinkInspectDetail{
+	result := &inspect.LinkInspectDetail{
 		Id:          self.Id(),
 		Iteration:   self.Iteration(),
 		Key:         self.key,
@@ -162,6 +219,13 @@ func (self *splitImpl) InspectLink() *inspect.LinkInspectDetail {
 		DestVersion: self.DestVersion(),
 		Dialed:      self.dialed,
 	}
+	if payloadSched := self.payloadSched.Load(); payloadSched != nil {
+		result.Qos = append(result.Qos, payloadSched.inspect())
+	}
+	if ackSched := self.ackSched.Load(); ackSched != nil {
+		result.Qos = append(result.Qos, ackSched.inspect())
+	}
+	return result
 }
 
 func (self *splitImpl) GetAddresses() []*ctrl_pb.LinkConn {
@@ -79,6 +79,18 @@ func (self *testRegistryEnv) GetRateLimiterPool() goroutines.Pool {
 	panic("implement me")
 }
 
+func (self *testRegistryEnv) GetMetricsRegistry() metrics.UsageRegistry {
+	return nil
+}
+
+func (self *testRegistryEnv) GetLinkBondingOptions() *link.BondingOptions {
+	return link.DefaultBondingOptions()
+}
+
+func (self *testRegistryEnv) GetBondForwarder() link.BondForwarder {
+	return nil
+}
+
 type testDial struct {
 	Key           string
 	LinkId        string
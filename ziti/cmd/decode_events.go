@@ -0,0 +1,102 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"github.com/openziti/ziti/controller/events"
+	"github.com/openziti/ziti/ziti/cmd/common"
+	cmdhelper "github.com/openziti/ziti/ziti/cmd/helpers"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"io"
+	"os"
+)
+
+type DecodeEventsOptions struct {
+	common.CommonOptions
+	eventTypes []string
+}
+
+func NewCmdDecodeEvents(out io.Writer, errOut io.Writer) *cobra.Command {
+	options := &DecodeEventsOptions{
+		CommonOptions: common.CommonOptions{
+			Out: out,
+			Err: errOut,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "decode-events [event-file]",
+		Short: "Converts an event file written with the protobuf format to json, one event per line. Reads stdin if no file is given",
+		Args:  cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			options.Cmd = cmd
+			options.Args = args
+			err := options.Run()
+			cmdhelper.CheckErr(err)
+		},
+	}
+
+	cmd.Flags().StringSliceVarP(&options.eventTypes, "type", "t", nil, "only output events of the given types, for example circuit or usage.v3")
+
+	return cmd
+}
+
+func (self *DecodeEventsOptions) Run() error {
+	var input io.Reader = os.Stdin
+	if len(self.Args) > 0 {
+		f, err := os.Open(self.Args[0])
+		if err != nil {
+			return errors.Wrapf(err, "unable to open event file '%v'", self.Args[0])
+		}
+		defer func() { _ = f.Close() }()
+		input = f
+	}
+
+	typeFilter := map[string]struct{}{}
+	for _, eventType := range self.eventTypes {
+		typeFilter[eventType] = struct{}{}
+	}
+
+	reader := events.NewProtobufEventReader(bufio.NewReader(input))
+	for count := 0; ; count++ {
+		evt, err := reader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrapf(err, "unable to decode event %v", count+1)
+		}
+
+		if len(typeFilter) > 0 {
+			if _, found := typeFilter[evt.GetEventType()]; !found {
+				continue
+			}
+		}
+
+		buf, err := evt.Format()
+		if err != nil {
+			return errors.Wrapf(err, "unable to format event %v as json", count+1)
+		}
+
+		if _, err = fmt.Fprintln(self.Out, string(buf)); err != nil {
+			return err
+		}
+	}
+}
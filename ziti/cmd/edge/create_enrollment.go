@@ -39,6 +39,7 @@ func newCreateEnrollmentCmd(out io.Writer, errOut io.Writer) *cobra.Command {
 	cmd.AddCommand(newCreateEnrollmentOtt(out, errOut))
 	cmd.AddCommand(newCreateEnrollmentOttCa(out, errOut))
 	cmd.AddCommand(newCreateEnrollmentUpdb(out, errOut))
+	cmd.AddCommand(newCreateEnrollmentDeviceKey(out, errOut))
 
 	return cmd
 }
@@ -280,3 +281,56 @@ func runCreateEnrollmentUpdb(options *createEnrollmentOptions) error {
 
 	return err
 }
+
+func newCreateEnrollmentDeviceKey(out io.Writer, errOut io.Writer) *cobra.Command {
+	options := &api.Options{
+		CommonOptions: common.CommonOptions{
+			Out: out,
+			Err: errOut,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "device-key <identityIdOrName>",
+		Short: "creates a one time enrollment for the device key of the given identity",
+		Long: "Creates a devicekey enrollment for an identity. The identity enrolls the public half of its device key " +
+			"by supplying the enrollment token, the PEM encoded public key and a signature over the token made with the device key",
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			options.Cmd = cmd
+			options.Args = args
+
+			err := runCreateEnrollmentDeviceKey(options)
+			cmdhelper.CheckErr(err)
+		},
+		SuggestFor: []string{},
+	}
+
+	// allow interspersing positional args and flags
+	cmd.Flags().SetInterspersed(true)
+	options.AddCommonFlags(cmd)
+
+	return cmd
+}
+
+func runCreateEnrollmentDeviceKey(options *api.Options) error {
+	identityId, err := mapNameToID("identities", options.Args[0], *options)
+
+	if err != nil {
+		return err
+	}
+
+	result, err := postEntityOfType(fmt.Sprintf("identities/%v/device-key-enrollment", identityId), "{}", options)
+
+	if err != nil {
+		return err
+	}
+
+	if options.OutputJSONResponse {
+		return nil
+	}
+
+	_, err = fmt.Fprintf(options.Out, "New device key enrollment %v created for identity %v\ntoken: %v\nexpires at: %v\n",
+		result.S("data", "id").Data(), options.Args[0], result.S("data", "token").Data(), result.S("data", "expiresAt").Data())
+	return err
+}
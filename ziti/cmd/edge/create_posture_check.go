@@ -42,6 +42,9 @@ func newCreatePostureCheckCmd(out io.Writer, errOut io.Writer) *cobra.Command {
 	cmd.AddCommand(newCreatePostureCheckOsCmd(out, errOut))
 	cmd.AddCommand(newCreatePostureCheckMfaCmd(out, errOut))
 	cmd.AddCommand(newCreatePostureCheckProcessMultiCmd(out, errOut))
+	cmd.AddCommand(newCreatePostureCheckDeviceKeyCmd(out, errOut))
+	cmd.AddCommand(newCreatePostureCheckSdkVersionCmd(out, errOut))
+	cmd.AddCommand(newCreatePostureCheckSourceIpCmd(out, errOut))
 
 	return cmd
 }
@@ -59,6 +62,23 @@ type createPostureCheckMfaOptions struct {
 	ignoreLegacyEndpoints bool
 }
 
+type createPostureCheckDeviceKeyOptions struct {
+	createPostureCheckOptions
+	timeoutSeconds int64
+}
+
+type createPostureCheckSdkVersionOptions struct {
+	createPostureCheckOptions
+	constraints []string
+	appIds      []string
+}
+
+type createPostureCheckSourceIpOptions struct {
+	createPostureCheckOptions
+	allowedCidrs []string
+	deniedCidrs  []string
+}
+
 type createPostureCheckMacOptions struct {
 	createPostureCheckOptions
 	addresses []string
@@ -307,11 +327,14 @@ const (
 	OsIOS           = "iOS"
 	OsLinux         = "Linux"
 
-	PostureCheckTypeDomain  = "DOMAIN"
-	PostureCheckTypeProcess = "PROCESS"
-	PostureCheckTypeMAC     = "MAC"
-	PostureCheckTypeOS      = "OS"
-	PostureCheckTypeMFA     = "MFA"
+	PostureCheckTypeDomain     = "DOMAIN"
+	PostureCheckTypeProcess    = "PROCESS"
+	PostureCheckTypeMAC        = "MAC"
+	PostureCheckTypeOS         = "OS"
+	PostureCheckTypeMFA        = "MFA"
+	PostureCheckTypeDeviceKey  = "DEVICE_KEY"
+	PostureCheckTypeSdkVersion = "SDK_VERSION"
+	PostureCheckTypeSourceIp   = "SOURCE_IP"
 )
 
 // Returns the normalized Edge API value or empty string
@@ -756,3 +779,189 @@ func runCreatePostureCheckMfa(o *createPostureCheckMfaOptions) error {
 
 	return nil
 }
+
+func newCreatePostureCheckDeviceKeyCmd(out io.Writer, errOut io.Writer) *cobra.Command {
+	options := createPostureCheckDeviceKeyOptions{
+		createPostureCheckOptions: createPostureCheckOptions{
+			EntityOptions: api.NewEntityOptions(out, errOut),
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "device-key <name>",
+		Short: "creates a posture check requiring proof of an enrolled device key",
+		Long: "Creates a DEVICE_KEY posture check. API sessions pass the check by signing a controller issued challenge " +
+			"with the device key enrolled for their identity. Device keys are enrolled with 'create enrollment device-key'",
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			options.Cmd = cmd
+			options.Args = args
+
+			err := runCreatePostureCheckDeviceKey(&options)
+			cmdhelper.CheckErr(err)
+		},
+		SuggestFor: []string{},
+	}
+
+	// allow interspersing positional args and flags
+	cmd.Flags().SetInterspersed(true)
+	options.AddCommonFlags(cmd)
+	cmd.Flags().Int64VarP(&options.timeoutSeconds, "seconds", "s", 0, "Seconds a device key proof remains valid for. 0 means for the lifetime of the API session")
+	options.addPostureFlags(cmd)
+
+	return cmd
+}
+
+func runCreatePostureCheckDeviceKey(o *createPostureCheckDeviceKeyOptions) error {
+	entityData := gabs.New()
+	api.SetJSONValue(entityData, o.timeoutSeconds, "timeoutSeconds")
+
+	setPostureCheckEntityValues(entityData, &o.createPostureCheckOptions, PostureCheckTypeDeviceKey)
+
+	result, err := CreateEntityOfType("posture-checks", entityData.String(), &o.Options)
+
+	if err != nil {
+		return err
+	}
+
+	checkId := result.S("data", "id").Data()
+
+	if _, err = fmt.Fprintf(o.Out, "%v\n", checkId); err != nil {
+		panic(err)
+	}
+
+	return nil
+}
+
+func newCreatePostureCheckSdkVersionCmd(out io.Writer, errOut io.Writer) *cobra.Command {
+	options := createPostureCheckSdkVersionOptions{
+		createPostureCheckOptions: createPostureCheckOptions{
+			EntityOptions: api.NewEntityOptions(out, errOut),
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "sdk-version <name>",
+		Short: "creates a posture check requiring minimum SDK versions and allowed application ids",
+		Long: "Creates an SDK_VERSION posture check. Constraints have the form <sdk type>:<os type>:<semver range>, for " +
+			"example 'ziti-sdk-golang::>=0.20.0' or ':windows:>=2.1.0'. An empty sdk or os type matches any value. " +
+			"SDKs which match none of the constraints fail the check",
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			options.Cmd = cmd
+			options.Args = args
+
+			err := runCreatePostureCheckSdkVersion(&options)
+			cmdhelper.CheckErr(err)
+		},
+		SuggestFor: []string{},
+	}
+
+	// allow interspersing positional args and flags
+	cmd.Flags().SetInterspersed(true)
+	options.AddCommonFlags(cmd)
+	cmd.Flags().StringArrayVarP(&options.constraints, "constraint", "c", nil, "A version constraint of the form <sdk type>:<os type>:<semver range>. May be repeated")
+	cmd.Flags().StringSliceVar(&options.appIds, "app-ids", nil, "Application ids which are allowed. If not set, any application id is allowed")
+	options.addPostureFlags(cmd)
+
+	return cmd
+}
+
+func runCreatePostureCheckSdkVersion(o *createPostureCheckSdkVersionOptions) error {
+	constraints, err := parseSdkVersionConstraints(o.constraints)
+	if err != nil {
+		return err
+	}
+
+	entityData := gabs.New()
+	api.SetJSONValue(entityData, constraints, "sdkVersionConstraints")
+	api.SetJSONValue(entityData, o.appIds, "appIds")
+
+	setPostureCheckEntityValues(entityData, &o.createPostureCheckOptions, PostureCheckTypeSdkVersion)
+
+	result, err := CreateEntityOfType("posture-checks", entityData.String(), &o.Options)
+
+	if err != nil {
+		return err
+	}
+
+	checkId := result.S("data", "id").Data()
+
+	if _, err = fmt.Fprintf(o.Out, "%v\n", checkId); err != nil {
+		panic(err)
+	}
+
+	return nil
+}
+
+// parseSdkVersionConstraints parses constraints of the form <sdk type>:<os type>:<semver range>
+func parseSdkVersionConstraints(constraints []string) ([]map[string]string, error) {
+	result := []map[string]string{}
+	for _, constraint := range constraints {
+		parts := strings.SplitN(constraint, ":", 3)
+		if len(parts) != 3 || parts[2] == "" {
+			return nil, fmt.Errorf("invalid constraint '%s', expected <sdk type>:<os type>:<semver range>", constraint)
+		}
+		result = append(result, map[string]string{
+			"sdkType":  parts[0],
+			"osType":   parts[1],
+			"versions": parts[2],
+		})
+	}
+	return result, nil
+}
+
+func newCreatePostureCheckSourceIpCmd(out io.Writer, errOut io.Writer) *cobra.Command {
+	options := createPostureCheckSourceIpOptions{
+		createPostureCheckOptions: createPostureCheckOptions{
+			EntityOptions: api.NewEntityOptions(out, errOut),
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "source-ip <name>",
+		Short: "creates a posture check restricting the client source IP",
+		Long: "Creates a SOURCE_IP posture check. The source IP observed by the controller and by edge routers on each " +
+			"dial must not be in a denied CIDR and, if allowed CIDRs are given, must be in one of them",
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			options.Cmd = cmd
+			options.Args = args
+
+			err := runCreatePostureCheckSourceIp(&options)
+			cmdhelper.CheckErr(err)
+		},
+		SuggestFor: []string{},
+	}
+
+	// allow interspersing positional args and flags
+	cmd.Flags().SetInterspersed(true)
+	options.AddCommonFlags(cmd)
+	cmd.Flags().StringSliceVar(&options.allowedCidrs, "allowed-cidrs", nil, "CIDRs the client source IP must be in")
+	cmd.Flags().StringSliceVar(&options.deniedCidrs, "denied-cidrs", nil, "CIDRs the client source IP must not be in. Takes precedence over allowed CIDRs")
+	options.addPostureFlags(cmd)
+
+	return cmd
+}
+
+func runCreatePostureCheckSourceIp(o *createPostureCheckSourceIpOptions) error {
+	entityData := gabs.New()
+	api.SetJSONValue(entityData, o.allowedCidrs, "allowedCidrs")
+	api.SetJSONValue(entityData, o.deniedCidrs, "deniedCidrs")
+
+	setPostureCheckEntityValues(entityData, &o.createPostureCheckOptions, PostureCheckTypeSourceIp)
+
+	result, err := CreateEntityOfType("posture-checks", entityData.String(), &o.Options)
+
+	if err != nil {
+		return err
+	}
+
+	checkId := result.S("data", "id").Data()
+
+	if _, err = fmt.Fprintf(o.Out, "%v\n", checkId); err != nil {
+		panic(err)
+	}
+
+	return nil
+}
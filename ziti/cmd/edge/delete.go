@@ -76,6 +76,7 @@ func newDeleteCmd(out io.Writer, errOut io.Writer) *cobra.Command {
 	cmd.AddCommand(newDeleteCmdForEntityType("transit-router", newOptions()))
 	cmd.AddCommand(newDeleteCmdForEntityType("auth-policy", newOptions()))
 	cmd.AddCommand(newDeleteCmdForEntityType("external-jwt-signer", newOptions(), "ext-jwt-signer", "ext-jwt-signers", "external-jwt-signers"))
+	cmd.AddCommand(newDeleteDeviceKeyCmd(out, errOut))
 
 	return cmd
 }
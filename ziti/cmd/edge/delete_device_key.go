@@ -0,0 +1,63 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package edge
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/openziti/ziti/ziti/cmd/api"
+	"github.com/openziti/ziti/ziti/cmd/common"
+	cmdhelper "github.com/openziti/ziti/ziti/cmd/helpers"
+	"github.com/spf13/cobra"
+)
+
+func newDeleteDeviceKeyCmd(out io.Writer, errOut io.Writer) *cobra.Command {
+	options := &api.Options{
+		CommonOptions: common.CommonOptions{Out: out, Err: errOut},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "device-key <identity id or name>",
+		Short: "removes the enrolled device key of an identity",
+		Long:  "Removes the enrolled device key of an identity. Device key posture checks fail for the identity until a new device key is enrolled",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			options.Cmd = cmd
+			options.Args = args
+			err := runDeleteDeviceKey(options)
+			cmdhelper.CheckErr(err)
+		},
+		SuggestFor: []string{},
+	}
+
+	// allow interspersing positional args and flags
+	cmd.Flags().SetInterspersed(true)
+	options.AddCommonFlags(cmd)
+
+	return cmd
+}
+
+func runDeleteDeviceKey(o *api.Options) error {
+	id, err := mapNameToID("identities", o.Args[0], *o)
+	if err != nil {
+		return err
+	}
+
+	_, err = deleteEntityOfTypeWithBody(fmt.Sprintf("identities/%v/device-key", id), "", o)
+	return err
+}
@@ -41,6 +41,7 @@ func newPolicyAdivsorCmd(out io.Writer, errOut io.Writer) *cobra.Command {
 
 	cmd.AddCommand(newPolicyAdvisorIdentitiesCmd(out, errOut))
 	cmd.AddCommand(newPolicyAdvisorServicesCmd(out, errOut))
+	cmd.AddCommand(newPolicyAdvisorSimulateCmd(out, errOut))
 
 	return cmd
 }
@@ -357,16 +358,27 @@ func runPolicyAdvisorForIdentityAndService(identityId, serviceId string, o *poli
 		}
 	}
 
+	deniedBy, err := result.S("data", "deniedBy").Children()
+	if err != nil && err != gabs.ErrNotObjOrArray {
+		return err
+	}
+
 	status := "OKAY "
 	detail := ""
 	if !isBindAllowed && !isDialAllowed {
 		status = "ERROR"
-		detail += "\n  - No access to service. Adjust service policies."
+		detail += "\n  - No access to service. Adjust service policies, or check whether they are outside their schedule."
+	}
+
+	for _, denyPolicy := range deniedBy {
+		policyName, _ := denyPolicy.S("name").Data().(string)
+		policyId, _ := denyPolicy.S("id").Data().(string)
+		detail += fmt.Sprintf("\n  - Access denied by deny policy %v (%v).", policyName, policyId)
 	}
 
 	if identityRouterCount < 1 {
 		status = "ERROR"
-		detail += "\n  - Identity has no edge routers assigned. Adjust edge router policies."
+		detail += "\n  - Identity has no edge routers assigned. Adjust edge router policies, or check whether they are outside their schedule."
 	}
 
 	if serviceRouterCount < 1 {
@@ -0,0 +1,310 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package edge
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/openziti/ziti/ziti/cmd/api"
+	"github.com/openziti/ziti/ziti/cmd/common"
+	cmdhelper "github.com/openziti/ziti/ziti/cmd/helpers"
+	"github.com/openziti/ziti/ziti/util"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// simulationEntityTypes maps the entity types accepted on the command line to the simulation entity type and the
+// edge management API path used to resolve names
+var simulationEntityTypes = map[string][2]string{
+	"service-policy":             {"servicePolicies", "service-policies"},
+	"edge-router-policy":         {"edgeRouterPolicies", "edge-router-policies"},
+	"service-edge-router-policy": {"serviceEdgeRouterPolicies", "service-edge-router-policies"},
+	"identity":                   {"identities", "identities"},
+}
+
+type policySimulation struct {
+	Changes []*policySimulationChange `json:"changes"`
+}
+
+type policySimulationChange struct {
+	Action            string   `json:"action"`
+	EntityType        string   `json:"entityType"`
+	Id                string   `json:"id,omitempty"`
+	Name              string   `json:"name,omitempty"`
+	Semantic          string   `json:"semantic,omitempty"`
+	PolicyType        string   `json:"policyType,omitempty"`
+	Effect            string   `json:"effect,omitempty"`
+	IdentityRoles     []string `json:"identityRoles"`
+	ServiceRoles      []string `json:"serviceRoles"`
+	EdgeRouterRoles   []string `json:"edgeRouterRoles"`
+	PostureCheckRoles []string `json:"postureCheckRoles"`
+	RoleAttributes    []string `json:"roleAttributes"`
+}
+
+type policySimulationResult struct {
+	ServiceAccess           []*simulatedAccessChange `json:"serviceAccess"`
+	EdgeRouterAccess        []*simulatedAccessChange `json:"edgeRouterAccess"`
+	ServiceEdgeRouterAccess []*simulatedAccessChange `json:"serviceEdgeRouterAccess"`
+}
+
+type simulatedAccessChange struct {
+	SubjectId   string `json:"subjectId"`
+	SubjectName string `json:"subjectName"`
+	TargetId    string `json:"targetId"`
+	TargetName  string `json:"targetName"`
+	Permission  string `json:"permission"`
+	Granted     bool   `json:"granted"`
+}
+
+type policyAdvisorSimulateOptions struct {
+	api.Options
+	file              string
+	action            string
+	entityType        string
+	id                string
+	name              string
+	semantic          string
+	policyType        string
+	effect            string
+	identityRoles     []string
+	serviceRoles      []string
+	edgeRouterRoles   []string
+	postureCheckRoles []string
+	roleAttributes    []string
+}
+
+// newPolicyAdvisorSimulateCmd creates the 'edge policy-advisor simulate' command
+func newPolicyAdvisorSimulateCmd(out io.Writer, errOut io.Writer) *cobra.Command {
+	options := &policyAdvisorSimulateOptions{
+		Options: api.Options{
+			CommonOptions: common.CommonOptions{Out: out, Err: errOut},
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "simulate",
+		Short: "shows how access would change if proposed policy changes were applied, without applying them",
+		Long: "Simulates a change to a service policy, edge router policy, service edge router policy or to the role attributes " +
+			"of an identity and lists the identity to service, identity to edge router and service to edge router access which " +
+			"would be gained or lost. A single change may be given with flags. Several changes may be given in a JSON or YAML " +
+			"file holding a 'changes' list, whose entries have the fields action, entityType, id, name, semantic, policyType, effect, " +
+			"identityRoles, serviceRoles, edgeRouterRoles, postureCheckRoles and roleAttributes",
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			options.Cmd = cmd
+			options.Args = args
+			err := runPolicyAdvisorSimulate(options)
+			cmdhelper.CheckErr(err)
+		},
+		SuggestFor: []string{},
+	}
+
+	// allow interspersing positional args and flags
+	cmd.Flags().SetInterspersed(true)
+	cmd.Flags().StringVarP(&options.file, "file", "f", "", "JSON or YAML file containing the changes to simulate")
+	cmd.Flags().StringVar(&options.action, "action", "", "The change to simulate. One of create, update or delete")
+	cmd.Flags().StringVar(&options.entityType, "type", "", "The type of entity to change. One of service-policy, edge-router-policy, service-edge-router-policy or identity")
+	cmd.Flags().StringVar(&options.id, "id", "", "The id or name of the entity to update or delete")
+	cmd.Flags().StringVar(&options.name, "name", "", "The name of the policy")
+	cmd.Flags().StringVar(&options.semantic, "semantic", "", "Semantic of the policy. One of AllOf or AnyOf")
+	cmd.Flags().StringVar(&options.policyType, "policy-type", "", "Service policy type. One of Dial or Bind")
+	cmd.Flags().StringVar(&options.effect, "effect", "", "Service policy effect. One of Allow or Deny")
+	cmd.Flags().StringSliceVar(&options.identityRoles, "identity-roles", nil, "Identity roles of the policy")
+	cmd.Flags().StringSliceVar(&options.serviceRoles, "service-roles", nil, "Service roles of the policy")
+	cmd.Flags().StringSliceVar(&options.edgeRouterRoles, "edge-router-roles", nil, "Edge router roles of the policy")
+	cmd.Flags().StringSliceVarP(&options.postureCheckRoles, "posture-check-roles", "p", nil, "Posture check roles of the service policy")
+	cmd.Flags().StringSliceVar(&options.roleAttributes, "role-attributes", nil, "Role attributes of the identity")
+	options.AddCommonFlags(cmd)
+
+	return cmd
+}
+
+func runPolicyAdvisorSimulate(o *policyAdvisorSimulateOptions) error {
+	simulation, err := o.getSimulation()
+	if err != nil {
+		return err
+	}
+
+	for _, change := range simulation.Changes {
+		if err = o.resolveId(change); err != nil {
+			return err
+		}
+	}
+
+	body, err := json.Marshal(simulation)
+	if err != nil {
+		return err
+	}
+
+	resp, err := postEntityOfType("policy-advisor/simulate", string(body), &o.Options)
+	if err != nil {
+		return err
+	}
+
+	if o.OutputJSONResponse || resp == nil || !resp.Exists("data") {
+		return nil
+	}
+
+	result := &policySimulationResult{}
+	if err = json.Unmarshal(resp.S("data").Bytes(), result); err != nil {
+		return errors.Wrap(err, "unable to parse simulation result")
+	}
+
+	o.outputAccessChanges("Service access", result.ServiceAccess)
+	o.outputAccessChanges("Edge router access", result.EdgeRouterAccess)
+	o.outputAccessChanges("Service edge router access", result.ServiceEdgeRouterAccess)
+
+	return nil
+}
+
+func (o *policyAdvisorSimulateOptions) getSimulation() (*policySimulation, error) {
+	if o.file != "" {
+		if o.action != "" || o.entityType != "" {
+			return nil, errors.New("--file may not be combined with --action or --type")
+		}
+		return readPolicySimulation(o.file)
+	}
+
+	if o.action == "" || o.entityType == "" {
+		return nil, errors.New("either --file, or --action and --type must be specified")
+	}
+
+	entityType, found := simulationEntityTypes[o.entityType]
+	if !found {
+		return nil, errors.Errorf("invalid --type '%s'. valid types are service-policy, edge-router-policy, service-edge-router-policy and identity", o.entityType)
+	}
+
+	change := &policySimulationChange{
+		Action:     o.action,
+		EntityType: entityType[0],
+		Id:         o.id,
+		Name:       o.name,
+		Semantic:   o.semantic,
+		PolicyType: o.policyType,
+		Effect:     o.effect,
+	}
+
+	// only send roles which were given, so that updates leave the others unchanged
+	change.IdentityRoles = o.getChangedSlice("identity-roles", o.identityRoles)
+	change.ServiceRoles = o.getChangedSlice("service-roles", o.serviceRoles)
+	change.EdgeRouterRoles = o.getChangedSlice("edge-router-roles", o.edgeRouterRoles)
+	change.PostureCheckRoles = o.getChangedSlice("posture-check-roles", o.postureCheckRoles)
+	change.RoleAttributes = o.getChangedSlice("role-attributes", o.roleAttributes)
+
+	return &policySimulation{
+		Changes: []*policySimulationChange{change},
+	}, nil
+}
+
+func (o *policyAdvisorSimulateOptions) getChangedSlice(flag string, val []string) []string {
+	if !o.Cmd.Flags().Changed(flag) {
+		return nil
+	}
+	if val == nil {
+		return []string{}
+	}
+	return val
+}
+
+// resolveId maps the name given for an entity being updated or deleted to its id
+func (o *policyAdvisorSimulateOptions) resolveId(change *policySimulationChange) error {
+	if change == nil || change.Id == "" || change.Action == "create" {
+		return nil
+	}
+
+	for _, entityType := range simulationEntityTypes {
+		if entityType[0] == change.EntityType {
+			id, err := api.MapNameToID(util.EdgeAPI, entityType[1], &o.Options, change.Id)
+			if err != nil {
+				return err
+			}
+			change.Id = id
+		}
+	}
+
+	return nil
+}
+
+func (o *policyAdvisorSimulateOptions) outputAccessChanges(title string, changes []*simulatedAccessChange) {
+	_, _ = fmt.Fprintf(o.Out, "%s:\n", title)
+	if len(changes) == 0 {
+		_, _ = fmt.Fprintln(o.Out, "    no changes")
+	}
+
+	for _, change := range changes {
+		outcome := "LOSES"
+		if change.Granted {
+			outcome = "GAINS"
+		}
+
+		permission := ""
+		if change.Permission != "" {
+			permission = " " + change.Permission
+		}
+
+		_, _ = fmt.Fprintf(o.Out, "    %s (%s) %s%s access to %s (%s)\n", change.SubjectName, change.SubjectId,
+			outcome, permission, change.TargetName, change.TargetId)
+	}
+	_, _ = fmt.Fprintln(o.Out)
+}
+
+func readPolicySimulation(file string) (*policySimulation, error) {
+	contents, err := os.ReadFile(file)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read changes file %s", file)
+	}
+
+	// YAML is a superset of JSON, so parse generically and round trip through JSON to pick up the simulation's field names
+	var parsed interface{}
+	if err = yaml.Unmarshal(contents, &parsed); err != nil {
+		return nil, errors.Wrapf(err, "unable to parse changes file %s", file)
+	}
+
+	if list, ok := parsed.([]interface{}); ok {
+		parsed = map[string]interface{}{"changes": list}
+	}
+
+	jsonContents, err := json.Marshal(parsed)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to parse changes file %s", file)
+	}
+
+	result := &policySimulation{}
+	if err = json.Unmarshal(jsonContents, result); err != nil {
+		return nil, errors.Wrapf(err, "unable to parse changes file %s", file)
+	}
+
+	if len(result.Changes) == 0 {
+		return nil, errors.Errorf("no changes found in %s", file)
+	}
+
+	for idx, change := range result.Changes {
+		if change == nil || change.Action == "" || change.EntityType == "" {
+			return nil, errors.Errorf("change %d in %s must specify action and entityType", idx+1, file)
+		}
+		if entityType, found := simulationEntityTypes[strings.ToLower(change.EntityType)]; found {
+			change.EntityType = entityType[0]
+		}
+	}
+
+	return result, nil
+}
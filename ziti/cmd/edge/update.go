@@ -51,6 +51,9 @@ func newUpdateCmd(out io.Writer, errOut io.Writer) *cobra.Command {
 	cmd.AddCommand(newUpdateServiceEdgeRouterPolicyCmd(out, errOut))
 	cmd.AddCommand(newUpdateTerminatorCmd(out, errOut))
 	cmd.AddCommand(newUpdatePostureCheckCmd(out, errOut))
+	cmd.AddCommand(newUpdatePolicyScheduleCmd(out, errOut))
+	cmd.AddCommand(newUpdatePolicyEffectCmd(out, errOut))
+	cmd.AddCommand(newUpdatePostureCheckGracePeriodCmd(out, errOut))
 	cmd.AddCommand(newUpdateExtJwtSignerCmd(out, errOut))
 	cmd.AddCommand(newUpdateAuthPolicySignerCmd(out, errOut))
 
@@ -0,0 +1,69 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package edge
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/Jeffail/gabs"
+	"github.com/openziti/ziti/ziti/cmd/api"
+	"github.com/openziti/ziti/ziti/cmd/common"
+	cmdhelper "github.com/openziti/ziti/ziti/cmd/helpers"
+	"github.com/spf13/cobra"
+)
+
+func newUpdatePolicyEffectCmd(out io.Writer, errOut io.Writer) *cobra.Command {
+	options := &api.Options{
+		CommonOptions: common.CommonOptions{Out: out, Err: errOut},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "policy-effect <service policy id or name> <Allow|Deny>",
+		Short: "sets whether a service policy allows or denies access",
+		Long: "Sets the effect of a service policy. Deny policies take precedence over allow policies, so identities " +
+			"matched by a deny policy lose the matching permission on its services, whichever allow policies grant it. " +
+			"Deny policies may not have posture checks or schedules",
+		Args: cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			options.Cmd = cmd
+			options.Args = args
+			err := runUpdatePolicyEffect(options)
+			cmdhelper.CheckErr(err)
+		},
+		SuggestFor: []string{},
+	}
+
+	// allow interspersing positional args and flags
+	cmd.Flags().SetInterspersed(true)
+	options.AddCommonFlags(cmd)
+
+	return cmd
+}
+
+func runUpdatePolicyEffect(o *api.Options) error {
+	id, err := mapNameToID("service-policies", o.Args[0], *o)
+	if err != nil {
+		return err
+	}
+
+	entityData := gabs.New()
+	api.SetJSONValue(entityData, o.Args[1], "effect")
+
+	_, err = putEntityOfType(fmt.Sprintf("service-policies/%v/effect", id), entityData.String(), o)
+	return err
+}
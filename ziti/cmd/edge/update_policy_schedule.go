@@ -0,0 +1,134 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package edge
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/Jeffail/gabs"
+	"github.com/openziti/ziti/ziti/cmd/api"
+	"github.com/openziti/ziti/ziti/cmd/common"
+	cmdhelper "github.com/openziti/ziti/ziti/cmd/helpers"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+type updatePolicyScheduleOptions struct {
+	api.Options
+	validFrom  string
+	validUntil string
+	windows    []string
+	timezone   string
+	clear      bool
+}
+
+func newUpdatePolicyScheduleCmd(out io.Writer, errOut io.Writer) *cobra.Command {
+	options := &updatePolicyScheduleOptions{
+		Options: api.Options{
+			CommonOptions: common.CommonOptions{Out: out, Err: errOut},
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "policy-schedule <service or edge router policy id or name>",
+		Short: "sets when a service or edge router policy is in force",
+		Long: "Sets the schedule of a service or edge router policy. The given flags replace any existing schedule. " +
+			"Windows have the form '<days> <HH:MM>-<HH:MM>', for example 'mon-fri 09:00-17:00' or 'sat,sun 22:00-02:00'",
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			options.Cmd = cmd
+			options.Args = args
+			err := runUpdatePolicySchedule(options)
+			cmdhelper.CheckErr(err)
+		},
+		SuggestFor: []string{},
+	}
+
+	// allow interspersing positional args and flags
+	cmd.Flags().SetInterspersed(true)
+	cmd.Flags().StringVar(&options.validFrom, "valid-from", "", "RFC3339 time before which the policy is not in force")
+	cmd.Flags().StringVar(&options.validUntil, "valid-until", "", "RFC3339 time from which the policy is no longer in force")
+	cmd.Flags().StringArrayVar(&options.windows, "window", nil, "Recurring window in which the policy is in force. May be specified more than once")
+	cmd.Flags().StringVar(&options.timezone, "timezone", "", "IANA timezone windows are evaluated in. Defaults to UTC")
+	cmd.Flags().BoolVar(&options.clear, "clear", false, "Remove the schedule, so the policy is always in force")
+	options.AddCommonFlags(cmd)
+
+	return cmd
+}
+
+func runUpdatePolicySchedule(o *updatePolicyScheduleOptions) error {
+	isSet := o.validFrom != "" || o.validUntil != "" || len(o.windows) > 0
+	if o.clear && (isSet || o.timezone != "") {
+		return errors.New("--clear may not be combined with other schedule flags")
+	}
+
+	if !o.clear && !isSet {
+		return errors.New("no schedule specified. must specify --valid-from, --valid-until or --window, or --clear to remove the schedule")
+	}
+
+	entityData := gabs.New()
+	if err := setScheduleTime(entityData, "valid-from", o.validFrom, "validFrom"); err != nil {
+		return err
+	}
+
+	if err := setScheduleTime(entityData, "valid-until", o.validUntil, "validUntil"); err != nil {
+		return err
+	}
+
+	if len(o.windows) > 0 {
+		api.SetJSONValue(entityData, o.windows, "windows")
+	}
+
+	if o.timezone != "" {
+		api.SetJSONValue(entityData, o.timezone, "timezone")
+	}
+
+	entityType, id, err := mapPolicyNameToID(o.Args[0], o.Options)
+	if err != nil {
+		return err
+	}
+
+	_, err = putEntityOfType(fmt.Sprintf("%s/%s/schedule", entityType, id), entityData.String(), &o.Options)
+	return err
+}
+
+func setScheduleTime(entityData *gabs.Container, flag, val string, field string) error {
+	if val == "" {
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339, val)
+	if err != nil {
+		return errors.Wrapf(err, "invalid --%s, expected an RFC3339 time, such as 2006-01-02T15:04:05Z", flag)
+	}
+	api.SetJSONValue(entityData, t.Format(time.RFC3339), field)
+	return nil
+}
+
+// mapPolicyNameToID resolves the given name or id against service policies first, then edge router policies. Returns
+// the entity type of the policy found along with its id
+func mapPolicyNameToID(idOrName string, o api.Options) (string, string, error) {
+	if id, err := mapNameToID("service-policies", idOrName, o); err == nil {
+		return "service-policies", id, nil
+	}
+	id, err := mapNameToID("edge-router-policies", idOrName, o)
+	if err != nil {
+		return "", "", errors.Errorf("no service policy or edge router policy found with id or name %v", idOrName)
+	}
+	return "edge-router-policies", id, nil
+}
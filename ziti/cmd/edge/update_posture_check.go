@@ -39,6 +39,9 @@ func newUpdatePostureCheckCmd(out io.Writer, errOut io.Writer) *cobra.Command {
 	cmd.AddCommand(newUpdatePostureCheckProcessCmd(out, errOut))
 	cmd.AddCommand(newUpdatePostureCheckOsCmd(out, errOut))
 	cmd.AddCommand(newUpdatePostureCheckMfaCmd(out, errOut))
+	cmd.AddCommand(newUpdatePostureCheckDeviceKeyCmd(out, errOut))
+	cmd.AddCommand(newUpdatePostureCheckSdkVersionCmd(out, errOut))
+	cmd.AddCommand(newUpdatePostureCheckSourceIpCmd(out, errOut))
 
 	return cmd
 }
@@ -75,6 +78,23 @@ type updatePostureCheckMfaOptions struct {
 	ignoreLegacyEndpoints bool
 }
 
+type updatePostureCheckDeviceKeyOptions struct {
+	updatePostureCheckOptions
+	timeoutSeconds int64
+}
+
+type updatePostureCheckSdkVersionOptions struct {
+	updatePostureCheckOptions
+	constraints []string
+	appIds      []string
+}
+
+type updatePostureCheckSourceIpOptions struct {
+	updatePostureCheckOptions
+	allowedCidrs []string
+	deniedCidrs  []string
+}
+
 type updatePostureCheckOsOptions struct {
 	updatePostureCheckOptions
 	os []string
@@ -528,3 +548,233 @@ func runUpdatePostureCheckOs(o *updatePostureCheckOsOptions) error {
 	_, err = patchEntityOfType(fmt.Sprintf("posture-checks/%v", id), entityData.String(), &o.Options)
 	return err
 }
+
+func newUpdatePostureCheckDeviceKeyCmd(out io.Writer, errOut io.Writer) *cobra.Command {
+	options := &updatePostureCheckDeviceKeyOptions{
+		updatePostureCheckOptions: updatePostureCheckOptions{
+			EntityOptions: api.NewEntityOptions(out, errOut),
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "device-key <idOrName>",
+		Short: "updates a device key posture check",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			options.Cmd = cmd
+			options.Args = args
+			err := runUpdatePostureCheckDeviceKey(options)
+			cmdhelper.CheckErr(err)
+		},
+		SuggestFor: []string{},
+	}
+
+	options.AddCommonFlags(cmd)
+	// allow interspersing positional args and flags
+	cmd.Flags().SetInterspersed(true)
+	cmd.Flags().StringVarP(&options.name, "name", "n", "", "Set the name ")
+	cmd.Flags().Int64VarP(&options.timeoutSeconds, "seconds", "s", 0, "Seconds a device key proof remains valid for. 0 means for the lifetime of the API session")
+	cmd.Flags().StringSliceVarP(&options.roleAttributes, "role-attributes", "a", nil, "comma-separated role attributes for the posture check. Use '' to unset.")
+	return cmd
+}
+
+func runUpdatePostureCheckDeviceKey(o *updatePostureCheckDeviceKeyOptions) error {
+	id, err := mapNameToID("posture-checks", o.Args[0], o.Options)
+	if err != nil {
+		return err
+	}
+	entityData := gabs.New()
+	change := false
+
+	if o.Cmd.Flags().Changed("name") {
+		api.SetJSONValue(entityData, o.name, "name")
+		change = true
+	}
+
+	if o.Cmd.Flags().Changed("seconds") {
+		api.SetJSONValue(entityData, o.timeoutSeconds, "timeoutSeconds")
+		change = true
+	}
+
+	if o.Cmd.Flags().Changed("role-attributes") {
+		api.SetJSONValue(entityData, o.roleAttributes, "roleAttributes")
+		change = true
+	}
+
+	if o.TagsProvided() {
+		o.SetTags(entityData)
+		change = true
+	}
+
+	if !change {
+		return errors.New("no change specified. must specify at least one attribute to change")
+	}
+
+	api.SetJSONValue(entityData, PostureCheckTypeDeviceKey, "typeId")
+
+	_, err = patchEntityOfType(fmt.Sprintf("posture-checks/%v", id), entityData.String(), &o.Options)
+	return err
+}
+
+func newUpdatePostureCheckSdkVersionCmd(out io.Writer, errOut io.Writer) *cobra.Command {
+	options := &updatePostureCheckSdkVersionOptions{
+		updatePostureCheckOptions: updatePostureCheckOptions{
+			EntityOptions: api.NewEntityOptions(out, errOut),
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "sdk-version <idOrName>",
+		Short: "updates an SDK version posture check",
+		Long: "Updates an SDK_VERSION posture check. Constraints have the form <sdk type>:<os type>:<semver range> and " +
+			"replace any existing constraints",
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			options.Cmd = cmd
+			options.Args = args
+			err := runUpdatePostureCheckSdkVersion(options)
+			cmdhelper.CheckErr(err)
+		},
+		SuggestFor: []string{},
+	}
+
+	options.AddCommonFlags(cmd)
+	// allow interspersing positional args and flags
+	cmd.Flags().SetInterspersed(true)
+	cmd.Flags().StringVarP(&options.name, "name", "n", "", "Set the name ")
+	cmd.Flags().StringArrayVarP(&options.constraints, "constraint", "c", nil, "A version constraint of the form <sdk type>:<os type>:<semver range>. May be repeated. Use '' to unset.")
+	cmd.Flags().StringSliceVar(&options.appIds, "app-ids", nil, "Application ids which are allowed. Use '' to allow any application id.")
+	cmd.Flags().StringSliceVarP(&options.roleAttributes, "role-attributes", "a", nil, "comma-separated role attributes for the posture check. Use '' to unset.")
+	return cmd
+}
+
+func runUpdatePostureCheckSdkVersion(o *updatePostureCheckSdkVersionOptions) error {
+	id, err := mapNameToID("posture-checks", o.Args[0], o.Options)
+	if err != nil {
+		return err
+	}
+	entityData := gabs.New()
+	change := false
+
+	if o.Cmd.Flags().Changed("name") {
+		api.SetJSONValue(entityData, o.name, "name")
+		change = true
+	}
+
+	if o.Cmd.Flags().Changed("constraint") {
+		constraints, err := parseSdkVersionConstraints(withoutEmpty(o.constraints))
+		if err != nil {
+			return err
+		}
+		api.SetJSONValue(entityData, constraints, "sdkVersionConstraints")
+		change = true
+	}
+
+	if o.Cmd.Flags().Changed("app-ids") {
+		api.SetJSONValue(entityData, withoutEmpty(o.appIds), "appIds")
+		change = true
+	}
+
+	if o.Cmd.Flags().Changed("role-attributes") {
+		api.SetJSONValue(entityData, o.roleAttributes, "roleAttributes")
+		change = true
+	}
+
+	if o.TagsProvided() {
+		o.SetTags(entityData)
+		change = true
+	}
+
+	if !change {
+		return errors.New("no change specified. must specify at least one attribute to change")
+	}
+
+	api.SetJSONValue(entityData, PostureCheckTypeSdkVersion, "typeId")
+
+	_, err = patchEntityOfType(fmt.Sprintf("posture-checks/%v", id), entityData.String(), &o.Options)
+	return err
+}
+
+func newUpdatePostureCheckSourceIpCmd(out io.Writer, errOut io.Writer) *cobra.Command {
+	options := &updatePostureCheckSourceIpOptions{
+		updatePostureCheckOptions: updatePostureCheckOptions{
+			EntityOptions: api.NewEntityOptions(out, errOut),
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "source-ip <idOrName>",
+		Short: "updates a source IP posture check",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			options.Cmd = cmd
+			options.Args = args
+			err := runUpdatePostureCheckSourceIp(options)
+			cmdhelper.CheckErr(err)
+		},
+		SuggestFor: []string{},
+	}
+
+	options.AddCommonFlags(cmd)
+	// allow interspersing positional args and flags
+	cmd.Flags().SetInterspersed(true)
+	cmd.Flags().StringVarP(&options.name, "name", "n", "", "Set the name ")
+	cmd.Flags().StringSliceVar(&options.allowedCidrs, "allowed-cidrs", nil, "CIDRs the client source IP must be in. Use '' to unset.")
+	cmd.Flags().StringSliceVar(&options.deniedCidrs, "denied-cidrs", nil, "CIDRs the client source IP must not be in. Use '' to unset.")
+	cmd.Flags().StringSliceVarP(&options.roleAttributes, "role-attributes", "a", nil, "comma-separated role attributes for the posture check. Use '' to unset.")
+	return cmd
+}
+
+func runUpdatePostureCheckSourceIp(o *updatePostureCheckSourceIpOptions) error {
+	id, err := mapNameToID("posture-checks", o.Args[0], o.Options)
+	if err != nil {
+		return err
+	}
+	entityData := gabs.New()
+	change := false
+
+	if o.Cmd.Flags().Changed("name") {
+		api.SetJSONValue(entityData, o.name, "name")
+		change = true
+	}
+
+	if o.Cmd.Flags().Changed("allowed-cidrs") {
+		api.SetJSONValue(entityData, withoutEmpty(o.allowedCidrs), "allowedCidrs")
+		change = true
+	}
+
+	if o.Cmd.Flags().Changed("denied-cidrs") {
+		api.SetJSONValue(entityData, withoutEmpty(o.deniedCidrs), "deniedCidrs")
+		change = true
+	}
+
+	if o.Cmd.Flags().Changed("role-attributes") {
+		api.SetJSONValue(entityData, o.roleAttributes, "roleAttributes")
+		change = true
+	}
+
+	if o.TagsProvided() {
+		o.SetTags(entityData)
+		change = true
+	}
+
+	if !change {
+		return errors.New("no change specified. must specify at least one attribute to change")
+	}
+
+	api.SetJSONValue(entityData, PostureCheckTypeSourceIp, "typeId")
+
+	_, err = patchEntityOfType(fmt.Sprintf("posture-checks/%v", id), entityData.String(), &o.Options)
+	return err
+}
+
+// withoutEmpty returns the non-empty values, so that an empty value can be given to clear a list
+func withoutEmpty(values []string) []string {
+	result := []string{}
+	for _, value := range values {
+		if value != "" {
+			result = append(result, value)
+		}
+	}
+	return result
+}
@@ -0,0 +1,80 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package edge
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/Jeffail/gabs"
+	"github.com/openziti/ziti/ziti/cmd/api"
+	"github.com/openziti/ziti/ziti/cmd/common"
+	cmdhelper "github.com/openziti/ziti/ziti/cmd/helpers"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+func newUpdatePostureCheckGracePeriodCmd(out io.Writer, errOut io.Writer) *cobra.Command {
+	options := &api.Options{
+		CommonOptions: common.CommonOptions{Out: out, Err: errOut},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "posture-check-grace-period <posture check id or name> <duration>",
+		Short: "sets how long a posture check may fail before access is cut",
+		Long: "Sets the grace period of a posture check, for example 5m. When a posture check which was passing for an " +
+			"API session starts failing, access continues for the grace period, posture warning events are emitted and " +
+			"SDKs are told how long they have to remediate. A duration of 0 disables the grace period",
+		Args: cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			options.Cmd = cmd
+			options.Args = args
+			err := runUpdatePostureCheckGracePeriod(options)
+			cmdhelper.CheckErr(err)
+		},
+		SuggestFor: []string{},
+	}
+
+	// allow interspersing positional args and flags
+	cmd.Flags().SetInterspersed(true)
+	options.AddCommonFlags(cmd)
+
+	return cmd
+}
+
+func runUpdatePostureCheckGracePeriod(o *api.Options) error {
+	gracePeriod, err := time.ParseDuration(o.Args[1])
+	if err != nil {
+		return errors.Wrapf(err, "invalid grace period '%s'", o.Args[1])
+	}
+
+	if gracePeriod < 0 {
+		return errors.Errorf("grace period may not be negative, got %v", gracePeriod)
+	}
+
+	id, err := mapNameToID("posture-checks", o.Args[0], *o)
+	if err != nil {
+		return err
+	}
+
+	entityData := gabs.New()
+	api.SetJSONValue(entityData, int64(gracePeriod.Seconds()), "gracePeriodSeconds")
+
+	_, err = putEntityOfType(fmt.Sprintf("posture-checks/%v/grace-period", id), entityData.String(), o)
+	return err
+}
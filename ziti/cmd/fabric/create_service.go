@@ -25,8 +25,13 @@ import (
 
 type createServiceOptions struct {
 	api.Options
-	terminatorStrategy string
-	tags               map[string]string
+	terminatorStrategy  string
+	routerRoles         []string
+	routerRolesSemantic string
+	excludedLinks       []string
+	maxHops             uint32
+	priorityClass       string
+	tags                map[string]string
 }
 
 // newCreateServiceCmd creates the 'fabric create service' command for the given entity type
@@ -50,6 +55,11 @@ func newCreateServiceCmd(p common.OptionsProvider) *cobra.Command {
 	cmd.Flags().SetInterspersed(true)
 	cmd.Flags().StringToStringVarP(&options.tags, "tags", "t", nil, "Add tags to service definition")
 	cmd.Flags().StringVar(&options.terminatorStrategy, "terminator-strategy", "", "Specifies the terminator strategy for the service")
+	cmd.Flags().StringSliceVar(&options.routerRoles, "router-roles", nil, "Restrict paths for the service to routers matching these roles (#attribute) or ids (@id)")
+	cmd.Flags().StringVar(&options.routerRolesSemantic, "router-roles-semantic", "", "How router roles are matched, AllOf or AnyOf. Defaults to AllOf")
+	cmd.Flags().StringSliceVar(&options.excludedLinks, "excluded-links", nil, "Links which paths for the service may not use, as link ids or router id pairs in the form routerId:routerId")
+	cmd.Flags().Uint32Var(&options.maxHops, "max-hops", 0, "Maximum number of router to router hops in paths for the service. 0 means no limit")
+	cmd.Flags().StringVar(&options.priorityClass, "priority-class", "", "Priority class used to schedule the service's payloads on links: realtime, interactive, standard or bulk")
 	options.AddCommonFlags(cmd)
 
 	return cmd
@@ -64,6 +74,26 @@ func (o *createServiceOptions) createService(_ *cobra.Command, args []string) (e
 		api.SetJSONValue(entityData, o.terminatorStrategy, "terminatorStrategy")
 	}
 
+	if len(o.routerRoles) > 0 {
+		api.SetJSONValue(entityData, o.routerRoles, "routerRoles")
+	}
+
+	if o.routerRolesSemantic != "" {
+		api.SetJSONValue(entityData, o.routerRolesSemantic, "routerRolesSemantic")
+	}
+
+	if len(o.excludedLinks) > 0 {
+		api.SetJSONValue(entityData, o.excludedLinks, "excludedLinks")
+	}
+
+	if o.maxHops > 0 {
+		api.SetJSONValue(entityData, o.maxHops, "maxHops")
+	}
+
+	if o.priorityClass != "" {
+		api.SetJSONValue(entityData, o.priorityClass, "priorityClass")
+	}
+
 	api.SetJSONValue(entityData, o.tags, "tags")
 
 	result, err := createEntityOfType("services", entityData.String(), &o.Options)
@@ -0,0 +1,173 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package fabric
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/openziti/ziti/controller/rest_client/circuit"
+	"github.com/openziti/ziti/controller/rest_model"
+	"github.com/openziti/ziti/ziti/cmd/api"
+	"github.com/openziti/ziti/ziti/cmd/common"
+	cmdhelper "github.com/openziti/ziti/ziti/cmd/helpers"
+	"github.com/openziti/ziti/ziti/util"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+func newRerouteCmd(p common.OptionsProvider) *cobra.Command {
+	rerouteCmd := &cobra.Command{
+		Use:   "reroute",
+		Short: "moves circuits onto new paths",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdhelper.CheckErr(cmd.Help())
+		},
+	}
+
+	rerouteCmd.AddCommand(newRerouteCircuitCmd(p))
+	rerouteCmd.AddCommand(newRerouteTargetCmd(p, "link", "links"))
+	rerouteCmd.AddCommand(newRerouteTargetCmd(p, "router", "routers"))
+
+	return rerouteCmd
+}
+
+type rerouteOptions struct {
+	api.Options
+	path []string
+}
+
+func newRerouteCircuitCmd(p common.OptionsProvider) *cobra.Command {
+	options := &rerouteOptions{
+		Options: api.Options{CommonOptions: p()},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "circuit <circuit id>",
+		Short: "reroutes a circuit",
+		Long: "Reroutes a circuit onto a newly computed path or, if --path is given, onto a path through the given routers. " +
+			"A circuit placed on an explicit path is pinned to it, and won't be moved by smart rerouting",
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			options.Cmd = cmd
+			options.Args = args
+			err := runReroute(options, &rest_model.CircuitReroute{
+				CircuitID: args[0],
+				Path:      options.path,
+			})
+			cmdhelper.CheckErr(err)
+		},
+		SuggestFor: []string{},
+	}
+
+	// allow interspersing positional args and flags
+	cmd.Flags().SetInterspersed(true)
+	cmd.Flags().StringSliceVar(&options.path, "path", nil, "Ordered router ids or names to route the circuit through, from the initiating router to the terminating router")
+	options.AddCommonFlags(cmd)
+
+	return cmd
+}
+
+func newRerouteTargetCmd(p common.OptionsProvider, entityType, entityTypePlural string) *cobra.Command {
+	options := &rerouteOptions{
+		Options: api.Options{CommonOptions: p()},
+	}
+
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("%s <%s id>", entityType, entityType),
+		Short: fmt.Sprintf("reroutes all circuits using a %s onto paths which avoid it", entityType),
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			options.Cmd = cmd
+			options.Args = args
+			reroute := &rest_model.CircuitReroute{}
+			if entityType == "link" {
+				reroute.LinkID = args[0]
+			} else {
+				id, err := api.MapNameToID(util.FabricAPI, entityTypePlural, &options.Options, args[0])
+				cmdhelper.CheckErr(err)
+				reroute.RouterID = id
+			}
+			cmdhelper.CheckErr(runReroute(options, reroute))
+		},
+		SuggestFor: []string{},
+	}
+
+	// allow interspersing positional args and flags
+	cmd.Flags().SetInterspersed(true)
+	options.AddCommonFlags(cmd)
+
+	return cmd
+}
+
+func runReroute(o *rerouteOptions, reroute *rest_model.CircuitReroute) error {
+	for i, routerIdOrName := range reroute.Path {
+		id, err := api.MapNameToID(util.FabricAPI, "routers", &o.Options, routerIdOrName)
+		if err != nil {
+			return err
+		}
+		reroute.Path[i] = id
+	}
+
+	client, err := util.NewFabricManagementClient(o)
+	if err != nil {
+		return err
+	}
+
+	ok, err := client.Circuit.RerouteCircuits(&circuit.RerouteCircuitsParams{
+		Reroute: reroute,
+		Context: context.Background(),
+	})
+	if err != nil {
+		return err
+	}
+
+	if o.OutputJSONResponse || ok == nil || ok.Payload == nil {
+		return nil
+	}
+
+	for _, circuitId := range ok.Payload.Rerouted {
+		if _, err = fmt.Fprintf(o.Out, "rerouted circuit %v\n", circuitId); err != nil {
+			return err
+		}
+	}
+
+	if len(ok.Payload.Rerouted) == 0 && len(ok.Payload.Failed) == 0 {
+		if _, err = fmt.Fprintln(o.Out, "no circuits to reroute"); err != nil {
+			return err
+		}
+	}
+
+	var failed []string
+	for circuitId := range ok.Payload.Failed {
+		failed = append(failed, circuitId)
+	}
+	sort.Strings(failed)
+
+	for _, circuitId := range failed {
+		if _, err = fmt.Fprintf(o.Out, "failed to reroute circuit %v: %v\n", circuitId, ok.Payload.Failed[circuitId]); err != nil {
+			return err
+		}
+	}
+
+	if len(failed) > 0 {
+		return errors.Errorf("%v of %v circuits could not be rerouted", len(failed), len(failed)+len(ok.Payload.Rerouted))
+	}
+
+	return nil
+}
@@ -36,6 +36,7 @@ func NewFabricCmd(p common.OptionsProvider) *cobra.Command {
 	fabricCmd.AddCommand(newDbCmd(p))
 	fabricCmd.AddCommand(newStreamCommand(p))
 	fabricCmd.AddCommand(newRaftCmd(p))
+	fabricCmd.AddCommand(newRerouteCmd(p))
 	fabricCmd.AddCommand(newValidateCommand(p))
 	return fabricCmd
 }
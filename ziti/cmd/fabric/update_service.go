@@ -30,9 +30,14 @@ import (
 
 type updateServiceOptions struct {
 	api.Options
-	name               string
-	terminatorStrategy string
-	tags               map[string]string
+	name                string
+	terminatorStrategy  string
+	routerRoles         []string
+	routerRolesSemantic string
+	excludedLinks       []string
+	maxHops             uint32
+	priorityClass       string
+	tags                map[string]string
 }
 
 func newUpdateServiceCmd(p common.OptionsProvider) *cobra.Command {
@@ -57,6 +62,11 @@ func newUpdateServiceCmd(p common.OptionsProvider) *cobra.Command {
 	cmd.Flags().SetInterspersed(true)
 	cmd.Flags().StringVarP(&options.name, "name", "n", "", "Set the name of the service")
 	cmd.Flags().StringVar(&options.terminatorStrategy, "terminator-strategy", "", "Specifies the terminator strategy for the service")
+	cmd.Flags().StringSliceVar(&options.routerRoles, "router-roles", nil, "Restrict paths for the service to routers matching these roles (#attribute) or ids (@id). Empty to remove the restriction")
+	cmd.Flags().StringVar(&options.routerRolesSemantic, "router-roles-semantic", "", "How router roles are matched, AllOf or AnyOf")
+	cmd.Flags().StringSliceVar(&options.excludedLinks, "excluded-links", nil, "Links which paths for the service may not use, as link ids or router id pairs in the form routerId:routerId")
+	cmd.Flags().Uint32Var(&options.maxHops, "max-hops", 0, "Maximum number of router to router hops in paths for the service. 0 means no limit")
+	cmd.Flags().StringVar(&options.priorityClass, "priority-class", "", "Priority class used to schedule the service's payloads on links: realtime, interactive, standard or bulk")
 	cmd.Flags().StringToStringVar(&options.tags, "tags", nil, "Custom management tags")
 	options.AddCommonFlags(cmd)
 
@@ -82,6 +92,31 @@ func runUpdateService(o *updateServiceOptions) error {
 		change = true
 	}
 
+	if o.Cmd.Flags().Changed("router-roles") {
+		api.SetJSONValue(entityData, o.routerRoles, "routerRoles")
+		change = true
+	}
+
+	if o.Cmd.Flags().Changed("router-roles-semantic") {
+		api.SetJSONValue(entityData, o.routerRolesSemantic, "routerRolesSemantic")
+		change = true
+	}
+
+	if o.Cmd.Flags().Changed("excluded-links") {
+		api.SetJSONValue(entityData, o.excludedLinks, "excludedLinks")
+		change = true
+	}
+
+	if o.Cmd.Flags().Changed("max-hops") {
+		api.SetJSONValue(entityData, o.maxHops, "maxHops")
+		change = true
+	}
+
+	if o.Cmd.Flags().Changed("priority-class") {
+		api.SetJSONValue(entityData, o.priorityClass, "priorityClass")
+		change = true
+	}
+
 	if o.Cmd.Flags().Changed("tags") {
 		api.SetJSONValue(entityData, o.tags, "tags")
 		change = true